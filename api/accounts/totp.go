@@ -0,0 +1,163 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// TotpEnrollment is an unconfirmed TOTP enrollment of a password account.
+type TotpEnrollment struct {
+	// Secret is the base32 encoded TOTP secret.
+	Secret string `json:"secret,omitempty"`
+	// Url is the otpauth URI of the secret, which can be rendered as a QR
+	// code for authenticator applications.
+	Url string `json:"url,omitempty"`
+}
+
+type EnrollTotpResult struct {
+	Item     *TotpEnrollment
+	response *api.Response
+}
+
+func (n EnrollTotpResult) GetItem() interface{} {
+	return n.Item
+}
+
+func (n EnrollTotpResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// TotpRecoveryCodes are the single use recovery codes of a password account,
+// which can be used instead of a TOTP code.
+type TotpRecoveryCodes struct {
+	RecoveryCodes []string `json:"recovery_codes,omitempty"`
+}
+
+type ConfirmTotpResult struct {
+	Item     *TotpRecoveryCodes
+	response *api.Response
+}
+
+func (n ConfirmTotpResult) GetItem() interface{} {
+	return n.Item
+}
+
+func (n ConfirmTotpResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// EnrollTotp starts enrolling the password account with the given ID in TOTP
+// multi-factor authentication. The enrollment must be confirmed with
+// ConfirmTotp.
+func (c *Client) EnrollTotp(ctx context.Context, accountId string, opt ...Option) (*EnrollTotpResult, error) {
+	if accountId == "" {
+		return nil, fmt.Errorf("empty accountId value passed into EnrollTotp request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in EnrollTotp request")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("accounts/%s:enroll-totp", accountId), nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating EnrollTotp request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during EnrollTotp call: %w", err)
+	}
+
+	target := new(EnrollTotpResult)
+	target.Item = new(TotpEnrollment)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding EnrollTotp response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+// ConfirmTotp confirms the TOTP enrollment of the password account with the
+// given ID using a code from the authenticator application and returns the
+// account's recovery codes.
+func (c *Client) ConfirmTotp(ctx context.Context, accountId, code string, opt ...Option) (*ConfirmTotpResult, error) {
+	if accountId == "" {
+		return nil, fmt.Errorf("empty accountId value passed into ConfirmTotp request")
+	}
+	if code == "" {
+		return nil, fmt.Errorf("empty code value passed into ConfirmTotp request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in ConfirmTotp request")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	reqBody := map[string]interface{}{
+		"code": code,
+	}
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("accounts/%s:confirm-totp", accountId), reqBody, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ConfirmTotp request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ConfirmTotp call: %w", err)
+	}
+
+	target := new(ConfirmTotpResult)
+	target.Item = new(TotpRecoveryCodes)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ConfirmTotp response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+// RemoveTotp removes the TOTP enrollment and recovery codes of the password
+// account with the given ID.
+func (c *Client) RemoveTotp(ctx context.Context, accountId string, opt ...Option) (*AccountUpdateResult, error) {
+	if accountId == "" {
+		return nil, fmt.Errorf("empty accountId value passed into RemoveTotp request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in RemoveTotp request")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("accounts/%s:remove-totp", accountId), nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RemoveTotp request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during RemoveTotp call: %w", err)
+	}
+
+	target := new(AccountUpdateResult)
+	target.Item = new(Account)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding RemoveTotp response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
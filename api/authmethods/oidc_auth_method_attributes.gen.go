@@ -16,5 +16,6 @@ type OidcAuthMethodAttributes struct {
 	ClaimsScopes                      []string `json:"claims_scopes,omitempty"`
 	AccountClaimMaps                  []string `json:"account_claim_maps,omitempty"`
 	DisableDiscoveredConfigValidation bool     `json:"disable_discovered_config_validation,omitempty"`
+	StoreRefreshTokens                bool     `json:"store_refresh_tokens,omitempty"`
 	DryRun                            bool     `json:"dry_run,omitempty"`
 }
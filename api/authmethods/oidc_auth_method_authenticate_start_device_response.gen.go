@@ -0,0 +1,11 @@
+// Code generated by "make api"; DO NOT EDIT.
+package authmethods
+
+type OidcAuthMethodAuthenticateStartDeviceResponse struct {
+	UserCode                string `json:"user_code,omitempty"`
+	VerificationUri         string `json:"verification_uri,omitempty"`
+	VerificationUriComplete string `json:"verification_uri_complete,omitempty"`
+	Interval                uint32 `json:"interval,omitempty"`
+	ExpiresIn               uint32 `json:"expires_in,omitempty"`
+	TokenId                 string `json:"token_id,omitempty"`
+}
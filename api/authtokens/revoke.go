@@ -0,0 +1,125 @@
+package authtokens
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// RevokedAuthTokens is the result of revoking the auth tokens of a user.
+type RevokedAuthTokens struct {
+	RevokedCount uint32 `json:"revoked_count,omitempty"`
+}
+
+type RevokeAllForUserResult struct {
+	Item     *RevokedAuthTokens
+	response *api.Response
+}
+
+func (n RevokeAllForUserResult) GetItem() interface{} {
+	return n.Item
+}
+
+func (n RevokeAllForUserResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// RevokeAllForUser revokes all of the auth tokens issued to the user with the
+// given ID.
+func (c *Client) RevokeAllForUser(ctx context.Context, userId string, opt ...Option) (*RevokeAllForUserResult, error) {
+	if userId == "" {
+		return nil, fmt.Errorf("empty userId value passed into RevokeAllForUser request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in RevokeAllForUser request")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	reqBody := map[string]interface{}{
+		"user_id": userId,
+	}
+
+	req, err := c.client.NewRequest(ctx, "POST", "auth-tokens:revoke-all-for-user", reqBody, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RevokeAllForUser request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during RevokeAllForUser call: %w", err)
+	}
+
+	target := new(RevokeAllForUserResult)
+	target.Item = new(RevokedAuthTokens)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding RevokeAllForUser response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+// Introspection describes an auth token. Item and Principals are only set if
+// the token is active.
+type Introspection struct {
+	Active     bool       `json:"active,omitempty"`
+	Item       *AuthToken `json:"item,omitempty"`
+	Principals []string   `json:"principals,omitempty"`
+}
+
+type IntrospectResult struct {
+	Item     *Introspection
+	response *api.Response
+}
+
+func (n IntrospectResult) GetItem() interface{} {
+	return n.Item
+}
+
+func (n IntrospectResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// Introspect returns whether the given token is valid along with its
+// expiration and the principals of the user it was issued to.
+func (c *Client) Introspect(ctx context.Context, token string, opt ...Option) (*IntrospectResult, error) {
+	if token == "" {
+		return nil, fmt.Errorf("empty token value passed into Introspect request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in Introspect request")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	reqBody := map[string]interface{}{
+		"token": token,
+	}
+
+	req, err := c.client.NewRequest(ctx, "POST", "auth-tokens:introspect", reqBody, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Introspect request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during Introspect call: %w", err)
+	}
+
+	target := new(IntrospectResult)
+	target.Item = new(Introspection)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding Introspect response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
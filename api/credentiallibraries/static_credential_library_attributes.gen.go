@@ -0,0 +1,11 @@
+// Code generated by "make api"; DO NOT EDIT.
+package credentiallibraries
+
+type StaticCredentialLibraryAttributes struct {
+	CredentialType string                 `json:"credential_type,omitempty"`
+	Username       string                 `json:"username,omitempty"`
+	Password       string                 `json:"password,omitempty"`
+	PrivateKey     string                 `json:"private_key,omitempty"`
+	Object         map[string]interface{} `json:"object,omitempty"`
+	SecretHmac     string                 `json:"secret_hmac,omitempty"`
+}
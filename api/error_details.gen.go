@@ -2,6 +2,7 @@
 package api
 
 type ErrorDetails struct {
-	RequestFields []*FieldError   `json:"request_fields,omitempty"`
-	WrappedErrors []*WrappedError `json:"wrapped_errors,omitempty"`
+	RequestFields []*FieldError        `json:"request_fields,omitempty"`
+	WrappedErrors []*WrappedError      `json:"wrapped_errors,omitempty"`
+	SessionLimit  *SessionLimitDetails `json:"session_limit,omitempty"`
 }
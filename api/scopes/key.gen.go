@@ -0,0 +1,9 @@
+// Code generated by "make api"; DO NOT EDIT.
+package scopes
+
+type Key struct {
+	Id       string        `json:"id,omitempty"`
+	ScopeId  string        `json:"scope_id,omitempty"`
+	Purpose  string        `json:"purpose,omitempty"`
+	Versions []*KeyVersion `json:"versions,omitempty"`
+}
@@ -0,0 +1,139 @@
+package scopes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+type KeyListResult struct {
+	Items    []*Key
+	response *api.Response
+}
+
+func (n KeyListResult) GetItems() interface{} {
+	return n.Items
+}
+
+func (n KeyListResult) GetResponse() *api.Response {
+	return n.response
+}
+
+type KeyActionResult struct {
+	response *api.Response
+}
+
+// GetItem will always be nil for KeyActionResult
+func (n KeyActionResult) GetItem() interface{} {
+	return nil
+}
+
+func (n KeyActionResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// ListKeys returns the keys of the scope with the given ID along with their
+// versions, newest first. Key material is never returned.
+func (c *Client) ListKeys(ctx context.Context, scopeId string, opt ...Option) (*KeyListResult, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("empty scopeId value passed into ListKeys request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("scopes/%s:list-keys", scopeId), nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ListKeys request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ListKeys call: %w", err)
+	}
+
+	target := new(KeyListResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ListKeys response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+// RotateKeys creates a new version of the root key and of every key of the
+// scope with the given ID. Existing values are re-encrypted with the new
+// versions in the background.
+func (c *Client) RotateKeys(ctx context.Context, scopeId string, opt ...Option) (*KeyActionResult, error) {
+	return c.keyAction(ctx, "RotateKeys", "rotate-keys", scopeId, nil, opt...)
+}
+
+// DestroyKeyVersion destroys the key version with the given ID belonging to
+// the scope with the given ID. The newest version of a key and versions which
+// are still needed to decrypt values cannot be destroyed.
+func (c *Client) DestroyKeyVersion(ctx context.Context, scopeId, keyVersionId string, opt ...Option) (*KeyActionResult, error) {
+	if keyVersionId == "" {
+		return nil, fmt.Errorf("empty keyVersionId value passed into DestroyKeyVersion request")
+	}
+	return c.keyAction(ctx, "DestroyKeyVersion", "destroy-key-version", scopeId, map[string]interface{}{"key_version_id": keyVersionId}, opt...)
+}
+
+// keyAction performs the key action on the scope with the given ID, adding
+// fields to the request body. name is the name of the calling method used in
+// errors.
+func (c *Client) keyAction(ctx context.Context, name, action, scopeId string, fields map[string]interface{}, opt ...Option) (*KeyActionResult, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("empty scopeId value passed into %s request", name)
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	for k, v := range fields {
+		opts.postMap[k] = v
+	}
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("scopes/%s:%s", scopeId, action), opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s request: %w", name, err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during %s call: %w", name, err)
+	}
+
+	apiErr, err := resp.Decode(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding %s response: %w", name, err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	return &KeyActionResult{response: resp}, nil
+}
@@ -100,6 +100,18 @@ func DefaultDescription() Option {
 	}
 }
 
+func WithMaxConcurrentSessionsPerUser(inMaxConcurrentSessionsPerUser int32) Option {
+	return func(o *options) {
+		o.postMap["max_concurrent_sessions_per_user"] = inMaxConcurrentSessionsPerUser
+	}
+}
+
+func DefaultMaxConcurrentSessionsPerUser() Option {
+	return func(o *options) {
+		o.postMap["max_concurrent_sessions_per_user"] = nil
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
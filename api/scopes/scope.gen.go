@@ -12,18 +12,19 @@ import (
 )
 
 type Scope struct {
-	Id                          string              `json:"id,omitempty"`
-	ScopeId                     string              `json:"scope_id,omitempty"`
-	Scope                       *ScopeInfo          `json:"scope,omitempty"`
-	Name                        string              `json:"name,omitempty"`
-	Description                 string              `json:"description,omitempty"`
-	CreatedTime                 time.Time           `json:"created_time,omitempty"`
-	UpdatedTime                 time.Time           `json:"updated_time,omitempty"`
-	Version                     uint32              `json:"version,omitempty"`
-	Type                        string              `json:"type,omitempty"`
-	PrimaryAuthMethodId         string              `json:"primary_auth_method_id,omitempty"`
-	AuthorizedActions           []string            `json:"authorized_actions,omitempty"`
-	AuthorizedCollectionActions map[string][]string `json:"authorized_collection_actions,omitempty"`
+	Id                           string              `json:"id,omitempty"`
+	ScopeId                      string              `json:"scope_id,omitempty"`
+	Scope                        *ScopeInfo          `json:"scope,omitempty"`
+	Name                         string              `json:"name,omitempty"`
+	Description                  string              `json:"description,omitempty"`
+	CreatedTime                  time.Time           `json:"created_time,omitempty"`
+	UpdatedTime                  time.Time           `json:"updated_time,omitempty"`
+	Version                      uint32              `json:"version,omitempty"`
+	Type                         string              `json:"type,omitempty"`
+	PrimaryAuthMethodId          string              `json:"primary_auth_method_id,omitempty"`
+	MaxConcurrentSessionsPerUser int32               `json:"max_concurrent_sessions_per_user,omitempty"`
+	AuthorizedActions            []string            `json:"authorized_actions,omitempty"`
+	AuthorizedCollectionActions  map[string][]string `json:"authorized_collection_actions,omitempty"`
 
 	response *api.Response
 }
@@ -0,0 +1,9 @@
+// Code generated by "make api"; DO NOT EDIT.
+package api
+
+type SessionHolder struct {
+	SessionId string `json:"session_id,omitempty"`
+	UserId    string `json:"user_id,omitempty"`
+	TargetId  string `json:"target_id,omitempty"`
+	Status    string `json:"status,omitempty"`
+}
@@ -0,0 +1,9 @@
+// Code generated by "make api"; DO NOT EDIT.
+package api
+
+type SessionLimitDetails struct {
+	Kind       string           `json:"kind,omitempty"`
+	ResourceId string           `json:"resource_id,omitempty"`
+	Limit      int32            `json:"limit,omitempty"`
+	Holders    []*SessionHolder `json:"holders,omitempty"`
+}
@@ -0,0 +1,23 @@
+// Code generated by "make api"; DO NOT EDIT.
+package sessions
+
+import (
+	"time"
+)
+
+type Connection struct {
+	PublicId           string    `json:"public_id,omitempty"`
+	SessionId          string    `json:"session_id,omitempty"`
+	ClientTcpAddress   string    `json:"client_tcp_address,omitempty"`
+	ClientTcpPort      uint32    `json:"client_tcp_port,omitempty"`
+	EndpointTcpAddress string    `json:"endpoint_tcp_address,omitempty"`
+	EndpointTcpPort    uint32    `json:"endpoint_tcp_port,omitempty"`
+	BytesUp            uint64    `json:"bytes_up,omitempty,string"`
+	BytesDown          uint64    `json:"bytes_down,omitempty,string"`
+	ClosedReason       string    `json:"closed_reason,omitempty"`
+	RecordingId        string    `json:"recording_id,omitempty"`
+	CreatedTime        time.Time `json:"created_time,omitempty"`
+	ClosedTime         time.Time `json:"closed_time,omitempty"`
+	DurationSeconds    uint32    `json:"duration_seconds,omitempty"`
+	WorkerChain        []string  `json:"worker_chain,omitempty"`
+}
@@ -23,6 +23,9 @@ type options struct {
 	withSkipCurlOutput      bool
 	withFilter              string
 	withRecursive           bool
+	withPageSize            uint32
+	withListToken           string
+	withSkipAutoPagination  bool
 }
 
 func getDefaultOptions() options {
@@ -49,6 +52,12 @@ func getOpts(opt ...Option) (options, []api.Option) {
 	if opts.withRecursive {
 		opts.queryMap["recursive"] = strconv.FormatBool(opts.withRecursive)
 	}
+	if opts.withPageSize != 0 {
+		opts.queryMap["page_size"] = strconv.FormatUint(uint64(opts.withPageSize), 10)
+	}
+	if opts.withListToken != "" {
+		opts.queryMap["list_token"] = opts.withListToken
+	}
 	return opts, apiOpts
 }
 
@@ -86,3 +95,29 @@ func WithRecursive(recurse bool) Option {
 		o.withRecursive = true
 	}
 }
+
+// WithPageSize tells the API to return at most the given number of items per
+// page when listing this resource. Unless WithSkipAutoPagination is used,
+// every page is still read and the full listing returned.
+func WithPageSize(size uint32) Option {
+	return func(o *options) {
+		o.withPageSize = size
+	}
+}
+
+// WithListToken tells the API to resume a paginated listing from the list
+// token returned with a previous page.
+func WithListToken(token string) Option {
+	return func(o *options) {
+		o.withListToken = token
+	}
+}
+
+// WithSkipAutoPagination tells the client to return only the first page of a
+// paginated listing along with the list token for the next page, instead of
+// reading every page.
+func WithSkipAutoPagination(skip bool) Option {
+	return func(o *options) {
+		o.withSkipAutoPagination = skip
+	}
+}
@@ -0,0 +1,76 @@
+package sessions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+type SessionRecordingReadResult struct {
+	Item     *SessionRecording
+	response *api.Response
+}
+
+func (n SessionRecordingReadResult) GetItem() interface{} {
+	return n.Item
+}
+
+func (n SessionRecordingReadResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// ReadRecording returns a page of the recording of the connection with the
+// given ID belonging to the session with the given ID, starting with the chunk
+// at the given offset. The offset of the next page is returned in the
+// recording's NextOffset, which is zero once the whole recording has been read.
+func (c *Client) ReadRecording(ctx context.Context, sessionId, connectionId string, offset uint32, opt ...Option) (*SessionRecordingReadResult, error) {
+	if sessionId == "" {
+		return nil, fmt.Errorf("empty sessionId value passed into ReadRecording request")
+	}
+	if connectionId == "" {
+		return nil, fmt.Errorf("empty connectionId value passed into ReadRecording request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	opts.queryMap["connection_id"] = connectionId
+	if offset > 0 {
+		opts.queryMap["offset"] = strconv.FormatUint(uint64(offset), 10)
+	}
+
+	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("sessions/%s:read-recording", sessionId), nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ReadRecording request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ReadRecording call: %w", err)
+	}
+
+	target := new(SessionRecordingReadResult)
+	target.Item = new(SessionRecording)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ReadRecording response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
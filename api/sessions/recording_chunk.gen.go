@@ -0,0 +1,12 @@
+// Code generated by "make api"; DO NOT EDIT.
+package sessions
+
+import (
+	"time"
+)
+
+type RecordingChunk struct {
+	Time      time.Time `json:"time,omitempty"`
+	Direction string    `json:"direction,omitempty"`
+	Data      []byte    `json:"data,omitempty"`
+}
@@ -31,7 +31,11 @@ type Session struct {
 	WorkerInfo        []*WorkerInfo     `json:"worker_info,omitempty"`
 	Certificate       []byte            `json:"certificate,omitempty"`
 	TerminationReason string            `json:"termination_reason,omitempty"`
+	BytesUp           uint64            `json:"bytes_up,omitempty,string"`
+	BytesDown         uint64            `json:"bytes_down,omitempty,string"`
+	DurationSeconds   uint32            `json:"duration_seconds,omitempty"`
 	AuthorizedActions []string          `json:"authorized_actions,omitempty"`
+	Connections       []*Connection     `json:"connections,omitempty"`
 
 	response *api.Response
 }
@@ -68,8 +72,11 @@ func (n SessionDeleteResult) GetResponse() *api.Response {
 }
 
 type SessionListResult struct {
-	Items    []*Session
-	response *api.Response
+	Items []*Session
+	// ListToken is set when more items remain to be listed. It is only set
+	// when automatic pagination is skipped.
+	ListToken string `json:"list_token,omitempty"`
+	response  *api.Response
 }
 
 func (n SessionListResult) GetItems() interface{} {
@@ -150,32 +157,42 @@ func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*Sess
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["scope_id"] = scopeId
 
-	req, err := c.client.NewRequest(ctx, "GET", "sessions", nil, apiOpts...)
-	if err != nil {
-		return nil, fmt.Errorf("error creating List request: %w", err)
-	}
+	// Unless told otherwise, follow the list token returned with each page
+	// until the listing is complete. Items holds the items of every page, but
+	// the returned response is the one of the last page read, so its body only
+	// holds the items of that page.
+	target := new(SessionListResult)
+	for {
+		req, err := c.client.NewRequest(ctx, "GET", "sessions", nil, apiOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("error creating List request: %w", err)
+		}
 
-	if len(opts.queryMap) > 0 {
 		q := url.Values{}
 		for k, v := range opts.queryMap {
 			q.Add(k, v)
 		}
 		req.URL.RawQuery = q.Encode()
-	}
 
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("error performing client request during List call: %w", err)
-	}
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("error performing client request during List call: %w", err)
+		}
 
-	target := new(SessionListResult)
-	apiErr, err := resp.Decode(target)
-	if err != nil {
-		return nil, fmt.Errorf("error decoding List response: %w", err)
-	}
-	if apiErr != nil {
-		return nil, apiErr
+		page := new(SessionListResult)
+		apiErr, err := resp.Decode(page)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding List response: %w", err)
+		}
+		if apiErr != nil {
+			return nil, apiErr
+		}
+		target.Items = append(target.Items, page.Items...)
+		target.ListToken = page.ListToken
+		target.response = resp
+		if opts.withSkipAutoPagination || page.ListToken == "" {
+			return target, nil
+		}
+		opts.queryMap["list_token"] = page.ListToken
 	}
-	target.response = resp
-	return target, nil
 }
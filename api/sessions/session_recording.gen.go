@@ -0,0 +1,11 @@
+// Code generated by "make api"; DO NOT EDIT.
+package sessions
+
+type SessionRecording struct {
+	Id           string            `json:"id,omitempty"`
+	SessionId    string            `json:"session_id,omitempty"`
+	ConnectionId string            `json:"connection_id,omitempty"`
+	Chunks       []*RecordingChunk `json:"chunks,omitempty"`
+	Offset       uint32            `json:"offset,omitempty"`
+	NextOffset   uint32            `json:"next_offset,omitempty"`
+}
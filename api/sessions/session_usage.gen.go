@@ -0,0 +1,12 @@
+// Code generated by "make api"; DO NOT EDIT.
+package sessions
+
+type SessionUsage struct {
+	UserId          string `json:"user_id,omitempty"`
+	TargetId        string `json:"target_id,omitempty"`
+	ScopeId         string `json:"scope_id,omitempty"`
+	SessionCount    uint32 `json:"session_count,omitempty"`
+	ConnectionCount uint32 `json:"connection_count,omitempty"`
+	BytesUp         uint64 `json:"bytes_up,omitempty,string"`
+	BytesDown       uint64 `json:"bytes_down,omitempty,string"`
+}
@@ -6,7 +6,8 @@ import (
 )
 
 type SessionState struct {
-	Status    string    `json:"status,omitempty"`
-	StartTime time.Time `json:"start_time,omitempty"`
-	EndTime   time.Time `json:"end_time,omitempty"`
+	Status     string    `json:"status,omitempty"`
+	StartTime  time.Time `json:"start_time,omitempty"`
+	EndTime    time.Time `json:"end_time,omitempty"`
+	ApproverId string    `json:"approver_id,omitempty"`
 }
@@ -0,0 +1,74 @@
+package sessions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+type SessionUsageResult struct {
+	Items    []*SessionUsage
+	response *api.Response
+}
+
+func (n SessionUsageResult) GetItems() interface{} {
+	return n.Items
+}
+
+func (n SessionUsageResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// Usage returns the connections and bytes proxied by the sessions in the
+// scope with the given ID, totalled per user and target. Only sessions created
+// at or after startTime and before endTime are included; a zero startTime or
+// endTime leaves that end of the range open. WithRecursive includes the
+// sessions of child scopes.
+func (c *Client) Usage(ctx context.Context, scopeId string, startTime, endTime time.Time, opt ...Option) (*SessionUsageResult, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("empty scopeId value passed into Usage request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	opts.queryMap["scope_id"] = scopeId
+	if !startTime.IsZero() {
+		opts.queryMap["start_time"] = startTime.UTC().Format(time.RFC3339Nano)
+	}
+	if !endTime.IsZero() {
+		opts.queryMap["end_time"] = endTime.UTC().Format(time.RFC3339Nano)
+	}
+
+	req, err := c.client.NewRequest(ctx, "GET", "sessions:usage", nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Usage request: %w", err)
+	}
+
+	q := url.Values{}
+	for k, v := range opts.queryMap {
+		q.Add(k, v)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.client.Do(req, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during Usage call: %w", err)
+	}
+
+	target := new(SessionUsageResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding Usage response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
@@ -23,6 +23,9 @@ type options struct {
 	withSkipCurlOutput      bool
 	withFilter              string
 	withRecursive           bool
+	withPageSize            uint32
+	withListToken           string
+	withSkipAutoPagination  bool
 }
 
 func getDefaultOptions() options {
@@ -49,6 +52,12 @@ func getOpts(opt ...Option) (options, []api.Option) {
 	if opts.withRecursive {
 		opts.queryMap["recursive"] = strconv.FormatBool(opts.withRecursive)
 	}
+	if opts.withPageSize != 0 {
+		opts.queryMap["page_size"] = strconv.FormatUint(uint64(opts.withPageSize), 10)
+	}
+	if opts.withListToken != "" {
+		opts.queryMap["list_token"] = opts.withListToken
+	}
 	return opts, apiOpts
 }
 
@@ -87,6 +96,32 @@ func WithRecursive(recurse bool) Option {
 	}
 }
 
+// WithPageSize tells the API to return at most the given number of items per
+// page when listing this resource. Unless WithSkipAutoPagination is used,
+// every page is still read and the full listing returned.
+func WithPageSize(size uint32) Option {
+	return func(o *options) {
+		o.withPageSize = size
+	}
+}
+
+// WithListToken tells the API to resume a paginated listing from the list
+// token returned with a previous page.
+func WithListToken(token string) Option {
+	return func(o *options) {
+		o.withListToken = token
+	}
+}
+
+// WithSkipAutoPagination tells the client to return only the first page of a
+// paginated listing along with the list token for the next page, instead of
+// reading every page.
+func WithSkipAutoPagination(skip bool) Option {
+	return func(o *options) {
+		o.withSkipAutoPagination = skip
+	}
+}
+
 func WithApplicationCredentialLibraryIds(inApplicationCredentialLibraryIds []string) Option {
 	return func(o *options) {
 		o.postMap["application_credential_library_ids"] = inApplicationCredentialLibraryIds
@@ -111,6 +146,30 @@ func DefaultApplicationCredentialSourceIds() Option {
 	}
 }
 
+func WithApprovalRequired(inApprovalRequired bool) Option {
+	return func(o *options) {
+		o.postMap["approval_required"] = inApprovalRequired
+	}
+}
+
+func DefaultApprovalRequired() Option {
+	return func(o *options) {
+		o.postMap["approval_required"] = nil
+	}
+}
+
+func WithApproverIds(inApproverIds []string) Option {
+	return func(o *options) {
+		o.postMap["approver_ids"] = inApproverIds
+	}
+}
+
+func DefaultApproverIds() Option {
+	return func(o *options) {
+		o.postMap["approver_ids"] = nil
+	}
+}
+
 func WithAttributes(inAttributes map[string]interface{}) Option {
 	return func(o *options) {
 		o.postMap["attributes"] = inAttributes
@@ -123,6 +182,54 @@ func DefaultAttributes() Option {
 	}
 }
 
+func WithPostgresTargetDefaultPort(inDefaultPort uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_port"] = inDefaultPort
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPostgresTargetDefaultPort() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_port"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithSshTargetDefaultPort(inDefaultPort uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_port"] = inDefaultPort
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSshTargetDefaultPort() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_port"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithTcpTargetDefaultPort(inDefaultPort uint32) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -177,6 +284,54 @@ func WithHostId(inHostId string) Option {
 	}
 }
 
+func WithSshTargetHostKeys(inHostKeys []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["host_keys"] = inHostKeys
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSshTargetHostKeys() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["host_keys"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithIngressWorkerFilter(inIngressWorkerFilter string) Option {
+	return func(o *options) {
+		o.postMap["ingress_worker_filter"] = inIngressWorkerFilter
+	}
+}
+
+func DefaultIngressWorkerFilter() Option {
+	return func(o *options) {
+		o.postMap["ingress_worker_filter"] = nil
+	}
+}
+
+func WithMaxConcurrentSessions(inMaxConcurrentSessions int32) Option {
+	return func(o *options) {
+		o.postMap["max_concurrent_sessions"] = inMaxConcurrentSessions
+	}
+}
+
+func DefaultMaxConcurrentSessions() Option {
+	return func(o *options) {
+		o.postMap["max_concurrent_sessions"] = nil
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
@@ -0,0 +1,7 @@
+// Code generated by "make api"; DO NOT EDIT.
+package targets
+
+type SshTargetAttributes struct {
+	DefaultPort uint32   `json:"default_port,omitempty"`
+	HostKeys    []string `json:"host_keys,omitempty"`
+}
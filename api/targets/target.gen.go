@@ -29,6 +29,10 @@ type Target struct {
 	SessionMaxSeconds               uint32                 `json:"session_max_seconds,omitempty"`
 	SessionConnectionLimit          int32                  `json:"session_connection_limit,omitempty"`
 	WorkerFilter                    string                 `json:"worker_filter,omitempty"`
+	ApprovalRequired                bool                   `json:"approval_required,omitempty"`
+	ApproverIds                     []string               `json:"approver_ids,omitempty"`
+	MaxConcurrentSessions           int32                  `json:"max_concurrent_sessions,omitempty"`
+	IngressWorkerFilter             string                 `json:"ingress_worker_filter,omitempty"`
 	ApplicationCredentialLibraryIds []string               `json:"application_credential_library_ids,omitempty"`
 	ApplicationCredentialLibraries  []*CredentialLibrary   `json:"application_credential_libraries,omitempty"`
 	ApplicationCredentialSourceIds  []string               `json:"application_credential_source_ids,omitempty"`
@@ -73,8 +77,11 @@ func (n TargetDeleteResult) GetResponse() *api.Response {
 }
 
 type TargetListResult struct {
-	Items    []*Target
-	response *api.Response
+	Items []*Target
+	// ListToken is set when more items remain to be listed. It is only set
+	// when automatic pagination is skipped.
+	ListToken string `json:"list_token,omitempty"`
+	response  *api.Response
 }
 
 func (n TargetListResult) GetItems() interface{} {
@@ -309,34 +316,44 @@ func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*Targ
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["scope_id"] = scopeId
 
-	req, err := c.client.NewRequest(ctx, "GET", "targets", nil, apiOpts...)
-	if err != nil {
-		return nil, fmt.Errorf("error creating List request: %w", err)
-	}
+	// Unless told otherwise, follow the list token returned with each page
+	// until the listing is complete. Items holds the items of every page, but
+	// the returned response is the one of the last page read, so its body only
+	// holds the items of that page.
+	target := new(TargetListResult)
+	for {
+		req, err := c.client.NewRequest(ctx, "GET", "targets", nil, apiOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("error creating List request: %w", err)
+		}
 
-	if len(opts.queryMap) > 0 {
 		q := url.Values{}
 		for k, v := range opts.queryMap {
 			q.Add(k, v)
 		}
 		req.URL.RawQuery = q.Encode()
-	}
 
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("error performing client request during List call: %w", err)
-	}
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("error performing client request during List call: %w", err)
+		}
 
-	target := new(TargetListResult)
-	apiErr, err := resp.Decode(target)
-	if err != nil {
-		return nil, fmt.Errorf("error decoding List response: %w", err)
-	}
-	if apiErr != nil {
-		return nil, apiErr
+		page := new(TargetListResult)
+		apiErr, err := resp.Decode(page)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding List response: %w", err)
+		}
+		if apiErr != nil {
+			return nil, apiErr
+		}
+		target.Items = append(target.Items, page.Items...)
+		target.ListToken = page.ListToken
+		target.response = resp
+		if opts.withSkipAutoPagination || page.ListToken == "" {
+			return target, nil
+		}
+		opts.queryMap["list_token"] = page.ListToken
 	}
-	target.response = resp
-	return target, nil
 }
 
 func (c *Client) AddCredentialLibraries(ctx context.Context, id string, version uint32, opt ...Option) (*TargetUpdateResult, error) {
@@ -99,6 +99,18 @@ func DefaultDescription() Option {
 	}
 }
 
+func WithDisabled(inDisabled bool) Option {
+	return func(o *options) {
+		o.postMap["disabled"] = inDisabled
+	}
+}
+
+func DefaultDisabled() Option {
+	return func(o *options) {
+		o.postMap["disabled"] = nil
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
@@ -4,4 +4,12 @@ import (
 	// Enable tcp target support.
 	_ "github.com/hashicorp/boundary/internal/servers/controller/handlers/targets/tcp"
 	_ "github.com/hashicorp/boundary/internal/target/tcp"
+
+	// Enable ssh target support.
+	_ "github.com/hashicorp/boundary/internal/servers/controller/handlers/targets/ssh"
+	_ "github.com/hashicorp/boundary/internal/target/ssh"
+
+	// Enable postgres target support.
+	_ "github.com/hashicorp/boundary/internal/servers/controller/handlers/targets/postgres"
+	_ "github.com/hashicorp/boundary/internal/target/postgres"
 )
@@ -45,12 +45,18 @@ const (
 	SessionConnectionLimitField          = "session_connection_limit"
 	SessionMaxSecondsField               = "session_max_seconds"
 	WorkerFilterField                    = "worker_filter"
+	IngressWorkerFilterField             = "ingress_worker_filter"
+	ApprovalRequiredField                = "approval_required"
+	ApproverIdsField                     = "approver_ids"
+	MaxConcurrentSessionsField           = "max_concurrent_sessions"
+	MaxConcurrentSessionsPerUserField    = "max_concurrent_sessions_per_user"
 	AccountIdsField                      = "account_ids"
 	AccountsField                        = "accounts"
 	LoginNameField                       = "login_name"
 	FullNameField                        = "full_name"
 	PrimaryAccountIdField                = "primary_account_id"
 	EmailField                           = "email"
+	DisabledField                        = "disabled"
 	ManagedGroupIdsField                 = "managed_group_ids"
 	FilterField                          = "filter"
 	CredentialStoreIdField               = "credential_store_id"
@@ -70,4 +76,15 @@ const (
 	EgressCredentialSourceIdsField       = "egress_credential_source_ids"
 	EgressCredentialSourcesField         = "egress_credential_sources"
 	ConnectionsField                     = "connections"
+	PageSizeField                        = "page_size"
+	ListTokenField                       = "list_token"
+	AddressField                         = "address"
+	LastStatusTimeField                  = "last_status_time"
+	ActiveSessionCountField              = "active_session_count"
+	ConfigTagsField                      = "config_tags"
+	PluginVersionField                   = "plugin_version"
+	CatalogAttributesField               = "catalog_attributes"
+	BytesUpField                         = "bytes_up"
+	BytesDownField                       = "bytes_down"
+	DurationSecondsField                 = "duration_seconds"
 )
@@ -5,6 +5,13 @@ import "time"
 const (
 	TcpProxyV1     = "boundary-tcp-proxy-v1"
 	ServiceTokenV1 = "s1"
+
+	// ReverseTunnelV1 is the ALPN protocol reverse workers use to open the
+	// tunnel a controller relays connections to them over
+	ReverseTunnelV1 = "boundary-reverse-tunnel-v1"
+	// ReverseRelayV1 is the ALPN protocol workers use to ask a controller to
+	// relay a connection to a reverse worker
+	ReverseRelayV1 = "boundary-reverse-relay-v1"
 )
 
 type (
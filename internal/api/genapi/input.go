@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/sessions"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/users"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/workers"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -38,6 +39,7 @@ type fieldInfo struct {
 	Name              string
 	ProtoName         string
 	FieldType         string
+	JsonString        bool
 	GenerateSdkOption bool
 	SubtypeNames      []string
 	Query             bool
@@ -95,6 +97,10 @@ type structInfo struct {
 	// listing
 	recursiveListing bool
 
+	// paginatedListing indicates that the collection can be listed in pages
+	// using a page size and list token
+	paginatedListing bool
+
 	// extraFields allows specifying extra options that will be created for a
 	// given type, e.g. arguments only valid for one call or purpose and not
 	// conveyed within the item itself
@@ -133,6 +139,16 @@ var inputStructs = []*structInfo{
 		outFile:     "field_error.gen.go",
 		skipOptions: true,
 	},
+	{
+		inProto:     &api.SessionLimitDetails{},
+		outFile:     "session_limit_details.gen.go",
+		skipOptions: true,
+	},
+	{
+		inProto:     &api.SessionHolder{},
+		outFile:     "session_holder.gen.go",
+		skipOptions: true,
+	},
 	// Scope related resources
 	{
 		inProto:     &scopes.ScopeInfo{},
@@ -144,6 +160,26 @@ var inputStructs = []*structInfo{
 		outFile:     "plugins/plugin_info.gen.go",
 		skipOptions: true,
 	},
+	{
+		inProto: &plugins.Plugin{},
+		outFile: "plugins/plugin.gen.go",
+		templates: []*template.Template{
+			clientTemplate,
+			readTemplate,
+			listTemplate,
+		},
+		pluralResourceName:  "plugins",
+		createResponseTypes: true,
+		recursiveListing:    true,
+	},
+	{
+		inProto: &scopes.KeyVersion{},
+		outFile: "scopes/key_version.gen.go",
+	},
+	{
+		inProto: &scopes.Key{},
+		outFile: "scopes/key.gen.go",
+	},
 	{
 		inProto: &scopes.Scope{},
 		outFile: "scopes/scope.gen.go",
@@ -267,6 +303,14 @@ var inputStructs = []*structInfo{
 				VarName:   "grantStrings",
 			},
 		},
+		extraFields: []fieldInfo{
+			{
+				Name:        "ExpirationTime",
+				ProtoName:   "expiration_time",
+				FieldType:   "time.Time",
+				SkipDefault: true,
+			},
+		},
 		pluralResourceName:  "roles",
 		versionEnabled:      true,
 		createResponseTypes: true,
@@ -283,11 +327,21 @@ var inputStructs = []*structInfo{
 		outFile:     "authmethods/oidc_auth_method_attributes.gen.go",
 		subtypeName: "OidcAuthMethod",
 	},
+	{
+		inProto:     &authmethods.LdapAuthMethodAttributes{},
+		outFile:     "authmethods/ldap_auth_method_attributes.gen.go",
+		subtypeName: "LdapAuthMethod",
+	},
 	{
 		inProto:     &authmethods.OidcAuthMethodAuthenticateStartResponse{},
 		outFile:     "authmethods/oidc_auth_method_authenticate_start_response.gen.go",
 		subtypeName: "OidcAuthMethod",
 	},
+	{
+		inProto:     &authmethods.OidcAuthMethodAuthenticateStartDeviceResponse{},
+		outFile:     "authmethods/oidc_auth_method_authenticate_start_device_response.gen.go",
+		subtypeName: "OidcAuthMethod",
+	},
 	{
 		inProto: &authmethods.AuthMethod{},
 		outFile: "authmethods/authmethods.gen.go",
@@ -316,6 +370,11 @@ var inputStructs = []*structInfo{
 		outFile:     "accounts/oidc_account_attributes.gen.go",
 		subtypeName: "OidcAccount",
 	},
+	{
+		inProto:     &accounts.LdapAccountAttributes{},
+		outFile:     "accounts/ldap_account_attributes.gen.go",
+		subtypeName: "LdapAccount",
+	},
 	{
 		inProto: &accounts.Account{},
 		outFile: "accounts/account.gen.go",
@@ -344,6 +403,17 @@ var inputStructs = []*structInfo{
 			},
 		},
 	},
+	{
+		inProto:     &managedgroups.LdapManagedGroupAttributes{},
+		outFile:     "managedgroups/ldap_managed_group_attributes.gen.go",
+		subtypeName: "LdapManagedGroup",
+		fieldOverrides: []fieldInfo{
+			{
+				Name:        "GroupNames",
+				SkipDefault: true,
+			},
+		},
+	},
 	{
 		inProto: &managedgroups.ManagedGroup{},
 		outFile: "managedgroups/managedgroups.gen.go",
@@ -419,6 +489,11 @@ var inputStructs = []*structInfo{
 			},
 		},
 	},
+	{
+		inProto:     &credentiallibraries.StaticCredentialLibraryAttributes{},
+		outFile:     "credentiallibraries/static_credential_library_attributes.gen.go",
+		subtypeName: "StaticCredentialLibrary",
+	},
 	{
 		inProto: &credentiallibraries.CredentialLibrary{},
 		outFile: "credentiallibraries/credential_library.gen.go",
@@ -617,6 +692,16 @@ var inputStructs = []*structInfo{
 		outFile:     "targets/tcp_target_attributes.gen.go",
 		subtypeName: "TcpTarget",
 	},
+	{
+		inProto:     &targets.SshTargetAttributes{},
+		outFile:     "targets/ssh_target_attributes.gen.go",
+		subtypeName: "SshTarget",
+	},
+	{
+		inProto:     &targets.PostgresTargetAttributes{},
+		outFile:     "targets/postgres_target_attributes.gen.go",
+		subtypeName: "PostgresTarget",
+	},
 	{
 		inProto: &targets.Target{},
 		outFile: "targets/target.gen.go",
@@ -680,6 +765,7 @@ var inputStructs = []*structInfo{
 		typeOnCreate:        true,
 		createResponseTypes: true,
 		recursiveListing:    true,
+		paginatedListing:    true,
 	},
 	{
 		inProto: &sessions.SessionState{},
@@ -689,6 +775,22 @@ var inputStructs = []*structInfo{
 		inProto: &sessions.WorkerInfo{},
 		outFile: "sessions/workers.gen.go",
 	},
+	{
+		inProto: &sessions.Connection{},
+		outFile: "sessions/connection.gen.go",
+	},
+	{
+		inProto: &sessions.RecordingChunk{},
+		outFile: "sessions/recording_chunk.gen.go",
+	},
+	{
+		inProto: &sessions.SessionRecording{},
+		outFile: "sessions/session_recording.gen.go",
+	},
+	{
+		inProto: &sessions.SessionUsage{},
+		outFile: "sessions/session_usage.gen.go",
+	},
 	{
 		inProto: &sessions.Session{},
 		outFile: "sessions/session.gen.go",
@@ -701,5 +803,21 @@ var inputStructs = []*structInfo{
 		createResponseTypes: true,
 		fieldFilter:         []string{"private_key"},
 		recursiveListing:    true,
+		paginatedListing:    true,
+	},
+	{
+		inProto: &workers.Worker{},
+		outFile: "workers/worker.gen.go",
+		templates: []*template.Template{
+			clientTemplate,
+			readTemplate,
+			updateTemplate,
+			deleteTemplate,
+			listTemplate,
+		},
+		pluralResourceName:  "workers",
+		versionEnabled:      true,
+		createResponseTypes: true,
+		recursiveListing:    true,
 	},
 }
@@ -66,13 +66,18 @@ func parsePBs() {
 					name = fmt.Sprintf("%s.%s", pkg, name)
 				}
 				switch name {
-				case "v1.AuthorizedCollectionActionsEntry":
+				case "v1.AuthorizedCollectionActionsEntry", "v1.ConfigTagsEntry":
 					fi.FieldType = "map[string][]string"
 				default:
 					fi.FieldType = sliceText + ptr + name
 				}
 			case protoreflect.BytesKind:
 				fi.FieldType = "[]byte"
+			case protoreflect.Int64Kind, protoreflect.Uint64Kind:
+				// 64-bit integers are encoded as strings in the JSON
+				// representation of protobufs.
+				fi.FieldType = sliceText + k.String()
+				fi.JsonString = fd.Cardinality() != protoreflect.Repeated
 			default:
 				fi.FieldType = sliceText + k.String()
 			}
@@ -49,6 +49,7 @@ type templateInput struct {
 	TypeOnCreate          bool
 	CreateResponseTypes   bool
 	RecursiveListing      bool
+	PaginatedListing      bool
 }
 
 func fillTemplates() {
@@ -68,6 +69,7 @@ func fillTemplates() {
 			TypeOnCreate:        in.typeOnCreate,
 			CreateResponseTypes: in.createResponseTypes,
 			RecursiveListing:    in.recursiveListing,
+			PaginatedListing:    in.paginatedListing,
 		}
 		if in.packageOverride != "" {
 			input.Package = in.packageOverride
@@ -189,6 +191,7 @@ func fillTemplates() {
 			Package:          pkg,
 			Fields:           fields,
 			RecursiveListing: inputMap[pkg].recursiveListing,
+			PaginatedListing: inputMap[pkg].paginatedListing,
 		}
 
 		if err := optionTemplate.Execute(outBuf, input); err != nil {
@@ -227,7 +230,47 @@ func (c *Client) List(ctx context.Context, {{ .CollectionFunctionArg }} string,
 
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["{{ snakeCase .CollectionFunctionArg }}"] = {{ .CollectionFunctionArg }}
+{{ if .PaginatedListing }}
+	// Unless told otherwise, follow the list token returned with each page
+	// until the listing is complete. Items holds the items of every page, but
+	// the returned response is the one of the last page read, so its body only
+	// holds the items of that page.
+	target := new({{ .Name }}ListResult)
+	for {
+		req, err := c.client.NewRequest(ctx, "GET", "{{ .CollectionPath }}", nil, apiOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("error creating List request: %w", err)
+		}
+
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("error performing client request during List call: %w", err)
+		}
 
+		page := new({{ .Name }}ListResult)
+		apiErr, err := resp.Decode(page)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding List response: %w", err)
+		}
+		if apiErr != nil {
+			return nil, apiErr
+		}
+		target.Items = append(target.Items, page.Items...)
+		target.ListToken = page.ListToken
+		target.response = resp
+		if opts.withSkipAutoPagination || page.ListToken == "" {
+			return target, nil
+		}
+		opts.queryMap["list_token"] = page.ListToken
+	}
+}
+{{ else }}
 	req, err := c.client.NewRequest(ctx, "GET", "{{ .CollectionPath }}", nil, apiOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("error creating List request: %w", err)
@@ -257,6 +300,7 @@ func (c *Client) List(ctx context.Context, {{ .CollectionFunctionArg }} string,
 	target.response = resp
 	return target, nil
 }
+{{ end }}
 `))
 
 var readTemplate = template.Must(template.New("").Parse(`
@@ -577,7 +621,7 @@ import (
 )
 
 type {{ .Name }} struct { {{ range .Fields }}
-{{ .Name }}  {{ .FieldType }} `, "`json:\"{{ .ProtoName }},omitempty\"`", `{{ end }}
+{{ .Name }}  {{ .FieldType }} `, "`json:\"{{ .ProtoName }},omitempty{{ if .JsonString }},string{{ end }}\"`", `{{ end }}
 {{ if .CreateResponseTypes }}
 	response *api.Response
 {{ else if ( eq .Name "Error" ) }}
@@ -616,7 +660,10 @@ func (n {{ .Name }}DeleteResult) GetResponse() *api.Response {
 }
 
 type {{ .Name }}ListResult struct {
-	Items []*{{ .Name }}
+	Items []*{{ .Name }}{{ if .PaginatedListing }}
+	// ListToken is set when more items remain to be listed. It is only set
+	// when automatic pagination is skipped.
+	ListToken string `, "`json:\"list_token,omitempty\"`", `{{ end }}
 	response *api.Response
 }
 
@@ -680,6 +727,9 @@ type options struct {
 	withSkipCurlOutput bool
 	withFilter string
 	{{ if .RecursiveListing }} withRecursive bool {{ end }}
+	{{ if .PaginatedListing }} withPageSize uint32
+	withListToken string
+	withSkipAutoPagination bool {{ end }}
 }
 
 func getDefaultOptions() options {
@@ -705,6 +755,12 @@ func getOpts(opt ...Option) (options, []api.Option) {
 	}{{ if .RecursiveListing }}
 	if opts.withRecursive {
 		opts.queryMap["recursive"] = strconv.FormatBool(opts.withRecursive)
+	} {{ end }}{{ if .PaginatedListing }}
+	if opts.withPageSize != 0 {
+		opts.queryMap["page_size"] = strconv.FormatUint(uint64(opts.withPageSize), 10)
+	}
+	if opts.withListToken != "" {
+		opts.queryMap["list_token"] = opts.withListToken
 	} {{ end }}
 	return opts, apiOpts
 }
@@ -743,6 +799,32 @@ func WithRecursive(recurse bool) Option {
 		o.withRecursive = true
 	}
 }
+{{ end }}{{ if .PaginatedListing }}
+// WithPageSize tells the API to return at most the given number of items per
+// page when listing this resource. Unless WithSkipAutoPagination is used,
+// every page is still read and the full listing returned.
+func WithPageSize(size uint32) Option {
+	return func(o *options) {
+		o.withPageSize = size
+	}
+}
+
+// WithListToken tells the API to resume a paginated listing from the list
+// token returned with a previous page.
+func WithListToken(token string) Option {
+	return func(o *options) {
+		o.withListToken = token
+	}
+}
+
+// WithSkipAutoPagination tells the client to return only the first page of a
+// paginated listing along with the list token for the next page, instead of
+// reading every page.
+func WithSkipAutoPagination(skip bool) Option {
+	return func(o *options) {
+		o.withSkipAutoPagination = skip
+	}
+}
 {{ end }}
 {{ range $fieldIndex, $field := .Fields }}
 {{ $subtypes := (removeDups $field.SubtypeNames ) }}
@@ -0,0 +1,335 @@
+package ldap
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"text/template"
+
+	goldap "github.com/go-ldap/ldap/v3"
+	"github.com/hashicorp/boundary/internal/auth/ldap/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	ldaplib "github.com/hashicorp/boundary/internal/libs/ldap"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-kms-wrapping/structwrapping"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultAuthMethodTableName defines the default table name for an AuthMethod
+const defaultAuthMethodTableName = "auth_ldap_method"
+
+const (
+	// DefaultUserAttr is the user attribute used when the auth method does
+	// not specify one.
+	DefaultUserAttr = "cn"
+	// DefaultGroupAttr is the group attribute used when the auth method does
+	// not specify one.
+	DefaultGroupAttr = "cn"
+	// DefaultUserFilter is the user filter used when the auth method does not
+	// specify one.
+	DefaultUserFilter = "({{.UserAttr}}={{.Username}})"
+	// DefaultGroupFilter is the group filter used when the auth method does
+	// not specify one.  It finds posix, groupOfNames and groupOfUniqueNames
+	// groups the user is a member of.
+	DefaultGroupFilter = "(|(memberUid={{.Username}})(member={{.UserDN}})(uniqueMember={{.UserDN}}))"
+)
+
+// AuthMethod contains an LDAP auth method configuration. It is owned by a
+// scope.  AuthMethods can have Accounts, ManagedGroups, Urls and
+// Certificates.
+type AuthMethod struct {
+	*store.AuthMethod
+	tableName string
+}
+
+// NewAuthMethod creates a new in memory AuthMethod assigned to scopeId.  At
+// least one url must be provided with WithUrls and a user dn must be provided
+// with WithUserDn.
+//
+// The user and group filters are go templates which are rendered with the
+// fields UserAttr, Username and UserDN.  All values are escaped for use in a
+// filter.  See DefaultUserFilter and DefaultGroupFilter.
+//
+// The bind password provided by WithBindCredential will be encrypted when
+// stored in the database and an hmac representation will also be stored when
+// ever the password changes.  The password is not returned via the API, the
+// hmac is returned so callers can determine if it's been updated.
+//
+// Supports the options of WithName, WithDescription, WithUrls,
+// WithCertificates, WithStartTLS, WithInsecureTLS, WithDiscoverDn,
+// WithAnonGroupSearch, WithEnableGroups, WithUserDn, WithUserAttr,
+// WithUserFilter, WithGroupDn, WithGroupAttr, WithGroupFilter and
+// WithBindCredential and all other options are ignored.
+func NewAuthMethod(ctx context.Context, scopeId string, opt ...Option) (*AuthMethod, error) {
+	const op = "ldap.NewAuthMethod"
+	opts := getOpts(opt...)
+
+	a := &AuthMethod{
+		AuthMethod: &store.AuthMethod{
+			ScopeId:         scopeId,
+			Name:            opts.withName,
+			Description:     opts.withDescription,
+			StartTls:        opts.withStartTls,
+			InsecureTls:     opts.withInsecureTls,
+			DiscoverDn:      opts.withDiscoverDn,
+			AnonGroupSearch: opts.withAnonGroupSearch,
+			EnableGroups:    opts.withEnableGroups,
+			UserDn:          opts.withUserDn,
+			UserAttr:        opts.withUserAttr,
+			UserFilter:      opts.withUserFilter,
+			GroupDn:         opts.withGroupDn,
+			GroupAttr:       opts.withGroupAttr,
+			GroupFilter:     opts.withGroupFilter,
+			BindDn:          opts.withBindDn,
+			BindPassword:    opts.withBindPassword,
+		},
+	}
+	if len(opts.withUrls) > 0 {
+		a.Urls = make([]string, 0, len(opts.withUrls))
+		for _, u := range opts.withUrls {
+			a.Urls = append(a.Urls, u.String())
+		}
+	}
+	if len(opts.withCertificates) > 0 {
+		pems, err := EncodeCertificates(ctx, opts.withCertificates...)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		a.Certificates = pems
+	}
+	if err := a.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped.
+	}
+	if a.BindPasswordHmac != "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "bind password hmac should be empty")
+	}
+	return a, nil
+}
+
+// validate the AuthMethod.  On success, it will return nil.
+func (a *AuthMethod) validate(ctx context.Context, caller errors.Op) error {
+	if a.ScopeId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing scope id")
+	}
+	if len(a.Urls) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing urls")
+	}
+	seen := make(map[string]bool, len(a.Urls))
+	for _, u := range a.Urls {
+		if _, err := ldaplib.ParseURL(u); err != nil {
+			return errors.New(ctx, errors.InvalidParameter, caller, fmt.Sprintf("invalid url %q", u), errors.WithWrap(err))
+		}
+		if seen[u] {
+			return errors.New(ctx, errors.InvalidParameter, caller, fmt.Sprintf("duplicate url %q", u))
+		}
+		seen[u] = true
+	}
+	if a.UserDn == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing user dn")
+	}
+	if (a.BindDn == "") != (a.BindPassword == "") {
+		return errors.New(ctx, errors.InvalidParameter, caller, "bind dn and bind password must be set together")
+	}
+	if _, err := a.userFilter("username"); err != nil {
+		return errors.New(ctx, errors.InvalidParameter, caller, "invalid user filter", errors.WithWrap(err))
+	}
+	if _, err := a.groupFilter("username", "cn=username"); err != nil {
+		return errors.New(ctx, errors.InvalidParameter, caller, "invalid group filter", errors.WithWrap(err))
+	}
+	for _, c := range a.Certificates {
+		if _, err := ParseCertificates(ctx, c); err != nil {
+			return errors.Wrap(ctx, err, caller)
+		}
+	}
+	return nil
+}
+
+// AllocAuthMethod makes an empty one in memory
+func AllocAuthMethod() AuthMethod {
+	return AuthMethod{
+		AuthMethod: &store.AuthMethod{},
+	}
+}
+
+// Clone an AuthMethod.
+func (a *AuthMethod) Clone() *AuthMethod {
+	cp := proto.Clone(a.AuthMethod)
+	return &AuthMethod{
+		AuthMethod: cp.(*store.AuthMethod),
+	}
+}
+
+// TableName returns the table name.
+func (a *AuthMethod) TableName() string {
+	if a.tableName != "" {
+		return a.tableName
+	}
+	return defaultAuthMethodTableName
+}
+
+// SetTableName sets the table name.
+func (a *AuthMethod) SetTableName(n string) {
+	a.tableName = n
+}
+
+// oplog will create oplog metadata for the AuthMethod.
+func (a *AuthMethod) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{a.GetPublicId()},
+		"resource-type":      []string{"ldap auth method"},
+		"op-type":            []string{op.String()},
+		"scope-id":           []string{a.ScopeId},
+	}
+	return metadata
+}
+
+// encrypt the auth method's bind password before writing it to the db.  It is
+// a no-op when the auth method has no bind password.
+func (a *AuthMethod) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "ldap.(AuthMethod).encrypt"
+	if cipher == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing cipher")
+	}
+	if a.BindPassword == "" {
+		a.CtBindPassword, a.BindPasswordHmac, a.KeyId = nil, "", ""
+		return nil
+	}
+	if err := structwrapping.WrapStruct(ctx, cipher, a.AuthMethod, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	a.KeyId = cipher.KeyID()
+	if err := a.hmacBindPassword(ctx, cipher); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// decrypt the auth method's bind password after reading it from the db.  It is
+// a no-op when the auth method has no bind password.
+func (a *AuthMethod) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "ldap.(AuthMethod).decrypt"
+	if cipher == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing cipher")
+	}
+	if len(a.CtBindPassword) == 0 {
+		return nil
+	}
+	if err := structwrapping.UnwrapStruct(ctx, cipher, a.AuthMethod, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
+
+// hmacBindPassword before writing it to the db
+func (a *AuthMethod) hmacBindPassword(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "ldap.(AuthMethod).hmacBindPassword"
+	if cipher == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing cipher")
+	}
+	hm, err := crypto.HmacSha256(ctx, []byte(a.BindPassword), cipher, []byte(a.PublicId), nil, crypto.WithBase64Encoding())
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Code(errors.Encryption)))
+	}
+	a.BindPasswordHmac = hm
+	return nil
+}
+
+// filterData is the data used to render the user and group filter templates.
+type filterData struct {
+	UserAttr string
+	Username string
+	UserDN   string
+}
+
+// userFilter renders the auth method's user filter for username.
+func (a *AuthMethod) userFilter(username string) (string, error) {
+	f := a.UserFilter
+	if f == "" {
+		f = DefaultUserFilter
+	}
+	return renderFilter(f, filterData{
+		UserAttr: a.userAttr(),
+		Username: goldap.EscapeFilter(username),
+	})
+}
+
+// groupFilter renders the auth method's group filter for username and
+// userDn.
+func (a *AuthMethod) groupFilter(username, userDn string) (string, error) {
+	f := a.GroupFilter
+	if f == "" {
+		f = DefaultGroupFilter
+	}
+	return renderFilter(f, filterData{
+		UserAttr: a.userAttr(),
+		Username: goldap.EscapeFilter(username),
+		UserDN:   goldap.EscapeFilter(userDn),
+	})
+}
+
+func (a *AuthMethod) userAttr() string {
+	if a.UserAttr != "" {
+		return a.UserAttr
+	}
+	return DefaultUserAttr
+}
+
+func (a *AuthMethod) groupAttr() string {
+	if a.GroupAttr != "" {
+		return a.GroupAttr
+	}
+	return DefaultGroupAttr
+}
+
+// renderFilter renders the filter template with data and ensures the result
+// is a valid ldap filter.
+func renderFilter(filter string, data filterData) (string, error) {
+	tmpl, err := template.New("filter").Option("missingkey=error").Parse(filter)
+	if err != nil {
+		return "", err
+	}
+	var b bytes.Buffer
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", err
+	}
+	if _, err := goldap.CompileFilter(b.String()); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+type convertedValues struct {
+	Urls  []interface{}
+	Certs []interface{}
+}
+
+// convertValueObjects converts the embedded value objects. It will return an
+// error if the AuthMethod's public id is not set.
+func (a *AuthMethod) convertValueObjects(ctx context.Context) (*convertedValues, error) {
+	const op = "ldap.(AuthMethod).convertValueObjects"
+	if a.PublicId == "" {
+		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	urls := make([]interface{}, 0, len(a.Urls))
+	for i, u := range a.Urls {
+		obj, err := NewUrl(ctx, a.PublicId, i+1, u)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		urls = append(urls, obj)
+	}
+	certs := make([]interface{}, 0, len(a.Certificates))
+	for _, c := range a.Certificates {
+		obj, err := NewCertificate(ctx, a.PublicId, c)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		certs = append(certs, obj)
+	}
+	return &convertedValues{
+		Urls:  urls,
+		Certs: certs,
+	}, nil
+}
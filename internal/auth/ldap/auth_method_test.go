@@ -0,0 +1,137 @@
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAuthMethod(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+	urls := TestConvertToUrls(t, "ldaps://ldap1.example.com", "ldap://ldap2.example.com:1389")
+	tests := []struct {
+		name            string
+		scopeId         string
+		opt             []Option
+		wantErrMatch    *errors.Template
+		wantErrContains string
+	}{
+		{
+			name:    "valid",
+			scopeId: "o_1234567890",
+			opt: []Option{
+				WithName("alice"),
+				WithDescription("alice's ldap"),
+				WithUrls(urls...),
+				WithUserDn("ou=people,dc=example,dc=com"),
+				WithGroupDn("ou=groups,dc=example,dc=com"),
+				WithBindCredential("cn=admin,dc=example,dc=com", "admin-password"),
+				WithStartTLS(),
+				WithEnableGroups(),
+			},
+		},
+		{
+			name:            "missing-scope",
+			opt:             []Option{WithUrls(urls...), WithUserDn("ou=people,dc=example,dc=com")},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "missing scope id",
+		},
+		{
+			name:            "missing-urls",
+			scopeId:         "o_1234567890",
+			opt:             []Option{WithUserDn("ou=people,dc=example,dc=com")},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "missing urls",
+		},
+		{
+			name:            "invalid-url",
+			scopeId:         "o_1234567890",
+			opt:             []Option{WithUrls(TestConvertToUrls(t, "ldap://")...), WithUserDn("ou=people,dc=example,dc=com")},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "invalid url",
+		},
+		{
+			name:            "duplicate-urls",
+			scopeId:         "o_1234567890",
+			opt:             []Option{WithUrls(urls[0], urls[0]), WithUserDn("ou=people,dc=example,dc=com")},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "duplicate url",
+		},
+		{
+			name:            "missing-user-dn",
+			scopeId:         "o_1234567890",
+			opt:             []Option{WithUrls(urls...)},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "missing user dn",
+		},
+		{
+			name:            "bind-dn-without-password",
+			scopeId:         "o_1234567890",
+			opt:             []Option{WithUrls(urls...), WithUserDn("ou=people,dc=example,dc=com"), WithBindCredential("cn=admin", "")},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "bind dn and bind password must be set together",
+		},
+		{
+			name:            "invalid-user-filter",
+			scopeId:         "o_1234567890",
+			opt:             []Option{WithUrls(urls...), WithUserDn("ou=people,dc=example,dc=com"), WithUserFilter("(uid={{.Username}}")},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "invalid user filter",
+		},
+		{
+			name:            "unknown-group-filter-field",
+			scopeId:         "o_1234567890",
+			opt:             []Option{WithUrls(urls...), WithUserDn("ou=people,dc=example,dc=com"), WithGroupFilter("(member={{.Unknown}})")},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "invalid group filter",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewAuthMethod(ctx, tt.scopeId, tt.opt...)
+			if tt.wantErrContains != "" {
+				require.Error(err)
+				assert.Nil(got)
+				if tt.wantErrMatch != nil {
+					assert.Truef(errors.Match(tt.wantErrMatch, err), "want err code: %q got: %q", tt.wantErrMatch.Code, err)
+				}
+				assert.Contains(err.Error(), tt.wantErrContains)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.scopeId, got.ScopeId)
+			assert.Equal([]string{"ldaps://ldap1.example.com", "ldap://ldap2.example.com:1389"}, got.Urls)
+			assert.Empty(got.BindPasswordHmac)
+		})
+	}
+}
+
+func TestAuthMethod_filters(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	am := AllocAuthMethod()
+
+	f, err := am.userFilter("alice")
+	require.NoError(err)
+	assert.Equal("(cn=alice)", f)
+
+	f, err = am.userFilter("a*)(uid=*")
+	require.NoError(err)
+	assert.Equal(`(cn=a\2a\29\28uid=\2a)`, f)
+
+	f, err = am.groupFilter("alice", "cn=alice,dc=example,dc=com")
+	require.NoError(err)
+	assert.Equal("(|(memberUid=alice)(member=cn=alice,dc=example,dc=com)(uniqueMember=cn=alice,dc=example,dc=com))", f)
+
+	am.UserAttr = "uid"
+	am.UserFilter = "(&(objectClass=person)({{.UserAttr}}={{.Username}}))"
+	f, err = am.userFilter("alice")
+	require.NoError(err)
+	assert.Equal("(&(objectClass=person)(uid=alice))", f)
+}
@@ -0,0 +1,175 @@
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	ldaplib "github.com/hashicorp/boundary/internal/libs/ldap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Authenticate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	rw := db.New(conn)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(err)
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(err)
+
+	s := testDirectory(t)
+	am := TestAuthMethod(t, conn, databaseWrapper, org.PublicId, []string{s.URL()},
+		WithUserDn("ou=people,dc=example,dc=com"),
+		WithUserAttr("uid"),
+		WithGroupDn("ou=groups,dc=example,dc=com"),
+		WithEnableGroups(),
+	)
+	admins := TestManagedGroup(t, conn, am, []string{"CN=admins,ou=groups,dc=example,dc=com"})
+	sales := TestManagedGroup(t, conn, am, []string{"cn=sales,ou=groups,dc=example,dc=com"})
+
+	acct, err := repo.Authenticate(ctx, am.PublicId, "alice", "wrong")
+	require.NoError(err)
+	assert.Nil(acct)
+
+	acct, err = repo.Authenticate(ctx, am.PublicId, "Alice", "alice-password")
+	require.NoError(err)
+	require.NotNil(acct)
+	assert.Equal("alice", acct.LoginName)
+	assert.Equal("uid=alice,ou=people,dc=example,dc=com", acct.Dn)
+	assert.Equal("Alice Eve Smith", acct.FullName)
+	assert.Equal("alice@example.com", acct.Email)
+	groups, err := acct.MemberOf(ctx)
+	require.NoError(err)
+	assert.ElementsMatch([]string{"cn=admins,ou=groups,dc=example,dc=com", "cn=developers,ou=groups,dc=example,dc=com"}, groups)
+
+	members, err := repo.ListManagedGroupMembershipsByGroup(ctx, admins.PublicId)
+	require.NoError(err)
+	require.Len(members, 1)
+	assert.Equal(acct.PublicId, members[0].MemberId)
+	members, err = repo.ListManagedGroupMembershipsByGroup(ctx, sales.PublicId)
+	require.NoError(err)
+	assert.Empty(members)
+
+	// authenticating again updates the existing account
+	again, err := repo.Authenticate(ctx, am.PublicId, "alice", "alice-password")
+	require.NoError(err)
+	assert.Equal(acct.PublicId, again.PublicId)
+}
+
+func TestRepository_Authenticate_Failures(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	rw := db.New(conn)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		ldaps     bool
+		setup     func(s *ldaplib.TestServer) []Option
+		loginName string
+		password  string
+		wantErr   bool
+		wantIsErr errors.Code
+	}{
+		{
+			name:      "bad-password",
+			loginName: "alice",
+			password:  "wrong",
+		},
+		{
+			name:      "no-such-user",
+			loginName: "mallory",
+			password:  "alice-password",
+		},
+		{
+			name: "no-such-user-discover-dn",
+			setup: func(_ *ldaplib.TestServer) []Option {
+				return []Option{WithDiscoverDn()}
+			},
+			loginName: "mallory",
+			password:  "alice-password",
+		},
+		{
+			name: "multiple-match",
+			setup: func(s *ldaplib.TestServer) []Option {
+				s.AddEntry("uid=alice,ou=contractors,ou=people,dc=example,dc=com", map[string][]string{
+					"uid":          {"alice"},
+					"userPassword": {"contractor-password"},
+				})
+				return []Option{WithDiscoverDn()}
+			},
+			loginName: "alice",
+			password:  "alice-password",
+			wantErr:   true,
+			wantIsErr: errors.MultipleRecords,
+		},
+		{
+			name:      "ldaps-untrusted",
+			ldaps:     true,
+			loginName: "alice",
+			password:  "alice-password",
+			wantErr:   true,
+			wantIsErr: errors.Unavailable,
+		},
+		{
+			name: "start-tls-untrusted",
+			setup: func(_ *ldaplib.TestServer) []Option {
+				return []Option{WithStartTLS()}
+			},
+			loginName: "alice",
+			password:  "alice-password",
+			wantErr:   true,
+			wantIsErr: errors.Unavailable,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			var sOpts []ldaplib.TestOption
+			if tt.ldaps {
+				sOpts = append(sOpts, ldaplib.WithTestLDAPS())
+			}
+			s := testDirectory(t, sOpts...)
+			opts := []Option{
+				WithUserDn("ou=people,dc=example,dc=com"),
+				WithUserAttr("uid"),
+			}
+			if tt.setup != nil {
+				opts = append(opts, tt.setup(s)...)
+			}
+			org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+			databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+			require.NoError(err)
+			am := TestAuthMethod(t, conn, databaseWrapper, org.PublicId, []string{s.URL()}, opts...)
+
+			acct, err := repo.Authenticate(ctx, am.PublicId, tt.loginName, tt.password)
+			if tt.wantErr {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tt.wantIsErr), err), "want err code: %q got: %q", tt.wantIsErr, err)
+			} else {
+				require.NoError(err)
+			}
+			assert.Nil(acct)
+
+			// no account is created when authentication fails
+			accts, err := repo.ListAccounts(ctx, am.PublicId)
+			require.NoError(err)
+			assert.Empty(accts)
+		})
+	}
+}
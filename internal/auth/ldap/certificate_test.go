@@ -0,0 +1,119 @@
+package ldap
+
+import (
+	"context"
+	"crypto/x509"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestNewCertificate(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+	_, pem := testGenerateCA(t, "localhost")
+	tests := []struct {
+		name         string
+		authMethodId string
+		cert         string
+		wantIsErr    errors.Code
+	}{
+		{name: "valid", authMethodId: "amldap_1234567890", cert: pem},
+		{name: "missing-auth-method", cert: pem, wantIsErr: errors.InvalidParameter},
+		{name: "empty-certificate", authMethodId: "amldap_1234567890", wantIsErr: errors.InvalidParameter},
+		{name: "not-pem", authMethodId: "amldap_1234567890", cert: "not a certificate", wantIsErr: errors.InvalidParameter},
+		{
+			name:         "not-a-certificate",
+			authMethodId: "amldap_1234567890",
+			cert:         "-----BEGIN CERTIFICATE-----\nbm90IGEgY2VydGlmaWNhdGU=\n-----END CERTIFICATE-----\n",
+			wantIsErr:    errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewCertificate(ctx, tt.authMethodId, tt.cert)
+			if tt.wantIsErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantIsErr), err), "want err code: %q got: %q", tt.wantIsErr, err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.authMethodId, got.LdapMethodId)
+			assert.Equal(tt.cert, got.Cert)
+		})
+	}
+}
+
+func TestCertificate_Create(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	rw := db.New(conn)
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(err)
+	am := TestAuthMethod(t, conn, databaseWrapper, org.PublicId, []string{"ldaps://ldap.example.com"}, WithUserDn("ou=people,dc=example,dc=com"))
+
+	_, pem := testGenerateCA(t, "localhost")
+	c, err := NewCertificate(ctx, am.PublicId, pem)
+	require.NoError(err)
+	require.NoError(rw.Create(ctx, c))
+
+	found := AllocCertificate()
+	require.NoError(rw.LookupWhere(ctx, &found, "ldap_method_id = ? and certificate = ?", am.PublicId, pem))
+	assert.True(proto.Equal(c.Certificate, found.Certificate))
+
+	err = rw.Create(ctx, c.Clone())
+	assert.Truef(errors.Match(errors.T(errors.NotUnique), err), "want err code: %q got: %q", errors.NotUnique, err)
+
+	_, pem2 := testGenerateCA(t, "127.0.0.1")
+	c2, err := NewCertificate(ctx, am.PublicId, pem2)
+	require.NoError(err)
+	assert.False(proto.Equal(c.Clone().Certificate, c2.Certificate))
+}
+
+func TestCertificate_SetTableName(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	c := AllocCertificate()
+	assert.Equal(defaultCertificateTableName, c.TableName())
+	c.SetTableName("new-name")
+	assert.Equal("new-name", c.TableName())
+	c.SetTableName("")
+	assert.Equal(defaultCertificateTableName, c.TableName())
+}
+
+func TestEncodeAndParseCertificates(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+	assert, require := assert.New(t), require.New(t)
+	c1, p1 := testGenerateCA(t, "localhost")
+	c2, p2 := testGenerateCA(t, "127.0.0.1")
+
+	pems, err := EncodeCertificates(ctx, c1, c2)
+	require.NoError(err)
+	assert.Equal([]string{p1, p2}, pems)
+
+	certs, err := ParseCertificates(ctx, pems...)
+	require.NoError(err)
+	assert.Equal([]*x509.Certificate{c1, c2}, certs)
+
+	_, err = EncodeCertificates(ctx)
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+	_, err = EncodeCertificates(ctx, c1, nil)
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+	_, err = ParseCertificates(ctx)
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+	_, err = ParseCertificates(ctx, p1, "")
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+}
@@ -0,0 +1,211 @@
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	goldap "github.com/go-ldap/ldap/v3"
+	"github.com/hashicorp/boundary/internal/errors"
+	ldaplib "github.com/hashicorp/boundary/internal/libs/ldap"
+)
+
+// defaultDirectoryTimeout bounds how long a single authentication attempt
+// may spend connecting to and querying the auth method's ldap servers.
+const defaultDirectoryTimeout = 30 * time.Second
+
+// userEntry is the information about a user retrieved from the directory
+// during authentication.
+type userEntry struct {
+	dn       string
+	fullName string
+	email    string
+	groups   []string
+}
+
+// tlsConfig returns the tls configuration used when connecting to the auth
+// method's ldap servers.  The auth method's certificates, when present,
+// replace the system's trust roots.
+func (a *AuthMethod) tlsConfig(ctx context.Context) (*tls.Config, error) {
+	const op = "ldap.(AuthMethod).tlsConfig"
+	cfg := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: a.InsecureTls,
+	}
+	if len(a.Certificates) > 0 {
+		pool := x509.NewCertPool()
+		for _, c := range a.Certificates {
+			if ok := pool.AppendCertsFromPEM([]byte(c)); !ok {
+				return nil, errors.New(ctx, errors.InvalidParameter, op, "unable to add certificate to pool")
+			}
+		}
+		cfg.RootCAs = pool
+	}
+	return cfg, nil
+}
+
+// connect connects to the first available of the auth method's ldap servers,
+// trying them in order, and issues a StartTLS command when configured to do
+// so.  The connection's operations time out at the deadline of ctx.
+func (a *AuthMethod) connect(ctx context.Context) (*goldap.Conn, error) {
+	const op = "ldap.(AuthMethod).connect"
+	cfg, err := a.tlsConfig(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	var errs []string
+	for _, u := range a.Urls {
+		timeout := defaultDirectoryTimeout
+		if dl, ok := ctx.Deadline(); ok {
+			timeout = time.Until(dl)
+		}
+		if timeout <= 0 {
+			errs = append(errs, fmt.Sprintf("%s: %s", u, context.DeadlineExceeded))
+			break
+		}
+		pu, err := ldaplib.ParseURL(u)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", u, err))
+			continue
+		}
+		c, err := goldap.DialURL(pu.String(), goldap.DialWithDialer(&net.Dialer{Timeout: timeout}), goldap.DialWithTLSConfig(cfg))
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", u, err))
+			continue
+		}
+		c.SetTimeout(timeout)
+		if a.StartTls && pu.Scheme == "ldap" {
+			// unlike ldaps:// connections, StartTLS does not derive the
+			// server name to verify from the address.
+			tlsCfg := cfg.Clone()
+			tlsCfg.ServerName = pu.Hostname()
+			if err := c.StartTLS(tlsCfg); err != nil {
+				c.Close()
+				errs = append(errs, fmt.Sprintf("%s: unable to start tls: %s", u, err))
+				continue
+			}
+		}
+		return c, nil
+	}
+	return nil, errors.New(ctx, errors.Unavailable, op, fmt.Sprintf("unable to connect to any ldap server: %s", strings.Join(errs, "; ")))
+}
+
+// authenticate the loginName and password against the auth method's
+// directory.  It returns nil, nil if the credentials are invalid.
+func (a *AuthMethod) authenticate(ctx context.Context, loginName, password string) (*userEntry, error) {
+	const op = "ldap.(AuthMethod).authenticate"
+	if loginName == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing login name")
+	}
+	if password == "" {
+		// an empty password is an unauthenticated bind (RFC 4513 5.1.2),
+		// which must never be treated as a successful authentication.
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultDirectoryTimeout)
+	defer cancel()
+
+	conn, err := a.connect(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer conn.Close()
+
+	if a.BindDn != "" {
+		if err := conn.Bind(a.BindDn, a.BindPassword); err != nil {
+			return nil, errors.New(ctx, errors.Unknown, op, "unable to bind with the bind dn", errors.WithWrap(err))
+		}
+	}
+
+	var entry *goldap.Entry
+	userDn := fmt.Sprintf("%s=%s,%s", a.userAttr(), ldaplib.EscapeDN(loginName), a.UserDn)
+	if a.DiscoverDn {
+		filter, err := a.userFilter(loginName)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("invalid user filter"))
+		}
+		res, err := conn.Search(goldap.NewSearchRequest(a.UserDn, goldap.ScopeWholeSubtree, goldap.NeverDerefAliases, 2, 0, false, filter, nil, nil))
+		switch {
+		case goldap.IsErrorWithCode(err, goldap.LDAPResultSizeLimitExceeded):
+			return nil, errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("more than one user matched %q", filter))
+		case err != nil:
+			return nil, errors.New(ctx, errors.Unknown, op, "unable to search for user", errors.WithWrap(err))
+		case len(res.Entries) == 0:
+			return nil, nil
+		case len(res.Entries) > 1:
+			return nil, errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("more than one user matched %q", filter))
+		}
+		entry = res.Entries[0]
+		userDn = entry.DN
+	}
+
+	if err := conn.Bind(userDn, password); err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials) {
+			return nil, nil
+		}
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to bind as user", errors.WithWrap(err))
+	}
+
+	if entry == nil {
+		res, err := conn.Search(goldap.NewSearchRequest(userDn, goldap.ScopeBaseObject, goldap.NeverDerefAliases, 0, 0, false, "(objectClass=*)", nil, nil))
+		switch {
+		case goldap.IsErrorAnyOf(err, goldap.LDAPResultInsufficientAccessRights, goldap.LDAPResultNoSuchObject):
+			// the user may not be allowed to read their own entry, which
+			// doesn't prevent them from authenticating.
+		case err != nil:
+			return nil, errors.New(ctx, errors.Unknown, op, "unable to read user entry", errors.WithWrap(err))
+		case len(res.Entries) == 1:
+			entry = res.Entries[0]
+		}
+	}
+
+	u := &userEntry{dn: userDn}
+	if entry != nil {
+		u.fullName = entry.GetAttributeValue("displayName")
+		if u.fullName == "" {
+			u.fullName = entry.GetAttributeValue("cn")
+		}
+		u.email = entry.GetAttributeValue("mail")
+	}
+
+	if !a.EnableGroups {
+		return u, nil
+	}
+	if a.GroupDn == "" {
+		if entry != nil {
+			u.groups = entry.GetAttributeValues("memberOf")
+		}
+		return u, nil
+	}
+
+	groupConn := conn
+	switch {
+	case a.AnonGroupSearch:
+		groupConn, err = a.connect(ctx)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		defer groupConn.Close()
+	case a.BindDn != "":
+		if err := conn.Bind(a.BindDn, a.BindPassword); err != nil {
+			return nil, errors.New(ctx, errors.Unknown, op, "unable to bind with the bind dn", errors.WithWrap(err))
+		}
+	}
+	filter, err := a.groupFilter(loginName, userDn)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("invalid group filter"))
+	}
+	groups, err := groupConn.Search(goldap.NewSearchRequest(a.GroupDn, goldap.ScopeWholeSubtree, goldap.NeverDerefAliases, 0, 0, false, filter, []string{a.groupAttr()}, nil))
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to search for groups", errors.WithWrap(err))
+	}
+	for _, g := range groups.Entries {
+		u.groups = append(u.groups, g.DN)
+	}
+	return u, nil
+}
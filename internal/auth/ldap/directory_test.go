@@ -0,0 +1,283 @@
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	goldap "github.com/go-ldap/ldap/v3"
+	"github.com/hashicorp/boundary/internal/errors"
+	ldaplib "github.com/hashicorp/boundary/internal/libs/ldap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testDirectory(t *testing.T, opt ...ldaplib.TestOption) *ldaplib.TestServer {
+	t.Helper()
+	entries := []*goldap.Entry{
+		goldap.NewEntry("dc=example,dc=com", nil),
+		goldap.NewEntry("ou=people,dc=example,dc=com", nil),
+		goldap.NewEntry("ou=groups,dc=example,dc=com", nil),
+		goldap.NewEntry("cn=admin,dc=example,dc=com", map[string][]string{
+			"cn":           {"admin"},
+			"userPassword": {"admin-password"},
+		}),
+		goldap.NewEntry("uid=alice,ou=people,dc=example,dc=com", map[string][]string{
+			"objectClass":  {"person"},
+			"uid":          {"alice"},
+			"cn":           {"alice"},
+			"displayName":  {"Alice Eve Smith"},
+			"mail":         {"alice@example.com"},
+			"memberOf":     {"cn=admins,ou=groups,dc=example,dc=com"},
+			"userPassword": {"alice-password"},
+		}),
+		goldap.NewEntry("cn=admins,ou=groups,dc=example,dc=com", map[string][]string{
+			"cn":     {"admins"},
+			"member": {"uid=alice,ou=people,dc=example,dc=com"},
+		}),
+		goldap.NewEntry("cn=developers,ou=groups,dc=example,dc=com", map[string][]string{
+			"cn":        {"developers"},
+			"memberUid": {"alice"},
+		}),
+		goldap.NewEntry("cn=sales,ou=groups,dc=example,dc=com", map[string][]string{
+			"cn":        {"sales"},
+			"memberUid": {"bob"},
+		}),
+	}
+	opt = append(opt, ldaplib.WithTestEntries(entries...))
+	return ldaplib.NewTestServer(t, opt...)
+}
+
+func TestAuthMethod_authenticate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tests := []struct {
+		name       string
+		ldaps      bool
+		noAnon     bool
+		setup      func(s *ldaplib.TestServer, am *AuthMethod)
+		loginName  string
+		password   string
+		wantErr    bool
+		wantIsErr  errors.Code
+		wantNil    bool
+		wantGroups []string
+		wantName   string
+		wantEmail  string
+	}{
+		{
+			name:      "constructed-dn",
+			loginName: "alice",
+			password:  "alice-password",
+			wantName:  "Alice Eve Smith",
+			wantEmail: "alice@example.com",
+		},
+		{
+			name:      "bad-password",
+			loginName: "alice",
+			password:  "wrong",
+			wantNil:   true,
+		},
+		{
+			name:      "unknown-user",
+			loginName: "eve",
+			password:  "alice-password",
+			wantNil:   true,
+		},
+		{
+			name:      "empty-password",
+			loginName: "alice",
+			wantNil:   true,
+		},
+		{
+			name: "discover-dn",
+			setup: func(_ *ldaplib.TestServer, am *AuthMethod) {
+				am.DiscoverDn = true
+				am.UserFilter = "(&(objectClass=person)({{.UserAttr}}={{.Username}}))"
+			},
+			loginName: "alice",
+			password:  "alice-password",
+			wantName:  "Alice Eve Smith",
+			wantEmail: "alice@example.com",
+		},
+		{
+			name: "discover-dn-with-bind-credential",
+			setup: func(_ *ldaplib.TestServer, am *AuthMethod) {
+				am.DiscoverDn = true
+				am.BindDn = "cn=admin,dc=example,dc=com"
+				am.BindPassword = "admin-password"
+			},
+			noAnon:    true,
+			loginName: "alice",
+			password:  "alice-password",
+			wantName:  "Alice Eve Smith",
+			wantEmail: "alice@example.com",
+		},
+		{
+			name: "discover-dn-no-anonymous-search",
+			setup: func(_ *ldaplib.TestServer, am *AuthMethod) {
+				am.DiscoverDn = true
+			},
+			noAnon:    true,
+			loginName: "alice",
+			password:  "alice-password",
+			wantErr:   true,
+		},
+		{
+			name: "discover-dn-multiple-match",
+			setup: func(s *ldaplib.TestServer, am *AuthMethod) {
+				am.DiscoverDn = true
+				s.AddEntry("uid=alice,ou=contractors,ou=people,dc=example,dc=com", map[string][]string{
+					"uid":          {"alice"},
+					"userPassword": {"contractor-password"},
+				})
+			},
+			loginName: "alice",
+			password:  "alice-password",
+			wantErr:   true,
+			wantIsErr: errors.MultipleRecords,
+		},
+		{
+			name: "member-of-groups",
+			setup: func(_ *ldaplib.TestServer, am *AuthMethod) {
+				am.EnableGroups = true
+			},
+			loginName:  "alice",
+			password:   "alice-password",
+			wantName:   "Alice Eve Smith",
+			wantEmail:  "alice@example.com",
+			wantGroups: []string{"cn=admins,ou=groups,dc=example,dc=com"},
+		},
+		{
+			name: "group-search",
+			setup: func(_ *ldaplib.TestServer, am *AuthMethod) {
+				am.EnableGroups = true
+				am.GroupDn = "ou=groups,dc=example,dc=com"
+			},
+			loginName: "alice",
+			password:  "alice-password",
+			wantName:  "Alice Eve Smith",
+			wantEmail: "alice@example.com",
+			wantGroups: []string{
+				"cn=admins,ou=groups,dc=example,dc=com",
+				"cn=developers,ou=groups,dc=example,dc=com",
+			},
+		},
+		{
+			name: "anon-group-search",
+			setup: func(_ *ldaplib.TestServer, am *AuthMethod) {
+				am.EnableGroups = true
+				am.AnonGroupSearch = true
+				am.GroupDn = "ou=groups,dc=example,dc=com"
+				am.GroupFilter = "(memberUid={{.Username}})"
+			},
+			loginName:  "alice",
+			password:   "alice-password",
+			wantName:   "Alice Eve Smith",
+			wantEmail:  "alice@example.com",
+			wantGroups: []string{"cn=developers,ou=groups,dc=example,dc=com"},
+		},
+		{
+			name:  "ldaps",
+			ldaps: true,
+			setup: func(s *ldaplib.TestServer, am *AuthMethod) {
+				am.Certificates = []string{s.CACert()}
+			},
+			loginName: "alice",
+			password:  "alice-password",
+			wantName:  "Alice Eve Smith",
+			wantEmail: "alice@example.com",
+		},
+		{
+			name:      "ldaps-untrusted",
+			ldaps:     true,
+			loginName: "alice",
+			password:  "alice-password",
+			wantErr:   true,
+			wantIsErr: errors.Unavailable,
+		},
+		{
+			name:  "ldaps-insecure",
+			ldaps: true,
+			setup: func(_ *ldaplib.TestServer, am *AuthMethod) {
+				am.InsecureTls = true
+			},
+			loginName: "alice",
+			password:  "alice-password",
+			wantName:  "Alice Eve Smith",
+			wantEmail: "alice@example.com",
+		},
+		{
+			name: "start-tls",
+			setup: func(s *ldaplib.TestServer, am *AuthMethod) {
+				am.StartTls = true
+				am.Certificates = []string{s.CACert()}
+			},
+			loginName: "alice",
+			password:  "alice-password",
+			wantName:  "Alice Eve Smith",
+			wantEmail: "alice@example.com",
+		},
+		{
+			name: "start-tls-untrusted",
+			setup: func(_ *ldaplib.TestServer, am *AuthMethod) {
+				am.StartTls = true
+			},
+			loginName: "alice",
+			password:  "alice-password",
+			wantErr:   true,
+			wantIsErr: errors.Unavailable,
+		},
+		{
+			name: "failover",
+			setup: func(s *ldaplib.TestServer, am *AuthMethod) {
+				am.Urls = []string{"ldap://127.0.0.1:1", s.URL()}
+			},
+			loginName: "alice",
+			password:  "alice-password",
+			wantName:  "Alice Eve Smith",
+			wantEmail: "alice@example.com",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			var opts []ldaplib.TestOption
+			if tt.ldaps {
+				opts = append(opts, ldaplib.WithTestLDAPS())
+			}
+			if tt.noAnon {
+				opts = append(opts, ldaplib.WithTestNoAnonymousSearch())
+			}
+			s := testDirectory(t, opts...)
+
+			am := AllocAuthMethod()
+			am.Urls = []string{s.URL()}
+			am.UserDn = "ou=people,dc=example,dc=com"
+			am.UserAttr = "uid"
+			if tt.setup != nil {
+				tt.setup(s, &am)
+			}
+
+			got, err := am.authenticate(ctx, tt.loginName, tt.password)
+			if tt.wantErr {
+				require.Error(err)
+				if tt.wantIsErr != 0 {
+					assert.Truef(errors.Match(errors.T(tt.wantIsErr), err), "unexpected error: %s", err)
+				}
+				return
+			}
+			require.NoError(err)
+			if tt.wantNil {
+				assert.Nil(got)
+				return
+			}
+			require.NotNil(got)
+			assert.Equal("uid=alice,ou=people,dc=example,dc=com", got.dn)
+			assert.Equal(tt.wantName, got.fullName)
+			assert.Equal(tt.wantEmail, got.email)
+			assert.ElementsMatch(tt.wantGroups, got.groups)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewManagedGroup(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+	assert, require := assert.New(t), require.New(t)
+
+	mg, err := NewManagedGroup(ctx, "amldap_1234567890", []string{"cn=admins,ou=groups,dc=example,dc=com"}, WithName("admins"))
+	require.NoError(err)
+	assert.Equal("admins", mg.Name)
+	assert.Equal(`["cn=admins,ou=groups,dc=example,dc=com"]`, mg.GroupNames)
+
+	_, err = NewManagedGroup(ctx, "", []string{"cn=admins"})
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+	_, err = NewManagedGroup(ctx, "amldap_1234567890", nil)
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+	_, err = NewManagedGroup(ctx, "amldap_1234567890", []string{" "})
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+}
+
+func TestManagedGroup_matches(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+	mg, err := NewManagedGroup(ctx, "amldap_1234567890", []string{"cn=admins,ou=groups,dc=example,dc=com", "cn=ops,ou=groups,dc=example,dc=com"})
+	require.NoError(t, err)
+	tests := []struct {
+		name     string
+		memberOf []string
+		want     bool
+	}{
+		{name: "no-groups"},
+		{name: "match", memberOf: []string{"cn=dev,ou=groups,dc=example,dc=com", "cn=ops,ou=groups,dc=example,dc=com"}, want: true},
+		{name: "case-insensitive", memberOf: []string{"CN=Admins,OU=groups,DC=example,DC=com"}, want: true},
+		{name: "no-match", memberOf: []string{"cn=dev,ou=groups,dc=example,dc=com"}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mg.matches(ctx, tt.memberOf)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package ldap
+
+import (
+	"crypto/x509"
+	"net/url"
+
+	"github.com/hashicorp/boundary/internal/db"
+)
+
+// getOpts - iterate the inbound Options and return a struct.
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withName              string
+	withDescription       string
+	withLimit             int
+	withPublicId          string
+	withUrls              []*url.URL
+	withCertificates      []*x509.Certificate
+	withStartTls          bool
+	withInsecureTls       bool
+	withDiscoverDn        bool
+	withAnonGroupSearch   bool
+	withEnableGroups      bool
+	withUserDn            string
+	withUserAttr          string
+	withUserFilter        string
+	withGroupDn           string
+	withGroupAttr         string
+	withGroupFilter       string
+	withBindDn            string
+	withBindPassword      string
+	withDn                string
+	withFullName          string
+	withEmail             string
+	withMemberOfGroups    []string
+	withOrderByCreateTime bool
+	ascending             bool
+	withReader            db.Reader
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithDescription provides an optional description.
+func WithDescription(desc string) Option {
+	return func(o *options) {
+		o.withDescription = desc
+	}
+}
+
+// WithName provides an optional name.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.withName = name
+	}
+}
+
+// WithLimit provides an option to provide a limit.  Intentionally allowing
+// negative integers.   If WithLimit < 0, then unlimited results are returned.
+// If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) {
+		o.withLimit = l
+	}
+}
+
+// WithPublicId provides an option for passing a public id to the operation
+func WithPublicId(publicId string) Option {
+	return func(o *options) {
+		o.withPublicId = publicId
+	}
+}
+
+// WithUrls provides optional ldap server urls.  The servers are tried in the
+// order provided.
+func WithUrls(urls ...*url.URL) Option {
+	return func(o *options) {
+		o.withUrls = urls
+	}
+}
+
+// WithCertificates provides optional certificates which are used as trust
+// anchors when connecting to the ldap servers.
+func WithCertificates(certs ...*x509.Certificate) Option {
+	return func(o *options) {
+		o.withCertificates = certs
+	}
+}
+
+// WithStartTLS provides an option to issue a StartTLS command after
+// connecting to an ldap:// server.
+func WithStartTLS() Option {
+	return func(o *options) {
+		o.withStartTls = true
+	}
+}
+
+// WithInsecureTLS provides an option to skip verification of the ldap
+// server's tls certificate.
+func WithInsecureTLS() Option {
+	return func(o *options) {
+		o.withInsecureTls = true
+	}
+}
+
+// WithDiscoverDn provides an option to search for the user's dn using the
+// user filter instead of constructing it from the user attribute and user dn.
+func WithDiscoverDn() Option {
+	return func(o *options) {
+		o.withDiscoverDn = true
+	}
+}
+
+// WithAnonGroupSearch provides an option to use an anonymous bind when
+// searching for the user's groups.
+func WithAnonGroupSearch() Option {
+	return func(o *options) {
+		o.withAnonGroupSearch = true
+	}
+}
+
+// WithEnableGroups provides an option to search for the user's groups when
+// they authenticate.
+func WithEnableGroups() Option {
+	return func(o *options) {
+		o.withEnableGroups = true
+	}
+}
+
+// WithUserDn provides an optional base dn for user searches.
+func WithUserDn(dn string) Option {
+	return func(o *options) {
+		o.withUserDn = dn
+	}
+}
+
+// WithUserAttr provides an optional user attribute.
+func WithUserAttr(attr string) Option {
+	return func(o *options) {
+		o.withUserAttr = attr
+	}
+}
+
+// WithUserFilter provides an optional user filter template.
+func WithUserFilter(filter string) Option {
+	return func(o *options) {
+		o.withUserFilter = filter
+	}
+}
+
+// WithGroupDn provides an optional base dn for group searches.
+func WithGroupDn(dn string) Option {
+	return func(o *options) {
+		o.withGroupDn = dn
+	}
+}
+
+// WithGroupAttr provides an optional group attribute.
+func WithGroupAttr(attr string) Option {
+	return func(o *options) {
+		o.withGroupAttr = attr
+	}
+}
+
+// WithGroupFilter provides an optional group filter template.
+func WithGroupFilter(filter string) Option {
+	return func(o *options) {
+		o.withGroupFilter = filter
+	}
+}
+
+// WithBindCredential provides an optional dn and password used to bind to
+// the ldap server when searching for users and groups.
+func WithBindCredential(dn, password string) Option {
+	return func(o *options) {
+		o.withBindDn = dn
+		o.withBindPassword = password
+	}
+}
+
+// WithDn provides an optional dn for the account.
+func WithDn(dn string) Option {
+	return func(o *options) {
+		o.withDn = dn
+	}
+}
+
+// WithFullName provides an optional full name for the account.
+func WithFullName(n string) Option {
+	return func(o *options) {
+		o.withFullName = n
+	}
+}
+
+// WithEmail provides an optional email address for the account.
+func WithEmail(email string) Option {
+	return func(o *options) {
+		o.withEmail = email
+	}
+}
+
+// WithMemberOfGroups provides optional group dns for the account.
+func WithMemberOfGroups(groups ...string) Option {
+	return func(o *options) {
+		o.withMemberOfGroups = groups
+	}
+}
+
+// WithOrderByCreateTime provides an option to specify ordering by the
+// CreateTime field.
+func WithOrderByCreateTime(ascending bool) Option {
+	return func(o *options) {
+		o.withOrderByCreateTime = true
+		o.ascending = ascending
+	}
+}
+
+// WithReader provides an optional reader
+func WithReader(reader db.Reader) Option {
+	return func(o *options) {
+		o.withReader = reader
+	}
+}
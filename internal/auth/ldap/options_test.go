@@ -0,0 +1,198 @@
+package ldap
+
+import (
+	"crypto/x509"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_GetOpts provides unit tests for GetOpts and all the options
+func Test_GetOpts(t *testing.T) {
+	t.Parallel()
+	t.Run("WithName", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithName("test"))
+		testOpts := getDefaultOptions()
+		testOpts.withName = "test"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithDescription", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithDescription("test desc"))
+		testOpts := getDefaultOptions()
+		testOpts.withDescription = "test desc"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithLimit", func(t *testing.T) {
+		assert := assert.New(t)
+		// test default of 0
+		opts := getOpts()
+		testOpts := getDefaultOptions()
+		testOpts.withLimit = 0
+		assert.Equal(opts, testOpts)
+
+		opts = getOpts(WithLimit(-1))
+		testOpts = getDefaultOptions()
+		testOpts.withLimit = -1
+		assert.Equal(opts, testOpts)
+
+		opts = getOpts(WithLimit(1))
+		testOpts = getDefaultOptions()
+		testOpts.withLimit = 1
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithPublicId", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithPublicId("test id"))
+		testOpts := getDefaultOptions()
+		testOpts.withPublicId = "test id"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithUrls", func(t *testing.T) {
+		assert := assert.New(t)
+		urls := TestConvertToUrls(t, "ldap://alice.com", "ldaps://bob.com")
+		opts := getOpts(WithUrls(urls...))
+		testOpts := getDefaultOptions()
+		testOpts.withUrls = urls
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithCertificates", func(t *testing.T) {
+		assert := assert.New(t)
+		certs := []*x509.Certificate{{Raw: []byte("alice")}, {Raw: []byte("bob")}}
+		opts := getOpts(WithCertificates(certs...))
+		testOpts := getDefaultOptions()
+		testOpts.withCertificates = certs
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithStartTLS", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithStartTLS())
+		testOpts := getDefaultOptions()
+		testOpts.withStartTls = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithInsecureTLS", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithInsecureTLS())
+		testOpts := getDefaultOptions()
+		testOpts.withInsecureTls = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithDiscoverDn", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithDiscoverDn())
+		testOpts := getDefaultOptions()
+		testOpts.withDiscoverDn = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithAnonGroupSearch", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithAnonGroupSearch())
+		testOpts := getDefaultOptions()
+		testOpts.withAnonGroupSearch = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithEnableGroups", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithEnableGroups())
+		testOpts := getDefaultOptions()
+		testOpts.withEnableGroups = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithUserDn", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithUserDn("ou=people,dc=example,dc=com"))
+		testOpts := getDefaultOptions()
+		testOpts.withUserDn = "ou=people,dc=example,dc=com"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithUserAttr", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithUserAttr("uid"))
+		testOpts := getDefaultOptions()
+		testOpts.withUserAttr = "uid"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithUserFilter", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithUserFilter("(uid={{.Username}})"))
+		testOpts := getDefaultOptions()
+		testOpts.withUserFilter = "(uid={{.Username}})"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithGroupDn", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithGroupDn("ou=groups,dc=example,dc=com"))
+		testOpts := getDefaultOptions()
+		testOpts.withGroupDn = "ou=groups,dc=example,dc=com"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithGroupAttr", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithGroupAttr("cn"))
+		testOpts := getDefaultOptions()
+		testOpts.withGroupAttr = "cn"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithGroupFilter", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithGroupFilter("(member={{.UserDN}})"))
+		testOpts := getDefaultOptions()
+		testOpts.withGroupFilter = "(member={{.UserDN}})"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithBindCredential", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithBindCredential("cn=admin,dc=example,dc=com", "password"))
+		testOpts := getDefaultOptions()
+		testOpts.withBindDn = "cn=admin,dc=example,dc=com"
+		testOpts.withBindPassword = "password"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithDn", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithDn("uid=alice,ou=people,dc=example,dc=com"))
+		testOpts := getDefaultOptions()
+		testOpts.withDn = "uid=alice,ou=people,dc=example,dc=com"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithFullName", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithFullName("Alice Eve Smith"))
+		testOpts := getDefaultOptions()
+		testOpts.withFullName = "Alice Eve Smith"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithEmail", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithEmail("alice@example.com"))
+		testOpts := getDefaultOptions()
+		testOpts.withEmail = "alice@example.com"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithMemberOfGroups", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithMemberOfGroups("cn=admins,ou=groups,dc=example,dc=com", "cn=users,ou=groups,dc=example,dc=com"))
+		testOpts := getDefaultOptions()
+		testOpts.withMemberOfGroups = []string{"cn=admins,ou=groups,dc=example,dc=com", "cn=users,ou=groups,dc=example,dc=com"}
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithOrderByCreateTime", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithOrderByCreateTime(true))
+		testOpts := getDefaultOptions()
+		testOpts.withOrderByCreateTime = true
+		testOpts.ascending = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithReader", func(t *testing.T) {
+		assert := assert.New(t)
+		testOpts := getDefaultOptions()
+		assert.Nil(testOpts.withReader)
+		var r db.Reader
+		opts := getOpts(WithReader(r))
+		assert.Equal(r, opts.withReader)
+	})
+}
@@ -0,0 +1,113 @@
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Account_CRUD(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	rw := db.New(conn)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(err)
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(err)
+	am := TestAuthMethod(t, conn, databaseWrapper, org.PublicId, []string{"ldaps://ldap.example.com"}, WithUserDn("ou=people,dc=example,dc=com"))
+
+	a, err := NewAccount(ctx, am.PublicId, " Alice ", WithName("alice"), WithDescription("alice's account"))
+	require.NoError(err)
+	created, err := repo.CreateAccount(ctx, org.PublicId, a)
+	require.NoError(err)
+	assert.NotEmpty(created.PublicId)
+	assert.Equal("alice", created.LoginName)
+	assert.Equal("alice", created.Name)
+	assert.Empty(a.PublicId, "the account passed in must not be changed")
+
+	// the login name must be unique within the auth method
+	dup, err := NewAccount(ctx, am.PublicId, "alice")
+	require.NoError(err)
+	_, err = repo.CreateAccount(ctx, org.PublicId, dup)
+	assert.Truef(errors.Match(errors.T(errors.NotUnique), err), "want err code: %q got: %q", errors.NotUnique, err)
+
+	_, err = repo.CreateAccount(ctx, org.PublicId, created)
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+	_, err = repo.CreateAccount(ctx, "", a)
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+	_, err = repo.CreateAccount(ctx, org.PublicId, a, WithPublicId("bad_1234567890"))
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+
+	bob, err := NewAccount(ctx, am.PublicId, "bob")
+	require.NoError(err)
+	bob, err = repo.CreateAccount(ctx, org.PublicId, bob, WithPublicId(AccountPrefix+"_1234567890"))
+	require.NoError(err)
+	assert.Equal(AccountPrefix+"_1234567890", bob.PublicId)
+
+	found, err := repo.LookupAccount(ctx, created.PublicId)
+	require.NoError(err)
+	assert.Equal(created.LoginName, found.LoginName)
+	found, err = repo.LookupAccount(ctx, AccountPrefix+"_doesnotexist")
+	require.NoError(err)
+	assert.Nil(found)
+	_, err = repo.LookupAccount(ctx, "")
+	assert.Truef(errors.Match(errors.T(errors.InvalidPublicId), err), "want err code: %q got: %q", errors.InvalidPublicId, err)
+
+	listed, err := repo.ListAccounts(ctx, am.PublicId)
+	require.NoError(err)
+	assert.Len(listed, 2)
+	listed, err = repo.ListAccounts(ctx, am.PublicId, WithLimit(1))
+	require.NoError(err)
+	assert.Len(listed, 1)
+	_, err = repo.ListAccounts(ctx, "")
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+
+	update := AllocAccount()
+	update.PublicId = created.PublicId
+	update.Name = "alice-renamed"
+	updated, rowsUpdated, err := repo.UpdateAccount(ctx, org.PublicId, update, created.Version, []string{NameField, DescriptionField})
+	require.NoError(err)
+	assert.Equal(1, rowsUpdated)
+	assert.Equal("alice-renamed", updated.Name)
+	assert.Empty(updated.Description)
+	assert.Equal(created.Version+1, updated.Version)
+
+	// only the name and description can be updated
+	update.LoginName = "eve"
+	_, _, err = repo.UpdateAccount(ctx, org.PublicId, update, updated.Version, []string{"LoginName"})
+	assert.Truef(errors.Match(errors.T(errors.InvalidFieldMask), err), "want err code: %q got: %q", errors.InvalidFieldMask, err)
+	_, _, err = repo.UpdateAccount(ctx, org.PublicId, update, updated.Version, nil)
+	assert.Truef(errors.Match(errors.T(errors.EmptyFieldMask), err), "want err code: %q got: %q", errors.EmptyFieldMask, err)
+	_, _, err = repo.UpdateAccount(ctx, org.PublicId, update, 0, []string{NameField})
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+
+	// names are unique within the auth method
+	update = AllocAccount()
+	update.PublicId = bob.PublicId
+	update.Name = "alice-renamed"
+	_, _, err = repo.UpdateAccount(ctx, org.PublicId, update, bob.Version, []string{NameField})
+	assert.Truef(errors.Match(errors.T(errors.NotUnique), err), "want err code: %q got: %q", errors.NotUnique, err)
+
+	deleted, err := repo.DeleteAccount(ctx, org.PublicId, created.PublicId)
+	require.NoError(err)
+	assert.Equal(1, deleted)
+	found, err = repo.LookupAccount(ctx, created.PublicId)
+	require.NoError(err)
+	assert.Nil(found)
+	deleted, err = repo.DeleteAccount(ctx, org.PublicId, created.PublicId)
+	require.NoError(err)
+	assert.Equal(0, deleted)
+	_, err = repo.DeleteAccount(ctx, org.PublicId, "")
+	assert.Truef(errors.Match(errors.T(errors.InvalidPublicId), err), "want err code: %q got: %q", errors.InvalidPublicId, err)
+}
@@ -0,0 +1,85 @@
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_AuthMethod_CRUD(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	rw := db.New(conn)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(err)
+
+	am, err := NewAuthMethod(ctx, org.PublicId,
+		WithName("ldap"),
+		WithUrls(TestConvertToUrls(t, "ldaps://ldap1.example.com", "ldaps://ldap2.example.com")...),
+		WithUserDn("ou=people,dc=example,dc=com"),
+		WithBindCredential("cn=admin,dc=example,dc=com", "admin-password"),
+		WithEnableGroups(),
+	)
+	require.NoError(err)
+	created, err := repo.CreateAuthMethod(ctx, am)
+	require.NoError(err)
+	assert.NotEmpty(created.PublicId)
+	assert.Equal([]string{"ldaps://ldap1.example.com", "ldaps://ldap2.example.com"}, created.Urls)
+	assert.Equal("admin-password", created.BindPassword)
+	assert.NotEmpty(created.BindPasswordHmac)
+	assert.True(created.EnableGroups)
+
+	_, err = repo.CreateAuthMethod(ctx, am)
+	assert.Truef(errors.Match(errors.T(errors.NotUnique), err), "want err code: %q got: %q", errors.NotUnique, err)
+
+	found, err := repo.LookupAuthMethod(ctx, created.PublicId)
+	require.NoError(err)
+	assert.Equal(created.Urls, found.Urls)
+	assert.Equal(created.BindPasswordHmac, found.BindPasswordHmac)
+
+	listed, err := repo.ListAuthMethods(ctx, []string{org.PublicId})
+	require.NoError(err)
+	assert.Len(listed, 1)
+
+	// reorder the urls, remove the bind credential and disable groups
+	update := AllocAuthMethod()
+	update.PublicId = created.PublicId
+	update.Urls = []string{"ldaps://ldap2.example.com", "ldaps://ldap1.example.com"}
+	updated, rowsUpdated, err := repo.UpdateAuthMethod(ctx, &update, created.Version, []string{UrlsField, BindDnField, BindPasswordField, EnableGroupsField})
+	require.NoError(err)
+	assert.Equal(1, rowsUpdated)
+	assert.Equal(update.Urls, updated.Urls)
+	assert.Empty(updated.BindDn)
+	assert.Empty(updated.BindPassword)
+	assert.Empty(updated.BindPasswordHmac)
+	assert.False(updated.EnableGroups)
+	assert.Equal(created.Version+1, updated.Version)
+
+	// a bind dn without a password is invalid
+	update = AllocAuthMethod()
+	update.PublicId = created.PublicId
+	update.BindDn = "cn=admin,dc=example,dc=com"
+	_, _, err = repo.UpdateAuthMethod(ctx, &update, updated.Version, []string{BindDnField})
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+
+	_, _, err = repo.UpdateAuthMethod(ctx, &update, updated.Version, []string{"ScopeId"})
+	assert.Truef(errors.Match(errors.T(errors.InvalidFieldMask), err), "want err code: %q got: %q", errors.InvalidFieldMask, err)
+
+	deleted, err := repo.DeleteAuthMethod(ctx, created.PublicId)
+	require.NoError(err)
+	assert.Equal(1, deleted)
+	found, err = repo.LookupAuthMethod(ctx, created.PublicId)
+	require.NoError(err)
+	assert.Nil(found)
+}
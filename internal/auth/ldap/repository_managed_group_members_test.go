@@ -0,0 +1,104 @@
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_SetManagedGroupMemberships(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	rw := db.New(conn)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(err)
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(err)
+	am := TestAuthMethod(t, conn, databaseWrapper, org.PublicId, []string{"ldaps://ldap.example.com"}, WithUserDn("ou=people,dc=example,dc=com"))
+	acct := TestAccount(t, conn, am, "alice")
+	admins := TestManagedGroup(t, conn, am, []string{"cn=admins,ou=groups,dc=example,dc=com"})
+	ops := TestManagedGroup(t, conn, am, []string{"cn=ops,ou=groups,dc=example,dc=com"})
+	dev := TestManagedGroup(t, conn, am, []string{"cn=dev,ou=groups,dc=example,dc=com"})
+
+	memberIds := func(ms []*ManagedGroupMemberAccount) []string {
+		var ids []string
+		for _, m := range ms {
+			assert.Equal(acct.PublicId, m.MemberId)
+			ids = append(ids, m.ManagedGroupId)
+		}
+		return ids
+	}
+
+	// duplicates are only added once
+	members, rows, err := repo.SetManagedGroupMemberships(ctx, am, acct, []*ManagedGroup{admins, ops, admins})
+	require.NoError(err)
+	assert.Equal(2, rows)
+	assert.ElementsMatch([]string{admins.PublicId, ops.PublicId}, memberIds(members))
+
+	// the versions of the managed groups were incremented so they must be
+	// looked up again
+	admins, err = repo.LookupManagedGroup(ctx, admins.PublicId)
+	require.NoError(err)
+	ops, err = repo.LookupManagedGroup(ctx, ops.PublicId)
+	require.NoError(err)
+
+	// ops is removed, dev is added and admins is kept
+	members, rows, err = repo.SetManagedGroupMemberships(ctx, am, acct, []*ManagedGroup{admins, dev})
+	require.NoError(err)
+	assert.Equal(2, rows)
+	assert.ElementsMatch([]string{admins.PublicId, dev.PublicId}, memberIds(members))
+
+	byMember, err := repo.ListManagedGroupMembershipsByMember(ctx, acct.PublicId)
+	require.NoError(err)
+	assert.ElementsMatch([]string{admins.PublicId, dev.PublicId}, memberIds(byMember))
+	byMember, err = repo.ListManagedGroupMembershipsByMember(ctx, acct.PublicId, WithLimit(1))
+	require.NoError(err)
+	assert.Len(byMember, 1)
+	byGroup, err := repo.ListManagedGroupMembershipsByGroup(ctx, dev.PublicId)
+	require.NoError(err)
+	assert.ElementsMatch([]string{dev.PublicId}, memberIds(byGroup))
+	byGroup, err = repo.ListManagedGroupMembershipsByGroup(ctx, ops.PublicId)
+	require.NoError(err)
+	assert.Empty(byGroup)
+
+	// admins still has the version it had before the last set, so the
+	// managed group has changed since it was matched
+	_, _, err = repo.SetManagedGroupMemberships(ctx, am, acct, []*ManagedGroup{admins})
+	assert.Error(err)
+
+	// an empty set removes all the memberships
+	members, rows, err = repo.SetManagedGroupMemberships(ctx, am, acct, nil)
+	require.NoError(err)
+	assert.Equal(2, rows)
+	assert.Empty(members)
+
+	// nothing to do
+	members, rows, err = repo.SetManagedGroupMemberships(ctx, am, acct, nil)
+	require.NoError(err)
+	assert.Equal(0, rows)
+	assert.Empty(members)
+
+	noVersion := AllocManagedGroup()
+	noVersion.PublicId = admins.PublicId
+	_, _, err = repo.SetManagedGroupMemberships(ctx, am, acct, []*ManagedGroup{noVersion})
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+	_, _, err = repo.SetManagedGroupMemberships(ctx, nil, acct, nil)
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+	_, _, err = repo.SetManagedGroupMemberships(ctx, am, nil, nil)
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+	_, err = repo.ListManagedGroupMembershipsByMember(ctx, "")
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+	_, err = repo.ListManagedGroupMembershipsByGroup(ctx, "")
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+}
@@ -0,0 +1,106 @@
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/intglobals"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_ManagedGroup_CRUD(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	rw := db.New(conn)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(err)
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(err)
+	am := TestAuthMethod(t, conn, databaseWrapper, org.PublicId, []string{"ldaps://ldap.example.com"}, WithUserDn("ou=people,dc=example,dc=com"))
+
+	mg, err := NewManagedGroup(ctx, am.PublicId, []string{"cn=admins,ou=groups,dc=example,dc=com"}, WithName("admins"))
+	require.NoError(err)
+	created, err := repo.CreateManagedGroup(ctx, org.PublicId, mg)
+	require.NoError(err)
+	assert.NotEmpty(created.PublicId)
+	assert.Equal("admins", created.Name)
+	assert.Empty(mg.PublicId, "the managed group passed in must not be changed")
+
+	_, err = repo.CreateManagedGroup(ctx, org.PublicId, mg)
+	assert.Truef(errors.Match(errors.T(errors.NotUnique), err), "want err code: %q got: %q", errors.NotUnique, err)
+	_, err = repo.CreateManagedGroup(ctx, org.PublicId, created)
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+	_, err = repo.CreateManagedGroup(ctx, "", mg)
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+	noGroups := mg.Clone()
+	noGroups.GroupNames = ""
+	_, err = repo.CreateManagedGroup(ctx, org.PublicId, noGroups)
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+
+	ops := TestManagedGroup(t, conn, am, []string{"cn=ops,ou=groups,dc=example,dc=com"}, WithName("ops"))
+
+	found, err := repo.LookupManagedGroup(ctx, created.PublicId)
+	require.NoError(err)
+	assert.Equal(created.GroupNames, found.GroupNames)
+	found, err = repo.LookupManagedGroup(ctx, intglobals.LdapManagedGroupPrefix+"_doesnotexist")
+	require.NoError(err)
+	assert.Nil(found)
+	_, err = repo.LookupManagedGroup(ctx, "")
+	assert.Truef(errors.Match(errors.T(errors.InvalidPublicId), err), "want err code: %q got: %q", errors.InvalidPublicId, err)
+
+	listed, err := repo.ListManagedGroups(ctx, am.PublicId)
+	require.NoError(err)
+	assert.Len(listed, 2)
+	listed, err = repo.ListManagedGroups(ctx, am.PublicId, WithLimit(1))
+	require.NoError(err)
+	assert.Len(listed, 1)
+	_, err = repo.ListManagedGroups(ctx, "")
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+
+	update := AllocManagedGroup()
+	update.PublicId = created.PublicId
+	require.NoError(update.SetGroupNames(ctx, []string{"cn=admins,ou=groups,dc=example,dc=com", "cn=root,ou=groups,dc=example,dc=com"}))
+	updated, rowsUpdated, err := repo.UpdateManagedGroup(ctx, org.PublicId, update, created.Version, []string{GroupNamesField, DescriptionField})
+	require.NoError(err)
+	assert.Equal(1, rowsUpdated)
+	groups, err := updated.Groups(ctx)
+	require.NoError(err)
+	assert.Equal([]string{"cn=admins,ou=groups,dc=example,dc=com", "cn=root,ou=groups,dc=example,dc=com"}, groups)
+	assert.Equal(created.Version+1, updated.Version)
+
+	// the group names can't be removed
+	update = AllocManagedGroup()
+	update.PublicId = created.PublicId
+	_, _, err = repo.UpdateManagedGroup(ctx, org.PublicId, update, updated.Version, []string{GroupNamesField})
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+	_, _, err = repo.UpdateManagedGroup(ctx, org.PublicId, update, updated.Version, []string{"AuthMethodId"})
+	assert.Truef(errors.Match(errors.T(errors.InvalidFieldMask), err), "want err code: %q got: %q", errors.InvalidFieldMask, err)
+	_, _, err = repo.UpdateManagedGroup(ctx, org.PublicId, update, updated.Version, nil)
+	assert.Truef(errors.Match(errors.T(errors.EmptyFieldMask), err), "want err code: %q got: %q", errors.EmptyFieldMask, err)
+
+	// names are unique within the auth method
+	update = AllocManagedGroup()
+	update.PublicId = ops.PublicId
+	update.Name = "admins"
+	_, _, err = repo.UpdateManagedGroup(ctx, org.PublicId, update, ops.Version, []string{NameField})
+	assert.Truef(errors.Match(errors.T(errors.NotUnique), err), "want err code: %q got: %q", errors.NotUnique, err)
+
+	deleted, err := repo.DeleteManagedGroup(ctx, org.PublicId, created.PublicId)
+	require.NoError(err)
+	assert.Equal(1, deleted)
+	found, err = repo.LookupManagedGroup(ctx, created.PublicId)
+	require.NoError(err)
+	assert.Nil(found)
+	_, err = repo.DeleteManagedGroup(ctx, org.PublicId, "")
+	assert.Truef(errors.Match(errors.T(errors.InvalidPublicId), err), "want err code: %q got: %q", errors.InvalidPublicId, err)
+}
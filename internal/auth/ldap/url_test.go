@@ -0,0 +1,93 @@
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestNewUrl(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+	tests := []struct {
+		name         string
+		authMethodId string
+		priority     int
+		serverUrl    string
+		wantIsErr    errors.Code
+	}{
+		{name: "ldap", authMethodId: "amldap_1234567890", priority: 1, serverUrl: "ldap://ldap.example.com"},
+		{name: "ldaps-with-port", authMethodId: "amldap_1234567890", priority: 2, serverUrl: "ldaps://ldap.example.com:1636"},
+		{name: "missing-auth-method", priority: 1, serverUrl: "ldap://ldap.example.com", wantIsErr: errors.InvalidParameter},
+		{name: "zero-priority", authMethodId: "amldap_1234567890", serverUrl: "ldap://ldap.example.com", wantIsErr: errors.InvalidParameter},
+		{name: "missing-url", authMethodId: "amldap_1234567890", priority: 1, wantIsErr: errors.InvalidParameter},
+		{name: "wrong-scheme", authMethodId: "amldap_1234567890", priority: 1, serverUrl: "https://ldap.example.com", wantIsErr: errors.InvalidParameter},
+		{name: "missing-host", authMethodId: "amldap_1234567890", priority: 1, serverUrl: "ldap://", wantIsErr: errors.InvalidParameter},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewUrl(ctx, tt.authMethodId, tt.priority, tt.serverUrl)
+			if tt.wantIsErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantIsErr), err), "want err code: %q got: %q", tt.wantIsErr, err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.authMethodId, got.LdapMethodId)
+			assert.Equal(uint32(tt.priority), got.ConnectionPriority)
+			assert.Equal(tt.serverUrl, got.ServerUrl)
+		})
+	}
+}
+
+func TestUrl_Create(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	rw := db.New(conn)
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(err)
+	am := TestAuthMethod(t, conn, databaseWrapper, org.PublicId, []string{"ldap://ldap1.example.com"}, WithUserDn("ou=people,dc=example,dc=com"))
+
+	u, err := NewUrl(ctx, am.PublicId, 2, "ldaps://ldap2.example.com")
+	require.NoError(err)
+	require.NoError(rw.Create(ctx, u))
+
+	found := AllocUrl()
+	require.NoError(rw.LookupWhere(ctx, &found, "ldap_method_id = ? and connection_priority = ?", am.PublicId, 2))
+	assert.Equal("ldaps://ldap2.example.com", found.ServerUrl)
+
+	// priorities are unique within an auth method
+	dup, err := NewUrl(ctx, am.PublicId, 1, "ldaps://ldap3.example.com")
+	require.NoError(err)
+	err = rw.Create(ctx, dup)
+	assert.Truef(errors.Match(errors.T(errors.NotUnique), err), "want err code: %q got: %q", errors.NotUnique, err)
+
+	cp := u.Clone()
+	assert.True(proto.Equal(cp.Url, u.Url))
+	cp.ServerUrl = "ldaps://ldap4.example.com"
+	assert.False(proto.Equal(cp.Url, u.Url))
+}
+
+func TestUrl_SetTableName(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	u := AllocUrl()
+	assert.Equal(defaultUrlTableName, u.TableName())
+	u.SetTableName("new-name")
+	assert.Equal("new-name", u.TableName())
+	u.SetTableName("")
+	assert.Equal(defaultUrlTableName, u.TableName())
+}
@@ -47,7 +47,8 @@ type AuthMethod struct {
 // ClientSecret equals the client's secret which will be encrypted when stored
 // in the database and an hmac representation will also be stored when ever the
 // secret changes.  The secret is not returned via the API, the hmac is returned
-// so callers can determine if it's been updated.
+// so callers can determine if it's been updated. The secret may be empty for
+// public clients, which rely on PKCE to protect the authorization code.
 //
 // MaxAge equals the Maximum Authentication Age. Specifies the allowable elapsed
 // time in seconds since the last time the End-User was actively authenticated
@@ -58,7 +59,8 @@ type AuthMethod struct {
 // See: https://openid.net/specs/openid-connect-core-1_0.html
 //
 // Supports the options of WithMaxAge, WithSigningAlgs, WithAudClaims,
-// WithApiUrl and WithCertificates and all other options are ignored.
+// WithApiUrl, WithCertificates and WithStoreRefreshTokens and all other options
+// are ignored.
 func NewAuthMethod(ctx context.Context, scopeId string, clientId string, clientSecret ClientSecret, opt ...Option) (*AuthMethod, error) {
 	const op = "oidc.NewAuthMethod"
 	opts := getOpts(opt...)
@@ -71,15 +73,16 @@ func NewAuthMethod(ctx context.Context, scopeId string, clientId string, clientS
 
 	a := &AuthMethod{
 		AuthMethod: &store.AuthMethod{
-			ScopeId:          scopeId,
-			Name:             opts.withName,
-			Description:      opts.withDescription,
-			OperationalState: string(opts.withOperationalState),
-			Issuer:           u,
-			ClientId:         clientId,
-			ClientSecret:     string(clientSecret),
-			MaxAge:           int32(opts.withMaxAge),
-			ClaimsScopes:     opts.withClaimsScopes,
+			ScopeId:            scopeId,
+			Name:               opts.withName,
+			Description:        opts.withDescription,
+			OperationalState:   string(opts.withOperationalState),
+			Issuer:             u,
+			ClientId:           clientId,
+			ClientSecret:       string(clientSecret),
+			MaxAge:             int32(opts.withMaxAge),
+			ClaimsScopes:       opts.withClaimsScopes,
+			StoreRefreshTokens: opts.withStoreRefreshTokens,
 		},
 	}
 	if opts.withApiUrl != nil {
@@ -260,9 +263,6 @@ func (am *AuthMethod) isComplete(ctx context.Context) error {
 	if am.ClientId == "" {
 		result = multierror.Append(result, errors.New(ctx, errors.InvalidParameter, op, "missing client id"))
 	}
-	if am.ClientSecret == "" {
-		result = multierror.Append(result, errors.New(ctx, errors.InvalidParameter, op, "missing client secret"))
-	}
 	if len(am.SigningAlgs) == 0 {
 		result = multierror.Append(result, errors.New(ctx, errors.InvalidParameter, op, "missing signing algorithms"))
 	}
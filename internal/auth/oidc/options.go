@@ -45,6 +45,7 @@ type options struct {
 	withOperationalState    AuthMethodState
 	withAccountClaimMap     map[string]AccountToClaim
 	withReader              db.Reader
+	withStoreRefreshTokens  bool
 }
 
 func getDefaultOptions() options {
@@ -229,3 +230,12 @@ func WithReader(reader db.Reader) Option {
 		o.withReader = reader
 	}
 }
+
+// WithStoreRefreshTokens provides an option to have the auth method store the
+// refresh tokens issued by the IdP so they can be used to periodically
+// re-check a user's standing with the IdP.
+func WithStoreRefreshTokens(store bool) Option {
+	return func(o *options) {
+		o.withStoreRefreshTokens = store
+	}
+}
@@ -0,0 +1,30 @@
+package oidc
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+
+	"github.com/hashicorp/cap/oidc"
+)
+
+// codeVerifier is a PKCE code verifier restored from an authentication
+// attempt's request.State.  It implements the oidc.CodeVerifier interface using
+// the S256 challenge method, which is the only method StartAuth uses.
+type codeVerifier string
+
+var _ oidc.CodeVerifier = codeVerifier("")
+
+// Verifier implements the oidc.CodeVerifier interface.
+func (v codeVerifier) Verifier() string { return string(v) }
+
+// Challenge implements the oidc.CodeVerifier interface.
+func (v codeVerifier) Challenge() string {
+	sum := sha256.Sum256([]byte(v))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// Method implements the oidc.CodeVerifier interface.
+func (v codeVerifier) Method() oidc.ChallengeMethod { return oidc.S256 }
+
+// Copy implements the oidc.CodeVerifier interface.
+func (v codeVerifier) Copy() oidc.CodeVerifier { return v }
@@ -0,0 +1,23 @@
+package oidc
+
+import (
+	"testing"
+
+	"github.com/hashicorp/cap/oidc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_codeVerifier(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+
+	want, err := oidc.NewCodeVerifier()
+	require.NoError(err)
+
+	got := codeVerifier(want.Verifier())
+	assert.Equal(want.Verifier(), got.Verifier())
+	assert.Equal(want.Challenge(), got.Challenge())
+	assert.Equal(want.Method(), got.Method())
+	assert.Equal(got, got.Copy())
+}
@@ -104,6 +104,10 @@ func Test_convertToProvider(t *testing.T) {
 	testAm.ApiUrl = allowedRedirect
 	testAm.Certificates = []string{tp.CACert()}
 
+	publicAm := testAm.Clone()
+	publicAm.ClientSecret = ""
+	publicP := testProvider(t, id, "", fmt.Sprintf(CallbackEndpoint, allowedRedirect), tp)
+
 	type args struct{}
 	tests := []struct {
 		name        string
@@ -115,7 +119,7 @@ func Test_convertToProvider(t *testing.T) {
 		{"equal", testAm, p, false, errors.Unknown},
 		{"missing-issuer", func() *AuthMethod { cp := testAm.Clone(); cp.Issuer = ""; return cp }(), nil, true, errors.InvalidParameter},
 		{"missing-client-id", func() *AuthMethod { cp := testAm.Clone(); cp.ClientId = ""; return cp }(), nil, true, errors.InvalidParameter},
-		{"missing-client-secret", func() *AuthMethod { cp := testAm.Clone(); cp.ClientSecret = ""; return cp }(), nil, true, errors.InvalidParameter},
+		{"public-client", publicAm, publicP, false, errors.Unknown},
 		{"missing-algs", func() *AuthMethod { cp := testAm.Clone(); cp.SigningAlgs = nil; return cp }(), nil, true, errors.InvalidParameter},
 		{"missing-api-url", func() *AuthMethod { cp := testAm.Clone(); cp.ApiUrl = ""; return cp }(), nil, true, errors.InvalidParameter},
 	}
@@ -13,3 +13,59 @@ const (
 	returning public_id, version
        `
 )
+
+const (
+	rewrapClientSecretsQuery = `
+select public_id,
+       scope_id,
+       client_secret,
+       key_id
+  from auth_oidc_method
+ where key_id not in (select private_id from kms_latest_database_key_version);
+`
+
+	updateClientSecretKeyQuery = `
+update auth_oidc_method
+   set client_secret      = @client_secret,
+       client_secret_hmac = @client_secret_hmac,
+       key_id             = @key_id
+ where public_id = @public_id
+   and key_id = @old_key_id;
+`
+
+	updateAuthMethodKeyIdQuery = `
+update auth_oidc_method
+   set key_id = @key_id
+ where public_id = @public_id
+   and key_id = @old_key_id;
+`
+)
+
+const (
+	upsertRefreshTokenQuery = `
+insert into auth_oidc_refresh_token
+       (auth_token_id, oidc_method_id, refresh_token, key_id)
+values (@auth_token_id, @oidc_method_id, @refresh_token, @key_id)
+    on conflict (auth_token_id)
+    do update set refresh_token = excluded.refresh_token,
+                  key_id        = excluded.key_id,
+                  check_time    = current_timestamp;
+`
+
+	listRefreshTokensToCheckQuery = `
+select rt.auth_token_id,
+       rt.oidc_method_id,
+       am.scope_id,
+       rt.refresh_token,
+       rt.key_id
+  from auth_oidc_refresh_token rt
+  join auth_oidc_method am
+    on am.public_id = rt.oidc_method_id
+  join auth_token at
+    on at.public_id = rt.auth_token_id
+ where at.status = 'token issued'
+   and rt.check_time < @checked_before
+ order by rt.check_time
+ limit @limit;
+`
+)
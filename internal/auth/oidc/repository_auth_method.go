@@ -120,7 +120,11 @@ func (r *Repository) upsertAccount(ctx context.Context, am *AuthMethod, IdTokenC
 		columns, values = append(columns, "full_name"), append(values, sql.Named(fmt.Sprintf("%d", len(values)+1), foundName))
 	}
 	if foundName != nil {
-		acctForOplog.FullName = foundName.(string)
+		v, ok := foundName.(string)
+		if !ok {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s claim is not a string", fromName))
+		}
+		acctForOplog.FullName = v
 		conflictClauses = append(conflictClauses, fmt.Sprintf("full_name = @%d", len(values)))
 		fieldMasks = append(fieldMasks, NameField)
 	} else {
@@ -138,7 +142,11 @@ func (r *Repository) upsertAccount(ctx context.Context, am *AuthMethod, IdTokenC
 		columns, values = append(columns, "email"), append(values, sql.Named(fmt.Sprintf("%d", len(values)+1), foundEmail))
 	}
 	if foundEmail != nil {
-		acctForOplog.Email = foundEmail.(string)
+		v, ok := foundEmail.(string)
+		if !ok {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s claim is not a string", fromEmail))
+		}
+		acctForOplog.Email = v
 		conflictClauses = append(conflictClauses, fmt.Sprintf("email = @%d", len(values)))
 		fieldMasks = append(fieldMasks, "Email")
 	} else {
@@ -164,6 +164,7 @@ func (r *Repository) getAuthMethods(ctx context.Context, authMethodId string, sc
 		if agg.AccountClaimMaps != "" {
 			am.AccountClaimMaps = strings.Split(agg.AccountClaimMaps, aggregateDelimiter)
 		}
+		am.StoreRefreshTokens = agg.StoreRefreshTokens
 		authMethods = append(authMethods, &am)
 	}
 	return authMethods, nil
@@ -195,6 +196,7 @@ type authMethodAgg struct {
 	Certs                             string
 	ClaimsScopes                      string
 	AccountClaimMaps                  string
+	StoreRefreshTokens                bool
 }
 
 // TableName returns the table name for gorm
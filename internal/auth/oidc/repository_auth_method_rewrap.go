@@ -0,0 +1,89 @@
+package oidc
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+)
+
+// rewrapAuthMethod is an auth method whose client secret is not encrypted
+// with the latest version of the database key of its scope.
+type rewrapAuthMethod struct {
+	PublicId       string
+	ScopeId        string
+	CtClientSecret []byte `gorm:"column:client_secret"`
+	KeyId          string
+}
+
+// RewrapClientSecrets re-encrypts the client secrets of the auth methods which
+// are not encrypted with the latest version of the database key of their
+// scope, so older versions of the key can be destroyed. The HMAC of the
+// client secret is recomputed with the new key version. It returns the
+// number of auth methods updated. No options are currently supported.
+func (r *Repository) RewrapClientSecrets(ctx context.Context, _ ...Option) (int, error) {
+	const op = "oidc.(Repository).RewrapClientSecrets"
+	rows, err := r.reader.Query(ctx, rewrapClientSecretsQuery, nil)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var ams []*rewrapAuthMethod
+	for rows.Next() {
+		var am rewrapAuthMethod
+		if err := r.reader.ScanRows(rows, &am); err != nil {
+			return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		ams = append(ams, &am)
+	}
+
+	var rowsUpdated int
+	for _, ram := range ams {
+		databaseWrapper, err := r.kms.GetWrapper(ctx, ram.ScopeId, kms.KeyPurposeDatabase)
+		if err != nil {
+			return rowsUpdated, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+		}
+		if len(ram.CtClientSecret) == 0 {
+			// There is no client secret yet, so only the key id needs to
+			// move to the latest version.
+			n, err := r.writer.Exec(ctx, updateAuthMethodKeyIdQuery, []interface{}{
+				sql.Named("key_id", databaseWrapper.KeyID()),
+				sql.Named("public_id", ram.PublicId),
+				sql.Named("old_key_id", ram.KeyId),
+			})
+			if err != nil {
+				return rowsUpdated, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", ram.PublicId)))
+			}
+			rowsUpdated += n
+			continue
+		}
+
+		am := AllocAuthMethod()
+		am.PublicId, am.CtClientSecret, am.KeyId = ram.PublicId, ram.CtClientSecret, ram.KeyId
+		oldWrapper, err := r.kms.GetWrapper(ctx, ram.ScopeId, kms.KeyPurposeDatabase, kms.WithKeyId(ram.KeyId))
+		if err != nil {
+			return rowsUpdated, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+		}
+		if err := am.decrypt(ctx, oldWrapper); err != nil {
+			return rowsUpdated, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", ram.PublicId)))
+		}
+		if err := am.encrypt(ctx, databaseWrapper); err != nil {
+			return rowsUpdated, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", ram.PublicId)))
+		}
+		n, err := r.writer.Exec(ctx, updateClientSecretKeyQuery, []interface{}{
+			sql.Named("client_secret", am.CtClientSecret),
+			sql.Named("client_secret_hmac", am.ClientSecretHmac),
+			sql.Named("key_id", am.KeyId),
+			sql.Named("public_id", ram.PublicId),
+			sql.Named("old_key_id", ram.KeyId),
+		})
+		if err != nil {
+			return rowsUpdated, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", ram.PublicId)))
+		}
+		rowsUpdated += n
+	}
+	return rowsUpdated, nil
+}
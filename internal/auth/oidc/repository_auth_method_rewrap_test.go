@@ -0,0 +1,53 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_RewrapClientSecrets(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(err)
+
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(err)
+	withSecret := TestAuthMethod(t, conn, databaseWrapper, org.PublicId, InactiveState, "alice_rp", "alices-dogs-name")
+
+	n, err := repo.RewrapClientSecrets(ctx)
+	require.NoError(err)
+	assert.Equal(0, n)
+
+	require.NoError(kmsCache.RotateKeys(ctx, org.PublicId, rand.Reader))
+	newWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(err)
+	require.NotEqual(databaseWrapper.KeyID(), newWrapper.KeyID())
+
+	n, err = repo.RewrapClientSecrets(ctx)
+	require.NoError(err)
+	assert.Equal(1, n)
+
+	got, err := repo.LookupAuthMethod(ctx, withSecret.PublicId)
+	require.NoError(err)
+	assert.Equal(withSecret.ClientSecret, got.ClientSecret)
+	assert.NotEqual(withSecret.ClientSecretHmac, got.ClientSecretHmac)
+	assert.Equal(newWrapper.KeyID(), got.KeyId)
+
+	n, err = repo.RewrapClientSecrets(ctx)
+	require.NoError(err)
+	assert.Equal(0, n)
+}
@@ -34,6 +34,7 @@ const (
 	CertificatesField                      = "Certificates"
 	ClaimsScopesField                      = "ClaimsScopes"
 	AccountClaimMapsField                  = "AccountClaimMaps"
+	StoreRefreshTokensField                = "StoreRefreshTokens"
 	TokenClaimsField                       = "TokenClaims"
 	UserinfoClaimsField                    = "UserinfoClaims"
 )
@@ -93,21 +94,22 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, versi
 
 	dbMask, nullFields := dbcommon.BuildUpdatePaths(
 		map[string]interface{}{
-			NameField:             am.Name,
-			DescriptionField:      am.Description,
-			IssuerField:           am.Issuer,
-			ClientIdField:         am.ClientId,
-			ClientSecretField:     am.ClientSecret,
-			MaxAgeField:           am.MaxAge,
-			SigningAlgsField:      am.SigningAlgs,
-			ApiUrlField:           am.ApiUrl,
-			AudClaimsField:        am.AudClaims,
-			CertificatesField:     am.Certificates,
-			ClaimsScopesField:     am.ClaimsScopes,
-			AccountClaimMapsField: am.AccountClaimMaps,
+			NameField:               am.Name,
+			DescriptionField:        am.Description,
+			IssuerField:             am.Issuer,
+			ClientIdField:           am.ClientId,
+			ClientSecretField:       am.ClientSecret,
+			MaxAgeField:             am.MaxAge,
+			SigningAlgsField:        am.SigningAlgs,
+			ApiUrlField:             am.ApiUrl,
+			AudClaimsField:          am.AudClaims,
+			CertificatesField:       am.Certificates,
+			ClaimsScopesField:       am.ClaimsScopes,
+			AccountClaimMapsField:   am.AccountClaimMaps,
+			StoreRefreshTokensField: am.StoreRefreshTokens,
 		},
 		fieldMaskPaths,
-		nil,
+		[]string{StoreRefreshTokensField},
 	)
 	if len(dbMask) == 0 && len(nullFields) == 0 {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
@@ -576,6 +578,7 @@ func validateFieldMask(ctx context.Context, fieldMaskPaths []string) error {
 		case strings.EqualFold(CertificatesField, f):
 		case strings.EqualFold(ClaimsScopesField, f):
 		case strings.EqualFold(AccountClaimMapsField, f):
+		case strings.EqualFold(StoreRefreshTokensField, f):
 		default:
 			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid field mask: %s", f))
 		}
@@ -642,6 +645,8 @@ func applyUpdate(new, orig *AuthMethod, fieldMaskPaths []string) *AuthMethod {
 				cp.AccountClaimMaps = make([]string, 0, len(new.AccountClaimMaps))
 				cp.AccountClaimMaps = append(cp.AccountClaimMaps, new.AccountClaimMaps...)
 			}
+		case StoreRefreshTokensField:
+			cp.StoreRefreshTokens = new.StoreRefreshTokens
 		}
 	}
 	return cp
@@ -0,0 +1,115 @@
+package oidc
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-kms-wrapping/structwrapping"
+)
+
+// refreshToken is the refresh token issued by an auth method's IdP when
+// Boundary issued the auth token for AuthTokenId. It is only stored for auth
+// methods with StoreRefreshTokens set.
+type refreshToken struct {
+	AuthTokenId    string
+	OidcMethodId   string
+	ScopeId        string
+	CtRefreshToken []byte `gorm:"column:refresh_token" wrapping:"ct,refresh_token"`
+	RefreshToken   []byte `gorm:"-" wrapping:"pt,refresh_token"`
+	KeyId          string
+}
+
+// upsertRefreshToken stores token as the refresh token for the auth token
+// authTokenId, encrypted with the latest database key of the auth method's
+// scope. An existing refresh token for the auth token is replaced and its
+// check time is reset.
+func (r *Repository) upsertRefreshToken(ctx context.Context, am *AuthMethod, authTokenId, token string) error {
+	const op = "oidc.(Repository).upsertRefreshToken"
+	if am == nil || am.AuthMethod == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	}
+	if authTokenId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing auth token id")
+	}
+	if token == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing refresh token")
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	rt := &refreshToken{RefreshToken: []byte(token)}
+	if err := rt.encrypt(ctx, databaseWrapper); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if _, err := r.writer.Exec(ctx, upsertRefreshTokenQuery, []interface{}{
+		sql.Named("auth_token_id", authTokenId),
+		sql.Named("oidc_method_id", am.PublicId),
+		sql.Named("refresh_token", rt.CtRefreshToken),
+		sql.Named("key_id", rt.KeyId),
+	}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", authTokenId)))
+	}
+	return nil
+}
+
+// listRefreshTokensToCheck returns up to limit decrypted refresh tokens of
+// issued auth tokens which haven't been checked since checkedBefore, least
+// recently checked first.
+func (r *Repository) listRefreshTokensToCheck(ctx context.Context, checkedBefore time.Time, limit int) ([]*refreshToken, error) {
+	const op = "oidc.(Repository).listRefreshTokensToCheck"
+	if limit <= 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "limit must be greater than zero")
+	}
+	rows, err := r.reader.Query(ctx, listRefreshTokensToCheckQuery, []interface{}{
+		sql.Named("checked_before", checkedBefore),
+		sql.Named("limit", limit),
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var rts []*refreshToken
+	for rows.Next() {
+		var rt refreshToken
+		if err := r.reader.ScanRows(rows, &rt); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		rts = append(rts, &rt)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	for _, rt := range rts {
+		databaseWrapper, err := r.kms.GetWrapper(ctx, rt.ScopeId, kms.KeyPurposeDatabase, kms.WithKeyId(rt.KeyId))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+		}
+		if err := rt.decrypt(ctx, databaseWrapper); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", rt.AuthTokenId)))
+		}
+	}
+	return rts, nil
+}
+
+func (rt *refreshToken) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "oidc.(refreshToken).encrypt"
+	if err := structwrapping.WrapStruct(ctx, cipher, rt, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	rt.KeyId = cipher.KeyID()
+	return nil
+}
+
+func (rt *refreshToken) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "oidc.(refreshToken).decrypt"
+	if err := structwrapping.UnwrapStruct(ctx, cipher, rt, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package oidc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_RefreshTokens(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(err)
+
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(err)
+	am := TestAuthMethod(t, conn, databaseWrapper, org.PublicId, InactiveState, "alice_rp", "alices-dogs-name")
+	at := authtoken.TestAuthToken(t, conn, kmsCache, org.PublicId)
+
+	require.Error(repo.upsertRefreshToken(ctx, nil, at.PublicId, "refresh-token"))
+	require.Error(repo.upsertRefreshToken(ctx, am, "", "refresh-token"))
+	require.Error(repo.upsertRefreshToken(ctx, am, at.PublicId, ""))
+	require.NoError(repo.upsertRefreshToken(ctx, am, at.PublicId, "refresh-token"))
+
+	got, err := repo.listRefreshTokensToCheck(ctx, time.Now().Add(-time.Hour), 10)
+	require.NoError(err)
+	assert.Empty(got)
+
+	got, err = repo.listRefreshTokensToCheck(ctx, time.Now().Add(time.Hour), 10)
+	require.NoError(err)
+	require.Len(got, 1)
+	assert.Equal(at.PublicId, got[0].AuthTokenId)
+	assert.Equal(am.PublicId, got[0].OidcMethodId)
+	assert.Equal(org.PublicId, got[0].ScopeId)
+	assert.Equal("refresh-token", string(got[0].RefreshToken))
+	assert.Equal(databaseWrapper.KeyID(), got[0].KeyId)
+
+	require.NoError(repo.upsertRefreshToken(ctx, am, at.PublicId, "rotated-refresh-token"))
+	got, err = repo.listRefreshTokensToCheck(ctx, time.Now().Add(time.Hour), 10)
+	require.NoError(err)
+	require.Len(got, 1)
+	assert.Equal("rotated-refresh-token", string(got[0].RefreshToken))
+
+	_, err = repo.listRefreshTokensToCheck(ctx, time.Now(), 0)
+	require.Error(err)
+}
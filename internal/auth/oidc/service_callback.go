@@ -43,6 +43,10 @@ import (
 //
 // * Use the authtoken.(Repository).CreateAuthToken(...) to create a pending
 // auth token for the authenticated user.
+//
+// * If the auth method stores refresh tokens, store the IdP's refresh token for
+// the pending auth token, so CheckRefreshTokens can re-check the user's
+// standing with the IdP.
 func Callback(
 	ctx context.Context,
 	oidcRepoFn OidcRepoFactory,
@@ -150,6 +154,10 @@ func Callback(
 	if len(am.AudClaims) > 0 {
 		opts = append(opts, oidc.WithAudiences(am.AudClaims...))
 	}
+	// attempts started before PKCE was introduced won't have a code verifier
+	if reqState.CodeVerifier != "" {
+		opts = append(opts, oidc.WithPKCE(codeVerifier(reqState.CodeVerifier)))
+	}
 	if strings.TrimSpace(am.ApiUrl) == "" {
 		return "", errors.New(ctx, errors.InvalidParameter, op, "empty api URL")
 	}
@@ -162,35 +170,60 @@ func Callback(
 		return "", errors.New(ctx, errors.Unknown, op, "unable to complete exchange with oidc provider", errors.WithWrap(err))
 	}
 
+	if err := completeAuthentication(ctx, r, iamRepoFn, atRepoFn, provider, am, tk, reqState.TokenRequestId); err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	// tada!  we can return a final redirect URL for the successful authentication.
+	return reqState.FinalRedirectUrl, nil
+}
+
+// completeAuthentication completes an authentication attempt once the IdP
+// has returned tokens for the user. The account is created or updated from
+// the ID Token and userinfo claims, its managed group memberships are synced
+// and a pending auth token with the id tokenRequestId is created for the
+// account's user. The IdP's refresh token is stored with the pending auth
+// token if the auth method stores refresh tokens.
+//
+// The ID Token of tk must have been verified by the caller.
+func completeAuthentication(
+	ctx context.Context,
+	r *Repository,
+	iamRepoFn IamRepoFactory,
+	atRepoFn AuthTokenRepoFactory,
+	provider *oidc.Provider,
+	am *AuthMethod,
+	tk *oidc.Tk,
+	tokenRequestId string) error {
+	const op = "oidc.completeAuthentication"
 	// okay, now we need some claims from both the ID Token and userinfo, so we can
 	// upsert an auth account
 	idTkClaims := map[string]interface{}{}     // intentionally, NOT nil for call to upsertAccount(...)
 	userInfoClaims := map[string]interface{}{} // intentionally, NOT nil for call to upsertAccount(...)
 
 	if err := tk.IDToken().Claims(&idTkClaims); err != nil {
-		return "", errors.New(ctx, errors.Unknown, op, "unable to parse ID Token claims", errors.WithWrap(err))
+		return errors.New(ctx, errors.Unknown, op, "unable to parse ID Token claims", errors.WithWrap(err))
 	}
 
 	userInfoTokenSource := tk.StaticTokenSource()
 	if userInfoTokenSource != nil {
 		sub, ok := idTkClaims["sub"].(string)
 		if !ok {
-			return "", errors.New(ctx, errors.Unknown, op, "subject is not present in ID Token, which should not be possible")
+			return errors.New(ctx, errors.Unknown, op, "subject is not present in ID Token, which should not be possible")
 		}
 		if err := provider.UserInfo(ctx, userInfoTokenSource, sub, &userInfoClaims); err != nil {
-			return "", errors.New(ctx, errors.Unknown, op, "unable to get user info from provider", errors.WithWrap(err))
+			return errors.New(ctx, errors.Unknown, op, "unable to get user info from provider", errors.WithWrap(err))
 		}
 	}
 
 	acct, err := r.upsertAccount(ctx, am, idTkClaims, userInfoClaims)
 	if err != nil {
-		return "", errors.Wrap(ctx, err, op)
+		return errors.Wrap(ctx, err, op)
 	}
 
 	// Get the set of all managed groups so we can filter
 	mgs, err := r.ListManagedGroups(ctx, am.GetPublicId())
 	if err != nil {
-		return "", errors.Wrap(ctx, err, op)
+		return errors.Wrap(ctx, err, op)
 	}
 	if len(mgs) > 0 {
 		matchedMgs := make([]*ManagedGroup, 0, len(mgs))
@@ -204,11 +237,11 @@ func Callback(
 			if err != nil {
 				// We check all filters on ingress so this should never happen,
 				// but we validate anyways
-				return "", errors.Wrap(ctx, err, op)
+				return errors.Wrap(ctx, err, op)
 			}
 			match, err := eval.Evaluate(evalData)
 			if err != nil && !errors.Is(err, pointerstructure.ErrNotFound) {
-				return "", errors.Wrap(ctx, err, op)
+				return errors.Wrap(ctx, err, op)
 			}
 			if match {
 				matchedMgs = append(matchedMgs, mg)
@@ -217,7 +250,7 @@ func Callback(
 		// We always pass it in, even if none match, because in that case we
 		// need to remove any mappings that exist
 		if _, _, err := r.SetManagedGroupMemberships(ctx, am, acct, matchedMgs); err != nil {
-			return "", errors.Wrap(ctx, err, op)
+			return errors.Wrap(ctx, err, op)
 		}
 	}
 
@@ -226,17 +259,17 @@ func Callback(
 	// autovivify users for the scope.
 	iamRepo, err := iamRepoFn()
 	if err != nil {
-		return "", errors.Wrap(ctx, err, op)
+		return errors.Wrap(ctx, err, op)
 	}
 
 	scope, err := iamRepo.LookupScope(ctx, am.ScopeId)
 	if err != nil {
-		return "", errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup account scope: "+scope.PublicId))
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup account scope: "+scope.PublicId))
 	}
 
 	user, err := iamRepo.LookupUserWithLogin(ctx, acct.PublicId)
 	if err != nil {
-		return "", errors.Wrap(ctx, err, op)
+		return errors.Wrap(ctx, err, op)
 	}
 
 	// Now we need to check filters and assign managed groups by filter.
@@ -246,14 +279,18 @@ func Callback(
 	// that initialed the authentication attempt.
 	tokenRepo, err := atRepoFn()
 	if err != nil {
-		return "", errors.Wrap(ctx, err, op)
+		return errors.Wrap(ctx, err, op)
 	}
-	if _, err := tokenRepo.CreateAuthToken(ctx, user, acct.PublicId, authtoken.WithPublicId(reqState.TokenRequestId), authtoken.WithStatus(authtoken.PendingStatus)); err != nil {
+	if _, err := tokenRepo.CreateAuthToken(ctx, user, acct.PublicId, authtoken.WithPublicId(tokenRequestId), authtoken.WithStatus(authtoken.PendingStatus)); err != nil {
 		if errors.Match(errors.T(errors.NotUnique), err) {
-			return "", errors.New(ctx, errors.Forbidden, op, "not a unique request", errors.WithWrap(err))
+			return errors.New(ctx, errors.Forbidden, op, "not a unique request", errors.WithWrap(err))
 		}
-		return "", errors.Wrap(ctx, err, op)
+		return errors.Wrap(ctx, err, op)
 	}
-	// tada!  we can return a final redirect URL for the successful authentication.
-	return reqState.FinalRedirectUrl, nil
+	if am.StoreRefreshTokens && tk.RefreshToken() != "" {
+		if err := r.upsertRefreshToken(ctx, am, tokenRequestId, string(tk.RefreshToken())); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+	return nil
 }
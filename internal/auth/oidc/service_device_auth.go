@@ -0,0 +1,390 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	gooidc "github.com/coreos/go-oidc/v3/oidc"
+	"github.com/hashicorp/boundary/internal/auth/oidc/request"
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/cap/oidc"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
+	"golang.org/x/oauth2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// deviceCodeGrantType is the grant type used to exchange a device code
+	// for tokens.
+	//
+	// See https://tools.ietf.org/html/rfc8628#section-3.4.
+	deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+	// defaultDeviceInterval is the polling interval used when the IdP doesn't
+	// return one.
+	defaultDeviceInterval = 5 * time.Second
+)
+
+// DeviceAuthorization is a device authorization request started with
+// StartDeviceAuth.
+type DeviceAuthorization struct {
+	// UserCode is the code the user enters at the VerificationUri.
+	UserCode string
+
+	// VerificationUri is the URI of the IdP where the user enters the
+	// UserCode.
+	VerificationUri string
+
+	// VerificationUriComplete is the VerificationUri including the UserCode.
+	// It's empty if the IdP didn't return one.
+	VerificationUriComplete string
+
+	// Interval is the minimum time the client should wait between token
+	// requests.
+	Interval time.Duration
+
+	// ExpiresIn is the time until the UserCode expires.
+	ExpiresIn time.Duration
+
+	// TokenId is the encrypted request token the client uses to poll for its
+	// Boundary token with DeviceTokenRequest.
+	TokenId string
+}
+
+// StartDeviceAuth accepts a request to start an OIDC authentication attempt
+// using the OAuth 2.0 device authorization grant, for clients which can't open
+// a browser on the user's machine. It requests a device code and user code
+// from the IdP's device authorization endpoint and returns the user code with
+// the IdP's verification URI, which the user visits on any device to complete
+// the authentication.
+//
+// The returned TokenId is an encrypted payload which includes the IdP's device
+// code. The client uses it to poll DeviceTokenRequest until the user has
+// completed the authentication.
+//
+// If the auth method is in an InactiveState or the IdP doesn't publish a
+// device authorization endpoint, then an error is returned.
+func StartDeviceAuth(ctx context.Context, oidcRepoFn OidcRepoFactory, authMethodId string) (*DeviceAuthorization, error) {
+	const op = "oidc.StartDeviceAuth"
+	if authMethodId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	if oidcRepoFn == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing oidc repo function")
+	}
+	r, err := oidcRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	am, err := r.lookupAuthMethod(ctx, authMethodId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if am == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("auth method %s not found", authMethodId))
+	}
+	if am.OperationalState == string(InactiveState) {
+		return nil, errors.New(ctx, errors.AuthMethodInactive, op, "not allowed to start authentication attempt")
+	}
+
+	provider, err := providerCache().get(ctx, am)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	_, deviceAuthUrl, err := discoverDeviceProvider(ctx, provider, am)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	params := url.Values{
+		"scope": {strings.Join(append([]string{gooidc.ScopeOpenID}, am.ClaimsScopes...), " ")},
+	}
+	var resp struct {
+		DeviceCode              string `json:"device_code"`
+		UserCode                string `json:"user_code"`
+		VerificationUri         string `json:"verification_uri"`
+		VerificationUrl         string `json:"verification_url"` // used by some IdPs instead of verification_uri
+		VerificationUriComplete string `json:"verification_uri_complete"`
+		ExpiresIn               int64  `json:"expires_in"`
+		Interval                int64  `json:"interval"`
+		Error                   string `json:"error"`
+		ErrorDescription        string `json:"error_description"`
+	}
+	status, err := postToProvider(ctx, provider, am, deviceAuthUrl, params, &resp)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if status != http.StatusOK {
+		return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("device authorization request refused by provider: %s: %s", resp.Error, resp.ErrorDescription))
+	}
+	if resp.VerificationUri == "" {
+		resp.VerificationUri = resp.VerificationUrl
+	}
+	switch {
+	case resp.DeviceCode == "":
+		return nil, errors.New(ctx, errors.Unknown, op, "provider did not return a device code")
+	case resp.UserCode == "":
+		return nil, errors.New(ctx, errors.Unknown, op, "provider did not return a user code")
+	case resp.VerificationUri == "":
+		return nil, errors.New(ctx, errors.Unknown, op, "provider did not return a verification uri")
+	}
+
+	da := &DeviceAuthorization{
+		UserCode:                resp.UserCode,
+		VerificationUri:         resp.VerificationUri,
+		VerificationUriComplete: resp.VerificationUriComplete,
+		Interval:                time.Duration(resp.Interval) * time.Second,
+		ExpiresIn:               time.Duration(resp.ExpiresIn) * time.Second,
+	}
+	if da.Interval <= 0 {
+		da.Interval = defaultDeviceInterval
+	}
+	if da.ExpiresIn <= 0 {
+		da.ExpiresIn = AttemptExpiration
+	}
+
+	tokenRequestId, err := authtoken.NewAuthTokenId()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	requestWrapper, err := requestWrappingWrapper(ctx, r.kms, am.ScopeId, authMethodId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	t := &request.Token{
+		RequestId:      tokenRequestId,
+		ExpirationTime: &timestamp.Timestamp{Timestamp: timestamppb.New(time.Now().Add(da.ExpiresIn).Truncate(time.Second))},
+		DeviceCode:     resp.DeviceCode,
+	}
+	if da.TokenId, err = encryptMessage(ctx, requestWrapper, am, t); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return da, nil
+}
+
+// DeviceTokenRequest is an oidc domain service function for processing a
+// token request for a device authorization request started with
+// StartDeviceAuth. It returns nil if the request token wasn't returned by
+// StartDeviceAuth or the user hasn't completed the authentication yet, in which
+// case the client should poll again after the DeviceAuthorization's Interval.
+//
+// * Decrypt the tokenRequestId and poll the IdP's token endpoint with its
+// device code.
+//
+// * Once the IdP returns tokens, verify the ID Token and complete the
+// authentication the same way as Callback, creating a pending auth token for
+// the user.
+//
+// * Use the authtoken.(Repository).IssueAuthToken to issue the pending token.
+//
+// An error with code Forbidden is returned if the user denied the request and
+// an error with code AuthAttemptExpired if the device code has expired.
+func DeviceTokenRequest(
+	ctx context.Context,
+	kms *kms.Kms,
+	oidcRepoFn OidcRepoFactory,
+	iamRepoFn IamRepoFactory,
+	atRepoFn AuthTokenRepoFactory,
+	authMethodId, tokenRequestId string) (*authtoken.AuthToken, error) {
+	const op = "oidc.DeviceTokenRequest"
+	switch {
+	case kms == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	case oidcRepoFn == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing oidc repository function")
+	case iamRepoFn == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing iam repository function")
+	case atRepoFn == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth token repository function")
+	case authMethodId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	case tokenRequestId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing token request id")
+	}
+	reqTk, err := decryptRequestToken(ctx, kms, authMethodId, tokenRequestId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if reqTk.DeviceCode == "" {
+		return nil, nil
+	}
+
+	r, err := oidcRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	am, err := r.lookupAuthMethod(ctx, authMethodId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if am == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("auth method %s not found", authMethodId))
+	}
+	if am.OperationalState == string(InactiveState) {
+		return nil, errors.New(ctx, errors.AuthMethodInactive, op, "not allowed to complete authentication attempt")
+	}
+	provider, err := providerCache().get(ctx, am)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	gp, _, err := discoverDeviceProvider(ctx, provider, am)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	params := url.Values{
+		"grant_type":  {deviceCodeGrantType},
+		"device_code": {reqTk.DeviceCode},
+	}
+	var resp struct {
+		AccessToken      string `json:"access_token"`
+		TokenType        string `json:"token_type"`
+		RefreshToken     string `json:"refresh_token"`
+		ExpiresIn        int64  `json:"expires_in"`
+		IdToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	status, err := postToProvider(ctx, provider, am, gp.Endpoint().TokenURL, params, &resp)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if status != http.StatusOK {
+		switch resp.Error {
+		case "authorization_pending", "slow_down":
+			// The user hasn't completed the authentication yet.
+			return nil, nil
+		case "access_denied":
+			return nil, errors.New(ctx, errors.Forbidden, op, "device authorization denied by provider")
+		case "expired_token":
+			return nil, errors.New(ctx, errors.AuthAttemptExpired, op, "device code has expired")
+		default:
+			return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("token request refused by provider: %s: %s", resp.Error, resp.ErrorDescription))
+		}
+	}
+	if resp.IdToken == "" {
+		return nil, errors.New(ctx, errors.Unknown, op, "id_token is missing from device code exchange")
+	}
+
+	clientCtx, err := provider.HTTPClientContext(ctx)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to get provider http client", errors.WithWrap(err))
+	}
+	verifier := gp.Verifier(&gooidc.Config{
+		ClientID:             am.ClientId,
+		SupportedSigningAlgs: am.SigningAlgs,
+	})
+	idTk, err := verifier.Verify(clientCtx, resp.IdToken)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "invalid id_token", errors.WithWrap(err))
+	}
+	if len(am.AudClaims) > 0 {
+		var allowed bool
+		for _, aud := range idTk.Audience {
+			if strutil.StrListContains(am.AudClaims, aud) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return nil, errors.New(ctx, errors.Unknown, op, "id_token audience is not allowed")
+		}
+	}
+
+	oauth2Tk := &oauth2.Token{
+		AccessToken:  resp.AccessToken,
+		TokenType:    resp.TokenType,
+		RefreshToken: resp.RefreshToken,
+	}
+	if resp.ExpiresIn > 0 {
+		oauth2Tk.Expiry = time.Now().Add(time.Duration(resp.ExpiresIn) * time.Second)
+	}
+	tk, err := oidc.NewToken(oidc.IDToken(resp.IdToken), oauth2Tk)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to create token", errors.WithWrap(err))
+	}
+	if err := completeAuthentication(ctx, r, iamRepoFn, atRepoFn, provider, am, tk, reqTk.RequestId); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	tokenRepo, err := atRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	authTk, err := tokenRepo.IssueAuthToken(ctx, reqTk.RequestId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if authTk.Token == "" {
+		return nil, errors.New(ctx, errors.Internal, op, "issued token is missing")
+	}
+	return authTk, nil
+}
+
+// discoverDeviceProvider returns the IdP's discovered configuration and its
+// device authorization endpoint. An error with code InvalidParameter is
+// returned if the IdP doesn't support the device authorization grant.
+func discoverDeviceProvider(ctx context.Context, provider *oidc.Provider, am *AuthMethod) (*gooidc.Provider, string, error) {
+	const op = "oidc.discoverDeviceProvider"
+	clientCtx, err := provider.HTTPClientContext(ctx)
+	if err != nil {
+		return nil, "", errors.New(ctx, errors.Unknown, op, "unable to get provider http client", errors.WithWrap(err))
+	}
+	gp, err := gooidc.NewProvider(clientCtx, am.Issuer)
+	if err != nil {
+		return nil, "", errors.New(ctx, errors.Unknown, op, "unable to discover provider configuration", errors.WithWrap(err))
+	}
+	var claims struct {
+		DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint"`
+	}
+	if err := gp.Claims(&claims); err != nil {
+		return nil, "", errors.New(ctx, errors.Unknown, op, "unable to parse provider configuration", errors.WithWrap(err))
+	}
+	if claims.DeviceAuthorizationEndpoint == "" {
+		return nil, "", errors.New(ctx, errors.InvalidParameter, op, "provider does not support the device authorization grant")
+	}
+	return gp, claims.DeviceAuthorizationEndpoint, nil
+}
+
+// postToProvider posts the form params to the IdP's endpoint, authenticating
+// as the auth method's client, and decodes the JSON response into resp. It
+// returns the response's status code.
+func postToProvider(ctx context.Context, provider *oidc.Provider, am *AuthMethod, endpoint string, params url.Values, resp interface{}) (int, error) {
+	const op = "oidc.postToProvider"
+	params.Set("client_id", am.ClientId)
+	if am.ClientSecret != "" {
+		params.Set("client_secret", am.ClientSecret)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(params.Encode()))
+	if err != nil {
+		return 0, errors.New(ctx, errors.Unknown, op, "unable to create request", errors.WithWrap(err))
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	client, err := provider.HTTPClient()
+	if err != nil {
+		return 0, errors.New(ctx, errors.Unknown, op, "unable to get provider http client", errors.WithWrap(err))
+	}
+	r, err := client.Do(req)
+	if err != nil {
+		return 0, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("unable to post to %s", endpoint), errors.WithWrap(err))
+	}
+	defer r.Body.Close()
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return 0, errors.New(ctx, errors.Unknown, op, "unable to read response", errors.WithWrap(err))
+	}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return 0, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("unable to decode response from %s with status %d", endpoint, r.StatusCode), errors.WithWrap(err))
+	}
+	return r.StatusCode, nil
+}
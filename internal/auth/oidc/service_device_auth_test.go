@@ -0,0 +1,376 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/cap/oidc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testDeviceProvider is a minimal IdP which supports the device
+// authorization grant. The responses of its device authorization and token
+// endpoints are set by the tests, which also use it to test refresh token
+// exchanges.
+type testDeviceProvider struct {
+	*httptest.Server
+	deviceStatus int
+	deviceResp   string
+	tokenStatus  int
+	tokenResp    string
+}
+
+func newTestDeviceProvider(t *testing.T) *testDeviceProvider {
+	t.Helper()
+	p := &testDeviceProvider{}
+	p.Server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		issuer := "https://" + req.Host
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/.well-known/openid-configuration":
+			err := json.NewEncoder(w).Encode(map[string]interface{}{
+				"issuer":                                issuer,
+				"authorization_endpoint":                issuer + "/authorize",
+				"token_endpoint":                        issuer + "/token",
+				"jwks_uri":                              issuer + "/.well-known/jwks.json",
+				"device_authorization_endpoint":         issuer + "/device",
+				"id_token_signing_alg_values_supported": []string{string(RS256)},
+			})
+			require.NoError(t, err)
+		case "/device":
+			require.NoError(t, req.ParseForm())
+			assert.Equal(t, "alice-rp", req.PostForm.Get("client_id"))
+			w.WriteHeader(p.deviceStatus)
+			_, _ = w.Write([]byte(p.deviceResp))
+		case "/token":
+			require.NoError(t, req.ParseForm())
+			if req.PostForm.Get("grant_type") == deviceCodeGrantType {
+				assert.Equal(t, "test-device-code", req.PostForm.Get("device_code"))
+			}
+			w.WriteHeader(p.tokenStatus)
+			_, _ = w.Write([]byte(p.tokenResp))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(p.Close)
+	return p
+}
+
+func (p *testDeviceProvider) caCert() string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: p.Certificate().Raw}))
+}
+
+func Test_StartDeviceAuth(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	rootWrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, rootWrapper)
+	repoFn := func() (*Repository, error) {
+		return NewRepository(ctx, rw, rw, kmsCache)
+	}
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, rootWrapper))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	idp := newTestDeviceProvider(t)
+	idpCert, err := ParseCertificates(ctx, idp.caCert())
+	require.NoError(t, err)
+	testAuthMethod := TestAuthMethod(
+		t, conn, databaseWrapper, org.PublicId, ActivePublicState,
+		"alice-rp", "fido",
+		WithIssuer(TestConvertToUrls(t, idp.URL)[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://alice.com/callback")[0]),
+		WithSigningAlgs(RS256),
+		WithCertificates(idpCert...),
+		WithClaimsScopes("email"),
+	)
+	testAuthMethodInactive := TestAuthMethod(
+		t, conn, databaseWrapper, org.PublicId, InactiveState,
+		"alice-rp2", "fido",
+		WithIssuer(TestConvertToUrls(t, idp.URL)[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://alice.com/callback")[0]),
+		WithSigningAlgs(RS256),
+		WithCertificates(idpCert...),
+	)
+
+	// the cap TestProvider doesn't support the device authorization grant
+	tp := oidc.StartTestProvider(t)
+	_, _, tpAlg, _ := tp.SigningKeys()
+	tpCert, err := ParseCertificates(ctx, tp.CACert())
+	require.NoError(t, err)
+	testAuthMethodNoDevice := TestAuthMethod(
+		t, conn, databaseWrapper, org.PublicId, ActivePublicState,
+		"alice-rp3", "fido",
+		WithIssuer(TestConvertToUrls(t, tp.Addr())[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://alice.com/callback")[0]),
+		WithSigningAlgs(Alg(tpAlg)),
+		WithCertificates(tpCert...),
+	)
+
+	tests := []struct {
+		name            string
+		repoFn          OidcRepoFactory
+		authMethodId    string
+		deviceStatus    int
+		deviceResp      string
+		want            *DeviceAuthorization
+		wantErrMatch    *errors.Template
+		wantErrContains string
+	}{
+		{
+			name:         "simple",
+			repoFn:       repoFn,
+			authMethodId: testAuthMethod.PublicId,
+			deviceStatus: http.StatusOK,
+			deviceResp:   `{"device_code":"test-device-code","user_code":"ABCD-EFGH","verification_uri":"https://idp.com/device","verification_uri_complete":"https://idp.com/device?code=ABCD-EFGH","expires_in":600,"interval":10}`,
+			want: &DeviceAuthorization{
+				UserCode:                "ABCD-EFGH",
+				VerificationUri:         "https://idp.com/device",
+				VerificationUriComplete: "https://idp.com/device?code=ABCD-EFGH",
+				Interval:                10 * time.Second,
+				ExpiresIn:               600 * time.Second,
+			},
+		},
+		{
+			name:         "verification-url-and-defaults",
+			repoFn:       repoFn,
+			authMethodId: testAuthMethod.PublicId,
+			deviceStatus: http.StatusOK,
+			deviceResp:   `{"device_code":"test-device-code","user_code":"ABCD-EFGH","verification_url":"https://idp.com/device"}`,
+			want: &DeviceAuthorization{
+				UserCode:        "ABCD-EFGH",
+				VerificationUri: "https://idp.com/device",
+				Interval:        defaultDeviceInterval,
+				ExpiresIn:       AttemptExpiration,
+			},
+		},
+		{
+			name:            "missing-repoFn",
+			authMethodId:    testAuthMethod.PublicId,
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "missing oidc repo function",
+		},
+		{
+			name:            "missing-auth-method-id",
+			repoFn:          repoFn,
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "missing auth method id",
+		},
+		{
+			name:            "inactive",
+			repoFn:          repoFn,
+			authMethodId:    testAuthMethodInactive.PublicId,
+			wantErrMatch:    errors.T(errors.AuthMethodInactive),
+			wantErrContains: "not allowed to start authentication attempt",
+		},
+		{
+			name:            "device-grant-not-supported",
+			repoFn:          repoFn,
+			authMethodId:    testAuthMethodNoDevice.PublicId,
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "provider does not support the device authorization grant",
+		},
+		{
+			name:            "refused",
+			repoFn:          repoFn,
+			authMethodId:    testAuthMethod.PublicId,
+			deviceStatus:    http.StatusBadRequest,
+			deviceResp:      `{"error":"invalid_client","error_description":"unknown client"}`,
+			wantErrMatch:    errors.T(errors.Unknown),
+			wantErrContains: "invalid_client: unknown client",
+		},
+		{
+			name:            "missing-user-code",
+			repoFn:          repoFn,
+			authMethodId:    testAuthMethod.PublicId,
+			deviceStatus:    http.StatusOK,
+			deviceResp:      `{"device_code":"test-device-code","verification_uri":"https://idp.com/device"}`,
+			wantErrMatch:    errors.T(errors.Unknown),
+			wantErrContains: "provider did not return a user code",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			idp.deviceStatus, idp.deviceResp = tt.deviceStatus, tt.deviceResp
+			got, err := StartDeviceAuth(ctx, tt.repoFn, tt.authMethodId)
+			if tt.wantErrMatch != nil {
+				require.Error(err)
+				assert.Nil(got)
+				assert.Truef(errors.Match(tt.wantErrMatch, err), "want err code: %q got: %q", tt.wantErrMatch.Code, err)
+				if tt.wantErrContains != "" {
+					assert.Contains(err.Error(), tt.wantErrContains)
+				}
+				return
+			}
+			require.NoError(err)
+			require.NotEmpty(got.TokenId)
+			reqTk, err := decryptRequestToken(ctx, kmsCache, tt.authMethodId, got.TokenId)
+			require.NoError(err)
+			assert.Equal("test-device-code", reqTk.DeviceCode)
+			assert.NotEmpty(reqTk.RequestId)
+
+			tt.want.TokenId = got.TokenId
+			assert.Equal(tt.want, got)
+		})
+	}
+}
+
+func Test_DeviceTokenRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	rootWrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, rootWrapper)
+	repoFn := func() (*Repository, error) {
+		return NewRepository(ctx, rw, rw, kmsCache)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.NewRepository(rw, rw, kmsCache)
+	}
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(rw, rw, kmsCache)
+	}
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, rootWrapper))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	idp := newTestDeviceProvider(t)
+	idpCert, err := ParseCertificates(ctx, idp.caCert())
+	require.NoError(t, err)
+	testAuthMethod := TestAuthMethod(
+		t, conn, databaseWrapper, org.PublicId, ActivePublicState,
+		"alice-rp", "fido",
+		WithIssuer(TestConvertToUrls(t, idp.URL)[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://alice.com/callback")[0]),
+		WithSigningAlgs(RS256),
+		WithCertificates(idpCert...),
+	)
+
+	idp.deviceStatus = http.StatusOK
+	idp.deviceResp = `{"device_code":"test-device-code","user_code":"ABCD-EFGH","verification_uri":"https://idp.com/device"}`
+	da, err := StartDeviceAuth(ctx, repoFn, testAuthMethod.PublicId)
+	require.NoError(t, err)
+
+	tokenPublicId, err := authtoken.NewAuthTokenId()
+	require.NoError(t, err)
+	nonDeviceTokenRequestId := TestTokenRequestId(t, testAuthMethod, kmsCache, 200*time.Second, tokenPublicId)
+
+	tests := []struct {
+		name            string
+		kms             *kms.Kms
+		repoFn          OidcRepoFactory
+		tokenRequestId  string
+		tokenStatus     int
+		tokenResp       string
+		wantErrMatch    *errors.Template
+		wantErrContains string
+	}{
+		{
+			name:           "not-a-device-request",
+			kms:            kmsCache,
+			repoFn:         repoFn,
+			tokenRequestId: nonDeviceTokenRequestId,
+		},
+		{
+			name:           "authorization-pending",
+			kms:            kmsCache,
+			repoFn:         repoFn,
+			tokenRequestId: da.TokenId,
+			tokenStatus:    http.StatusBadRequest,
+			tokenResp:      `{"error":"authorization_pending"}`,
+		},
+		{
+			name:           "slow-down",
+			kms:            kmsCache,
+			repoFn:         repoFn,
+			tokenRequestId: da.TokenId,
+			tokenStatus:    http.StatusBadRequest,
+			tokenResp:      `{"error":"slow_down"}`,
+		},
+		{
+			name:            "missing-kms",
+			repoFn:          repoFn,
+			tokenRequestId:  da.TokenId,
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "missing kms",
+		},
+		{
+			name:            "missing-repoFn",
+			kms:             kmsCache,
+			tokenRequestId:  da.TokenId,
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "missing oidc repository function",
+		},
+		{
+			name:            "missing-token-request-id",
+			kms:             kmsCache,
+			repoFn:          repoFn,
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "missing token request id",
+		},
+		{
+			name:            "access-denied",
+			kms:             kmsCache,
+			repoFn:          repoFn,
+			tokenRequestId:  da.TokenId,
+			tokenStatus:     http.StatusBadRequest,
+			tokenResp:       `{"error":"access_denied"}`,
+			wantErrMatch:    errors.T(errors.Forbidden),
+			wantErrContains: "device authorization denied by provider",
+		},
+		{
+			name:            "expired-token",
+			kms:             kmsCache,
+			repoFn:          repoFn,
+			tokenRequestId:  da.TokenId,
+			tokenStatus:     http.StatusBadRequest,
+			tokenResp:       `{"error":"expired_token"}`,
+			wantErrMatch:    errors.T(errors.AuthAttemptExpired),
+			wantErrContains: "device code has expired",
+		},
+		{
+			name:            "missing-id-token",
+			kms:             kmsCache,
+			repoFn:          repoFn,
+			tokenRequestId:  da.TokenId,
+			tokenStatus:     http.StatusOK,
+			tokenResp:       `{"access_token":"test-access-token","token_type":"Bearer"}`,
+			wantErrMatch:    errors.T(errors.Unknown),
+			wantErrContains: "id_token is missing",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			idp.tokenStatus, idp.tokenResp = tt.tokenStatus, tt.tokenResp
+			got, err := DeviceTokenRequest(ctx, tt.kms, tt.repoFn, iamRepoFn, atRepoFn, testAuthMethod.PublicId, tt.tokenRequestId)
+			if tt.wantErrMatch != nil {
+				require.Error(err)
+				assert.Nil(got)
+				assert.Truef(errors.Match(tt.wantErrMatch, err), "want err code: %q got: %q", tt.wantErrMatch.Code, err)
+				if tt.wantErrContains != "" {
+					assert.Contains(err.Error(), tt.wantErrContains)
+				}
+				return
+			}
+			require.NoError(err)
+			assert.Nil(got)
+		})
+	}
+}
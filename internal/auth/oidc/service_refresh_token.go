@@ -0,0 +1,144 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"golang.org/x/oauth2"
+)
+
+// refreshTokenCheckLimit is the max number of refresh tokens checked by a
+// single call to CheckRefreshTokens.
+const refreshTokenCheckLimit = 500
+
+// CheckRefreshTokens is an oidc domain service function which uses the
+// refresh tokens stored for auth methods with StoreRefreshTokens set to
+// re-check the standing of users with their IdP. Refresh tokens of issued auth
+// tokens that haven't been checked within the interval are exchanged with the
+// IdP for new tokens. It returns the number of refresh tokens checked and the
+// number of auth tokens revoked.
+//
+// * If the IdP refuses the refresh token with an invalid_grant error, the user
+// is no longer allowed to authenticate with the IdP and the auth token is
+// deleted, which also deletes its refresh token.
+//
+// * Otherwise the refresh token returned by the IdP is stored, encrypted with
+// the latest database key of the auth method's scope, and its check time is
+// reset.
+//
+// Other errors returned by an IdP, such as an invalid_client error caused by a
+// misconfigured client secret or rate limiting, and errors communicating with
+// an IdP are written as events and the refresh token is checked again on the
+// next call.
+func CheckRefreshTokens(ctx context.Context, oidcRepoFn OidcRepoFactory, atRepoFn AuthTokenRepoFactory, interval time.Duration) (checked, revoked int, e error) {
+	const op = "oidc.CheckRefreshTokens"
+	if oidcRepoFn == nil {
+		return 0, 0, errors.New(ctx, errors.InvalidParameter, op, "missing oidc repository function")
+	}
+	if atRepoFn == nil {
+		return 0, 0, errors.New(ctx, errors.InvalidParameter, op, "missing auth token repository function")
+	}
+	if interval <= 0 {
+		return 0, 0, errors.New(ctx, errors.InvalidParameter, op, "interval must be greater than zero")
+	}
+	r, err := oidcRepoFn()
+	if err != nil {
+		return 0, 0, errors.Wrap(ctx, err, op)
+	}
+	tokenRepo, err := atRepoFn()
+	if err != nil {
+		return 0, 0, errors.Wrap(ctx, err, op)
+	}
+	rts, err := r.listRefreshTokensToCheck(ctx, time.Now().Add(-interval), refreshTokenCheckLimit)
+	if err != nil {
+		return 0, 0, errors.Wrap(ctx, err, op)
+	}
+
+	ams := make(map[string]*AuthMethod)
+	for _, rt := range rts {
+		am, ok := ams[rt.OidcMethodId]
+		if !ok {
+			if am, err = r.lookupAuthMethod(ctx, rt.OidcMethodId); err != nil {
+				return checked, revoked, errors.Wrap(ctx, err, op)
+			}
+			ams[rt.OidcMethodId] = am
+		}
+		if am == nil {
+			// the auth method was deleted after the refresh tokens were
+			// listed, which deleted the refresh token.
+			continue
+		}
+		newToken, refused, err := refreshWithProvider(ctx, am, string(rt.RefreshToken))
+		checked++
+		switch {
+		case refused:
+			if _, err := tokenRepo.DeleteAuthToken(ctx, rt.AuthTokenId); err != nil {
+				return checked, revoked, errors.Wrap(ctx, err, op)
+			}
+			revoked++
+		case err != nil:
+			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to check refresh token", "auth_method_id", am.PublicId, "auth_token_id", rt.AuthTokenId))
+		default:
+			if err := r.upsertRefreshToken(ctx, am, rt.AuthTokenId, newToken); err != nil {
+				return checked, revoked, errors.Wrap(ctx, err, op)
+			}
+		}
+	}
+	return checked, revoked, nil
+}
+
+// refreshWithProvider exchanges the refresh token with the auth method's IdP
+// and returns the refresh token to use for the next exchange. refused is true
+// if the IdP rejected the refresh token with an invalid_grant error, which
+// means the refresh token is no longer valid.
+//
+// See https://tools.ietf.org/html/rfc6749#section-5.2.
+func refreshWithProvider(ctx context.Context, am *AuthMethod, token string) (newToken string, refused bool, e error) {
+	const op = "oidc.refreshWithProvider"
+	provider, err := providerCache().get(ctx, am)
+	if err != nil {
+		return "", false, errors.Wrap(ctx, err, op)
+	}
+	info, err := provider.DiscoveryInfo(ctx)
+	if err != nil {
+		return "", false, errors.New(ctx, errors.Unknown, op, "unable to get provider discovery info", errors.WithWrap(err))
+	}
+	clientCtx, err := provider.HTTPClientContext(ctx)
+	if err != nil {
+		return "", false, errors.New(ctx, errors.Unknown, op, "unable to get provider http client", errors.WithWrap(err))
+	}
+	cfg := oauth2.Config{
+		ClientID:     am.ClientId,
+		ClientSecret: am.ClientSecret,
+		Endpoint:     oauth2.Endpoint{TokenURL: info.TokenURL},
+	}
+	tk, err := cfg.TokenSource(clientCtx, &oauth2.Token{RefreshToken: token}).Token()
+	if err != nil {
+		var rErr *oauth2.RetrieveError
+		if errors.As(err, &rErr) && tokenErrorCode(rErr.Body) == "invalid_grant" {
+			return "", true, nil
+		}
+		return "", false, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("unable to refresh token with %s", info.TokenURL), errors.WithWrap(err))
+	}
+	return tk.RefreshToken, false, nil
+}
+
+// tokenErrorCode returns the error code of an error response from an IdP's
+// token endpoint. Some IdPs respond with form encoded values rather than JSON.
+func tokenErrorCode(body []byte) string {
+	var resp struct {
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(body, &resp); err == nil {
+		return resp.Error
+	}
+	if v, err := url.ParseQuery(string(body)); err == nil {
+		return v.Get("error")
+	}
+	return ""
+}
@@ -0,0 +1,110 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_refreshWithProvider(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rootWrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, rootWrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, rootWrapper))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	idp := newTestDeviceProvider(t)
+	idpCert, err := ParseCertificates(ctx, idp.caCert())
+	require.NoError(t, err)
+	testAuthMethod := TestAuthMethod(
+		t, conn, databaseWrapper, org.PublicId, ActivePublicState,
+		"alice-rp", "fido",
+		WithIssuer(TestConvertToUrls(t, idp.URL)[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://alice.com/callback")[0]),
+		WithSigningAlgs(RS256),
+		WithCertificates(idpCert...),
+	)
+
+	tests := []struct {
+		name        string
+		tokenStatus int
+		tokenResp   string
+		wantToken   string
+		wantRefused bool
+		wantErr     bool
+	}{
+		{
+			name:        "refreshed",
+			tokenStatus: http.StatusOK,
+			tokenResp:   `{"access_token":"test-access-token","token_type":"Bearer","refresh_token":"new-refresh-token"}`,
+			wantToken:   "new-refresh-token",
+		},
+		{
+			name:        "invalid-grant",
+			tokenStatus: http.StatusBadRequest,
+			tokenResp:   `{"error":"invalid_grant"}`,
+			wantRefused: true,
+		},
+		{
+			name:        "invalid-client",
+			tokenStatus: http.StatusUnauthorized,
+			tokenResp:   `{"error":"invalid_client"}`,
+			wantErr:     true,
+		},
+		{
+			name:        "rate-limited",
+			tokenStatus: http.StatusTooManyRequests,
+			tokenResp:   `{"error":"slow_down"}`,
+			wantErr:     true,
+		},
+		{
+			name:        "server-error",
+			tokenStatus: http.StatusInternalServerError,
+			tokenResp:   `{"error":"server_error"}`,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			idp.tokenStatus, idp.tokenResp = tt.tokenStatus, tt.tokenResp
+			got, refused, err := refreshWithProvider(ctx, testAuthMethod, "test-refresh-token")
+			assert.Equal(tt.wantRefused, refused)
+			if tt.wantErr {
+				require.Error(err)
+				assert.Empty(got)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantToken, got)
+		})
+	}
+}
+
+func Test_tokenErrorCode(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{name: "json", body: `{"error":"invalid_grant","error_description":"token revoked"}`, want: "invalid_grant"},
+		{name: "form", body: "error=invalid_grant&error_description=token+revoked", want: "invalid_grant"},
+		{name: "empty", body: "", want: ""},
+		{name: "no-error", body: `{"message":"too many requests"}`, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tokenErrorCode([]byte(tt.body)))
+		})
+	}
+}
@@ -18,7 +18,8 @@ import (
 // attempt. It returns two URLs and a tokenId.  authUrl is an OIDC authorization
 // request URL. The authUrl includes a "state" parameter which is encrypted and
 // has a payload which includes (among other things) the final redirect
-// (calculated from the clientInfo), a token_request_id, and nonce. The tokenUrl
+// (calculated from the clientInfo), a token_request_id, nonce and the PKCE code
+// verifier, whose challenge is also added to the authUrl. The tokenUrl
 // is the URL theclient can use to retrieve the results of the user's OIDC
 // authentication attempt. The tokenId is an encrypted payload for the POST
 // request to the tokenUrl.
@@ -78,6 +79,10 @@ func StartAuth(ctx context.Context, oidcRepoFn OidcRepoFactory, authMethodId str
 	if err != nil {
 		return nil, "", errors.New(ctx, errors.Unknown, op, "unable to generate nonce", errors.WithWrap(err))
 	}
+	verifier, err := oidc.NewCodeVerifier()
+	if err != nil {
+		return nil, "", errors.New(ctx, errors.Unknown, op, "unable to generate pkce code verifier", errors.WithWrap(err))
+	}
 	hash, err := provider.ConfigHash()
 	if err != nil {
 		return nil, "", errors.New(ctx, errors.Unknown, op, "unable to get provider config hash", errors.WithWrap(err))
@@ -89,6 +94,7 @@ func StartAuth(ctx context.Context, oidcRepoFn OidcRepoFactory, authMethodId str
 		FinalRedirectUrl:   finalRedirect,
 		Nonce:              nonce,
 		ProviderConfigHash: hash,
+		CodeVerifier:       verifier.Verifier(),
 	}
 
 	requestWrapper, err := requestWrappingWrapper(ctx, r.kms, am.ScopeId, authMethodId)
@@ -102,6 +108,7 @@ func StartAuth(ctx context.Context, oidcRepoFn OidcRepoFactory, authMethodId str
 	oidcOpts := []oidc.Option{
 		oidc.WithState(string(encodedEncryptedSt)),
 		oidc.WithNonce(nonce),
+		oidc.WithPKCE(verifier),
 	}
 	switch {
 	case am.MaxAge == -1:
@@ -229,6 +229,9 @@ func Test_StartAuth(t *testing.T) {
 				assert.Equal(fmt.Sprintf(FinalRedirectEndpoint, tt.apiSrv.URL), reqState.FinalRedirectUrl)
 			}
 			assert.Equal(authParams["nonce"][0], reqState.Nonce)
+			require.NotEmpty(reqState.CodeVerifier)
+			assert.Equal(authParams["code_challenge"], []string{codeVerifier(reqState.CodeVerifier).Challenge()})
+			assert.Equal(authParams["code_challenge_method"], []string{string(oidc.S256)})
 
 			assert.WithinDuration(reqState.CreateTime.Timestamp.AsTime(), now, 1*time.Second)
 			assert.WithinDuration(reqState.ExpirationTime.Timestamp.AsTime(), now.Add(AttemptExpiration), 1*time.Second)
@@ -37,6 +37,35 @@ func TokenRequest(ctx context.Context, kms *kms.Kms, atRepoFn AuthTokenRepoFacto
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing token request id")
 	}
 
+	reqTk, err := decryptRequestToken(ctx, kms, authMethodId, tokenRequestId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	tokenRepo, err := atRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	authTk, err := tokenRepo.IssueAuthToken(ctx, reqTk.RequestId)
+	if err != nil {
+		if errors.Match(errors.T(errors.RecordNotFound), err) {
+			// We don't have it -- at least not yet. So don't mark it as an
+			// error, but nothing is returned.
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if authTk.Token == "" {
+		return nil, errors.New(ctx, errors.Internal, op, "issued token is missing")
+	}
+	return authTk, nil
+}
+
+// decryptRequestToken decrypts the tokenRequestId returned by StartAuth or
+// StartDeviceAuth for the auth method authMethodId. An error with code
+// AuthAttemptExpired is returned if the request token has expired.
+func decryptRequestToken(ctx context.Context, kms *kms.Kms, authMethodId, tokenRequestId string) (*request.Token, error) {
+	const op = "oidc.decryptRequestToken"
 	reqTkWrapper, err := UnwrapMessage(ctx, tokenRequestId)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
@@ -74,22 +103,5 @@ func TokenRequest(ctx context.Context, kms *kms.Kms, atRepoFn AuthTokenRepoFacto
 	if time.Now().After(reqTk.ExpirationTime.Timestamp.AsTime()) {
 		return nil, errors.New(ctx, errors.AuthAttemptExpired, op, "request token id has expired")
 	}
-
-	tokenRepo, err := atRepoFn()
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
-	}
-	authTk, err := tokenRepo.IssueAuthToken(ctx, reqTk.RequestId)
-	if err != nil {
-		if errors.Match(errors.T(errors.RecordNotFound), err) {
-			// We don't have it -- at least not yet. So don't mark it as an
-			// error, but nothing is returned.
-			return nil, nil
-		}
-		return nil, errors.Wrap(ctx, err, op)
-	}
-	if authTk.Token == "" {
-		return nil, errors.New(ctx, errors.Internal, op, "issued token is missing")
-	}
-	return authTk, nil
+	return &reqTk, nil
 }
@@ -283,7 +283,6 @@ func testProvider(t *testing.T, clientId, clientSecret, allowedRedirectURL strin
 	t.Helper()
 	require := require.New(t)
 	require.NotEmptyf(clientId, "%s: client id is empty")
-	require.NotEmptyf(clientSecret, "%s: client secret is empty")
 	require.NotEmptyf(allowedRedirectURL, "%s: redirect URL is empty")
 
 	tp.SetClientCreds(clientId, clientSecret)
@@ -0,0 +1,23 @@
+package password
+
+import "unicode"
+
+// characterClasses returns the number of character classes password
+// contains. The classes are lowercase letters, uppercase letters, digits and
+// everything else, which counts as a symbol.
+func characterClasses(password string) int {
+	var lower, upper, digit, symbol int
+	for _, c := range password {
+		switch {
+		case unicode.IsLower(c):
+			lower = 1
+		case unicode.IsUpper(c):
+			upper = 1
+		case unicode.IsDigit(c):
+			digit = 1
+		default:
+			symbol = 1
+		}
+	}
+	return lower + upper + digit + symbol
+}
@@ -0,0 +1,30 @@
+package password
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCharacterClasses(t *testing.T) {
+	tests := []struct {
+		password string
+		want     int
+	}{
+		{"", 0},
+		{"abcdefgh", 1},
+		{"ABCDEFGH", 1},
+		{"12345678", 1},
+		{"!@#$%^&*", 1},
+		{"abcdEFGH", 2},
+		{"abcd1234", 2},
+		{"abCD12!@", 4},
+		{"pässwörd", 1},
+		{"Pässwörd 1", 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.password, func(t *testing.T) {
+			assert.Equal(t, tt.want, characterClasses(tt.password))
+		})
+	}
+}
@@ -20,11 +20,26 @@ select acct.name,                        -- Account.Name
        conf.iterations,                  -- Argon2Configuration.Iterations
        conf.memory,                      -- Argon2Configuration.Memory
        conf.threads,                     -- Argon2Configuration.Threads
-       meth.password_conf_id = cred.password_conf_id as is_current_conf
+       meth.password_conf_id = cred.password_conf_id as is_current_conf,
+       meth.lockout_threshold,
+       meth.lockout_duration_seconds,
+       coalesce(lo.failed_attempts, 0) as failed_attempts,
+       coalesce(lo.locked_until > now(), false) as is_locked,
+       meth.max_password_age_seconds > 0
+         and cred.create_time + make_interval(secs => meth.max_password_age_seconds) < now()
+         as is_password_expired,
+       exists (
+         select 1
+           from auth_password_totp totp
+          where totp.password_account_id = acct.public_id
+            and totp.confirmed
+       ) as has_totp
   from auth_password_argon2_cred cred,
        auth_password_argon2_conf conf,
-       auth_password_account acct,
-       auth_password_method meth
+       auth_password_method meth,
+       auth_password_account acct
+  left join auth_password_account_lockout lo
+         on lo.password_account_id = acct.public_id
  where acct.auth_method_id = @auth_method_id
    and acct.login_name = @login_name
    and cred.password_conf_id = conf.private_id
@@ -41,4 +56,162 @@ select *
         where public_id = @public_id
     );
 `
+
+	recordFailedAttemptQuery = `
+insert into auth_password_account_lockout as lo
+       (password_account_id, failed_attempts)
+values (@password_account_id, 1)
+    on conflict (password_account_id) do update
+   set failed_attempts = lo.failed_attempts + 1
+returning failed_attempts;
+`
+
+	// lockAccountQuery locks an account for lockout_duration_seconds or, if
+	// that is 0, until the account is unlocked.
+	lockAccountQuery = `
+update auth_password_account_lockout
+   set failed_attempts = 0,
+       locked_until = case
+         when @lockout_duration_seconds = 0 then 'infinity'::timestamptz
+         else now() + make_interval(secs => @lockout_duration_seconds)
+       end
+ where password_account_id = @password_account_id;
+`
+
+	unlockAccountQuery = `
+delete from auth_password_account_lockout
+ where password_account_id = @password_account_id;
+`
+
+	// previousCredentialsQuery returns the current and previous credentials
+	// of an account, most recent first.
+	previousCredentialsQuery = `
+select salt,         -- Argon2Credential.CtSalt/Salt
+       derived_key,  -- Argon2Credential.DerivedKey
+       key_id,       -- Argon2Credential.KeyId
+       key_length,   -- Argon2Configuration.KeyLength
+       iterations,   -- Argon2Configuration.Iterations
+       memory,       -- Argon2Configuration.Memory
+       threads       -- Argon2Configuration.Threads
+  from (
+         select 0 as rank, cred.create_time,
+                cred.salt, cred.derived_key, cred.key_id,
+                conf.key_length, conf.iterations, conf.memory, conf.threads
+           from auth_password_argon2_cred cred
+           join auth_password_argon2_conf conf
+             on cred.password_conf_id = conf.private_id
+          where cred.password_account_id = @password_account_id
+      union all
+         select 1 as rank, hist.create_time,
+                hist.salt, hist.derived_key, hist.key_id,
+                conf.key_length, conf.iterations, conf.memory, conf.threads
+           from auth_password_argon2_cred_history hist
+           join auth_password_argon2_conf conf
+             on hist.password_conf_id = conf.private_id
+          where hist.password_account_id = @password_account_id
+       ) as prev
+ order by rank, create_time desc
+ limit @limit;
+`
+
+	archiveCredentialQuery = `
+insert into auth_password_argon2_cred_history
+       (private_id, password_account_id, password_conf_id, salt, derived_key, key_id)
+select private_id, password_account_id, password_conf_id, salt, derived_key, key_id
+  from auth_password_argon2_cred
+ where password_account_id = @password_account_id;
+`
+
+	// pruneCredentialHistoryQuery deletes all but the most recent @keep
+	// previous credentials of an account.
+	pruneCredentialHistoryQuery = `
+delete from auth_password_argon2_cred_history
+ where password_account_id = @password_account_id
+   and private_id not in (
+         select private_id
+           from auth_password_argon2_cred_history
+          where password_account_id = @password_account_id
+          order by create_time desc
+          limit @keep
+       );
+`
+
+	// mfaStatusQuery returns whether the auth method of an account requires
+	// MFA and whether the account has a confirmed TOTP enrollment.
+	mfaStatusQuery = `
+select meth.require_mfa,
+       coalesce(totp.confirmed, false) as totp_confirmed
+  from auth_password_account acct
+  join auth_password_method meth
+    on meth.public_id = acct.auth_method_id
+  left join auth_password_totp totp
+    on totp.password_account_id = acct.public_id
+ where acct.public_id = @password_account_id;
+`
+
+	// enrollTotpQuery inserts a TOTP enrollment or replaces an unconfirmed
+	// one. Confirmed enrollments are left unchanged.
+	enrollTotpQuery = `
+insert into auth_password_totp as totp
+       (password_account_id, secret, key_id)
+values (@password_account_id, @secret, @key_id)
+    on conflict (password_account_id) do update
+   set secret = excluded.secret,
+       key_id = excluded.key_id,
+       last_used_step = 0
+ where not totp.confirmed;
+`
+
+	lookupTotpQuery = `
+select totp.secret,
+       totp.key_id,
+       totp.confirmed,
+       totp.last_used_step,
+       meth.lockout_threshold,
+       meth.lockout_duration_seconds,
+       coalesce(lo.locked_until > now(), false) as is_locked
+  from auth_password_totp totp
+  join auth_password_account acct
+    on acct.public_id = totp.password_account_id
+  join auth_password_method meth
+    on meth.public_id = acct.auth_method_id
+  left join auth_password_account_lockout lo
+    on lo.password_account_id = acct.public_id
+ where totp.password_account_id = @password_account_id;
+`
+
+	// useTotpStepQuery records that a code for @step was used and confirms
+	// the enrollment. It does not update the enrollment if a code for @step
+	// or a later step was already used.
+	useTotpStepQuery = `
+update auth_password_totp
+   set last_used_step = @step,
+       confirmed = true
+ where password_account_id = @password_account_id
+   and last_used_step < @step;
+`
+
+	deleteTotpQuery = `
+delete from auth_password_totp
+ where password_account_id = @password_account_id;
+`
+
+	useRecoveryCodeQuery = `
+update auth_password_recovery_code
+   set used_time = now()
+ where password_account_id = @password_account_id
+   and code_hash = @code_hash
+   and used_time is null;
+`
+
+	insertRecoveryCodeQuery = `
+insert into auth_password_recovery_code
+       (password_account_id, code_hash)
+values (@password_account_id, @code_hash);
+`
+
+	deleteRecoveryCodesQuery = `
+delete from auth_password_recovery_code
+ where password_account_id = @password_account_id;
+`
 )
@@ -78,6 +78,9 @@ func (r *Repository) CreateAccount(ctx context.Context, scopeId string, a *Accou
 		if cc.MinPasswordLength > len(opts.password) {
 			return nil, errors.New(ctx, errors.PasswordTooShort, op, fmt.Sprintf("must be longer than %v", cc.MinPasswordLength))
 		}
+		if cc.MinCharacterClasses > characterClasses(opts.password) {
+			return nil, errors.New(ctx, errors.PasswordTooWeak, op, fmt.Sprintf("must contain at least %d character classes", cc.MinCharacterClasses))
+		}
 		if cred, err = newArgon2Credential(a.PublicId, opts.password, cc.argon2()); err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
@@ -173,9 +173,13 @@ func (r *Repository) DeleteAuthMethod(ctx context.Context, scopeId, publicId str
 // not be set to null, but instead use the default values returned by
 // NewAuthMethod.  fieldMaskPaths provides field_mask.proto paths for fields
 // that should be updated.  Fields will be set to NULL if the field is a zero
-// value and included in fieldMask. Name, Description, MinPasswordLength,
-// and MinLoginNameLength are the only updatable fields, If no updatable fields
-// are included in the fieldMaskPaths, then an error is returned.
+// value and included in fieldMask, except for the password policy fields
+// MinCharacterClasses, PasswordHistoryCount, MaxPasswordAgeSeconds,
+// LockoutThreshold and LockoutDurationSeconds which are set to 0 and
+// RequireMfa which is set to false. Name, Description, MinPasswordLength,
+// MinLoginNameLength, RequireMfa and the password policy fields are the only
+// updatable fields, If no updatable fields are included in the
+// fieldMaskPaths, then an error is returned.
 func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMethod, version uint32, fieldMaskPaths []string, opt ...Option) (*AuthMethod, int, error) {
 	const op = "password.(Repository).UpdateAuthMethod"
 	if authMethod == nil {
@@ -193,6 +197,12 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMetho
 		case strings.EqualFold("Description", f):
 		case strings.EqualFold("MinLoginNameLength", f):
 		case strings.EqualFold("MinPasswordLength", f):
+		case strings.EqualFold("MinCharacterClasses", f):
+		case strings.EqualFold("PasswordHistoryCount", f):
+		case strings.EqualFold("MaxPasswordAgeSeconds", f):
+		case strings.EqualFold("LockoutThreshold", f):
+		case strings.EqualFold("LockoutDurationSeconds", f):
+		case strings.EqualFold("RequireMfa", f):
 		default:
 			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
 		}
@@ -200,13 +210,26 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMetho
 	var dbMask, nullFields []string
 	dbMask, nullFields = dbcommon.BuildUpdatePaths(
 		map[string]interface{}{
-			"Name":               authMethod.Name,
-			"Description":        authMethod.Description,
-			"MinPasswordLength":  authMethod.MinPasswordLength,
-			"MinLoginNameLength": authMethod.MinLoginNameLength,
+			"Name":                   authMethod.Name,
+			"Description":            authMethod.Description,
+			"MinPasswordLength":      authMethod.MinPasswordLength,
+			"MinLoginNameLength":     authMethod.MinLoginNameLength,
+			"MinCharacterClasses":    authMethod.MinCharacterClasses,
+			"PasswordHistoryCount":   authMethod.PasswordHistoryCount,
+			"MaxPasswordAgeSeconds":  authMethod.MaxPasswordAgeSeconds,
+			"LockoutThreshold":       authMethod.LockoutThreshold,
+			"LockoutDurationSeconds": authMethod.LockoutDurationSeconds,
+			"RequireMfa":             authMethod.RequireMfa,
 		},
 		fieldMaskPaths,
-		nil,
+		[]string{
+			"MinCharacterClasses",
+			"PasswordHistoryCount",
+			"MaxPasswordAgeSeconds",
+			"LockoutThreshold",
+			"LockoutDurationSeconds",
+			"RequireMfa",
+		},
 	)
 	if len(dbMask) == 0 && len(nullFields) == 0 {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "field mask must not be empty")
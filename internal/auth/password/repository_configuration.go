@@ -120,9 +120,11 @@ func (r *Repository) setArgon2Conf(ctx context.Context, scopeId string, c *Argon
 }
 
 type currentConfig struct {
-	ConfType           string
-	MinLoginNameLength int
-	MinPasswordLength  int
+	ConfType             string
+	MinLoginNameLength   int
+	MinPasswordLength    int
+	MinCharacterClasses  int
+	PasswordHistoryCount int
 
 	*Argon2Configuration
 }
@@ -0,0 +1,105 @@
+package password
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/observability/event"
+)
+
+// UnlockAccount unlocks the account for accountId and resets its count of
+// failed authentication attempts. The account is returned, whether or not
+// it was locked.
+//
+// Returns nil, error with code RecordNotFound if the account doesn't exist.
+func (r *Repository) UnlockAccount(ctx context.Context, accountId string) (*Account, error) {
+	const op = "password.(Repository).UnlockAccount"
+	if accountId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	acct, err := r.LookupAccount(ctx, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if acct == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "account not found")
+	}
+	if _, err := r.writer.Exec(ctx, unlockAccountQuery, []interface{}{sql.Named("password_account_id", accountId)}); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return acct, nil
+}
+
+// recordFailedAttempt counts a failed authentication attempt for acct and
+// locks the account once its auth method's lockout threshold is reached. An
+// audit event is written when the account is locked.
+func (r *Repository) recordFailedAttempt(ctx context.Context, acct *authAccount) error {
+	const op = "password.(Repository).recordFailedAttempt"
+	var locked bool
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			locked = false
+			rows, err := w.Query(ctx, recordFailedAttemptQuery, []interface{}{sql.Named("password_account_id", acct.PublicId)})
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			defer rows.Close()
+			var attempts uint32
+			for rows.Next() {
+				if err := rows.Scan(&attempts); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+			if err := rows.Err(); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if attempts < acct.LockoutThreshold {
+				return nil
+			}
+			if _, err := w.Exec(ctx, lockAccountQuery, []interface{}{
+				sql.Named("password_account_id", acct.PublicId),
+				sql.Named("lockout_duration_seconds", acct.LockoutDurationSeconds),
+			}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to lock account"))
+			}
+			locked = true
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if locked {
+		auditLockout(ctx, acct.Account)
+	}
+	return nil
+}
+
+// auditLockout writes an audit event recording that acct was locked. The
+// lockout has already been committed, so a failure to write the event is
+// logged rather than returned.
+func auditLockout(ctx context.Context, acct *Account) {
+	const op = "password.auditLockout"
+	id, err := event.NewId(event.IdPrefix)
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("unable to generate audit event id", "account_id", acct.GetPublicId()))
+		return
+	}
+	opts := []event.Option{
+		event.WithId(id),
+		event.WithRequest(&event.Request{
+			Operation: "lockout",
+			Endpoint:  fmt.Sprintf("/v1/accounts/%s", acct.GetPublicId()),
+		}),
+		event.WithFlush(),
+	}
+	if reqInfo, ok := event.RequestInfoFromContext(ctx); ok {
+		opts = append(opts, event.WithRequestInfo(reqInfo))
+	}
+	if err := event.WriteAudit(ctx, op, opts...); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("unable to write account lockout audit event", "account_id", acct.GetPublicId()))
+	}
+}
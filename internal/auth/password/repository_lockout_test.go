@@ -0,0 +1,129 @@
+package password
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/auth/password/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_AuthenticateLockout(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	authMethod := TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+
+	authMethod.LockoutThreshold = 2
+	authMethod, _, err = repo.UpdateAuthMethod(ctx, authMethod, authMethod.Version, []string{"LockoutThreshold"})
+	require.NoError(err)
+
+	passwd := "12345678"
+	acct, err := repo.CreateAccount(ctx, o.GetPublicId(), &Account{
+		Account: &store.Account{
+			AuthMethodId: authMethod.PublicId,
+			LoginName:    "kazmierczak",
+		},
+	}, WithPassword(passwd))
+	require.NoError(err)
+
+	// A successful authentication resets the failed attempts.
+	got, err := repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "wrong-password")
+	require.NoError(err)
+	assert.Nil(got)
+	got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, passwd)
+	require.NoError(err)
+	assert.NotNil(got)
+
+	for i := 0; i < 2; i++ {
+		got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "wrong-password")
+		require.NoError(err)
+		assert.Nil(got)
+	}
+
+	got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, passwd)
+	assert.Truef(errors.Match(errors.T(errors.AccountLocked), err), "want err code: %q got: %q", errors.AccountLocked, err)
+	assert.Nil(got)
+
+	unlocked, err := repo.UnlockAccount(ctx, acct.PublicId)
+	require.NoError(err)
+	assert.Equal(acct.PublicId, unlocked.PublicId)
+
+	got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, passwd)
+	require.NoError(err)
+	assert.NotNil(got)
+}
+
+func TestRepository_UnlockAccount(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	_, err = repo.UnlockAccount(ctx, "")
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+
+	_, err = repo.UnlockAccount(ctx, "acctpw_1234567890")
+	assert.Truef(t, errors.Match(errors.T(errors.RecordNotFound), err), "want err code: %q got: %q", errors.RecordNotFound, err)
+}
+
+func TestRepository_ChangePasswordHistory(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	authMethod := TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+
+	authMethod.PasswordHistoryCount = 2
+	authMethod.MinCharacterClasses = 2
+	authMethod, _, err = repo.UpdateAuthMethod(ctx, authMethod, authMethod.Version, []string{"PasswordHistoryCount", "MinCharacterClasses"})
+	require.NoError(err)
+
+	first := "password-1"
+	acct, err := repo.CreateAccount(ctx, o.GetPublicId(), &Account{
+		Account: &store.Account{
+			AuthMethodId: authMethod.PublicId,
+			LoginName:    "kazmierczak",
+		},
+	}, WithPassword(first))
+	require.NoError(err)
+
+	_, err = repo.ChangePassword(ctx, o.GetPublicId(), acct.PublicId, first, "onlylowercase", acct.Version)
+	assert.Truef(errors.Match(errors.T(errors.PasswordTooWeak), err), "want err code: %q got: %q", errors.PasswordTooWeak, err)
+
+	second := "password-2"
+	acct, err = repo.ChangePassword(ctx, o.GetPublicId(), acct.PublicId, first, second, acct.Version)
+	require.NoError(err)
+
+	_, err = repo.ChangePassword(ctx, o.GetPublicId(), acct.PublicId, second, first, acct.Version)
+	assert.Truef(errors.Match(errors.T(errors.PasswordReused), err), "want err code: %q got: %q", errors.PasswordReused, err)
+
+	third := "password-3"
+	acct, err = repo.ChangePassword(ctx, o.GetPublicId(), acct.PublicId, second, third, acct.Version)
+	require.NoError(err)
+
+	// Only the last two passwords are remembered.
+	_, err = repo.ChangePassword(ctx, o.GetPublicId(), acct.PublicId, third, first, acct.Version)
+	require.NoError(err)
+}
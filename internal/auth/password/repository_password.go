@@ -18,13 +18,27 @@ type authAccount struct {
 	*Account
 	*Argon2Credential
 	*Argon2Configuration
-	IsCurrentConf bool
+	IsCurrentConf          bool
+	LockoutThreshold       uint32
+	LockoutDurationSeconds uint32
+	FailedAttempts         uint32
+	IsLocked               bool
+	IsPasswordExpired      bool
+	HasTotp                bool
 }
 
 // Authenticate authenticates loginName and password match for loginName in
 // authMethodId. The account for the loginName is returned if authentication
 // is successful. Returns nil if authentication fails.
 //
+// If the auth method has a lockout threshold, failed attempts are counted and
+// the account is locked once the threshold is reached. Returns nil, error
+// with code AccountLocked while the account is locked, whether or not the
+// password matches. Returns the account and an error with code
+// PasswordExpired if the password matches but is older than the auth
+// method's maximum password age; the password must be changed with
+// ChangePassword before the account can authenticate.
+//
 // The CredentialId in the returned account represents a user's current
 // password. A new CredentialId is generated when a user's password is
 // changed and the old one is deleted.
@@ -52,13 +66,35 @@ func (r *Repository) Authenticate(ctx context.Context, scopeId, authMethodId, lo
 		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
 	}
 
-	acct, err := r.authenticate(ctx, scopeId, authMethodId, loginName, password)
+	acct, err := r.lookupAuthAccount(ctx, scopeId, authMethodId, loginName)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
 	if acct == nil {
 		return nil, nil
 	}
+	if acct.IsLocked {
+		return nil, errors.New(ctx, errors.AccountLocked, op, "account is locked", errors.WithoutEvent())
+	}
+	if !acct.passwordMatches(password) {
+		if acct.LockoutThreshold > 0 {
+			if err := r.recordFailedAttempt(ctx, acct); err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+		}
+		return nil, nil
+	}
+	// Failed attempts of accounts with a TOTP enrollment are reset once
+	// the TOTP code is verified, so that codes cannot be guessed by
+	// alternating them with the correct password.
+	if acct.FailedAttempts > 0 && !acct.HasTotp {
+		if _, err := r.writer.Exec(ctx, unlockAccountQuery, []interface{}{sql.Named("password_account_id", acct.PublicId)}); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to reset failed attempts"))
+		}
+	}
+	if acct.IsPasswordExpired {
+		return acct.Account, errors.New(ctx, errors.PasswordExpired, op, "password has expired", errors.WithoutEvent())
+	}
 
 	if !acct.IsCurrentConf {
 		cc, err := r.currentConfig(ctx, authMethodId)
@@ -163,6 +199,16 @@ func (r *Repository) ChangePassword(ctx context.Context, scopeId, accountId, old
 	if cc.MinPasswordLength > len(new) {
 		return nil, errors.New(ctx, errors.PasswordTooShort, op, fmt.Sprintf("must be at least %d", cc.MinPasswordLength))
 	}
+	if cc.MinCharacterClasses > characterClasses(new) {
+		return nil, errors.New(ctx, errors.PasswordTooWeak, op, fmt.Sprintf("must contain at least %d character classes", cc.MinCharacterClasses))
+	}
+	reused, err := r.passwordReused(ctx, scopeId, accountId, new, cc.PasswordHistoryCount)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if reused {
+		return nil, errors.New(ctx, errors.PasswordReused, op, fmt.Sprintf("must not be one of the last %d passwords", cc.PasswordHistoryCount))
+	}
 	newCred, err := newArgon2Credential(accountId, new, cc.argon2())
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
@@ -188,6 +234,9 @@ func (r *Repository) ChangePassword(ctx context.Context, scopeId, accountId, old
 				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated account and %d rows updated", rowsUpdated))
 			}
 
+			if err := archiveCredential(ctx, w, accountId, cc.PasswordHistoryCount); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
 			rowsDeleted, err := w.Delete(ctx, oldCred, db.WithOplog(oplogWrapper, oldCred.oplog(oplog.OpType_OP_TYPE_DELETE)))
 			if err != nil {
 				return errors.Wrap(ctx, err, op)
@@ -212,6 +261,21 @@ func (r *Repository) ChangePassword(ctx context.Context, scopeId, accountId, old
 
 func (r *Repository) authenticate(ctx context.Context, scopeId, authMethodId, loginName, password string) (*authAccount, error) {
 	const op = "password.(Repository).authenticate"
+	acct, err := r.lookupAuthAccount(ctx, scopeId, authMethodId, loginName)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if acct == nil || !acct.passwordMatches(password) {
+		return nil, nil
+	}
+	return acct, nil
+}
+
+// lookupAuthAccount returns the account for loginName in authMethodId with
+// its decrypted credential. Returns nil if there is no such account or it
+// has no password.
+func (r *Repository) lookupAuthAccount(ctx context.Context, scopeId, authMethodId, loginName string) (*authAccount, error) {
+	const op = "password.(Repository).lookupAuthAccount"
 	var accts []authAccount
 
 	rows, err := r.reader.Query(ctx, authenticateQuery, []interface{}{sql.Named("auth_method_id", authMethodId), sql.Named("login_name", loginName)})
@@ -247,15 +311,16 @@ func (r *Repository) authenticate(ctx context.Context, scopeId, authMethodId, lo
 	if err := acct.decrypt(ctx, databaseWrapper); err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt), errors.WithMsg("unable to decrypt credential"))
 	}
-
-	inputKey := argon2.IDKey([]byte(password), acct.Salt, acct.Iterations, acct.Memory, uint8(acct.Threads), acct.KeyLength)
-	if subtle.ConstantTimeCompare(inputKey, acct.DerivedKey) == 0 {
-		// authentication failed, password does not match
-		return nil, nil
-	}
 	return &acct, nil
 }
 
+// passwordMatches reports whether password matches the account's decrypted
+// credential.
+func (a *authAccount) passwordMatches(password string) bool {
+	inputKey := argon2.IDKey([]byte(password), a.Salt, a.Iterations, a.Memory, uint8(a.Threads), a.KeyLength)
+	return subtle.ConstantTimeCompare(inputKey, a.DerivedKey) == 1
+}
+
 // SetPassword sets the password for accountId to password. If password
 // contains an empty string, the password for accountId will be deleted.
 func (r *Repository) SetPassword(ctx context.Context, scopeId, accountId, password string, version uint32) (*Account, error) {
@@ -280,6 +345,7 @@ func (r *Repository) SetPassword(ctx context.Context, scopeId, accountId, passwo
 	}
 
 	var newCred *Argon2Credential
+	var historyCount int
 	if password != "" {
 		cc, err := r.currentConfigForAccount(ctx, accountId)
 		if err != nil {
@@ -291,6 +357,17 @@ func (r *Repository) SetPassword(ctx context.Context, scopeId, accountId, passwo
 		if cc.MinPasswordLength > len(password) {
 			return nil, errors.New(ctx, errors.PasswordTooShort, op, fmt.Sprintf("password must be at least %v", cc.MinPasswordLength))
 		}
+		if cc.MinCharacterClasses > characterClasses(password) {
+			return nil, errors.New(ctx, errors.PasswordTooWeak, op, fmt.Sprintf("password must contain at least %d character classes", cc.MinCharacterClasses))
+		}
+		reused, err := r.passwordReused(ctx, scopeId, accountId, password, cc.PasswordHistoryCount)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if reused {
+			return nil, errors.New(ctx, errors.PasswordReused, op, fmt.Sprintf("password must not be one of the last %d passwords", cc.PasswordHistoryCount))
+		}
+		historyCount = cc.PasswordHistoryCount
 		newCred, err = newArgon2Credential(accountId, password, cc.argon2())
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
@@ -322,6 +399,11 @@ func (r *Repository) SetPassword(ctx context.Context, scopeId, accountId, passwo
 				}
 			}
 			if oldCred.PrivateId != "" {
+				if newCred != nil {
+					if err := archiveCredential(ctx, w, accountId, historyCount); err != nil {
+						return errors.Wrap(ctx, err, op)
+					}
+				}
 				dCred := oldCred.clone()
 				rowsDeleted, err := w.Delete(ctx, dCred, db.WithOplog(oplogWrapper, oldCred.oplog(oplog.OpType_OP_TYPE_DELETE)))
 				if err != nil {
@@ -0,0 +1,82 @@
+package password
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"golang.org/x/crypto/argon2"
+)
+
+type previousCredential struct {
+	*Argon2Credential
+	*Argon2Configuration
+}
+
+// passwordReused reports whether password is one of the last historyCount
+// passwords of accountId, including its current password.
+func (r *Repository) passwordReused(ctx context.Context, scopeId, accountId, password string, historyCount int) (bool, error) {
+	const op = "password.(Repository).passwordReused"
+	if historyCount == 0 {
+		return false, nil
+	}
+	rows, err := r.reader.Query(ctx, previousCredentialsQuery, []interface{}{
+		sql.Named("password_account_id", accountId),
+		sql.Named("limit", historyCount),
+	})
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var creds []previousCredential
+	for rows.Next() {
+		var c previousCredential
+		if err := r.reader.ScanRows(rows, &c); err != nil {
+			return false, errors.Wrap(ctx, err, op)
+		}
+		creds = append(creds, c)
+	}
+
+	for _, c := range creds {
+		databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase, kms.WithKeyId(c.GetKeyId()))
+		if err != nil {
+			return false, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
+		}
+		if err := c.decrypt(ctx, databaseWrapper); err != nil {
+			return false, errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt), errors.WithMsg("unable to decrypt credential"))
+		}
+		key := argon2.IDKey([]byte(password), c.Salt, c.Iterations, c.Memory, uint8(c.Threads), c.KeyLength)
+		if subtle.ConstantTimeCompare(key, c.DerivedKey) == 1 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// archiveCredential copies the current credential of accountId to its
+// credential history before it is replaced, keeping the credentials needed
+// to check the last historyCount passwords. It must be called within a
+// transaction.
+func archiveCredential(ctx context.Context, w db.Writer, accountId string, historyCount int) error {
+	const op = "password.archiveCredential"
+	// The current credential counts towards the history, so only
+	// historyCount-1 previous credentials are kept.
+	keep := historyCount - 1
+	if keep > 0 {
+		if _, err := w.Exec(ctx, archiveCredentialQuery, []interface{}{sql.Named("password_account_id", accountId)}); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to archive credential"))
+		}
+	} else {
+		keep = 0
+	}
+	if _, err := w.Exec(ctx, pruneCredentialHistoryQuery, []interface{}{
+		sql.Named("password_account_id", accountId),
+		sql.Named("keep", keep),
+	}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to prune credential history"))
+	}
+	return nil
+}
@@ -0,0 +1,391 @@
+package password
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-kms-wrapping/structwrapping"
+)
+
+// MfaStatus is the multi-factor authentication status of an account.
+type MfaStatus int
+
+const (
+	// MfaNotRequired means the account authenticates with its password
+	// alone.
+	MfaNotRequired MfaStatus = iota
+
+	// MfaRequired means the account has a confirmed TOTP enrollment and
+	// must authenticate with a TOTP or recovery code after its password.
+	MfaRequired
+
+	// MfaEnrollmentRequired means the account's auth method requires MFA
+	// but the account has no confirmed TOTP enrollment. The account must
+	// enroll in TOTP after authenticating with its password.
+	MfaEnrollmentRequired
+)
+
+// TotpEnrollment is an unconfirmed TOTP enrollment of an account.
+type TotpEnrollment struct {
+	// Secret is the base32 encoded TOTP secret.
+	Secret string
+	// Url is the otpauth URI of the secret.
+	Url string
+}
+
+type totpAccount struct {
+	CtSecret               []byte `gorm:"column:secret" wrapping:"ct,totp_secret"`
+	Secret                 []byte `gorm:"-" wrapping:"pt,totp_secret"`
+	KeyId                  string
+	Confirmed              bool
+	LastUsedStep           uint64
+	LockoutThreshold       uint32
+	LockoutDurationSeconds uint32
+	IsLocked               bool
+}
+
+// LookupMfaStatus returns the multi-factor authentication status of the
+// account for accountId.
+//
+// Returns MfaNotRequired, error with code RecordNotFound if the account
+// doesn't exist.
+func (r *Repository) LookupMfaStatus(ctx context.Context, accountId string) (MfaStatus, error) {
+	const op = "password.(Repository).LookupMfaStatus"
+	if accountId == "" {
+		return MfaNotRequired, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	rows, err := r.reader.Query(ctx, mfaStatusQuery, []interface{}{sql.Named("password_account_id", accountId)})
+	if err != nil {
+		return MfaNotRequired, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var found, requireMfa, totpConfirmed bool
+	for rows.Next() {
+		found = true
+		if err := rows.Scan(&requireMfa, &totpConfirmed); err != nil {
+			return MfaNotRequired, errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return MfaNotRequired, errors.Wrap(ctx, err, op)
+	}
+	switch {
+	case !found:
+		return MfaNotRequired, errors.New(ctx, errors.RecordNotFound, op, "account not found")
+	case totpConfirmed:
+		return MfaRequired, nil
+	case requireMfa:
+		return MfaEnrollmentRequired, nil
+	}
+	return MfaNotRequired, nil
+}
+
+// EnrollTotp starts enrolling the account for accountId in TOTP. A new
+// secret is generated, encrypted with the database key of scopeId and
+// returned with its otpauth URI. The enrollment replaces any unconfirmed
+// enrollment and must be confirmed with ConfirmTotp or VerifyTotp.
+//
+// Returns nil, error with code RecordNotFound if the account doesn't exist
+// and nil, error with code NotUnique if the account already has a confirmed
+// enrollment.
+func (r *Repository) EnrollTotp(ctx context.Context, scopeId, accountId string) (*TotpEnrollment, error) {
+	const op = "password.(Repository).EnrollTotp"
+	if scopeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	if accountId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	acct, err := r.LookupAccount(ctx, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if acct == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "account not found")
+	}
+
+	secret, err := newTotpSecret()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to generate secret"))
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
+	}
+	t := &totpAccount{Secret: secret}
+	if err := t.encrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	rowsAffected, err := r.writer.Exec(ctx, enrollTotpQuery, []interface{}{
+		sql.Named("password_account_id", accountId),
+		sql.Named("secret", t.CtSecret),
+		sql.Named("key_id", t.KeyId),
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if rowsAffected == 0 {
+		return nil, errors.New(ctx, errors.NotUnique, op, "account already enrolled in totp")
+	}
+	return &TotpEnrollment{
+		Secret: totpEncoding.EncodeToString(secret),
+		Url:    totpUrl(acct.GetLoginName(), secret),
+	}, nil
+}
+
+// ConfirmTotp confirms the unconfirmed TOTP enrollment of the account for
+// accountId if code is the current TOTP code of the enrollment. New
+// recovery codes replace any previous recovery codes of the account and are
+// returned.
+//
+// Returns nil, nil if code is not valid. Returns nil, error with code
+// RecordNotFound if the account has no unconfirmed enrollment.
+func (r *Repository) ConfirmTotp(ctx context.Context, scopeId, accountId, code string) ([]string, error) {
+	const op = "password.(Repository).ConfirmTotp"
+	if scopeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	if accountId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	if code == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing code")
+	}
+	t, err := r.lookupTotp(ctx, scopeId, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if t == nil || t.Confirmed {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "no unconfirmed totp enrollment")
+	}
+	_, recoveryCodes, err := r.verifyTotp(ctx, accountId, t, code)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return recoveryCodes, nil
+}
+
+// VerifyTotp verifies code for the account for accountId as the second
+// factor of an authentication. code is either a TOTP code or an unused
+// recovery code of a confirmed enrollment, which is used up. A TOTP code
+// confirms an unconfirmed enrollment, in which case new recovery codes are
+// generated and returned.
+//
+// Each TOTP code is accepted only once. If code is not valid and the
+// account's auth method has a lockout threshold, the failed attempt is
+// counted like a failed password. A valid code resets the failed attempts.
+//
+// Returns false, nil, nil if code is not valid or the account has no
+// enrollment. Returns false, nil, error with code AccountLocked if the
+// account is locked.
+func (r *Repository) VerifyTotp(ctx context.Context, scopeId, accountId, code string) (bool, []string, error) {
+	const op = "password.(Repository).VerifyTotp"
+	if scopeId == "" {
+		return false, nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	if accountId == "" {
+		return false, nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	if code == "" {
+		return false, nil, errors.New(ctx, errors.InvalidParameter, op, "missing code")
+	}
+	t, err := r.lookupTotp(ctx, scopeId, accountId)
+	if err != nil {
+		return false, nil, errors.Wrap(ctx, err, op)
+	}
+	if t == nil {
+		return false, nil, nil
+	}
+	if t.IsLocked {
+		return false, nil, errors.New(ctx, errors.AccountLocked, op, "account is locked", errors.WithoutEvent())
+	}
+	verified, recoveryCodes, err := r.verifyTotp(ctx, accountId, t, code)
+	if err != nil {
+		return false, nil, errors.Wrap(ctx, err, op)
+	}
+	if verified {
+		if _, err := r.writer.Exec(ctx, unlockAccountQuery, []interface{}{sql.Named("password_account_id", accountId)}); err != nil {
+			return false, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to reset failed attempts"))
+		}
+		return true, recoveryCodes, nil
+	}
+	if t.LockoutThreshold > 0 {
+		acct, err := r.LookupAccount(ctx, accountId)
+		if err != nil {
+			return false, nil, errors.Wrap(ctx, err, op)
+		}
+		if acct != nil {
+			if err := r.recordFailedAttempt(ctx, &authAccount{
+				Account:                acct,
+				LockoutThreshold:       t.LockoutThreshold,
+				LockoutDurationSeconds: t.LockoutDurationSeconds,
+			}); err != nil {
+				return false, nil, errors.Wrap(ctx, err, op)
+			}
+		}
+	}
+	return false, nil, nil
+}
+
+// RemoveTotp removes the TOTP enrollment and recovery codes of the account
+// for accountId. The account is returned, whether or not it was enrolled.
+//
+// Returns nil, error with code RecordNotFound if the account doesn't exist.
+func (r *Repository) RemoveTotp(ctx context.Context, accountId string) (*Account, error) {
+	const op = "password.(Repository).RemoveTotp"
+	if accountId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	acct, err := r.LookupAccount(ctx, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if acct == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "account not found")
+	}
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			args := []interface{}{sql.Named("password_account_id", accountId)}
+			if _, err := w.Exec(ctx, deleteTotpQuery, args); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if _, err := w.Exec(ctx, deleteRecoveryCodesQuery, args); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return acct, nil
+}
+
+// lookupTotp returns the decrypted TOTP enrollment of accountId or nil if
+// the account has no enrollment.
+func (r *Repository) lookupTotp(ctx context.Context, scopeId, accountId string) (*totpAccount, error) {
+	const op = "password.(Repository).lookupTotp"
+	rows, err := r.reader.Query(ctx, lookupTotpQuery, []interface{}{sql.Named("password_account_id", accountId)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var t *totpAccount
+	for rows.Next() {
+		t = &totpAccount{}
+		if err := r.reader.ScanRows(rows, t); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if t == nil {
+		return nil, nil
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase, kms.WithKeyId(t.KeyId))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := t.decrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return t, nil
+}
+
+// verifyTotp checks code against the enrollment t of accountId and, if it
+// is valid, records its use. Recovery codes are only accepted for confirmed
+// enrollments. New recovery codes are returned if a TOTP code confirmed the
+// enrollment.
+func (r *Repository) verifyTotp(ctx context.Context, accountId string, t *totpAccount, code string) (bool, []string, error) {
+	const op = "password.(Repository).verifyTotp"
+	code = strings.TrimSpace(code)
+	if !isTotpCode(code) {
+		if !t.Confirmed {
+			return false, nil, nil
+		}
+		rowsUpdated, err := r.writer.Exec(ctx, useRecoveryCodeQuery, []interface{}{
+			sql.Named("password_account_id", accountId),
+			sql.Named("code_hash", hashRecoveryCode(code)),
+		})
+		if err != nil {
+			return false, nil, errors.Wrap(ctx, err, op)
+		}
+		return rowsUpdated == 1, nil, nil
+	}
+
+	step, ok := validateTotpCode(t.Secret, code, time.Now())
+	if !ok || step <= t.LastUsedStep {
+		return false, nil, nil
+	}
+	var recoveryCodes []string
+	if !t.Confirmed {
+		var err error
+		if recoveryCodes, err = newRecoveryCodes(); err != nil {
+			return false, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to generate recovery codes"))
+		}
+	}
+	var verified bool
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			verified = false
+			rowsUpdated, err := w.Exec(ctx, useTotpStepQuery, []interface{}{
+				sql.Named("password_account_id", accountId),
+				sql.Named("step", int64(step)),
+			})
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated == 0 {
+				// A code for this or a later step was used concurrently.
+				return nil
+			}
+			if recoveryCodes != nil {
+				if _, err := w.Exec(ctx, deleteRecoveryCodesQuery, []interface{}{sql.Named("password_account_id", accountId)}); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				for _, rc := range recoveryCodes {
+					if _, err := w.Exec(ctx, insertRecoveryCodeQuery, []interface{}{
+						sql.Named("password_account_id", accountId),
+						sql.Named("code_hash", hashRecoveryCode(rc)),
+					}); err != nil {
+						return errors.Wrap(ctx, err, op, errors.WithMsg("unable to store recovery code"))
+					}
+				}
+			}
+			verified = true
+			return nil
+		},
+	)
+	if err != nil {
+		return false, nil, errors.Wrap(ctx, err, op)
+	}
+	if !verified {
+		return false, nil, nil
+	}
+	return true, recoveryCodes, nil
+}
+
+func (t *totpAccount) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "password.(totpAccount).encrypt"
+	if err := structwrapping.WrapStruct(ctx, cipher, t, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	t.KeyId = cipher.KeyID()
+	return nil
+}
+
+func (t *totpAccount) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "password.(totpAccount).decrypt"
+	if err := structwrapping.UnwrapStruct(ctx, cipher, t, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package password
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth/password/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Totp(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	authMethod := TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+
+	acct, err := repo.CreateAccount(ctx, o.GetPublicId(), &Account{
+		Account: &store.Account{
+			AuthMethodId: authMethod.PublicId,
+			LoginName:    "kazmierczak",
+		},
+	}, WithPassword("12345678"))
+	require.NoError(err)
+
+	status, err := repo.LookupMfaStatus(ctx, acct.PublicId)
+	require.NoError(err)
+	assert.Equal(MfaNotRequired, status)
+
+	authMethod.RequireMfa = true
+	authMethod, _, err = repo.UpdateAuthMethod(ctx, authMethod, authMethod.Version, []string{"RequireMfa"})
+	require.NoError(err)
+	status, err = repo.LookupMfaStatus(ctx, acct.PublicId)
+	require.NoError(err)
+	assert.Equal(MfaEnrollmentRequired, status)
+
+	enrollment, err := repo.EnrollTotp(ctx, o.GetPublicId(), acct.PublicId)
+	require.NoError(err)
+	u, err := url.Parse(enrollment.Url)
+	require.NoError(err)
+	assert.Equal(enrollment.Secret, u.Query().Get("secret"))
+	secret, err := totpEncoding.DecodeString(enrollment.Secret)
+	require.NoError(err)
+
+	// Enrolling again before confirming replaces the secret.
+	enrollment, err = repo.EnrollTotp(ctx, o.GetPublicId(), acct.PublicId)
+	require.NoError(err)
+	assert.NotEqual(totpEncoding.EncodeToString(secret), enrollment.Secret)
+	secret, err = totpEncoding.DecodeString(enrollment.Secret)
+	require.NoError(err)
+
+	recoveryCodes, err := repo.ConfirmTotp(ctx, o.GetPublicId(), acct.PublicId, "000000x")
+	require.NoError(err)
+	assert.Nil(recoveryCodes)
+
+	now := time.Now()
+	code := totpCode(secret, totpStep(now))
+	recoveryCodes, err = repo.ConfirmTotp(ctx, o.GetPublicId(), acct.PublicId, code)
+	require.NoError(err)
+	assert.Len(recoveryCodes, recoveryCodeCount)
+
+	status, err = repo.LookupMfaStatus(ctx, acct.PublicId)
+	require.NoError(err)
+	assert.Equal(MfaRequired, status)
+
+	_, err = repo.EnrollTotp(ctx, o.GetPublicId(), acct.PublicId)
+	assert.Truef(errors.Match(errors.T(errors.NotUnique), err), "want err code: %q got: %q", errors.NotUnique, err)
+
+	// A code cannot be used twice.
+	verified, _, err := repo.VerifyTotp(ctx, o.GetPublicId(), acct.PublicId, code)
+	require.NoError(err)
+	assert.False(verified)
+
+	verified, _, err = repo.VerifyTotp(ctx, o.GetPublicId(), acct.PublicId, totpCode(secret, totpStep(now)+1))
+	require.NoError(err)
+	assert.True(verified)
+
+	// Neither can a recovery code.
+	verified, _, err = repo.VerifyTotp(ctx, o.GetPublicId(), acct.PublicId, recoveryCodes[0])
+	require.NoError(err)
+	assert.True(verified)
+	verified, _, err = repo.VerifyTotp(ctx, o.GetPublicId(), acct.PublicId, recoveryCodes[0])
+	require.NoError(err)
+	assert.False(verified)
+
+	removed, err := repo.RemoveTotp(ctx, acct.PublicId)
+	require.NoError(err)
+	assert.Equal(acct.PublicId, removed.PublicId)
+	status, err = repo.LookupMfaStatus(ctx, acct.PublicId)
+	require.NoError(err)
+	assert.Equal(MfaEnrollmentRequired, status)
+
+	verified, _, err = repo.VerifyTotp(ctx, o.GetPublicId(), acct.PublicId, recoveryCodes[1])
+	require.NoError(err)
+	assert.False(verified)
+}
+
+func TestRepository_VerifyTotpLockout(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	authMethod := TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+
+	authMethod.LockoutThreshold = 2
+	authMethod, _, err = repo.UpdateAuthMethod(ctx, authMethod, authMethod.Version, []string{"LockoutThreshold"})
+	require.NoError(err)
+
+	acct, err := repo.CreateAccount(ctx, o.GetPublicId(), &Account{
+		Account: &store.Account{
+			AuthMethodId: authMethod.PublicId,
+			LoginName:    "kazmierczak",
+		},
+	}, WithPassword("12345678"))
+	require.NoError(err)
+
+	enrollment, err := repo.EnrollTotp(ctx, o.GetPublicId(), acct.PublicId)
+	require.NoError(err)
+	secret, err := totpEncoding.DecodeString(enrollment.Secret)
+	require.NoError(err)
+	now := time.Now()
+	_, err = repo.ConfirmTotp(ctx, o.GetPublicId(), acct.PublicId, totpCode(secret, totpStep(now)))
+	require.NoError(err)
+
+	for i := 0; i < 2; i++ {
+		verified, _, err := repo.VerifyTotp(ctx, o.GetPublicId(), acct.PublicId, "wrong-recovery-code")
+		require.NoError(err)
+		assert.False(verified)
+	}
+
+	verified, _, err := repo.VerifyTotp(ctx, o.GetPublicId(), acct.PublicId, totpCode(secret, totpStep(now)+1))
+	assert.Truef(errors.Match(errors.T(errors.AccountLocked), err), "want err code: %q got: %q", errors.AccountLocked, err)
+	assert.False(verified)
+}
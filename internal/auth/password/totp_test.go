@@ -0,0 +1,85 @@
+package password
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTotpCode(t *testing.T) {
+	// Test vectors from RFC 6238, Appendix B, truncated to 6 digits.
+	secret := []byte("12345678901234567890")
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, totpCode(secret, totpStep(time.Unix(tt.unix, 0))))
+		})
+	}
+}
+
+func TestValidateTotpCode(t *testing.T) {
+	secret := []byte("12345678901234567890")
+	now := time.Unix(1111111109, 0)
+	step := totpStep(now)
+
+	got, ok := validateTotpCode(secret, "081804", now)
+	assert.True(t, ok)
+	assert.Equal(t, step, got)
+
+	got, ok = validateTotpCode(secret, totpCode(secret, step-1), now)
+	assert.True(t, ok)
+	assert.Equal(t, step-1, got)
+
+	got, ok = validateTotpCode(secret, totpCode(secret, step+1), now)
+	assert.True(t, ok)
+	assert.Equal(t, step+1, got)
+
+	_, ok = validateTotpCode(secret, totpCode(secret, step+2), now)
+	assert.False(t, ok)
+	_, ok = validateTotpCode(secret, "000000", now)
+	assert.False(t, ok)
+	_, ok = validateTotpCode(secret, "81804", now)
+	assert.False(t, ok)
+}
+
+func TestTotpUrl(t *testing.T) {
+	u, err := url.Parse(totpUrl("kazmierczak", []byte("12345678901234567890")))
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", u.Scheme)
+	assert.Equal(t, "totp", u.Host)
+	assert.Equal(t, "/Boundary:kazmierczak", u.Path)
+	assert.Equal(t, "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ", u.Query().Get("secret"))
+	assert.Equal(t, "Boundary", u.Query().Get("issuer"))
+	assert.Equal(t, "6", u.Query().Get("digits"))
+	assert.Equal(t, "30", u.Query().Get("period"))
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	codes, err := newRecoveryCodes()
+	require.NoError(err)
+	require.Len(codes, recoveryCodeCount)
+	seen := make(map[string]bool)
+	for _, c := range codes {
+		assert.Len(c, 19)
+		assert.False(isTotpCode(c))
+		assert.False(seen[c])
+		seen[c] = true
+		assert.Equal(hashRecoveryCode(c), hashRecoveryCode(strings.ToUpper(strings.ReplaceAll(c, "-", " "))))
+	}
+	assert.True(isTotpCode("123456"))
+	assert.False(isTotpCode("12345a"))
+}
@@ -185,7 +185,8 @@ func (r *Repository) LookupAuthToken(ctx context.Context, id string, opt ...Opti
 // ValidateToken returns a token from storage if the auth token with the provided id and token exists.  The
 // approximate last accessed time may be updated depending on how long it has been since the last time the token
 // was validated.  If a token is returned it is guaranteed to be valid. For security reasons, the actual token
-// value is not included in the returned AuthToken. If no valid auth token is found nil, nil is returned.
+// value is not included in the returned AuthToken. If no valid auth token is found, or the token has not
+// been issued, nil, nil is returned.
 // All options are ignored.
 //
 // NOTE: Do not log or add the token string to any errors to avoid leaking it as it is a secret.
@@ -209,6 +210,11 @@ func (r *Repository) ValidateToken(ctx context.Context, id, token string, opt ..
 	if retAT == nil {
 		return nil, nil
 	}
+	// Pending tokens are not valid until they are issued at the end of an
+	// authentication flow.
+	if retAT.GetStatus() != string(IssuedStatus) {
+		return nil, nil
+	}
 
 	// If the token is too old or stale invalidate it and return nothing.
 	exp, err := ptypes.Timestamp(retAT.GetExpirationTime().GetTimestamp())
@@ -287,6 +293,52 @@ func (r *Repository) ValidateToken(ctx context.Context, id, token string, opt ..
 	return retAT, nil
 }
 
+// CheckToken returns the auth token with the given id if token matches its
+// value and it is issued and neither expired nor stale. Unlike ValidateToken,
+// it doesn't update the token's approximate last access time or delete
+// expired or stale tokens, so checking a token doesn't keep it from becoming
+// stale. Nil is returned if the token isn't valid. The returned auth token's
+// Token field is empty.
+func (r *Repository) CheckToken(ctx context.Context, id, token string, _ ...Option) (*AuthToken, error) {
+	const op = "authtoken.(Repository).CheckToken"
+	if token == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing token")
+	}
+	if id == "" {
+		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+
+	retAT, err := r.LookupAuthToken(ctx, id, withTokenValue())
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if retAT == nil || retAT.GetStatus() != string(IssuedStatus) {
+		return nil, nil
+	}
+
+	exp, err := ptypes.Timestamp(retAT.GetExpirationTime().GetTimestamp())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("expiration time"), errors.WithCode(errors.InvalidTimeStamp))
+	}
+	lastAccessed, err := ptypes.Timestamp(retAT.GetApproximateLastAccessTime().GetTimestamp())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("last accessed time"), errors.WithCode(errors.InvalidTimeStamp))
+	}
+	now := time.Now()
+	if now.After(exp.Add(-timeSkew)) || now.Sub(lastAccessed)+timeSkew >= r.timeToStaleDuration {
+		return nil, nil
+	}
+
+	if retAT.GetToken() != token {
+		return nil, nil
+	}
+	retAT.Token = ""
+	return retAT, nil
+}
+
 // ListAuthTokens lists auth tokens in the given scopes and supports the
 // WithLimit option.
 func (r *Repository) ListAuthTokens(ctx context.Context, withScopeIds []string, opt ...Option) ([]*AuthToken, error) {
@@ -357,6 +409,37 @@ func (r *Repository) DeleteAuthToken(ctx context.Context, id string, opt ...Opti
 	return rowsDeleted, nil
 }
 
+// DeleteUserAuthTokens deletes the tokens of all accounts of the user with the
+// provided id from the repository returning a count of the number of records
+// deleted.  All options are ignored.
+func (r *Repository) DeleteUserAuthTokens(ctx context.Context, userId string, opt ...Option) (int, error) {
+	const op = "authtoken.(Repository).DeleteUserAuthTokens"
+	if userId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	}
+
+	const sql = `delete from auth_token where auth_account_id in (select public_id from auth_account where iam_user_id = ?)`
+	var rowsDeleted int
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			var err error
+			// tokens are not replicated, so they don't need oplog entries.
+			rowsDeleted, err = w.Exec(ctx, sql, []interface{}{userId})
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(userId))
+	}
+	return rowsDeleted, nil
+}
+
 // IssueAuthToken will retrieve the "pending" token and update it's status to
 // "issued".  If the token has already been issued, an error is returned with a
 // nil token.  If no token is found for the tokenRequestId an error is returned
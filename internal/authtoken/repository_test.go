@@ -566,6 +566,96 @@ func TestRepository_ValidateToken_expired(t *testing.T) {
 	}
 }
 
+func TestRepository_CheckToken(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+
+	org, _ := iam.TestScopes(t, iamRepo)
+	baseAT := TestAuthToken(t, conn, kms, org.GetPublicId())
+	aAcct := allocAuthAccount()
+	aAcct.PublicId = baseAT.GetAuthAccountId()
+	require.NoError(t, rw.LookupByPublicId(context.Background(), aAcct))
+	iamUser, _, err := iamRepo.LookupUser(context.Background(), aAcct.GetIamUserId())
+	require.NoError(t, err)
+	require.NotNil(t, iamUser)
+
+	badToken, err := newAuthToken()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		staleDuration      time.Duration
+		expirationDuration time.Duration
+		badToken           bool
+		wantReturned       bool
+	}{
+		{
+			name:               "valid",
+			staleDuration:      defaultTokenTimeToStaleDuration,
+			expirationDuration: defaultTokenTimeToLiveDuration,
+			wantReturned:       true,
+		},
+		{
+			name:               "mismatched-token",
+			staleDuration:      defaultTokenTimeToStaleDuration,
+			expirationDuration: defaultTokenTimeToLiveDuration,
+			badToken:           true,
+		},
+		{
+			name:               "stale",
+			staleDuration:      1 * time.Millisecond,
+			expirationDuration: defaultTokenTimeToLiveDuration,
+		},
+		{
+			name:               "expired",
+			staleDuration:      defaultTokenTimeToStaleDuration,
+			expirationDuration: 1 * time.Millisecond,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			timeSkew = 20 * time.Millisecond
+			// Make sure ValidateToken would have updated the last access time.
+			lastAccessedUpdateDuration = 0
+
+			repo, err := NewRepository(rw, rw, kms,
+				WithTokenTimeToLiveDuration(tt.expirationDuration),
+				WithTokenTimeToStaleDuration(tt.staleDuration))
+			require.NoError(err)
+
+			ctx := context.Background()
+			at, err := repo.CreateAuthToken(ctx, iamUser, baseAT.GetAuthAccountId())
+			require.NoError(err)
+			token := at.GetToken()
+			if tt.badToken {
+				token = badToken.Token
+			}
+
+			got, err := repo.CheckToken(ctx, at.GetPublicId(), token)
+			require.NoError(err)
+			if tt.wantReturned {
+				require.NotNil(got)
+				assert.Equal(at.GetPublicId(), got.GetPublicId())
+				assert.Empty(got.GetToken())
+			} else {
+				assert.Nil(got)
+			}
+
+			// The token is neither deleted nor has its last access time updated.
+			after, err := repo.LookupAuthToken(ctx, at.GetPublicId())
+			require.NoError(err)
+			require.NotNil(after)
+			assert.True(after.GetApproximateLastAccessTime().GetTimestamp().AsTime().Equal(at.GetApproximateLastAccessTime().GetTimestamp().AsTime()))
+		})
+	}
+}
+
 func TestRepository_DeleteAuthToken(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	rw := db.New(conn)
@@ -628,6 +718,87 @@ func TestRepository_DeleteAuthToken(t *testing.T) {
 	}
 }
 
+func TestRepository_DeleteUserAuthTokens(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+
+	at := TestAuthToken(t, conn, kms, org.GetPublicId())
+	u, _, err := iamRepo.LookupUser(ctx, at.GetIamUserId())
+	require.NoError(err)
+	_, err = repo.CreateAuthToken(ctx, u, at.GetAuthAccountId())
+	require.NoError(err)
+
+	other := TestAuthToken(t, conn, kms, org.GetPublicId())
+
+	_, err = repo.DeleteUserAuthTokens(ctx, "")
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "Unexpected error %s", err)
+
+	got, err := repo.DeleteUserAuthTokens(ctx, u.GetPublicId())
+	require.NoError(err)
+	assert.Equal(2, got)
+
+	tokens, err := repo.ListAuthTokens(ctx, []string{org.GetPublicId()})
+	require.NoError(err)
+	require.Len(tokens, 1)
+	assert.Equal(other.GetPublicId(), tokens[0].GetPublicId())
+}
+
+func TestRepository_RevokedAuthTokens(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	t.Run("set-password", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		org, _ := iam.TestScopes(t, iamRepo)
+		at := TestAuthToken(t, conn, kms, org.GetPublicId())
+		pwRepo, err := password.NewRepository(rw, rw, kms)
+		require.NoError(err)
+		acct, err := pwRepo.LookupAccount(ctx, at.GetAuthAccountId())
+		require.NoError(err)
+		_, err = pwRepo.SetPassword(ctx, org.GetPublicId(), acct.GetPublicId(), "a-new-password", acct.GetVersion())
+		require.NoError(err)
+		// Setting the initial password doesn't delete a credential.
+		found, err := repo.LookupAuthToken(ctx, at.GetPublicId())
+		require.NoError(err)
+		assert.NotNil(found)
+
+		acct, err = pwRepo.LookupAccount(ctx, at.GetAuthAccountId())
+		require.NoError(err)
+		_, err = pwRepo.SetPassword(ctx, org.GetPublicId(), acct.GetPublicId(), "another-password", acct.GetVersion())
+		require.NoError(err)
+		found, err = repo.LookupAuthToken(ctx, at.GetPublicId())
+		require.NoError(err)
+		assert.Nil(found)
+	})
+
+	t.Run("delete-user", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		org, _ := iam.TestScopes(t, iamRepo)
+		at := TestAuthToken(t, conn, kms, org.GetPublicId())
+		_, err := iamRepo.DeleteUser(ctx, at.GetIamUserId())
+		require.NoError(err)
+		found, err := repo.LookupAuthToken(ctx, at.GetPublicId())
+		require.NoError(err)
+		assert.Nil(found)
+	})
+}
+
 func TestRepository_ListAuthTokens(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	rw := db.New(conn)
@@ -28,6 +28,8 @@ const (
 	EnabledPluginHostLoopback
 	EnabledPluginHostAws
 	EnabledPluginHostAzure
+	EnabledPluginHostFile
+	EnabledPluginHostDns
 )
 
 func (e EnabledPlugin) String() string {
@@ -38,6 +40,10 @@ func (e EnabledPlugin) String() string {
 		return "AWS"
 	case EnabledPluginHostAzure:
 		return "Azure"
+	case EnabledPluginHostFile:
+		return "File"
+	case EnabledPluginHostDns:
+		return "DNS"
 	default:
 		return ""
 	}
@@ -106,6 +112,7 @@ type Command struct {
 	FlagVersion           int
 	FlagRecursive         bool
 	FlagFilter            string
+	FlagPageSize          uint
 
 	// Attribute values
 	FlagAttributes string
@@ -245,6 +245,30 @@ func (c *Command) PrintApiError(in *api.Error, contextStr string, opt ...Option)
 					)
 				}
 			}
+
+			if sl := in.Details.SessionLimit; sl != nil {
+				output = append(output,
+					"",
+					"  Session Limit:",
+					fmt.Sprintf("    Kind:                %s", sl.Kind),
+					fmt.Sprintf("    Resource ID:         %s", sl.ResourceId),
+					fmt.Sprintf("    Limit:               %d", sl.Limit),
+				)
+				if len(sl.Holders) > 0 {
+					output = append(output,
+						"",
+						"  Session Holders:",
+					)
+				}
+				for _, h := range sl.Holders {
+					output = append(output,
+						fmt.Sprintf("    Session ID:          %s", h.SessionId),
+						fmt.Sprintf("      User ID:           %s", h.UserId),
+						fmt.Sprintf("      Target ID:         %s", h.TargetId),
+						fmt.Sprintf("      Status:            %s", h.Status),
+					)
+				}
+			}
 		}
 
 		c.UI.Error(WrapForHelpText(output))
@@ -306,26 +330,20 @@ func (c *Command) PrintJsonItems(result api.GenericListResult) bool {
 		c.PrintCliError(errors.New("Error formatting as JSON: no response given to items formatter"))
 		return false
 	}
-	// First we need to grab the items out. The reason is that if we simply
-	// embed the raw message as with PrintJsonItem above, it will have {"items":
-	// {"items": []}}. However, we decode into a RawMessage which makes it much
-	// more efficient on both the decoding and encoding side.
-	type inMsg struct {
-		Items json.RawMessage `json:"items"`
-	}
-	var input inMsg
-	if resp.Body.Bytes() != nil {
-		if err := json.Unmarshal(resp.Body.Bytes(), &input); err != nil {
-			c.PrintCliError(fmt.Errorf("Error unmarshaling response body at format time: %w", err))
-			return false
-		}
+	// The items are taken from the result rather than the response body, as
+	// the response of an automatically paginated listing only holds the items
+	// of the last page.
+	items, err := json.Marshal(result.GetItems())
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error marshaling items at format time: %w", err))
+		return false
 	}
 	output := struct {
 		StatusCode int             `json:"status_code"`
 		Items      json.RawMessage `json:"items"`
 	}{
 		StatusCode: resp.HttpResponse().StatusCode,
-		Items:      input.Items,
+		Items:      items,
 	}
 	b, err := JsonFormatter{}.Format(output)
 	if err != nil {
@@ -25,6 +25,7 @@ import (
 	berrors "github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/observability/event"
+	hostplugin "github.com/hashicorp/boundary/internal/plugin/host"
 	"github.com/hashicorp/boundary/internal/servers"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
@@ -117,6 +118,7 @@ type Server struct {
 
 	EnabledPlugins []EnabledPlugin
 	HostPlugins    map[string]plgpb.HostPluginServiceClient
+	HostPluginInfo map[string]*hostplugin.Info
 
 	DevOidcSetup oidcSetup
 
@@ -19,18 +19,26 @@ func init() {
 	extraFlagsFunc = extraFlagsFuncImpl
 	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
 	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
 }
 
 type extraCmdVars struct {
 	flagPassword        string
 	flagCurrentPassword string
 	flagNewPassword     string
+	flagCode            string
+	etr                 *accounts.EnrollTotpResult
+	ctr                 *accounts.ConfirmTotpResult
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
 		"change-password": {"id", "current-password", "new-password", "version"},
 		"set-password":    {"id", "password", "version"},
+		"unlock":          {"id"},
+		"enroll-totp":     {"id"},
+		"confirm-totp":    {"id", "code"},
+		"remove-totp":     {"id"},
 	}
 }
 
@@ -42,6 +50,18 @@ func extraSynopsisFuncImpl(c *Command) string {
 	case "set-password":
 		return "Directly set the password on an account"
 
+	case "unlock":
+		return "Unlock an account locked out after failed authentication attempts"
+
+	case "enroll-totp":
+		return "Start enrolling an account in TOTP multi-factor authentication"
+
+	case "confirm-totp":
+		return "Confirm the TOTP enrollment of an account"
+
+	case "remove-totp":
+		return "Remove the TOTP enrollment of an account"
+
 	default:
 		return ""
 	}
@@ -86,6 +106,18 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			"",
 		})
+	case "unlock":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts unlock [options] [args]",
+			"",
+			"  This command allows unlocking a password-type account which was locked out after too many failed authentication attempts. Example:",
+			"",
+			"    Unlock a password-type account:",
+			"",
+			`      $ boundary accounts unlock -id acctpw_1234567890`,
+			"",
+			"",
+		})
 	}
 	return helpStr + c.Flags().Help()
 }
@@ -111,6 +143,12 @@ func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagNewPassword,
 				Usage:  "The new password for the account. If not specified, the command will prompt for the password to be entered in a non-echoing way.",
 			})
+		case "code":
+			f.StringVar(&base.StringVar{
+				Name:   "code",
+				Target: &c.flagCode,
+				Usage:  "The code from the authenticator application.",
+			})
 		}
 	}
 }
@@ -171,6 +209,11 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]accounts.O
 		c.flagNewPassword = strings.TrimSpace(value)
 	}
 
+	if strutil.StrListContains(flagsMap[c.Func], "code") && c.flagCode == "" {
+		c.UI.Error("Code must be passed in via -code")
+		return false
+	}
+
 	return true
 }
 
@@ -180,10 +223,74 @@ func executeExtraActionsImpl(c *Command, origResult api.GenericResult, origError
 		return accountClient.SetPassword(c.Context, c.FlagId, c.flagPassword, version, opts...)
 	case "change-password":
 		return accountClient.ChangePassword(c.Context, c.FlagId, c.flagCurrentPassword, c.flagNewPassword, version, opts...)
+	case "unlock":
+		return accountClient.Unlock(c.Context, c.FlagId, opts...)
+	case "enroll-totp":
+		var err error
+		c.etr, err = accountClient.EnrollTotp(c.Context, c.FlagId, opts...)
+		return nil, err
+	case "confirm-totp":
+		var err error
+		c.ctr, err = accountClient.ConfirmTotp(c.Context, c.FlagId, c.flagCode, opts...)
+		return nil, err
+	case "remove-totp":
+		return accountClient.RemoveTotp(c.Context, c.FlagId, opts...)
 	}
 	return origResult, origError
 }
 
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "enroll-totp":
+		switch base.Format(c.UI) {
+		case "table":
+			item := c.etr.Item
+			c.UI.Output(base.WrapForHelpText([]string{
+				"",
+				"TOTP enrollment information:",
+				fmt.Sprintf("  Secret:    %s", item.Secret),
+				fmt.Sprintf("  URL:       %s", item.Url),
+				"",
+				"  Add the secret or URL to an authenticator application, then confirm the",
+				"  enrollment with a code from it using \"boundary accounts confirm-totp\".",
+			}))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.etr); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+
+	case "confirm-totp":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(printRecoveryCodes(c.ctr.Item.RecoveryCodes))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.ctr); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func printRecoveryCodes(codes []string) string {
+	return base.WrapForHelpText([]string{
+		"",
+		"TOTP recovery codes:",
+		base.WrapSlice(2, codes),
+		"",
+		"  Each recovery code can be used once instead of a TOTP code. Store them",
+		"  somewhere safe; they will not be shown again.",
+	})
+}
+
 func (c *Command) printListTable(items []*accounts.Account) string {
 	if len(items) == 0 {
 		return "No accounts found"
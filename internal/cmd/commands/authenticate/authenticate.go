@@ -26,6 +26,10 @@ func (c *Command) Help() string {
 		"",
 		"      $ boundary authenticate password -auth-method-id ampw_1234567890 -login-name foo -password \"bar\"",
 		"",
+		"    Authenticate with LDAP auth method:",
+		"",
+		"      $ boundary authenticate ldap -auth-method-id amldap_1234567890 -login-name foo -password \"bar\"",
+		"",
 		"  Please see the auth method subcommand help for detailed usage information.",
 	})
 }
@@ -24,6 +24,8 @@ var (
 
 type OidcCommand struct {
 	*base.Command
+
+	flagDevice bool
 }
 
 func (c *OidcCommand) Synopsis() string {
@@ -38,6 +40,10 @@ func (c *OidcCommand) Help() string {
 		"",
 		`    $ boundary authenticate oidc -auth-method-id amoidc_1234567890`,
 		"",
+		"  When a browser can't be opened, such as over SSH, use the device authorization flow. A verification URL and user code are printed to complete the authentication on any device:",
+		"",
+		`    $ boundary authenticate oidc -auth-method-id amoidc_1234567890 -device`,
+		"",
 		"",
 	}) + c.Flags().Help()
 }
@@ -53,6 +59,12 @@ func (c *OidcCommand) Flags() *base.FlagSets {
 		Usage:  "The auth-method resource to use for the operation",
 	})
 
+	f.BoolVar(&base.BoolVar{
+		Name:   "device",
+		Target: &c.flagDevice,
+		Usage:  "If set, the device authorization flow is used instead of opening a browser. The auth method's provider must support the device authorization grant.",
+	})
+
 	return set
 }
 
@@ -85,29 +97,67 @@ func (c *OidcCommand) Run(args []string) int {
 	}
 	aClient := authmethods.NewClient(client)
 
-	result, err := aClient.Authenticate(c.Context, c.FlagAuthMethodId, "start", nil)
-	if err != nil {
-		if apiErr := api.AsServerError(err); apiErr != nil {
-			c.PrintApiError(apiErr, "Error from controller when performing authentication start")
-			return base.CommandApiError
+	var result *authmethods.AuthenticateResult
+	var tokenId string
+	pollInterval := 1500 * time.Millisecond
+	if c.flagDevice {
+		result, err = aClient.Authenticate(c.Context, c.FlagAuthMethodId, "start-device", nil)
+		if err != nil {
+			if apiErr := api.AsServerError(err); apiErr != nil {
+				c.PrintApiError(apiErr, "Error from controller when performing device authentication start")
+				return base.CommandApiError
+			}
+			c.PrintCliError(fmt.Errorf("Error trying to perform device authentication start: %w", err))
+			return base.CommandCliError
 		}
-		c.PrintCliError(fmt.Errorf("Error trying to perform authentication start: %w", err))
-		return base.CommandCliError
-	}
 
-	startResp := new(authmethods.OidcAuthMethodAuthenticateStartResponse)
-	if err := json.Unmarshal(result.GetRawAttributes(), startResp); err != nil {
-		c.PrintCliError(fmt.Errorf("Error trying to decode authenticate start response: %w", err))
-		return base.CommandCliError
-	}
+		startResp := new(authmethods.OidcAuthMethodAuthenticateStartDeviceResponse)
+		if err := json.Unmarshal(result.GetRawAttributes(), startResp); err != nil {
+			c.PrintCliError(fmt.Errorf("Error trying to decode device authentication start response: %w", err))
+			return base.CommandCliError
+		}
+		tokenId = startResp.TokenId
+		if startResp.Interval > 0 {
+			pollInterval = time.Duration(startResp.Interval) * time.Second
+		}
 
-	if base.Format(c.UI) == "table" {
-		c.UI.Output("Opening returned authentication URL in your browser...")
-	}
-	if err := util.OpenURL(startResp.AuthUrl); err != nil {
-		c.UI.Error(fmt.Errorf("Unable to open authentication URL in browser: %w", err).Error())
-		c.UI.Warn("Please open the following URL manually in your web browser:")
-		c.UI.Output(startResp.AuthUrl)
+		// Print to stderr in non-table formats to keep the token output
+		// parsable.
+		output := c.UI.Output
+		if base.Format(c.UI) != "table" {
+			output = c.UI.Warn
+		}
+		output(fmt.Sprintf("To authenticate, open the following URL in a web browser on any device:\n\n  %s\n", startResp.VerificationUri))
+		output(fmt.Sprintf("and enter the code: %s\n", startResp.UserCode))
+		if startResp.VerificationUriComplete != "" {
+			output(fmt.Sprintf("Alternatively, open the following URL which includes the code:\n\n  %s\n", startResp.VerificationUriComplete))
+		}
+	} else {
+		result, err = aClient.Authenticate(c.Context, c.FlagAuthMethodId, "start", nil)
+		if err != nil {
+			if apiErr := api.AsServerError(err); apiErr != nil {
+				c.PrintApiError(apiErr, "Error from controller when performing authentication start")
+				return base.CommandApiError
+			}
+			c.PrintCliError(fmt.Errorf("Error trying to perform authentication start: %w", err))
+			return base.CommandCliError
+		}
+
+		startResp := new(authmethods.OidcAuthMethodAuthenticateStartResponse)
+		if err := json.Unmarshal(result.GetRawAttributes(), startResp); err != nil {
+			c.PrintCliError(fmt.Errorf("Error trying to decode authenticate start response: %w", err))
+			return base.CommandCliError
+		}
+		tokenId = startResp.TokenId
+
+		if base.Format(c.UI) == "table" {
+			c.UI.Output("Opening returned authentication URL in your browser...")
+		}
+		if err := util.OpenURL(startResp.AuthUrl); err != nil {
+			c.UI.Error(fmt.Errorf("Unable to open authentication URL in browser: %w", err).Error())
+			c.UI.Warn("Please open the following URL manually in your web browser:")
+			c.UI.Output(startResp.AuthUrl)
+		}
 	}
 
 	var watchCode int
@@ -122,9 +172,9 @@ func (c *OidcCommand) Run(args []string) int {
 				watchCode = base.CommandCliError
 				return
 
-			case <-time.After(1500 * time.Millisecond):
+			case <-time.After(pollInterval):
 				result, err = aClient.Authenticate(c.Context, c.FlagAuthMethodId, "token", map[string]interface{}{
-					"token_id": startResp.TokenId,
+					"token_id": tokenId,
 				})
 				if err != nil {
 					if apiErr := api.AsServerError(err); apiErr != nil {
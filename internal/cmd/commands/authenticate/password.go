@@ -21,15 +21,27 @@ var (
 )
 
 var (
-	envPassword  = "BOUNDARY_AUTHENTICATE_PASSWORD_PASSWORD"
-	envLoginName = "BOUNDARY_AUTHENTICATE_PASSWORD_LOGIN_NAME"
+	envPassword    = "BOUNDARY_AUTHENTICATE_PASSWORD_PASSWORD"
+	envNewPassword = "BOUNDARY_AUTHENTICATE_PASSWORD_NEW_PASSWORD"
+	envLoginName   = "BOUNDARY_AUTHENTICATE_PASSWORD_LOGIN_NAME"
+	envTotpCode    = "BOUNDARY_AUTHENTICATE_PASSWORD_TOTP_CODE"
 )
 
+// newPasswordField is the request field reported by the controller when the
+// account's password has expired and a new password must be provided.
+const newPasswordField = "attributes.new_password"
+
+// pendingTokenIdField is the attribute returned instead of an auth token when
+// the account must also authenticate with a TOTP code.
+const pendingTokenIdField = "pending_token_id"
+
 type PasswordCommand struct {
 	*base.Command
 
-	flagLoginName string
-	flagPassword  string
+	flagLoginName   string
+	flagPassword    string
+	flagNewPassword string
+	flagTotpCode    string
 }
 
 func (c *PasswordCommand) Synopsis() string {
@@ -44,6 +56,10 @@ func (c *PasswordCommand) Help() string {
 		"",
 		`    $ boundary authenticate password -auth-method-id ampw_1234567890 -login-name foo -password "bar"`,
 		"",
+		"  If the account's password has expired, a new password must be provided. It is read from -new-password or, if that is not set, prompted for.",
+		"",
+		"  If the account uses multi-factor authentication, a TOTP code or recovery code must be provided as well. It is read from -totp-code or, if that is not set, prompted for. If the auth method requires multi-factor authentication and the account is not yet enrolled, the TOTP secret to add to an authenticator application is shown first.",
+		"",
 		"",
 	}) + c.Flags().Help()
 }
@@ -66,6 +82,20 @@ func (c *PasswordCommand) Flags() *base.FlagSets {
 		Usage:  "The password associated with the login name",
 	})
 
+	f.StringVar(&base.StringVar{
+		Name:   "new-password",
+		Target: &c.flagNewPassword,
+		EnvVar: envNewPassword,
+		Usage:  "A new password to set if the current password has expired",
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:   "totp-code",
+		Target: &c.flagTotpCode,
+		EnvVar: envTotpCode,
+		Usage:  "The TOTP code or a recovery code, if the account uses multi-factor authentication",
+	})
+
 	f.StringVar(&base.StringVar{
 		Name:   "auth-method-id",
 		EnvVar: "BOUNDARY_AUTH_METHOD_ID",
@@ -133,11 +163,40 @@ func (c *PasswordCommand) Run(args []string) int {
 		return base.CommandCliError
 	}
 
-	result, err := authmethods.NewClient(client).Authenticate(c.Context, c.FlagAuthMethodId, "login",
-		map[string]interface{}{
-			"login_name": c.flagLoginName,
-			"password":   c.flagPassword,
-		})
+	amClient := authmethods.NewClient(client)
+	result, err := amClient.Authenticate(c.Context, c.FlagAuthMethodId, "login", c.loginAttributes())
+	if err != nil && c.flagNewPassword == "" && passwordExpired(err) {
+		fmt.Print("Password has expired, please enter a new password now (will be hidden): ")
+		value, readErr := password.Read(os.Stdin)
+		fmt.Print("\n")
+		if readErr != nil {
+			c.UI.Error(fmt.Sprintf("An error occurred attempting to read the new password. The raw error message is shown below but usually this is because you attempted to pipe a value into the command or you are executing outside of a terminal (TTY). Use -new-password instead. The raw error was:\n\n%s", readErr.Error()))
+			return base.CommandUserError
+		}
+		c.flagNewPassword = strings.TrimSpace(value)
+		result, err = amClient.Authenticate(c.Context, c.FlagAuthMethodId, "login", c.loginAttributes())
+	}
+	if err == nil {
+		if pendingTokenId, ok := result.Attributes[pendingTokenIdField].(string); ok {
+			if ret := c.printTotpEnrollment(result); ret != base.CommandSuccess {
+				return ret
+			}
+			if c.flagTotpCode == "" {
+				fmt.Print("TOTP code is not set as flag or in env, please enter it now: ")
+				value, readErr := password.Read(os.Stdin)
+				fmt.Print("\n")
+				if readErr != nil {
+					c.UI.Error(fmt.Sprintf("An error occurred attempting to read the TOTP code. The raw error message is shown below but usually this is because you attempted to pipe a value into the command or you are executing outside of a terminal (TTY). Use -totp-code instead. The raw error was:\n\n%s", readErr.Error()))
+					return base.CommandUserError
+				}
+				c.flagTotpCode = strings.TrimSpace(value)
+			}
+			result, err = amClient.Authenticate(c.Context, c.FlagAuthMethodId, "totp", map[string]interface{}{
+				pendingTokenIdField: pendingTokenId,
+				"code":              c.flagTotpCode,
+			})
+		}
+	}
 	if err != nil {
 		if apiErr := api.AsServerError(err); apiErr != nil {
 			c.PrintApiError(apiErr, "Error from controller when performing authentication")
@@ -147,5 +206,81 @@ func (c *PasswordCommand) Run(args []string) int {
 		return base.CommandCliError
 	}
 
+	if codes, ok := result.Attributes["recovery_codes"].([]interface{}); ok {
+		recoveryCodes := make([]string, 0, len(codes))
+		for _, code := range codes {
+			recoveryCodes = append(recoveryCodes, fmt.Sprint(code))
+		}
+		c.printInfo(base.WrapForHelpText([]string{
+			"",
+			"TOTP recovery codes:",
+			base.WrapSlice(2, recoveryCodes),
+			"",
+			"  Each recovery code can be used once instead of a TOTP code. Store them",
+			"  somewhere safe; they will not be shown again.",
+		}))
+	}
+
 	return saveAndOrPrintToken(c.Command, result)
 }
+
+// printTotpEnrollment prints the TOTP secret returned when the account must
+// enroll in multi-factor authentication as part of authenticating.
+func (c *PasswordCommand) printTotpEnrollment(result *authmethods.AuthenticateResult) int {
+	url, _ := result.Attributes["totp_url"].(string)
+	if url == "" {
+		return base.CommandSuccess
+	}
+	secret, _ := result.Attributes["totp_secret"].(string)
+	if c.flagTotpCode != "" {
+		c.PrintCliError(errors.New("The account must enroll in TOTP before a code can be provided; run the command again without -totp-code"))
+		return base.CommandUserError
+	}
+	c.printInfo(base.WrapForHelpText([]string{
+		"",
+		"This auth method requires multi-factor authentication. Add the following",
+		"secret or URL to an authenticator application and enter the code it shows.",
+		"",
+		fmt.Sprintf("  Secret:    %s", secret),
+		fmt.Sprintf("  URL:       %s", url),
+		"",
+	}))
+	return base.CommandSuccess
+}
+
+func (c *PasswordCommand) loginAttributes() map[string]interface{} {
+	attrs := map[string]interface{}{
+		"login_name": c.flagLoginName,
+		"password":   c.flagPassword,
+	}
+	if c.flagNewPassword != "" {
+		attrs["new_password"] = c.flagNewPassword
+	}
+	return attrs
+}
+
+// passwordExpired reports whether err is the controller rejecting the
+// authentication because the account's password has expired.
+func passwordExpired(err error) bool {
+	apiErr := api.AsServerError(err)
+	if apiErr == nil || apiErr.Details == nil {
+		return false
+	}
+	for _, f := range apiErr.Details.RequestFields {
+		if f.Name == newPasswordField {
+			return true
+		}
+	}
+	return false
+}
+
+// printInfo prints information about the multi-factor authentication flow.
+// It goes to stderr when the output format is not a table so that JSON output
+// remains parseable.
+func (c *PasswordCommand) printInfo(msg string) {
+	if base.Format(c.UI) == "table" {
+		c.UI.Output(msg)
+		return
+	}
+	c.UI.Warn(msg)
+}
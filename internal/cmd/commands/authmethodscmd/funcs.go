@@ -217,6 +217,12 @@ func printItemTable(result api.GenericResult) string {
 }
 
 var keySubstMap = map[string]string{
-	"min_login_name_length": "Minimum Login Name Length",
-	"min_password_length":   "Minimum Password Length",
+	"min_login_name_length":    "Minimum Login Name Length",
+	"min_password_length":      "Minimum Password Length",
+	"min_character_classes":    "Minimum Character Classes",
+	"password_history_count":   "Password History Count",
+	"max_password_age_seconds": "Maximum Password Age Seconds",
+	"lockout_threshold":        "Lockout Threshold",
+	"lockout_duration_seconds": "Lockout Duration Seconds",
+	"require_mfa":              "Require MFA",
 }
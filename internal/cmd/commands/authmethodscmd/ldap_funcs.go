@@ -0,0 +1,273 @@
+package authmethodscmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+func init() {
+	extraLdapActionsFlagsMapFunc = extraLdapActionsFlagsMapFuncImpl
+	extraLdapFlagsFunc = extraLdapFlagsFuncImpl
+	extraLdapFlagsHandlingFunc = extraLdapFlagHandlingFuncImpl
+}
+
+type extraLdapCmdVars struct {
+	flagUrls            []string
+	flagStartTls        string
+	flagInsecureTls     string
+	flagDiscoverDn      string
+	flagAnonGroupSearch string
+	flagUserDn          string
+	flagUserAttr        string
+	flagUserFilter      string
+	flagEnableGroups    string
+	flagGroupDn         string
+	flagGroupAttr       string
+	flagGroupFilter     string
+	flagCertificates    []string
+	flagBindDn          string
+	flagBindPassword    string
+}
+
+const (
+	urlsFlagName            = "urls"
+	startTlsFlagName        = "start-tls"
+	insecureTlsFlagName     = "insecure-tls"
+	discoverDnFlagName      = "discover-dn"
+	anonGroupSearchFlagName = "anon-group-search"
+	userDnFlagName          = "user-dn"
+	userAttrFlagName        = "user-attr"
+	userFilterFlagName      = "user-filter"
+	enableGroupsFlagName    = "enable-groups"
+	groupDnFlagName         = "group-dn"
+	groupAttrFlagName       = "group-attr"
+	groupFilterFlagName     = "group-filter"
+	certificateFlagName     = "certificate"
+	bindDnFlagName          = "bind-dn"
+	bindPasswordFlagName    = "bind-password"
+)
+
+func extraLdapActionsFlagsMapFuncImpl() map[string][]string {
+	flags := map[string][]string{
+		"create": {
+			urlsFlagName,
+			startTlsFlagName,
+			insecureTlsFlagName,
+			discoverDnFlagName,
+			anonGroupSearchFlagName,
+			userDnFlagName,
+			userAttrFlagName,
+			userFilterFlagName,
+			enableGroupsFlagName,
+			groupDnFlagName,
+			groupAttrFlagName,
+			groupFilterFlagName,
+			certificateFlagName,
+			bindDnFlagName,
+			bindPasswordFlagName,
+		},
+	}
+	flags["update"] = flags["create"]
+	return flags
+}
+
+func extraLdapFlagsFuncImpl(c *LdapCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("LDAP Auth Method Options")
+
+	for _, name := range flagsLdapMap[c.Func] {
+		switch name {
+		case urlsFlagName:
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   urlsFlagName,
+				Target: &c.flagUrls,
+				Usage:  `The LDAP server URL, using either the "ldap" or "ldaps" scheme. May be specified multiple times; servers are tried in order.`,
+			})
+		case startTlsFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   startTlsFlagName,
+				Target: &c.flagStartTls,
+				Usage:  `Whether to issue StartTLS after connecting to an "ldap" URL. Accepts "true" or "false".`,
+			})
+		case insecureTlsFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   insecureTlsFlagName,
+				Target: &c.flagInsecureTls,
+				Usage:  `Whether to skip verification of the LDAP server's certificate. Accepts "true" or "false".`,
+			})
+		case discoverDnFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   discoverDnFlagName,
+				Target: &c.flagDiscoverDn,
+				Usage:  `Whether to search for the user's DN using the bind credentials or an anonymous bind. Accepts "true" or "false".`,
+			})
+		case anonGroupSearchFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   anonGroupSearchFlagName,
+				Target: &c.flagAnonGroupSearch,
+				Usage:  `Whether to use an anonymous bind when searching for the user's groups. Accepts "true" or "false".`,
+			})
+		case userDnFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   userDnFlagName,
+				Target: &c.flagUserDn,
+				Usage:  "The base DN under which to search for users.",
+			})
+		case userAttrFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   userAttrFlagName,
+				Target: &c.flagUserAttr,
+				Usage:  `The attribute on a user entry matching the login name, for example "uid" or "sAMAccountName".`,
+			})
+		case userFilterFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   userFilterFlagName,
+				Target: &c.flagUserFilter,
+				Usage:  "A Go template used to construct the LDAP user search filter.",
+			})
+		case enableGroupsFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   enableGroupsFlagName,
+				Target: &c.flagEnableGroups,
+				Usage:  `Whether to look up the user's groups at login time so they can be used by managed groups. Accepts "true" or "false".`,
+			})
+		case groupDnFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   groupDnFlagName,
+				Target: &c.flagGroupDn,
+				Usage:  "The base DN under which to search for groups.",
+			})
+		case groupAttrFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   groupAttrFlagName,
+				Target: &c.flagGroupAttr,
+				Usage:  "The attribute on a group entry which names the group.",
+			})
+		case groupFilterFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   groupFilterFlagName,
+				Target: &c.flagGroupFilter,
+				Usage:  "A Go template used to construct the LDAP group search filter.",
+			})
+		case certificateFlagName:
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   certificateFlagName,
+				Target: &c.flagCertificates,
+				Usage:  "Optional PEM-encoded X.509 CA certificate that can be used as a trust anchor when connecting to the LDAP server. May be specified multiple times.",
+			})
+		case bindDnFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   bindDnFlagName,
+				Target: &c.flagBindDn,
+				Usage:  "The DN used to bind when searching for users and groups.",
+			})
+		case bindPasswordFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   bindPasswordFlagName,
+				Target: &c.flagBindPassword,
+				Usage:  "The password used along with the bind DN.",
+			})
+		}
+	}
+}
+
+func (c *LdapCommand) extraLdapHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary auth-methods create ldap [options] [args]",
+			"",
+			"  Create an ldap-type auth method. Example:",
+			"",
+			`    $ boundary auth-methods create ldap -name prodops -urls "ldaps://ldap.example.com" -user-dn "ou=people,dc=example,dc=com" -description "Ldap auth-method for ProdOps"`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary auth-methods update ldap [options] [args]",
+			"",
+			"  Update an ldap-type auth method given its ID. Example:",
+			"",
+			`    $ boundary auth-methods update ldap -id amldap_1234567890 -name "devops" -description "Ldap auth-method for DevOps"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraLdapFlagHandlingFuncImpl(c *LdapCommand, _ *base.FlagSets, opts *[]authmethods.Option) bool {
+	switch {
+	case len(c.flagUrls) == 0:
+	case len(c.flagUrls) == 1 && c.flagUrls[0] == "null":
+		*opts = append(*opts, authmethods.DefaultLdapAuthMethodUrls())
+	default:
+		*opts = append(*opts, authmethods.WithLdapAuthMethodUrls(c.flagUrls))
+	}
+
+	boolFlags := []struct {
+		name   string
+		value  string
+		with   func(bool) authmethods.Option
+		defVal func() authmethods.Option
+	}{
+		{startTlsFlagName, c.flagStartTls, authmethods.WithLdapAuthMethodStartTls, authmethods.DefaultLdapAuthMethodStartTls},
+		{insecureTlsFlagName, c.flagInsecureTls, authmethods.WithLdapAuthMethodInsecureTls, authmethods.DefaultLdapAuthMethodInsecureTls},
+		{discoverDnFlagName, c.flagDiscoverDn, authmethods.WithLdapAuthMethodDiscoverDn, authmethods.DefaultLdapAuthMethodDiscoverDn},
+		{anonGroupSearchFlagName, c.flagAnonGroupSearch, authmethods.WithLdapAuthMethodAnonGroupSearch, authmethods.DefaultLdapAuthMethodAnonGroupSearch},
+		{enableGroupsFlagName, c.flagEnableGroups, authmethods.WithLdapAuthMethodEnableGroups, authmethods.DefaultLdapAuthMethodEnableGroups},
+	}
+	for _, b := range boolFlags {
+		switch b.value {
+		case "":
+		case "null":
+			*opts = append(*opts, b.defVal())
+		default:
+			val, err := strconv.ParseBool(b.value)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing -%s value %q: %s", b.name, b.value, err))
+				return false
+			}
+			*opts = append(*opts, b.with(val))
+		}
+	}
+
+	stringFlags := []struct {
+		value  string
+		with   func(string) authmethods.Option
+		defVal func() authmethods.Option
+	}{
+		{c.flagUserDn, authmethods.WithLdapAuthMethodUserDn, authmethods.DefaultLdapAuthMethodUserDn},
+		{c.flagUserAttr, authmethods.WithLdapAuthMethodUserAttr, authmethods.DefaultLdapAuthMethodUserAttr},
+		{c.flagUserFilter, authmethods.WithLdapAuthMethodUserFilter, authmethods.DefaultLdapAuthMethodUserFilter},
+		{c.flagGroupDn, authmethods.WithLdapAuthMethodGroupDn, authmethods.DefaultLdapAuthMethodGroupDn},
+		{c.flagGroupAttr, authmethods.WithLdapAuthMethodGroupAttr, authmethods.DefaultLdapAuthMethodGroupAttr},
+		{c.flagGroupFilter, authmethods.WithLdapAuthMethodGroupFilter, authmethods.DefaultLdapAuthMethodGroupFilter},
+		{c.flagBindDn, authmethods.WithLdapAuthMethodBindDn, authmethods.DefaultLdapAuthMethodBindDn},
+		{c.flagBindPassword, authmethods.WithLdapAuthMethodBindPassword, authmethods.DefaultLdapAuthMethodBindPassword},
+	}
+	for _, s := range stringFlags {
+		switch s.value {
+		case "":
+		case "null":
+			*opts = append(*opts, s.defVal())
+		default:
+			*opts = append(*opts, s.with(s.value))
+		}
+	}
+
+	switch {
+	case len(c.flagCertificates) == 0:
+	case len(c.flagCertificates) == 1 && c.flagCertificates[0] == "null":
+		*opts = append(*opts, authmethods.DefaultLdapAuthMethodCertificates())
+	default:
+		*opts = append(*opts, authmethods.WithLdapAuthMethodCertificates(c.flagCertificates))
+	}
+
+	return true
+}
@@ -28,6 +28,7 @@ type extraOidcCmdVars struct {
 	flagAllowedAudiences                  []string
 	flagClaimsScopes                      []string
 	flagAccountClaimMaps                  []string
+	flagStoreRefreshTokens                string
 	flagDisableDiscoveredConfigValidation bool
 	flagDryRun                            bool
 }
@@ -44,6 +45,7 @@ const (
 	allowedAudienceFlagName                   = "allowed-audience"
 	claimsScopes                              = "claims-scopes"
 	accountClaimMaps                          = "account-claim-maps"
+	storeRefreshTokensFlagName                = "store-refresh-tokens"
 	stateFlagName                             = "state"
 	disableDiscoveredConfigValidationFlagName = "disable-discovered-config-validation"
 	dryRunFlagName                            = "dry-run"
@@ -62,6 +64,7 @@ func extraOidcActionsFlagsMapFuncImpl() map[string][]string {
 			allowedAudienceFlagName,
 			claimsScopes,
 			accountClaimMaps,
+			storeRefreshTokensFlagName,
 		},
 		"change-state": {
 			idFlagName,
@@ -94,7 +97,7 @@ func extraOidcFlagsFuncImpl(c *OidcCommand, set *base.FlagSets, _ *base.FlagSet)
 			f.StringVar(&base.StringVar{
 				Name:   clientSecretFlagName,
 				Target: &c.flagClientSecret,
-				Usage:  "The corresponding client secret.",
+				Usage:  "The corresponding client secret. May be omitted for public clients, which rely on PKCE.",
 			})
 		case maxAgeFlagName:
 			f.StringVar(&base.StringVar{
@@ -138,6 +141,12 @@ func extraOidcFlagsFuncImpl(c *OidcCommand, set *base.FlagSets, _ *base.FlagSet)
 				Target: &c.flagAccountClaimMaps,
 				Usage:  `The optional account claim maps from custom claims to the standard claims of sub, name and email.  These maps are represented as key=value where the key equals the Provider from-claim and the value equals the Boundary to-claim.  For example "oid=sub". May be specified multiple times for different to-claims.`,
 			})
+		case storeRefreshTokensFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   storeRefreshTokensFlagName,
+				Target: &c.flagStoreRefreshTokens,
+				Usage:  `Whether to store the refresh tokens issued by the provider and use them to periodically re-check that users are still allowed to authenticate. Requires the provider to issue refresh tokens, e.g. by adding "offline_access" to the claims scopes.`,
+			})
 		case stateFlagName:
 			f.StringVar(&base.StringVar{
 				Name:   stateFlagName,
@@ -274,6 +283,18 @@ func extraOidcFlagHandlingFuncImpl(c *OidcCommand, f *base.FlagSets, opts *[]aut
 	default:
 		*opts = append(*opts, authmethods.WithOidcAuthMethodAccountClaimMaps(c.flagAccountClaimMaps))
 	}
+	switch c.flagStoreRefreshTokens {
+	case "":
+	case "null":
+		*opts = append(*opts, authmethods.DefaultOidcAuthMethodStoreRefreshTokens())
+	default:
+		store, err := strconv.ParseBool(c.flagStoreRefreshTokens)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagStoreRefreshTokens, err))
+			return false
+		}
+		*opts = append(*opts, authmethods.WithOidcAuthMethodStoreRefreshTokens(store))
+	}
 	if c.flagDisableDiscoveredConfigValidation {
 		*opts = append(*opts, authmethods.WithOidcAuthMethodDisableDiscoveredConfigValidation(c.flagDisableDiscoveredConfigValidation))
 	}
@@ -15,14 +15,30 @@ func init() {
 }
 
 type extraPasswordCmdVars struct {
-	flagMinLoginNameLength string
-	flagMinPasswordLength  string
+	flagMinLoginNameLength     string
+	flagMinPasswordLength      string
+	flagMinCharacterClasses    string
+	flagPasswordHistoryCount   string
+	flagMaxPasswordAgeSeconds  string
+	flagLockoutThreshold       string
+	flagLockoutDurationSeconds string
+	flagRequireMfa             string
 }
 
 func extraPasswordActionsFlagsMapFuncImpl() map[string][]string {
+	flags := []string{
+		"min-login-name-length",
+		"min-password-length",
+		"min-character-classes",
+		"password-history-count",
+		"max-password-age-seconds",
+		"lockout-threshold",
+		"lockout-duration-seconds",
+		"require-mfa",
+	}
 	return map[string][]string{
-		"create": {"min-login-name-length", "min-password-length"},
-		"update": {"min-login-name-length", "min-password-length"},
+		"create": flags,
+		"update": flags,
 	}
 }
 
@@ -71,6 +87,42 @@ func extraPasswordFlagsFuncImpl(c *PasswordCommand, set *base.FlagSets, f *base.
 				Target: &c.flagMinPasswordLength,
 				Usage:  "The minimum length of passwords",
 			})
+		case "min-character-classes":
+			f.StringVar(&base.StringVar{
+				Name:   "min-character-classes",
+				Target: &c.flagMinCharacterClasses,
+				Usage:  "The minimum number of character classes (lowercase, uppercase, digits and symbols) passwords must contain, between 0 and 4",
+			})
+		case "password-history-count":
+			f.StringVar(&base.StringVar{
+				Name:   "password-history-count",
+				Target: &c.flagPasswordHistoryCount,
+				Usage:  "The number of previous passwords, including the current one, which cannot be reused when changing a password",
+			})
+		case "max-password-age-seconds":
+			f.StringVar(&base.StringVar{
+				Name:   "max-password-age-seconds",
+				Target: &c.flagMaxPasswordAgeSeconds,
+				Usage:  "The number of seconds after which a password expires and must be changed at the next authentication; 0 means passwords do not expire",
+			})
+		case "lockout-threshold":
+			f.StringVar(&base.StringVar{
+				Name:   "lockout-threshold",
+				Target: &c.flagLockoutThreshold,
+				Usage:  "The number of consecutive failed authentication attempts after which an account is locked; 0 disables lockout",
+			})
+		case "lockout-duration-seconds":
+			f.StringVar(&base.StringVar{
+				Name:   "lockout-duration-seconds",
+				Target: &c.flagLockoutDurationSeconds,
+				Usage:  "The number of seconds a locked account stays locked; 0 means until it is unlocked by an administrator",
+			})
+		case "require-mfa":
+			f.StringVar(&base.StringVar{
+				Name:   "require-mfa",
+				Target: &c.flagRequireMfa,
+				Usage:  "Whether accounts must authenticate with a TOTP code in addition to their password; accounts not yet enrolled are enrolled at their next authentication",
+			})
 		}
 	}
 }
@@ -109,6 +161,43 @@ func extraPasswordFlagHandlingFuncImpl(c *PasswordCommand, _ *base.FlagSets, opt
 		addAttribute("min_password_length", uint32(length))
 	}
 
+	for _, attr := range []struct {
+		name  string
+		value string
+	}{
+		{"min_character_classes", c.flagMinCharacterClasses},
+		{"password_history_count", c.flagPasswordHistoryCount},
+		{"max_password_age_seconds", c.flagMaxPasswordAgeSeconds},
+		{"lockout_threshold", c.flagLockoutThreshold},
+		{"lockout_duration_seconds", c.flagLockoutDurationSeconds},
+	} {
+		switch attr.value {
+		case "":
+		case "null":
+			addAttribute(attr.name, nil)
+		default:
+			value, err := strconv.ParseUint(attr.value, 10, 32)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", attr.value, err))
+				return false
+			}
+			addAttribute(attr.name, uint32(value))
+		}
+	}
+
+	switch c.flagRequireMfa {
+	case "":
+	case "null":
+		addAttribute("require_mfa", nil)
+	default:
+		required, err := strconv.ParseBool(c.flagRequireMfa)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagRequireMfa, err))
+			return false
+		}
+		addAttribute("require_mfa", required)
+	}
+
 	if attributes != nil {
 		*opts = append(*opts, authmethods.WithAttributes(attributes))
 	}
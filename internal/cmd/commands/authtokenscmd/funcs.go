@@ -14,10 +14,121 @@ import (
 const selfFlag = "self"
 
 func init() {
+	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
+	extraSynopsisFunc = extraSynopsisFuncImpl
+	extraFlagsFunc = extraFlagsFuncImpl
 	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
+	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
+}
+
+type extraCmdVars struct {
+	flagUserId string
+	flagToken  string
+	rr         *authtokens.RevokeAllForUserResult
+	ir         *authtokens.IntrospectResult
+}
+
+func extraActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"revoke-all-for-user": {"user-id"},
+		"introspect":          {"token"},
+	}
+}
+
+func extraSynopsisFuncImpl(c *Command) string {
+	switch c.Func {
+	case "revoke-all-for-user":
+		return "Revoke all auth tokens of a user"
+
+	case "introspect":
+		return "Show whether an auth token is valid and who it was issued to"
+
+	default:
+		return ""
+	}
+}
+
+func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "":
+		return base.WrapForHelpText([]string{
+			"Usage: boundary auth-tokens [sub command] [options] [args]",
+			"",
+			"  This command allows operations on Boundary auth token resources. Example:",
+			"",
+			"    Read an auth token:",
+			"",
+			`      $ boundary auth-tokens read -id at_1234567890`,
+			"",
+			"  Please see the auth-tokens subcommand help for detailed usage information.",
+		})
+	case "revoke-all-for-user":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary auth-tokens revoke-all-for-user [options] [args]",
+			"",
+			"  This command revokes all of the auth tokens issued to a user, logging the user out of every session. Example:",
+			"",
+			"    Revoke the auth tokens of a user:",
+			"",
+			`      $ boundary auth-tokens revoke-all-for-user -user-id u_1234567890`,
+			"",
+			"",
+		})
+	case "introspect":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary auth-tokens introspect [options] [args]",
+			"",
+			"  This command shows whether an auth token is valid along with its expiration and the principals of the user it was issued to. Example:",
+			"",
+			"    Introspect an auth token:",
+			"",
+			`      $ boundary auth-tokens introspect -token at_1234567890_...`,
+			"",
+			"",
+		})
+	default:
+		helpStr = helpMap["base"]()
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
+	for _, name := range flagsMap[c.Func] {
+		switch name {
+		case "user-id":
+			f.StringVar(&base.StringVar{
+				Name:   "user-id",
+				Target: &c.flagUserId,
+				Usage:  "The ID of the user whose auth tokens should be revoked.",
+			})
+		case "token":
+			f.StringVar(&base.StringVar{
+				Name:   "token",
+				Target: &c.flagToken,
+				Usage:  "The auth token to introspect.",
+			})
+		}
+	}
 }
 
 func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, _ *[]authtokens.Option) bool {
+	switch c.Func {
+	case "revoke-all-for-user":
+		if c.flagUserId == "" {
+			c.PrintCliError(errors.New("User ID must be passed in via -user-id"))
+			return false
+		}
+		return true
+	case "introspect":
+		if c.flagToken == "" {
+			c.PrintCliError(errors.New("Token must be passed in via -token"))
+			return false
+		}
+		return true
+	}
+
 	if c.Func != "delete" && c.Func != "read" {
 		if strutil.StrListContains(flagsMap[c.Func], "id") && c.FlagId == "" {
 			c.PrintCliError(errors.New("ID is required but not passed in via -id"))
@@ -56,6 +167,84 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, _ *[]authtokens.Op
 	return true
 }
 
+func executeExtraActionsImpl(c *Command, origResult api.GenericResult, origError error, authtokensClient *authtokens.Client, _ uint32, opts []authtokens.Option) (api.GenericResult, error) {
+	switch c.Func {
+	case "revoke-all-for-user":
+		var err error
+		c.rr, err = authtokensClient.RevokeAllForUser(c.Context, c.flagUserId, opts...)
+		return nil, err
+	case "introspect":
+		var err error
+		c.ir, err = authtokensClient.Introspect(c.Context, c.flagToken, opts...)
+		return nil, err
+	}
+	return origResult, origError
+}
+
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "revoke-all-for-user":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(fmt.Sprintf("Revoked %d auth tokens.", c.rr.Item.RevokedCount))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.rr); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+
+	case "introspect":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(printIntrospection(c.ir.Item))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.ir); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func printIntrospection(in *authtokens.Introspection) string {
+	if !in.Active || in.Item == nil {
+		return "The auth token is not active."
+	}
+	nonAttributeMap := map[string]interface{}{
+		"Active":          in.Active,
+		"ID":              in.Item.Id,
+		"Scope ID":        in.Item.ScopeId,
+		"Auth Method ID":  in.Item.AuthMethodId,
+		"User ID":         in.Item.UserId,
+		"Expiration Time": in.Item.ExpirationTime.Local().Format(time.RFC1123),
+	}
+
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+
+	ret := []string{
+		"",
+		"Auth Token introspection:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+
+	if len(in.Principals) > 0 {
+		ret = append(ret,
+			"",
+			"  Principals:",
+			base.WrapSlice(4, in.Principals),
+		)
+	}
+
+	return base.WrapForHelpText(ret)
+}
+
 func (c *Command) printListTable(items []*authtokens.AuthToken) string {
 	if len(items) == 0 {
 		return "No auth tokens found"
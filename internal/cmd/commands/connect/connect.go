@@ -75,6 +75,8 @@ type Command struct {
 	flagUsername   string
 	flagDbname     string
 
+	flagNoCredentials bool
+
 	// HTTP
 	httpFlags
 
@@ -95,6 +97,9 @@ type Command struct {
 	sessionAuthz     *targets.SessionAuthorization
 	sessionAuthzData *targetspb.SessionAuthorizationData
 
+	// usedCredentials holds the brokered credentials passed to the client
+	usedCredentials map[*targets.SessionCredential]bool
+
 	connWg             *sync.WaitGroup
 	listenerCloseOnce  sync.Once
 	listener           *net.TCPListener
@@ -491,12 +496,11 @@ func (c *Command) Run(args []string) (retCode int) {
 	if c.sessionAuthz != nil && len(c.sessionAuthz.Credentials) > 0 {
 		creds = c.sessionAuthz.Credentials
 	}
-	switch c.Func {
-	case "postgres":
-		// Credentials are brokered when connecting to the postgres db.
-		// TODO: Figure out how to handle cases where we don't automatically know how to
-		// broker the credentials like unrecognized or multiple credentials.
-	case "connect":
+	switch {
+	case c.consumesCredentials():
+		// Brokered credentials are passed to the client when building its
+		// arguments, unless -no-credentials was given.
+	case c.Func == "connect":
 		// "connect" indicates there is no subcommand to the connect function.
 		// The only way a user will be able to connect to the session is by
 		// connecting directly to the port and address we report to them here.
@@ -521,23 +525,9 @@ func (c *Command) Run(args []string) (retCode int) {
 			c.UI.Output(string(out))
 		}
 	default:
-		if len(creds) == 0 {
-			break
-		}
-		switch base.Format(c.UI) {
-		case "table":
-			c.UI.Output(generateCredentialTableOutput(creds))
-		case "json":
-			out, err := json.Marshal(&struct {
-				Credentials []*targets.SessionCredential `json:"credentials"`
-			}{
-				Credentials: c.sessionAuthz.Credentials,
-			})
-			if err != nil {
-				c.PrintCliError(fmt.Errorf("error marshaling session information: %w", err))
-				return base.CommandCliError
-			}
-			c.UI.Output(string(out))
+		if err := c.printCredentials(creds); err != nil {
+			c.PrintCliError(err)
+			return base.CommandCliError
 		}
 	}
 
@@ -836,10 +826,21 @@ func (c *Command) handleExec(passthroughArgs []string) {
 		envs = append(envs, pgEnvs...)
 
 	case "rdp":
-		args = append(args, c.rdpFlags.buildArgs(c, port, ip, addr)...)
+		rdpArgs, rdpErr := c.rdpFlags.buildArgs(c, port, ip, addr)
+		if rdpErr != nil {
+			argsErr = rdpErr
+			break
+		}
+		args = append(args, rdpArgs...)
 
 	case "ssh":
-		args = append(args, c.sshFlags.buildArgs(c, port, ip, addr)...)
+		sshArgs, sshEnvs, sshErr := c.sshFlags.buildArgs(c, port, ip, addr)
+		if sshErr != nil {
+			argsErr = sshErr
+			break
+		}
+		args = append(args, sshArgs...)
+		envs = append(envs, sshEnvs...)
 
 	case "kube":
 		kubeArgs, err := c.kubeFlags.buildArgs(c, port, ip, addr)
@@ -857,6 +858,14 @@ func (c *Command) handleExec(passthroughArgs []string) {
 		return
 	}
 
+	if c.consumesCredentials() {
+		if err := c.printCredentials(c.unusedCredentials()); err != nil {
+			c.PrintCliError(err)
+			c.execCmdReturnValue.Store(int32(2))
+			return
+		}
+	}
+
 	args = append(passthroughArgs, args...)
 
 	stringReplacer := func(in, typ, replacer string) string {
@@ -0,0 +1,116 @@
+package connect
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/mapstructure"
+)
+
+// brokeredCredential contains the fields of a credential brokered for the
+// session which the connect helpers know how to pass to their clients.
+type brokeredCredential struct {
+	Username   string `mapstructure:"username"`
+	Password   string `mapstructure:"password"`
+	PrivateKey string `mapstructure:"private_key"`
+}
+
+// credentialOptions adds the options controlling whether brokered credentials
+// are passed to the client.
+func credentialOptions(c *Command, f *base.FlagSet) {
+	f.BoolVar(&base.BoolVar{
+		Name:   "no-credentials",
+		Target: &c.flagNoCredentials,
+		EnvVar: "BOUNDARY_CONNECT_NO_CREDENTIALS",
+		Usage:  `If set, credentials brokered for the session are not passed to the client. They are printed instead.`,
+	})
+}
+
+// consumesCredentials returns whether the helper passes brokered credentials
+// to its client rather than printing them. Credentials the helper does not
+// pass to its client are printed once the client's arguments are built.
+func (c *Command) consumesCredentials() bool {
+	switch c.Func {
+	case "http", "postgres", "rdp", "ssh":
+		return !c.flagNoCredentials
+	}
+	return false
+}
+
+// findCredential returns the first credential brokered for the session for
+// which match returns true and records it as passed to the client. It returns
+// false if no credential matches or the credentials are not to be passed to
+// the client.
+func (c *Command) findCredential(match func(brokeredCredential) bool) (brokeredCredential, bool, error) {
+	bc, cred, err := c.lookupCredential(match)
+	if err != nil || cred == nil {
+		return brokeredCredential{}, false, err
+	}
+	if c.usedCredentials == nil {
+		c.usedCredentials = make(map[*targets.SessionCredential]bool)
+	}
+	c.usedCredentials[cred] = true
+	return bc, true, nil
+}
+
+// lookupCredential is like findCredential but does not record the credential
+// as passed to the client, so it is still printed. It returns a nil
+// credential if none matches.
+func (c *Command) lookupCredential(match func(brokeredCredential) bool) (brokeredCredential, *targets.SessionCredential, error) {
+	if !c.consumesCredentials() || c.sessionAuthz == nil {
+		return brokeredCredential{}, nil, nil
+	}
+	for _, cred := range c.sessionAuthz.Credentials {
+		if cred.Secret == nil || cred.Secret.Decoded == nil {
+			continue
+		}
+		var bc brokeredCredential
+		if err := mapstructure.Decode(cred.Secret.Decoded, &bc); err != nil {
+			return brokeredCredential{}, nil, fmt.Errorf("Error interpreting brokered credential: %w", err)
+		}
+		if match(bc) {
+			return bc, cred, nil
+		}
+	}
+	return brokeredCredential{}, nil, nil
+}
+
+// unusedCredentials returns the credentials brokered for the session which
+// were not passed to the client.
+func (c *Command) unusedCredentials() []*targets.SessionCredential {
+	if c.sessionAuthz == nil {
+		return nil
+	}
+	var ret []*targets.SessionCredential
+	for _, cred := range c.sessionAuthz.Credentials {
+		if !c.usedCredentials[cred] {
+			ret = append(ret, cred)
+		}
+	}
+	return ret
+}
+
+// printCredentials prints the given credentials in the format requested by
+// the user.
+func (c *Command) printCredentials(creds []*targets.SessionCredential) error {
+	if len(creds) == 0 {
+		return nil
+	}
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(generateCredentialTableOutput(creds))
+	case "json":
+		out, err := json.Marshal(&struct {
+			Credentials []*targets.SessionCredential `json:"credentials"`
+		}{
+			Credentials: creds,
+		})
+		if err != nil {
+			return fmt.Errorf("error marshaling session information: %w", err)
+		}
+		c.UI.Output(string(out))
+	}
+	return nil
+}
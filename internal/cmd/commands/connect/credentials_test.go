@@ -0,0 +1,300 @@
+package connect
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	targetspb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+func testCommand(t *testing.T, fn string, creds ...map[string]interface{}) (*Command, *cli.MockUi) {
+	t.Helper()
+	ui := cli.NewMockUi()
+	c := &Command{
+		Command: base.NewCommand(&base.BoundaryUI{Ui: ui, Format: "json"}),
+		Func:    fn,
+		sessionAuthz: &targets.SessionAuthorization{
+			SessionId: "s_1234567890",
+		},
+		sessionAuthzData: &targetspb.SessionAuthorizationData{
+			SessionId: "s_1234567890",
+			HostId:    "h_1234567890",
+			Type:      "tcp",
+		},
+	}
+	for _, cred := range creds {
+		c.sessionAuthz.Credentials = append(c.sessionAuthz.Credentials, &targets.SessionCredential{
+			Secret: &targets.SessionSecret{Decoded: cred},
+		})
+	}
+	t.Cleanup(func() {
+		for _, f := range c.cleanupFuncs {
+			assert.NoError(t, f())
+		}
+	})
+	return c, ui
+}
+
+func TestFindCredential(t *testing.T) {
+	usernamePassword := map[string]interface{}{"username": "user", "password": "pass"}
+	usernameOnly := map[string]interface{}{"username": "other"}
+	hasPassword := func(bc brokeredCredential) bool { return bc.Password != "" }
+
+	tests := []struct {
+		name          string
+		fn            string
+		noCredentials bool
+		creds         []map[string]interface{}
+		want          brokeredCredential
+		wantFound     bool
+		wantErr       bool
+	}{
+		{
+			name:      "match",
+			fn:        "postgres",
+			creds:     []map[string]interface{}{usernameOnly, usernamePassword},
+			want:      brokeredCredential{Username: "user", Password: "pass"},
+			wantFound: true,
+		},
+		{
+			name:  "no-match",
+			fn:    "postgres",
+			creds: []map[string]interface{}{usernameOnly},
+		},
+		{
+			name: "no-credentials-brokered",
+			fn:   "postgres",
+		},
+		{
+			name:          "no-credentials-flag",
+			fn:            "postgres",
+			noCredentials: true,
+			creds:         []map[string]interface{}{usernamePassword},
+		},
+		{
+			name:  "helper-does-not-consume",
+			fn:    "kube",
+			creds: []map[string]interface{}{usernamePassword},
+		},
+		{
+			name:    "undecodable",
+			fn:      "postgres",
+			creds:   []map[string]interface{}{{"username": []string{"not", "a", "string"}}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			c, _ := testCommand(t, tt.fn, tt.creds...)
+			c.flagNoCredentials = tt.noCredentials
+
+			got, found, err := c.findCredential(hasPassword)
+			if tt.wantErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantFound, found)
+			assert.Equal(tt.want, got)
+			if tt.wantFound {
+				assert.Len(c.unusedCredentials(), len(tt.creds)-1)
+			} else {
+				assert.Len(c.unusedCredentials(), len(tt.creds))
+			}
+		})
+	}
+}
+
+func TestPostgresBuildArgs(t *testing.T) {
+	tests := []struct {
+		name          string
+		noCredentials bool
+		dbname        string
+		wantArgs      []string
+		wantEnvs      []string
+		wantWarning   bool
+	}{
+		{
+			name:     "brokered",
+			dbname:   "db",
+			wantArgs: []string{"-p", "5432", "-h", "127.0.0.1", "-d", "db"},
+			wantEnvs: []string{"PGUSER=user", "PGPASSWORD=pass"},
+		},
+		{
+			name:        "brokered-without-dbname",
+			wantArgs:    []string{"-p", "5432", "-h", "127.0.0.1"},
+			wantEnvs:    []string{"PGUSER=user", "PGPASSWORD=pass"},
+			wantWarning: true,
+		},
+		{
+			name:          "no-credentials",
+			noCredentials: true,
+			dbname:        "db",
+			wantArgs:      []string{"-p", "5432", "-h", "127.0.0.1", "-d", "db", "-U", "flag-user"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			c, ui := testCommand(t, "postgres", map[string]interface{}{"username": "user", "password": "pass"})
+			c.flagNoCredentials = tt.noCredentials
+			c.flagDbname = tt.dbname
+			c.flagUsername = "flag-user"
+			c.flagPostgresStyle = "psql"
+
+			args, envs, err := c.postgresFlags.buildArgs(c, "5432", "127.0.0.1", "127.0.0.1:5432")
+			require.NoError(err)
+			assert.Equal(tt.wantArgs, args)
+			assert.Equal(tt.wantEnvs, envs)
+			assert.Equal(tt.wantWarning, strings.Contains(ui.ErrorWriter.String(), "-dbname"))
+		})
+	}
+}
+
+func TestSshBuildArgs(t *testing.T) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	require.NoError(t, err)
+	privPem := string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+
+	t.Run("brokered", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		c, _ := testCommand(t, "ssh", map[string]interface{}{"username": "user", "private_key": privPem})
+		c.flagUsername = "flag-user"
+		c.flagSshStyle = "ssh"
+
+		args, envs, err := c.sshFlags.buildArgs(c, "22", "127.0.0.1", "127.0.0.1:22")
+		require.NoError(err)
+		assert.Equal([]string{"-p", "22", "127.0.0.1", "-o", "HostKeyAlias=h_1234567890", "-l", "user"}, args)
+		require.Len(envs, 1)
+		require.True(strings.HasPrefix(envs[0], "SSH_AUTH_SOCK="))
+
+		// The agent holds the brokered key
+		conn, err := net.Dial("unix", strings.TrimPrefix(envs[0], "SSH_AUTH_SOCK="))
+		require.NoError(err)
+		defer conn.Close()
+		keys, err := agent.NewClient(conn).List()
+		require.NoError(err)
+		require.Len(keys, 1)
+		assert.Equal("ssh-ed25519", keys[0].Type())
+	})
+
+	t.Run("no-credentials", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		c, _ := testCommand(t, "ssh", map[string]interface{}{"username": "user", "private_key": privPem})
+		c.flagNoCredentials = true
+		c.flagUsername = "flag-user"
+		c.flagSshStyle = "ssh"
+
+		args, envs, err := c.sshFlags.buildArgs(c, "22", "127.0.0.1", "127.0.0.1:22")
+		require.NoError(err)
+		assert.Equal([]string{"-p", "22", "127.0.0.1", "-o", "HostKeyAlias=h_1234567890", "-l", "flag-user"}, args)
+		assert.Empty(envs)
+	})
+}
+
+func TestHttpBuildArgs(t *testing.T) {
+	t.Run("brokered", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		c, _ := testCommand(t, "http", map[string]interface{}{"username": "user", "password": "pass"})
+		c.flagHttpStyle = "curl"
+		c.flagHttpScheme = "https"
+
+		args, err := c.httpFlags.buildArgs(c, "443", "127.0.0.1", "127.0.0.1:443")
+		require.NoError(err)
+		require.Len(args, 3)
+		assert.Equal("-H", args[0])
+		assert.Equal("https://127.0.0.1:443", args[2])
+		require.True(strings.HasPrefix(args[1], "@"))
+		header, err := ioutil.ReadFile(strings.TrimPrefix(args[1], "@"))
+		require.NoError(err)
+		assert.Equal("Authorization: Basic "+base64.StdEncoding.EncodeToString([]byte("user:pass"))+"\n", string(header))
+		// The password must not show up in the process list
+		assert.NotContains(strings.Join(args, " "), "pass")
+	})
+
+	t.Run("no-credentials", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		c, _ := testCommand(t, "http", map[string]interface{}{"username": "user", "password": "pass"})
+		c.flagNoCredentials = true
+		c.flagHttpStyle = "curl"
+		c.flagHttpScheme = "https"
+
+		args, err := c.httpFlags.buildArgs(c, "443", "127.0.0.1", "127.0.0.1:443")
+		require.NoError(err)
+		assert.Equal([]string{"https://127.0.0.1:443"}, args)
+	})
+}
+
+func TestRdpBuildArgs(t *testing.T) {
+	tests := []struct {
+		name        string
+		style       string
+		wantArgs    []string
+		wantWarning string
+	}{
+		{
+			name:        "xfreerdp",
+			style:       "xfreerdp",
+			wantArgs:    []string{"/v:127.0.0.1:3389", "/u:user"},
+			wantWarning: "only the username can be passed to xfreerdp",
+		},
+		{
+			name:        "mstsc",
+			style:       "mstsc.exe",
+			wantArgs:    []string{"/v", "127.0.0.1:3389"},
+			wantWarning: "cannot be passed to mstsc.exe",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			c, ui := testCommand(t, "rdp", map[string]interface{}{"username": "user", "password": "pass"})
+			c.flagRdpStyle = tt.style
+
+			args, err := c.rdpFlags.buildArgs(c, "3389", "127.0.0.1", "127.0.0.1:3389")
+			require.NoError(err)
+			assert.Equal(tt.wantArgs, args)
+			assert.Contains(ui.ErrorWriter.String(), tt.wantWarning)
+			// The password can't be passed, so the credential is still printed
+			assert.Len(c.unusedCredentials(), 1)
+		})
+	}
+}
+
+func TestPrintUnusedCredentials(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	c, ui := testCommand(t, "postgres",
+		map[string]interface{}{"username": "user", "password": "pass"},
+		map[string]interface{}{"token": "secret-token"},
+	)
+	c.flagDbname = "db"
+	c.flagPostgresStyle = "psql"
+
+	_, _, err := c.postgresFlags.buildArgs(c, "5432", "127.0.0.1", "127.0.0.1:5432")
+	require.NoError(err)
+	require.NoError(c.printCredentials(c.unusedCredentials()))
+
+	var out struct {
+		Credentials []*targets.SessionCredential `json:"credentials"`
+	}
+	require.NoError(json.Unmarshal(ui.OutputWriter.Bytes(), &out))
+	require.Len(out.Credentials, 1)
+	assert.Equal("secret-token", out.Credentials[0].Secret.Decoded["token"])
+}
@@ -1,8 +1,12 @@
 package connect
 
 import (
+	"encoding/base64"
 	"fmt"
+	"io/ioutil"
 	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/hashicorp/boundary/internal/cmd/base"
@@ -57,6 +61,8 @@ func httpOptions(c *Command, set *base.FlagSets) {
 		Completion: complete.PredictNothing,
 		Usage:      `Specifies the scheme to use.`,
 	})
+
+	credentialOptions(c, f)
 }
 
 type httpFlags struct {
@@ -87,6 +93,21 @@ func (h *httpFlags) buildArgs(c *Command, port, ip, addr string) ([]string, erro
 		if h.flagHttpMethod != "" {
 			args = append(args, "-X", h.flagHttpMethod)
 		}
+		creds, ok, err := c.findCredential(func(bc brokeredCredential) bool {
+			return bc.Username != "" && bc.Password != ""
+		})
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			// Pass the header through a file so the password does not show up
+			// in the process list.
+			headerFile, err := h.writeAuthHeader(c, creds)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, "-H", fmt.Sprintf("@%s", headerFile))
+		}
 		var uri string
 		if host != "" {
 			host = strings.TrimSuffix(host, "/")
@@ -103,3 +124,25 @@ func (h *httpFlags) buildArgs(c *Command, port, ip, addr string) ([]string, erro
 	}
 	return args, nil
 }
+
+// writeAuthHeader writes a basic auth header for the given credential to a
+// file in a temporary directory which is removed when the command exits, and
+// returns the file's path.
+func (h *httpFlags) writeAuthHeader(c *Command, creds brokeredCredential) (string, error) {
+	dir, err := ioutil.TempDir("", "*")
+	if err != nil {
+		return "", fmt.Errorf("Error creating tmp dir for basic auth header: %w", err)
+	}
+	c.cleanupFuncs = append(c.cleanupFuncs, func() error {
+		if err := os.RemoveAll(dir); err != nil {
+			return fmt.Errorf("Error removing temporary basic auth header directory; consider removing %s manually: %w", dir, err)
+		}
+		return nil
+	})
+	auth := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", creds.Username, creds.Password)))
+	headerFile := filepath.Join(dir, "header")
+	if err := ioutil.WriteFile(headerFile, []byte(fmt.Sprintf("Authorization: Basic %s\n", auth)), 0o600); err != nil {
+		return "", fmt.Errorf("Error writing basic auth header file: %w", err)
+	}
+	return headerFile, nil
+}
@@ -2,12 +2,9 @@ package connect
 
 import (
 	"fmt"
-	"io/ioutil"
-	"os"
 	"strings"
 
 	"github.com/hashicorp/boundary/internal/cmd/base"
-	"github.com/mitchellh/mapstructure"
 	"github.com/posener/complete"
 )
 
@@ -42,6 +39,8 @@ func postgresOptions(c *Command, set *base.FlagSets) {
 		Completion: complete.PredictNothing,
 		Usage:      `Specifies the database name to pass through to the client.`,
 	})
+
+	credentialOptions(c, f)
 }
 
 type postgresFlags struct {
@@ -52,33 +51,14 @@ func (p *postgresFlags) defaultExec() string {
 	return strings.ToLower(p.flagPostgresStyle)
 }
 
-type postgresCredentials struct {
-	Username string `mapstructure:"username"`
-	Password string `mapstructure:"password"`
-}
-
 func (p *postgresFlags) buildArgs(c *Command, port, ip, addr string) (args, envs []string, retErr error) {
-	var creds postgresCredentials
-	if c.sessionAuthz != nil && len(c.sessionAuthz.Credentials) > 0 {
-		for _, cred := range c.sessionAuthz.Credentials {
-			if cred.Secret == nil || cred.Secret.Decoded == nil {
-				continue
-			}
-			// TODO: Could allow switching on library ID or name
-			switch cred.CredentialLibrary.Type {
-			case "vault":
-				// Attempt unmarshaling into creds
-				if err := mapstructure.Decode(cred.Secret.Decoded, &creds); err != nil {
-					return nil, nil, fmt.Errorf("Error interpreting Vault secret: %w", err)
-				}
-			}
-
-			if creds.Username != "" && creds.Password != "" {
-				// In the future we can look for other types if we support other
-				// authentication mechanisms
-				break
-			}
-		}
+	// In the future we can look for other types if we support other
+	// authentication mechanisms
+	creds, _, err := c.findCredential(func(bc brokeredCredential) bool {
+		return bc.Username != "" && bc.Password != ""
+	})
+	if err != nil {
+		return nil, nil, err
 	}
 
 	switch p.flagPostgresStyle {
@@ -91,30 +71,13 @@ func (p *postgresFlags) buildArgs(c *Command, port, ip, addr string) (args, envs
 
 		switch {
 		case creds.Username != "":
-			args = append(args, "-U", creds.Username)
+			envs = append(envs, fmt.Sprintf("PGUSER=%s", creds.Username))
 		case c.flagUsername != "":
 			args = append(args, "-U", c.flagUsername)
 		}
 
 		if creds.Password != "" {
-			passfile, err := ioutil.TempFile("", "*")
-			if err != nil {
-				return nil, nil, fmt.Errorf("Error saving postgres password to tmp file: %w", err)
-			}
-			c.cleanupFuncs = append(c.cleanupFuncs, func() error {
-				if err := os.Remove(passfile.Name()); err != nil {
-					return fmt.Errorf("Error removing temporary password file; consider removing %s manually: %w", passfile.Name(), err)
-				}
-				return nil
-			})
-			_, err = passfile.WriteString(fmt.Sprintf("*:*:*:*:%s", creds.Password))
-			if err != nil {
-				return nil, nil, fmt.Errorf("Error writing password file to %s: %w", passfile.Name(), err)
-			}
-			if err := passfile.Close(); err != nil {
-				return nil, nil, fmt.Errorf("Error closing password file after writing to %s: %w", passfile.Name(), err)
-			}
-			envs = append(envs, fmt.Sprintf("PGPASSFILE=%s", passfile.Name()))
+			envs = append(envs, fmt.Sprintf("PGPASSWORD=%s", creds.Password))
 
 			if c.flagDbname == "" {
 				c.UI.Warn("Credentials are being brokered but no -dbname parameter provided. psql may misinterpret another parameter as the database name.")
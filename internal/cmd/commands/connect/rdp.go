@@ -20,9 +20,11 @@ func rdpOptions(c *Command, set *base.FlagSets) {
 		Name:       "style",
 		Target:     &c.flagRdpStyle,
 		EnvVar:     "BOUNDARY_CONNECT_RDP_STYLE",
-		Completion: complete.PredictSet("mstsc", "open"),
-		Usage:      `Specifies how the CLI will attempt to invoke an RDP client. This will also set a suitable default for -exec if a value was not specified. Currently-understood values are "mstsc", which is the default on Windows and launches the Windows client, and "open", which is the default on Mac and launches via an rdp:// URL.`,
+		Completion: complete.PredictSet("mstsc", "open", "xfreerdp"),
+		Usage:      `Specifies how the CLI will attempt to invoke an RDP client. This will also set a suitable default for -exec if a value was not specified. Currently-understood values are "mstsc", which is the default on Windows and launches the Windows client, "open", which is the default on Mac and launches via an rdp:// URL, and "xfreerdp".`,
 	})
+
+	credentialOptions(c, f)
 }
 
 type rdpFlags struct {
@@ -39,7 +41,7 @@ func (r *rdpFlags) defaultExec() string {
 		case "darwin":
 			r.flagRdpStyle = "open"
 		default:
-			// We may want to support rdesktop and/or xfreerdp at some point soon
+			// We may want to support rdesktop at some point soon
 			r.flagRdpStyle = "mstsc"
 		}
 	}
@@ -49,13 +51,35 @@ func (r *rdpFlags) defaultExec() string {
 	return r.flagRdpStyle
 }
 
-func (r *rdpFlags) buildArgs(c *Command, port, ip, addr string) []string {
+func (r *rdpFlags) buildArgs(c *Command, port, ip, addr string) ([]string, error) {
+	// Passwords can't be passed to RDP clients without exposing them on the
+	// command line, so the credential is only looked up for its username and
+	// is still printed for the user to enter into the client.
+	creds, cred, err := c.lookupCredential(func(bc brokeredCredential) bool {
+		return bc.Username != ""
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var args []string
 	switch r.flagRdpStyle {
 	case "mstsc.exe":
 		args = append(args, "/v", addr)
 	case "open":
 		args = append(args, "-n", "-W", fmt.Sprintf("rdp://full%saddress=s:%s", "%20", addr))
+	case "xfreerdp":
+		args = append(args, fmt.Sprintf("/v:%s", addr))
+		if creds.Username != "" {
+			args = append(args, fmt.Sprintf("/u:%s", creds.Username))
+		}
+	}
+	switch {
+	case cred == nil:
+	case r.flagRdpStyle == "xfreerdp":
+		c.UI.Warn("Credentials are being brokered but only the username can be passed to xfreerdp. They are printed instead; enter the password into the client.")
+	default:
+		c.UI.Warn(fmt.Sprintf("Credentials are being brokered but cannot be passed to %s. They are printed instead; enter them into the client.", r.flagRdpStyle))
 	}
-	return args
+	return args, nil
 }
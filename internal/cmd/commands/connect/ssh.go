@@ -1,11 +1,20 @@
 package connect
 
 import (
+	"crypto/ed25519"
 	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
 	"strings"
 
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/posener/complete"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
 )
 
 const (
@@ -29,8 +38,10 @@ func sshOptions(c *Command, set *base.FlagSets) {
 		Target:     &c.flagUsername,
 		EnvVar:     "BOUNDARY_CONNECT_USERNAME",
 		Completion: complete.PredictNothing,
-		Usage:      `Specifies the username to pass through to the client`,
+		Usage:      `Specifies the username to pass through to the client. May be overridden by credentials sourced from a credential store.`,
 	})
+
+	credentialOptions(c, f)
 }
 
 type sshFlags struct {
@@ -41,18 +52,135 @@ func (s *sshFlags) defaultExec() string {
 	return strings.ToLower(s.flagSshStyle)
 }
 
-func (s *sshFlags) buildArgs(c *Command, port, ip, addr string) []string {
+func (s *sshFlags) buildArgs(c *Command, port, ip, addr string) (args, envs []string, retErr error) {
+	creds, _, err := c.findCredential(func(bc brokeredCredential) bool {
+		return bc.Username != "" || bc.PrivateKey != ""
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// Might want -t for ssh or -tt but seems fine without it for now...
-	var args []string
 	switch s.flagSshStyle {
 	case "ssh":
 		args = append(args, "-p", port, ip)
 		args = append(args, "-o", fmt.Sprintf("HostKeyAlias=%s", c.sessionAuthzData.HostId))
+		if c.sessionAuthzData.GetType() == "ssh" {
+			// The worker terminates the SSH connection for ssh targets using
+			// the session's key as the host key, so pin that key rather than
+			// recording it in the user's known hosts file.
+			knownHosts, err := s.writeKnownHosts(c)
+			if err != nil {
+				return nil, nil, err
+			}
+			args = append(args, "-o", fmt.Sprintf("UserKnownHostsFile=%s", knownHosts))
+			args = append(args, "-o", "StrictHostKeyChecking=yes")
+		}
+		if creds.PrivateKey != "" {
+			sock, err := s.startAgent(c, creds.PrivateKey)
+			if err != nil {
+				return nil, nil, err
+			}
+			envs = append(envs, fmt.Sprintf("SSH_AUTH_SOCK=%s", sock))
+		}
 	case "putty":
 		args = append(args, "-P", port, ip)
+		if creds.PrivateKey != "" {
+			c.UI.Warn("Credentials are being brokered but the private key cannot be passed to putty. Only the username is used.")
+		}
 	}
-	if c.flagUsername != "" {
+	switch {
+	case creds.Username != "":
+		args = append(args, "-l", creds.Username)
+	case c.flagUsername != "":
 		args = append(args, "-l", c.flagUsername)
 	}
-	return args
+	return args, envs, nil
+}
+
+// startAgent starts an SSH agent holding only the given private key, listening
+// on a socket in a temporary directory, and returns the socket's path. The
+// agent is stopped and the directory removed when the command exits.
+func (s *sshFlags) startAgent(c *Command, privateKey string) (string, error) {
+	if runtime.GOOS == "windows" {
+		return "", fmt.Errorf("Loading brokered private keys into an SSH agent is not supported on Windows; use -no-credentials to connect without them")
+	}
+	key, err := ssh.ParseRawPrivateKey([]byte(privateKey))
+	if err != nil {
+		return "", fmt.Errorf("Error parsing brokered private key: %w", err)
+	}
+	keyring := agent.NewKeyring()
+	if err := keyring.Add(agent.AddedKey{
+		PrivateKey: key,
+		Comment:    fmt.Sprintf("boundary session %s", c.sessionAuthzData.GetSessionId()),
+	}); err != nil {
+		return "", fmt.Errorf("Error adding brokered private key to SSH agent: %w", err)
+	}
+
+	// The directory is only accessible by the current user, which protects
+	// the socket.
+	dir, err := ioutil.TempDir("", "*")
+	if err != nil {
+		return "", fmt.Errorf("Error creating tmp dir for SSH agent: %w", err)
+	}
+	sock := filepath.Join(dir, "agent.sock")
+	listener, err := net.Listen("unix", sock)
+	if err != nil {
+		os.RemoveAll(dir)
+		return "", fmt.Errorf("Error starting SSH agent: %w", err)
+	}
+	c.cleanupFuncs = append(c.cleanupFuncs, func() error {
+		listener.Close()
+		if err := os.RemoveAll(dir); err != nil {
+			return fmt.Errorf("Error removing temporary SSH agent directory; consider removing %s manually: %w", dir, err)
+		}
+		return nil
+	})
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				// The listener has been closed
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_ = agent.ServeAgent(keyring, conn)
+			}()
+		}
+	}()
+	return sock, nil
+}
+
+// writeKnownHosts writes a known hosts file containing the public key of the
+// session to a temporary file and returns its name. The file is removed when
+// the command exits.
+func (s *sshFlags) writeKnownHosts(c *Command) (string, error) {
+	pk := c.sessionAuthzData.GetPrivateKey()
+	if len(pk) != ed25519.PrivateKeySize {
+		return "", fmt.Errorf("Session authorization does not contain a valid private key")
+	}
+	pub, err := ssh.NewPublicKey(ed25519.PrivateKey(pk).Public())
+	if err != nil {
+		return "", fmt.Errorf("Error deriving session host key: %w", err)
+	}
+	khFile, err := ioutil.TempFile("", "*")
+	if err != nil {
+		return "", fmt.Errorf("Error saving session host key to tmp file: %w", err)
+	}
+	c.cleanupFuncs = append(c.cleanupFuncs, func() error {
+		if err := os.Remove(khFile.Name()); err != nil {
+			return fmt.Errorf("Error removing temporary known hosts file; consider removing %s manually: %w", khFile.Name(), err)
+		}
+		return nil
+	})
+	_, err = khFile.WriteString(knownhosts.Line([]string{c.sessionAuthzData.HostId}, pub) + "\n")
+	if err != nil {
+		return "", fmt.Errorf("Error writing known hosts file to %s: %w", khFile.Name(), err)
+	}
+	if err := khFile.Close(); err != nil {
+		return "", fmt.Errorf("Error closing known hosts file after writing to %s: %w", khFile.Name(), err)
+	}
+	return khFile.Name(), nil
 }
@@ -0,0 +1,170 @@
+package credentiallibrariescmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api/credentiallibraries"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+)
+
+func init() {
+	extraStaticFlagsFunc = extraStaticFlagsFuncImpl
+	extraStaticActionsFlagsMapFunc = extraStaticActionsFlagsMapFuncImpl
+	extraStaticFlagsHandlingFunc = extraStaticFlagHandlingFuncImpl
+}
+
+const (
+	credentialTypeFlagName = "credential-type"
+	usernameFlagName       = "username"
+	passwordFlagName       = "password"
+	privateKeyFlagName     = "private-key"
+	objectFlagName         = "object"
+)
+
+type extraStaticCmdVars struct {
+	flagCredentialType string
+	flagUsername       string
+	flagPassword       string
+	flagPrivateKey     string
+	flagObject         string
+}
+
+func extraStaticActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create": {
+			credentialTypeFlagName,
+			usernameFlagName,
+			passwordFlagName,
+			privateKeyFlagName,
+			objectFlagName,
+		},
+		"update": {
+			usernameFlagName,
+			passwordFlagName,
+			privateKeyFlagName,
+			objectFlagName,
+		},
+	}
+}
+
+func extraStaticFlagsFuncImpl(c *StaticCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("Static Credential Library Options")
+
+	for _, name := range flagsStaticMap[c.Func] {
+		switch name {
+		case credentialTypeFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   credentialTypeFlagName,
+				Target: &c.flagCredentialType,
+				Usage:  `The type of credential stored in the library: "username_password", "ssh_private_key", or "json".`,
+			})
+		case usernameFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   usernameFlagName,
+				Target: &c.flagUsername,
+				Usage:  "The username of a username_password or ssh_private_key credential.",
+			})
+		case passwordFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   passwordFlagName,
+				Target: &c.flagPassword,
+				Usage:  "The password of a username_password credential. This can be the value itself, refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
+			})
+		case privateKeyFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   privateKeyFlagName,
+				Target: &c.flagPrivateKey,
+				Usage:  "The PEM encoded private key of an ssh_private_key credential. This can be the value itself, refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
+			})
+		case objectFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   objectFlagName,
+				Target: &c.flagObject,
+				Usage:  "The JSON object of a json credential. This can be the value itself, refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
+			})
+		}
+	}
+}
+
+func extraStaticFlagHandlingFuncImpl(c *StaticCommand, f *base.FlagSets, opts *[]credentiallibraries.Option) bool {
+	switch c.flagCredentialType {
+	case "":
+	default:
+		*opts = append(*opts, credentiallibraries.WithStaticCredentialLibraryCredentialType(c.flagCredentialType))
+	}
+	switch c.flagUsername {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultStaticCredentialLibraryUsername())
+	default:
+		*opts = append(*opts, credentiallibraries.WithStaticCredentialLibraryUsername(c.flagUsername))
+	}
+	switch c.flagPassword {
+	case "":
+	default:
+		password, err := parseutil.ParsePath(c.flagPassword)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			c.UI.Error(fmt.Sprintf("Error parsing password: %v", err))
+			return false
+		}
+		*opts = append(*opts, credentiallibraries.WithStaticCredentialLibraryPassword(password))
+	}
+	switch c.flagPrivateKey {
+	case "":
+	default:
+		key, err := parseutil.ParsePath(c.flagPrivateKey)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			c.UI.Error(fmt.Sprintf("Error parsing private key: %v", err))
+			return false
+		}
+		*opts = append(*opts, credentiallibraries.WithStaticCredentialLibraryPrivateKey(key))
+	}
+	switch c.flagObject {
+	case "":
+	default:
+		raw, err := parseutil.ParsePath(c.flagObject)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			c.UI.Error(fmt.Sprintf("Error parsing object: %v", err))
+			return false
+		}
+		var object map[string]interface{}
+		if err := json.Unmarshal([]byte(raw), &object); err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing object as a JSON object: %v", err))
+			return false
+		}
+		*opts = append(*opts, credentiallibraries.WithStaticCredentialLibraryObject(object))
+	}
+
+	return true
+}
+
+func (c *StaticCommand) extraStaticHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-libraries create static -credential-store-id [options] [args]",
+			"",
+			"  Create a static-type credential library. Example:",
+			"",
+			`    $ boundary credential-libraries create static -credential-store-id csst_1234567890 -credential-type username_password -username admin -password env://ADMIN_PASSWORD`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-libraries update static [options] [args]",
+			"",
+			"  Update a static-type credential library given its ID. Example:",
+			"",
+			`    $ boundary credential-libraries update static -id clst_1234567890 -password file:///path/to/password`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
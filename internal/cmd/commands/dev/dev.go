@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/cmd/ops"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/intglobals"
@@ -541,7 +542,7 @@ func (c *Command) Run(args []string) int {
 	c.Info["[Recovery] AEAD Key Bytes"] = c.Config.DevRecoveryKey
 
 	// Initialize the listeners
-	if err := c.SetupListeners(c.UI, c.Config.SharedConfig, []string{"api", "cluster", "proxy"}); err != nil {
+	if err := c.SetupListeners(c.UI, c.Config.SharedConfig, []string{"api", "cluster", "proxy", "ops"}); err != nil {
 		c.UI.Error(err.Error())
 		return base.CommandUserError
 	}
@@ -596,7 +597,7 @@ func (c *Command) Run(args []string) int {
 	c.ReleaseLogGate()
 
 	{
-		c.EnabledPlugins = append(c.EnabledPlugins, base.EnabledPluginHostAws, base.EnabledPluginHostAzure)
+		c.EnabledPlugins = append(c.EnabledPlugins, base.EnabledPluginHostAws, base.EnabledPluginHostAzure, base.EnabledPluginHostFile, base.EnabledPluginHostDns)
 		conf := &controller.Config{
 			RawConfig: c.Config,
 			Server:    c.Server,
@@ -647,6 +648,14 @@ func (c *Command) Run(args []string) int {
 		}
 	}
 
+	opsServer, err := ops.NewServer(c.Logger, c.Listeners...)
+	if err != nil {
+		c.UI.Error(fmt.Errorf("Error initializing ops listeners: %w", err).Error())
+		return base.CommandCliError
+	}
+	opsServer.Start()
+	c.ShutdownFuncs = append(c.ShutdownFuncs, opsServer.Shutdown)
+
 	// Wait for shutdown
 	shutdownTriggered := false
 
@@ -0,0 +1,148 @@
+package pluginscmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/plugins"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary plugins [sub command] [options] [args]",
+			"",
+			"  This command allows operations on Boundary plugin resources. Plugins are registered by controllers when they start. Example:",
+			"",
+			"    List the plugins:",
+			"",
+			`      $ boundary plugins list -scope-id global`,
+			"",
+			"  Please see the plugins subcommand help for detailed usage information.",
+		})
+
+	default:
+		helpStr = helpMap["base"]()
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func (c *Command) printListTable(items []*plugins.Plugin) string {
+	if len(items) == 0 {
+		return "No plugins found"
+	}
+
+	var output []string
+	output = []string{
+		"",
+		"Plugin information:",
+	}
+
+	for i, item := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		if item.Id != "" {
+			output = append(output,
+				fmt.Sprintf("  ID:                       %s", item.Id),
+			)
+		} else {
+			output = append(output,
+				fmt.Sprintf("  ID:                       %s", "(not available)"),
+			)
+		}
+		if item.Name != "" {
+			output = append(output,
+				fmt.Sprintf("    Name:                   %s", item.Name),
+			)
+		}
+		if item.Description != "" {
+			output = append(output,
+				fmt.Sprintf("    Description:            %s", item.Description),
+			)
+		}
+		if item.PluginVersion != "" {
+			output = append(output,
+				fmt.Sprintf("    Plugin Version:         %s", item.PluginVersion),
+			)
+		}
+		if len(item.CatalogAttributes) > 0 {
+			output = append(output,
+				"    Catalog Attributes:",
+				base.WrapSlice(6, item.CatalogAttributes),
+			)
+		}
+		if len(item.AuthorizedActions) > 0 {
+			output = append(output,
+				"    Authorized Actions:",
+				base.WrapSlice(6, item.AuthorizedActions),
+			)
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
+
+func printItemTable(result api.GenericResult) string {
+	item := result.GetItem().(*plugins.Plugin)
+	nonAttributeMap := map[string]interface{}{}
+	if item.Id != "" {
+		nonAttributeMap["ID"] = item.Id
+	}
+	if item.Version != 0 {
+		nonAttributeMap["Version"] = item.Version
+	}
+	if !item.CreatedTime.IsZero() {
+		nonAttributeMap["Created Time"] = item.CreatedTime.Local().Format(time.RFC1123)
+	}
+	if !item.UpdatedTime.IsZero() {
+		nonAttributeMap["Updated Time"] = item.UpdatedTime.Local().Format(time.RFC1123)
+	}
+	if item.Name != "" {
+		nonAttributeMap["Name"] = item.Name
+	}
+	if item.Description != "" {
+		nonAttributeMap["Description"] = item.Description
+	}
+	if item.PluginVersion != "" {
+		nonAttributeMap["Plugin Version"] = item.PluginVersion
+	}
+
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+
+	ret := []string{
+		"",
+		"Plugin information:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+
+	if item.Scope != nil {
+		ret = append(ret,
+			"",
+			"  Scope:",
+			base.ScopeInfoForOutput(item.Scope, maxLength),
+		)
+	}
+
+	if len(item.AuthorizedActions) > 0 {
+		ret = append(ret,
+			"",
+			"  Authorized Actions:",
+			base.WrapSlice(4, item.AuthorizedActions),
+		)
+	}
+
+	if len(item.CatalogAttributes) > 0 {
+		ret = append(ret,
+			"",
+			"  Catalog Attributes:",
+			base.WrapSlice(4, item.CatalogAttributes),
+		)
+	}
+
+	return base.WrapForHelpText(ret)
+}
@@ -25,14 +25,15 @@ type extraCmdVars struct {
 	flagGrantScopeId string
 	flagPrincipals   []string
 	flagGrants       []string
+	flagExpiresIn    time.Duration
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
 		"create":            {"grant-scope-id"},
 		"update":            {"grant-scope-id"},
-		"add-principals":    {"id", "principal", "version"},
-		"set-principals":    {"id", "principal", "version"},
+		"add-principals":    {"id", "principal", "expires-in", "version"},
+		"set-principals":    {"id", "principal", "expires-in", "version"},
 		"remove-principals": {"id", "principal", "version"},
 		"add-grants":        {"id", "grant", "version"},
 		"set-grants":        {"id", "grant", "version"},
@@ -79,6 +80,10 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			`    $ boundary roles add-principals -id r_1234567890 -principal u_1234567890`,
 			"",
+			`  The "expires-in" flag can be used to remove the principals from the role after a period of time. Example:`,
+			"",
+			`    $ boundary roles add-principals -id r_1234567890 -principal u_1234567890 -expires-in 4h`,
+			"",
 			"",
 		})
 
@@ -158,6 +163,12 @@ func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagPrincipals,
 				Usage:  "The principals (users or groups) to add, remove, or set. May be specified multiple times.",
 			})
+		case "expires-in":
+			f.DurationVar(&base.DurationVar{
+				Name:   "expires-in",
+				Target: &c.flagExpiresIn,
+				Usage:  `If set, the principals' assignment to the role expires after this duration, e.g. "4h".`,
+			})
 		case "grant":
 			f.StringSliceVar(&base.StringSliceVar{
 				Name:   "grant",
@@ -177,6 +188,14 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]roles.Opti
 		*opts = append(*opts, roles.WithGrantScopeId(c.flagGrantScopeId))
 	}
 
+	switch {
+	case c.flagExpiresIn < 0:
+		c.UI.Error("The value of -expires-in must be positive")
+		return false
+	case c.flagExpiresIn > 0:
+		*opts = append(*opts, roles.WithExpirationTime(time.Now().Add(c.flagExpiresIn)))
+	}
+
 	switch c.Func {
 	case "add-principals", "remove-principals":
 		if len(c.flagPrincipals) == 0 {
@@ -359,6 +378,11 @@ func printItemTable(result api.GenericResult) string {
 			fmt.Sprintf("      Type:         %s", principal.Type),
 			fmt.Sprintf("      Scope ID:     %s", principal.ScopeId),
 		)
+		if !principal.ExpirationTime.IsZero() {
+			ret = append(ret,
+				fmt.Sprintf("      Expires:      %s", principal.ExpirationTime.Local().Format(time.RFC1123)),
+			)
+		}
 	}
 	if len(item.Grants) > 0 {
 		ret = append(ret,
@@ -3,6 +3,7 @@ package scopescmd
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"time"
 
 	"github.com/hashicorp/boundary/api"
@@ -11,28 +12,82 @@ import (
 )
 
 const (
-	flagPrimaryAuthMethodIdName     = "primary-auth-method-id"
-	flagSkipAdminRoleCreationName   = "skip-admin-role-creation"
-	flagSkipDefaultRoleCreationName = "skip-default-role-creation"
+	flagPrimaryAuthMethodIdName          = "primary-auth-method-id"
+	flagSkipAdminRoleCreationName        = "skip-admin-role-creation"
+	flagSkipDefaultRoleCreationName      = "skip-default-role-creation"
+	flagKeyVersionIdName                 = "key-version-id"
+	flagMaxConcurrentSessionsPerUserName = "max-concurrent-sessions-per-user"
 )
 
 func init() {
 	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
 	extraFlagsFunc = extraFlagsFuncImpl
 	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
+	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"create": {flagSkipAdminRoleCreationName, flagSkipDefaultRoleCreationName},
-		"update": {flagPrimaryAuthMethodIdName},
+		"create":              {flagSkipAdminRoleCreationName, flagSkipDefaultRoleCreationName, flagMaxConcurrentSessionsPerUserName},
+		"update":              {flagPrimaryAuthMethodIdName, flagMaxConcurrentSessionsPerUserName},
+		"list-keys":           {"id"},
+		"rotate-keys":         {"id"},
+		"destroy-key-version": {"id", flagKeyVersionIdName},
 	}
 }
 
 type extraCmdVars struct {
-	flagSkipAdminRoleCreation   bool
-	flagSkipDefaultRoleCreation bool
-	flagPrimaryAuthMethodId     string
+	flagSkipAdminRoleCreation        bool
+	flagSkipDefaultRoleCreation      bool
+	flagPrimaryAuthMethodId          string
+	flagKeyVersionId                 string
+	flagMaxConcurrentSessionsPerUser string
+	klr                              *scopes.KeyListResult
+	kar                              *scopes.KeyActionResult
+}
+
+func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "list-keys":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary scopes list-keys [options] [args]",
+			"",
+			"  List the keys of the scope specified by ID along with their versions. Key material is never shown. Example:",
+			"",
+			`    $ boundary scopes list-keys -id o_1234567890`,
+			"",
+			"",
+		})
+
+	case "rotate-keys":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary scopes rotate-keys [options] [args]",
+			"",
+			"  Create a new version of the root key and of every key of the scope specified by ID. New values are encrypted with the new versions and existing values are re-encrypted with them in the background. Example:",
+			"",
+			`    $ boundary scopes rotate-keys -id o_1234567890`,
+			"",
+			"",
+		})
+
+	case "destroy-key-version":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary scopes destroy-key-version [options] [args]",
+			"",
+			"  Destroy a version of a key of the scope specified by ID. The newest version of a key, versions of the oplog key and versions which are still needed to decrypt values cannot be destroyed. Example:",
+			"",
+			`    $ boundary scopes destroy-key-version -id o_1234567890 -key-version-id kdkv_1234567890`,
+			"",
+			"",
+		})
+
+	default:
+		helpStr = helpMap["base"]()
+	}
+
+	return helpStr + c.Flags().Help()
 }
 
 func extraFlagsFuncImpl(c *Command, set *base.FlagSets, f *base.FlagSet) {
@@ -56,6 +111,18 @@ func extraFlagsFuncImpl(c *Command, set *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagPrimaryAuthMethodId,
 				Usage:  "If set, the primary auth method id for the scope.  A primary auth method is allowed to create users on first login and is also used as a source for account full name and email for a scope's users",
 			})
+		case flagKeyVersionIdName:
+			f.StringVar(&base.StringVar{
+				Name:   flagKeyVersionIdName,
+				Target: &c.flagKeyVersionId,
+				Usage:  "The ID of the key version to destroy.",
+			})
+		case flagMaxConcurrentSessionsPerUserName:
+			f.StringVar(&base.StringVar{
+				Name:   flagMaxConcurrentSessionsPerUserName,
+				Target: &c.flagMaxConcurrentSessionsPerUser,
+				Usage:  "The maximum number of sessions a user can have open at the same time for the targets of the scope. For an org this counts the sessions in all of its projects. -1 means unlimited.",
+			})
 		}
 	}
 }
@@ -70,10 +137,112 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]scopes.Opt
 	if c.flagPrimaryAuthMethodId != "" {
 		*opts = append(*opts, scopes.WithPrimaryAuthMethodId(c.flagPrimaryAuthMethodId))
 	}
+	switch c.flagMaxConcurrentSessionsPerUser {
+	case "":
+	case "null":
+		*opts = append(*opts, scopes.DefaultMaxConcurrentSessionsPerUser())
+	default:
+		limit, err := strconv.ParseInt(c.flagMaxConcurrentSessionsPerUser, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagMaxConcurrentSessionsPerUser, err))
+			return false
+		}
+		*opts = append(*opts, scopes.WithMaxConcurrentSessionsPerUser(int32(limit)))
+	}
+
+	switch c.Func {
+	case "destroy-key-version":
+		if c.flagKeyVersionId == "" {
+			c.UI.Error("Key version ID is required but not passed in via -key-version-id")
+			return false
+		}
+	}
 
 	return true
 }
 
+func executeExtraActionsImpl(c *Command, origResult api.GenericResult, origError error, scopeClient *scopes.Client, _ uint32, opts []scopes.Option) (api.GenericResult, error) {
+	switch c.Func {
+	case "list-keys":
+		var err error
+		c.plural = "keys"
+		c.klr, err = scopeClient.ListKeys(c.Context, c.FlagId, opts...)
+		return nil, err
+	case "rotate-keys":
+		var err error
+		c.plural = "keys"
+		c.kar, err = scopeClient.RotateKeys(c.Context, c.FlagId, opts...)
+		return nil, err
+	case "destroy-key-version":
+		var err error
+		c.plural = "key version"
+		c.kar, err = scopeClient.DestroyKeyVersion(c.Context, c.FlagId, c.flagKeyVersionId, opts...)
+		return nil, err
+	}
+	return origResult, origError
+}
+
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "list-keys":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(printKeysTable(c.klr.Items))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItems(c.klr); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+
+	case "rotate-keys", "destroy-key-version":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(fmt.Sprintf("The %s operation completed successfully.", c.Func))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.kar); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func printKeysTable(items []*scopes.Key) string {
+	if len(items) == 0 {
+		return "No keys found"
+	}
+	output := []string{
+		"",
+		"Key information:",
+	}
+	for i, item := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		output = append(output,
+			fmt.Sprintf("  ID:                    %s", item.Id),
+			fmt.Sprintf("    Purpose:             %s", item.Purpose),
+			"    Versions:",
+		)
+		for _, v := range item.Versions {
+			output = append(output,
+				fmt.Sprintf("      ID:                %s", v.Id),
+				fmt.Sprintf("        Version:         %d", v.Version),
+				fmt.Sprintf("        Created Time:    %s", v.CreatedTime.Local().Format(time.RFC1123)),
+			)
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
+
 func (c *Command) printListTable(items []*scopes.Scope) string {
 	if len(items) == 0 {
 		return "No child scopes found"
@@ -152,6 +321,9 @@ func printItemTable(result api.GenericResult) string {
 	if item.PrimaryAuthMethodId != "" {
 		nonAttributeMap["Primary Auth Method ID"] = item.PrimaryAuthMethodId
 	}
+	if item.MaxConcurrentSessionsPerUser > 0 {
+		nonAttributeMap["Max Concurrent Sessions Per User"] = item.MaxConcurrentSessionsPerUser
+	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
 
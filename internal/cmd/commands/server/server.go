@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/cmd/ops"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/schema"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -237,6 +238,7 @@ func (c *Command) Run(args []string) int {
 				if lnConfig.Address == "" {
 					lnConfig.Address = "127.0.0.1:9202"
 				}
+			case "ops":
 			default:
 				c.UI.Error(fmt.Sprintf("Unknown listener purpose %q", lnConfig.Purpose[0]))
 				return base.CommandUserError
@@ -339,7 +341,7 @@ func (c *Command) Run(args []string) int {
 			}
 		}
 	}
-	if err := c.SetupListeners(c.UI, c.Config.SharedConfig, []string{"api", "cluster", "proxy"}); err != nil {
+	if err := c.SetupListeners(c.UI, c.Config.SharedConfig, []string{"api", "cluster", "proxy", "ops"}); err != nil {
 		c.UI.Error(err.Error())
 		return base.CommandUserError
 	}
@@ -457,7 +459,7 @@ func (c *Command) Run(args []string) int {
 	c.ReleaseLogGate()
 
 	if c.Config.Controller != nil {
-		c.EnabledPlugins = append(c.EnabledPlugins, base.EnabledPluginHostAws, base.EnabledPluginHostAzure)
+		c.EnabledPlugins = append(c.EnabledPlugins, base.EnabledPluginHostAws, base.EnabledPluginHostAzure, base.EnabledPluginHostFile, base.EnabledPluginHostDns)
 		if err := c.StartController(ctx); err != nil {
 			c.UI.Error(err.Error())
 			return base.CommandCliError
@@ -476,6 +478,14 @@ func (c *Command) Run(args []string) int {
 		}
 	}
 
+	opsServer, err := ops.NewServer(c.Logger, c.Listeners...)
+	if err != nil {
+		c.UI.Error(fmt.Errorf("Error initializing ops listeners: %w", err).Error())
+		return base.CommandCliError
+	}
+	opsServer.Start()
+	c.ShutdownFuncs = append(c.ShutdownFuncs, opsServer.Shutdown)
+
 	// Inform any tests that the server is ready
 	if c.startedCh != nil {
 		close(c.startedCh)
@@ -12,12 +12,40 @@ import (
 
 func init() {
 	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
+	extraSynopsisFunc = extraSynopsisFuncImpl
+	extraFlagsFunc = extraFlagsFuncImpl
+	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
 	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
+}
+
+type extraCmdVars struct {
+	flagConnectionId string
+	flagOffset       uint
+	flagStartTime    string
+	flagEndTime      string
+	startTime        time.Time
+	endTime          time.Time
+	srr              *sessions.SessionRecordingReadResult
+	sur              *sessions.SessionUsageResult
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"cancel": {"id"},
+		"cancel":         {"id"},
+		"read-recording": {"id", "connection-id", "offset"},
+		"approve":        {"id"},
+		"deny":           {"id"},
+		"usage":          {"scope-id", "recursive", "start-time", "end-time"},
+	}
+}
+
+func extraSynopsisFuncImpl(c *Command) string {
+	switch c.Func {
+	case "usage":
+		return "Show the connections and bytes proxied by sessions per user and target"
+	default:
+		return ""
 	}
 }
 
@@ -48,6 +76,50 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 		})
 
+	case "read-recording":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary sessions read-recording [options] [args]",
+			"",
+			"  Read the recording of a connection of the session specified by ID. Recordings are read a page at a time; if the recording has more chunks, the offset of the next page is shown and can be passed in via -offset. Example:",
+			"",
+			`    $ boundary sessions read-recording -id s_1234567890 -connection-id sc_1234567890`,
+			"",
+			"",
+		})
+
+	case "approve":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary sessions approve [options] [args]",
+			"",
+			"  Approve the session specified by ID, which must be pending approval, allowing it to be activated. Example:",
+			"",
+			`    $ boundary sessions approve -id s_1234567890`,
+			"",
+			"",
+		})
+
+	case "deny":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary sessions deny [options] [args]",
+			"",
+			"  Deny the session specified by ID, which must be pending approval, terminating it. Example:",
+			"",
+			`    $ boundary sessions deny -id s_1234567890`,
+			"",
+			"",
+		})
+
+	case "usage":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary sessions usage [options] [args]",
+			"",
+			"  Show the number of sessions and connections and the bytes proxied by the sessions in the scope specified by ID, totalled per user and target. The sessions can be limited to those created within a time range, given in RFC 3339 format. Example:",
+			"",
+			`    $ boundary sessions usage -scope-id global -recursive -start-time 2021-10-01T00:00:00Z -end-time 2021-11-01T00:00:00Z`,
+			"",
+			"",
+		})
+
 	default:
 		helpStr = helpMap["base"]()
 	}
@@ -55,14 +127,151 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 	return helpStr + c.Flags().Help()
 }
 
+func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
+	for _, name := range flagsMap[c.Func] {
+		switch name {
+		case "connection-id":
+			f.StringVar(&base.StringVar{
+				Name:   "connection-id",
+				Target: &c.flagConnectionId,
+				Usage:  "The ID of the connection whose recording should be read.",
+			})
+		case "offset":
+			f.UintVar(&base.UintVar{
+				Name:   "offset",
+				Target: &c.flagOffset,
+				Usage:  "The index of the first chunk of the recording to read.",
+			})
+		case "start-time":
+			f.StringVar(&base.StringVar{
+				Name:   "start-time",
+				Target: &c.flagStartTime,
+				Usage:  "If set, only sessions created at or after this time, in RFC 3339 format, are included.",
+			})
+		case "end-time":
+			f.StringVar(&base.StringVar{
+				Name:   "end-time",
+				Target: &c.flagEndTime,
+				Usage:  "If set, only sessions created before this time, in RFC 3339 format, are included.",
+			})
+		}
+	}
+}
+
+func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, _ *[]sessions.Option) bool {
+	switch c.Func {
+	case "read-recording":
+		if c.flagConnectionId == "" {
+			c.UI.Error("Connection ID is required but not passed in via -connection-id")
+			return false
+		}
+	case "usage":
+		if c.FlagScopeId == "" {
+			c.UI.Error("Scope ID must be passed in via -scope-id or BOUNDARY_SCOPE_ID")
+			return false
+		}
+		var err error
+		if c.flagStartTime != "" {
+			if c.startTime, err = time.Parse(time.RFC3339, c.flagStartTime); err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing -start-time: %s", err))
+				return false
+			}
+		}
+		if c.flagEndTime != "" {
+			if c.endTime, err = time.Parse(time.RFC3339, c.flagEndTime); err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing -end-time: %s", err))
+				return false
+			}
+		}
+	}
+	return true
+}
+
 func executeExtraActionsImpl(c *Command, origResult api.GenericResult, origError error, sessionClient *sessions.Client, version uint32, opts []sessions.Option) (api.GenericResult, error) {
 	switch c.Func {
 	case "cancel":
 		return sessionClient.Cancel(c.Context, c.FlagId, version, opts...)
+	case "approve":
+		return sessionClient.Approve(c.Context, c.FlagId, version, opts...)
+	case "deny":
+		return sessionClient.Deny(c.Context, c.FlagId, version, opts...)
+	case "read-recording":
+		var err error
+		c.plural = "session recording"
+		c.srr, err = sessionClient.ReadRecording(c.Context, c.FlagId, c.flagConnectionId, uint32(c.flagOffset), opts...)
+		return nil, err
+	case "usage":
+		var err error
+		c.plural = "session usage"
+		c.sur, err = sessionClient.Usage(c.Context, c.FlagScopeId, c.startTime, c.endTime, opts...)
+		return nil, err
 	}
 	return origResult, origError
 }
 
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "read-recording":
+		item := c.srr.GetItem().(*sessions.SessionRecording)
+
+		switch base.Format(c.UI) {
+		case "table":
+			nonAttributeMap := map[string]interface{}{
+				"ID":            item.Id,
+				"Session ID":    item.SessionId,
+				"Connection ID": item.ConnectionId,
+				"Offset":        item.Offset,
+			}
+			if item.NextOffset != 0 {
+				nonAttributeMap["Next Offset"] = item.NextOffset
+			}
+			maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+
+			ret := []string{
+				"",
+				"Session recording information:",
+				base.WrapMap(2, maxLength+2, nonAttributeMap),
+			}
+			if len(item.Chunks) > 0 {
+				ret = append(ret,
+					"",
+					"  Chunks:",
+				)
+				for _, chunk := range item.Chunks {
+					ret = append(ret,
+						fmt.Sprintf("    %s  %-4s  %d bytes", chunk.Time.Local().Format(time.RFC3339Nano), chunk.Direction, len(chunk.Data)),
+						fmt.Sprintf("      %q", chunk.Data),
+					)
+				}
+			}
+
+			c.UI.Output(base.WrapForHelpText(ret))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.srr); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+
+	case "usage":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(c.printUsageTable(c.sur.Items))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItems(c.sur); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func (c *Command) printListTable(items []*sessions.Session) string {
 	if len(items) == 0 {
 		return "No sessions found"
@@ -131,6 +340,39 @@ func (c *Command) printListTable(items []*sessions.Session) string {
 	return base.WrapForHelpText(output)
 }
 
+func (c *Command) printUsageTable(items []*sessions.SessionUsage) string {
+	if len(items) == 0 {
+		return "No session usage found"
+	}
+	var output []string
+	output = []string{
+		"",
+		"Session usage:",
+	}
+	for i, item := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		output = append(output,
+			fmt.Sprintf("  User ID:               %s", item.UserId),
+			fmt.Sprintf("    Target ID:           %s", item.TargetId),
+		)
+		if c.FlagRecursive && item.ScopeId != "" {
+			output = append(output,
+				fmt.Sprintf("    Scope ID:            %s", item.ScopeId),
+			)
+		}
+		output = append(output,
+			fmt.Sprintf("    Sessions:            %d", item.SessionCount),
+			fmt.Sprintf("    Connections:         %d", item.ConnectionCount),
+			fmt.Sprintf("    Bytes Up:            %d", item.BytesUp),
+			fmt.Sprintf("    Bytes Down:          %d", item.BytesDown),
+		)
+	}
+
+	return base.WrapForHelpText(output)
+}
+
 func printItemTable(result api.GenericResult) string {
 	item := result.GetItem().(*sessions.Session)
 	nonAttributeMap := map[string]interface{}{}
@@ -176,6 +418,13 @@ func printItemTable(result api.GenericResult) string {
 	if len(strings.TrimSpace(item.TerminationReason)) > 0 {
 		nonAttributeMap["Termination Reason"] = item.TerminationReason
 	}
+	if item.BytesUp != 0 || item.BytesDown != 0 {
+		nonAttributeMap["Bytes Up"] = item.BytesUp
+		nonAttributeMap["Bytes Down"] = item.BytesDown
+	}
+	if item.DurationSeconds != 0 {
+		nonAttributeMap["Duration"] = (time.Duration(item.DurationSeconds) * time.Second).String()
+	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
 
@@ -196,6 +445,36 @@ func printItemTable(result api.GenericResult) string {
 		}
 	}
 
+	var connectionsMaps []map[string]interface{}
+	if len(item.Connections) > 0 {
+		for _, conn := range item.Connections {
+			m := map[string]interface{}{
+				"ID":         conn.PublicId,
+				"Client":     fmt.Sprintf("%s:%d", conn.ClientTcpAddress, conn.ClientTcpPort),
+				"Endpoint":   fmt.Sprintf("%s:%d", conn.EndpointTcpAddress, conn.EndpointTcpPort),
+				"Bytes Up":   conn.BytesUp,
+				"Bytes Down": conn.BytesDown,
+			}
+			if !conn.CreatedTime.IsZero() {
+				m["Created Time"] = conn.CreatedTime.Local().Format(time.RFC1123)
+			}
+			if !conn.ClosedTime.IsZero() {
+				m["Closed Time"] = conn.ClosedTime.Local().Format(time.RFC1123)
+				m["Duration"] = (time.Duration(conn.DurationSeconds) * time.Second).String()
+			}
+			if conn.ClosedReason != "" {
+				m["Closed Reason"] = conn.ClosedReason
+			}
+			if len(conn.WorkerChain) > 0 {
+				m["Worker Chain"] = strings.Join(conn.WorkerChain, " -> ")
+			}
+			connectionsMaps = append(connectionsMaps, m)
+		}
+		if l := len("Closed Reason"); l > maxLength {
+			maxLength = l
+		}
+	}
+
 	var workerInfoMaps []map[string]interface{}
 	if len(item.WorkerInfo) > 0 {
 		for _, wi := range item.WorkerInfo {
@@ -244,6 +523,19 @@ func printItemTable(result api.GenericResult) string {
 		}
 	}
 
+	if len(item.Connections) > 0 {
+		ret = append(ret,
+			"",
+			"  Connections:",
+		)
+		for _, m := range connectionsMaps {
+			ret = append(ret,
+				base.WrapMap(4, maxLength, m),
+				"",
+			)
+		}
+	}
+
 	if len(item.WorkerInfo) > 0 {
 		ret = append(ret,
 			fmt.Sprintf("  Worker Info:   %s", ""),
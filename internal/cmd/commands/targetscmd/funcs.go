@@ -140,6 +140,14 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			`      $ boundary targets create tcp -name prodops -description "For ProdOps usage"`,
 			"",
+			"    Create an ssh-type target:",
+			"",
+			`      $ boundary targets create ssh -name prodops-ssh -description "For ProdOps SSH usage" -default-port 22`,
+			"",
+			"    Create a postgres-type target:",
+			"",
+			`      $ boundary targets create postgres -name prodops-db -description "For ProdOps database usage" -default-port 5432`,
+			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
 	case "update":
@@ -152,6 +160,14 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			`      $ boundary targets update tcp -id ttcp_1234567890 -name devops -description "For DevOps usage"`,
 			"",
+			"    Update an ssh-type target:",
+			"",
+			`      $ boundary targets update ssh -id tssh_1234567890 -name devops-ssh -description "For DevOps SSH usage"`,
+			"",
+			"    Update a postgres-type target:",
+			"",
+			`      $ boundary targets update postgres -id tpg_1234567890 -name devops-db -description "For DevOps database usage"`,
+			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
 	case "add-host-sets":
@@ -664,6 +680,18 @@ func printItemTable(result api.GenericResult) string {
 	if item.WorkerFilter != "" {
 		nonAttributeMap["Worker Filter"] = item.WorkerFilter
 	}
+	if item.IngressWorkerFilter != "" {
+		nonAttributeMap["Ingress Worker Filter"] = item.IngressWorkerFilter
+	}
+	if item.ApprovalRequired {
+		nonAttributeMap["Approval Required"] = item.ApprovalRequired
+	}
+	if len(item.ApproverIds) > 0 {
+		nonAttributeMap["Approver IDs"] = strings.Join(item.ApproverIds, ", ")
+	}
+	if item.MaxConcurrentSessions > 0 {
+		nonAttributeMap["Max Concurrent Sessions"] = item.MaxConcurrentSessions
+	}
 	if result.GetResponse() != nil && result.GetResponse().Map != nil {
 		if result.GetResponse().Map[globals.SessionConnectionLimitField] != nil {
 			nonAttributeMap["Session Connection Limit"] = item.SessionConnectionLimit
@@ -0,0 +1,232 @@
+package targetscmd
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-bexpr"
+)
+
+func init() {
+	extraPostgresActionsFlagsMapFunc = extraPostgresActionsFlagsMapFuncImpl
+	extraPostgresFlagsFunc = extraPostgresFlagsFuncImpl
+	extraPostgresFlagsHandlingFunc = extraPostgresFlagsHandlingFuncImpl
+}
+
+func extraPostgresActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create": {"default-port", "session-max-seconds", "session-connection-limit", "worker-filter", "ingress-worker-filter", "approval-required", "approver-id", "max-concurrent-sessions"},
+		"update": {"default-port", "session-max-seconds", "session-connection-limit", "worker-filter", "ingress-worker-filter", "approval-required", "approver-id", "max-concurrent-sessions"},
+	}
+}
+
+type extraPostgresCmdVars struct {
+	flagDefaultPort            string
+	flagSessionMaxSeconds      string
+	flagSessionConnectionLimit string
+	flagWorkerFilter           string
+	flagIngressWorkerFilter    string
+	flagApprovalRequired       string
+	flagApproverIds            []string
+	flagMaxConcurrentSessions  string
+}
+
+func (c *PostgresCommand) extraPostgresHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary targets create postgres [options] [args]",
+			"",
+			"  Create a postgres-type target. Example:",
+			"",
+			`    $ boundary targets create postgres -name prodops -description "PostgreSQL target for ProdOps"`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary targets update postgres [options] [args]",
+			"",
+			"  Update a postgres-type target given its ID. Example:",
+			"",
+			`    $ boundary targets update postgres -id tpg_1234567890 -name "devops" -description "PostgreSQL target for DevOps"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraPostgresFlagsFuncImpl(c *PostgresCommand, set *base.FlagSets, f *base.FlagSet) {
+	fs := set.NewFlagSet("PostgreSQL Target Options")
+
+	for _, name := range flagsPostgresMap[c.Func] {
+		switch name {
+		case "default-port":
+			fs.StringVar(&base.StringVar{
+				Name:   "default-port",
+				Target: &c.flagDefaultPort,
+				Usage:  "The default port to set on the target.",
+			})
+		case "session-max-seconds":
+			fs.StringVar(&base.StringVar{
+				Name:   "session-max-seconds",
+				Target: &c.flagSessionMaxSeconds,
+				Usage:  `The maximum lifetime of the session, including all connections. Can be specified as an integer number of seconds or a duration string.`,
+			})
+		case "session-connection-limit":
+			fs.StringVar(&base.StringVar{
+				Name:   "session-connection-limit",
+				Target: &c.flagSessionConnectionLimit,
+				Usage:  "The maximum number of connections allowed for a session. -1 means unlimited.",
+			})
+		case "worker-filter":
+			fs.StringVar(&base.StringVar{
+				Name:   "worker-filter",
+				Target: &c.flagWorkerFilter,
+				Usage:  "A boolean expression to filter which workers can handle sessions for this target.",
+			})
+		case "ingress-worker-filter":
+			fs.StringVar(&base.StringVar{
+				Name:   "ingress-worker-filter",
+				Target: &c.flagIngressWorkerFilter,
+				Usage:  "A boolean expression to filter which workers clients connect to for sessions for this target. These workers forward connections to a worker matching the worker filter.",
+			})
+		case "approval-required":
+			fs.StringVar(&base.StringVar{
+				Name:   "approval-required",
+				Target: &c.flagApprovalRequired,
+				Usage:  "Whether sessions for this target must be approved before they can be activated.",
+			})
+		case "approver-id":
+			fs.StringSliceVar(&base.StringSliceVar{
+				Name:   "approver-id",
+				Target: &c.flagApproverIds,
+				Usage:  "The ID of a user or group which can approve or deny sessions for this target. May be specified multiple times.",
+			})
+		case "max-concurrent-sessions":
+			fs.StringVar(&base.StringVar{
+				Name:   "max-concurrent-sessions",
+				Target: &c.flagMaxConcurrentSessions,
+				Usage:  "The maximum number of sessions which can be open for this target at the same time. -1 means unlimited.",
+			})
+		}
+	}
+}
+
+func extraPostgresFlagsHandlingFuncImpl(c *PostgresCommand, _ *base.FlagSets, opts *[]targets.Option) bool {
+	switch c.flagDefaultPort {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultPostgresTargetDefaultPort())
+	default:
+		port, err := strconv.ParseUint(c.flagDefaultPort, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagDefaultPort, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithPostgresTargetDefaultPort(uint32(port)))
+	}
+
+	switch c.flagSessionMaxSeconds {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSessionMaxSeconds())
+	default:
+		var final uint32
+		dur, err := strconv.ParseUint(c.flagSessionMaxSeconds, 10, 32)
+		if err == nil {
+			final = uint32(dur)
+		} else {
+			dur, err := time.ParseDuration(c.flagSessionMaxSeconds)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSessionMaxSeconds, err))
+				return false
+			}
+			final = uint32(dur.Seconds())
+		}
+		*opts = append(*opts, targets.WithSessionMaxSeconds(final))
+	}
+
+	switch c.flagSessionConnectionLimit {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSessionConnectionLimit())
+	default:
+		limit, err := strconv.ParseInt(c.flagSessionConnectionLimit, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSessionConnectionLimit, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithSessionConnectionLimit(int32(limit)))
+	}
+
+	switch c.flagWorkerFilter {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultWorkerFilter())
+	default:
+		if _, err := bexpr.CreateEvaluator(c.flagWorkerFilter); err != nil {
+			c.UI.Error(fmt.Sprintf("Unable to successfully parse filter expression: %s", err))
+			return false
+		}
+		*opts = append(*opts, targets.WithWorkerFilter(c.flagWorkerFilter))
+	}
+
+	switch c.flagIngressWorkerFilter {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultIngressWorkerFilter())
+	default:
+		if _, err := bexpr.CreateEvaluator(c.flagIngressWorkerFilter); err != nil {
+			c.UI.Error(fmt.Sprintf("Unable to successfully parse ingress filter expression: %s", err))
+			return false
+		}
+		*opts = append(*opts, targets.WithIngressWorkerFilter(c.flagIngressWorkerFilter))
+	}
+
+	switch c.flagApprovalRequired {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultApprovalRequired())
+	default:
+		required, err := strconv.ParseBool(c.flagApprovalRequired)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagApprovalRequired, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithApprovalRequired(required))
+	}
+
+	switch len(c.flagApproverIds) {
+	case 0:
+	case 1:
+		if c.flagApproverIds[0] == "null" {
+			*opts = append(*opts, targets.DefaultApproverIds())
+			break
+		}
+		fallthrough
+	default:
+		*opts = append(*opts, targets.WithApproverIds(c.flagApproverIds))
+	}
+
+	switch c.flagMaxConcurrentSessions {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultMaxConcurrentSessions())
+	default:
+		limit, err := strconv.ParseInt(c.flagMaxConcurrentSessions, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagMaxConcurrentSessions, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithMaxConcurrentSessions(int32(limit)))
+	}
+
+	return true
+}
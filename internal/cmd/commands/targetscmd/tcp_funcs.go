@@ -18,8 +18,8 @@ func init() {
 
 func extraTcpActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"create": {"default-port", "session-max-seconds", "session-connection-limit", "worker-filter"},
-		"update": {"default-port", "session-max-seconds", "session-connection-limit", "worker-filter"},
+		"create": {"default-port", "session-max-seconds", "session-connection-limit", "worker-filter", "ingress-worker-filter", "approval-required", "approver-id", "max-concurrent-sessions"},
+		"update": {"default-port", "session-max-seconds", "session-connection-limit", "worker-filter", "ingress-worker-filter", "approval-required", "approver-id", "max-concurrent-sessions"},
 	}
 }
 
@@ -28,6 +28,10 @@ type extraTcpCmdVars struct {
 	flagSessionMaxSeconds      string
 	flagSessionConnectionLimit string
 	flagWorkerFilter           string
+	flagIngressWorkerFilter    string
+	flagApprovalRequired       string
+	flagApproverIds            []string
+	flagMaxConcurrentSessions  string
 }
 
 func (c *TcpCommand) extraTcpHelpFunc(helpMap map[string]func() string) string {
@@ -87,6 +91,30 @@ func extraTcpFlagsFuncImpl(c *TcpCommand, set *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagWorkerFilter,
 				Usage:  "A boolean expression to filter which workers can handle sessions for this target.",
 			})
+		case "ingress-worker-filter":
+			fs.StringVar(&base.StringVar{
+				Name:   "ingress-worker-filter",
+				Target: &c.flagIngressWorkerFilter,
+				Usage:  "A boolean expression to filter which workers clients connect to for sessions for this target. These workers forward connections to a worker matching the worker filter.",
+			})
+		case "approval-required":
+			fs.StringVar(&base.StringVar{
+				Name:   "approval-required",
+				Target: &c.flagApprovalRequired,
+				Usage:  "Whether sessions for this target must be approved before they can be activated.",
+			})
+		case "approver-id":
+			fs.StringSliceVar(&base.StringSliceVar{
+				Name:   "approver-id",
+				Target: &c.flagApproverIds,
+				Usage:  "The ID of a user or group which can approve or deny sessions for this target. May be specified multiple times.",
+			})
+		case "max-concurrent-sessions":
+			fs.StringVar(&base.StringVar{
+				Name:   "max-concurrent-sessions",
+				Target: &c.flagMaxConcurrentSessions,
+				Usage:  "The maximum number of sessions which can be open for this target at the same time. -1 means unlimited.",
+			})
 		}
 	}
 }
@@ -150,5 +178,55 @@ func extraTcpFlagsHandlingFuncImpl(c *TcpCommand, _ *base.FlagSets, opts *[]targ
 		*opts = append(*opts, targets.WithWorkerFilter(c.flagWorkerFilter))
 	}
 
+	switch c.flagIngressWorkerFilter {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultIngressWorkerFilter())
+	default:
+		if _, err := bexpr.CreateEvaluator(c.flagIngressWorkerFilter); err != nil {
+			c.UI.Error(fmt.Sprintf("Unable to successfully parse ingress filter expression: %s", err))
+			return false
+		}
+		*opts = append(*opts, targets.WithIngressWorkerFilter(c.flagIngressWorkerFilter))
+	}
+
+	switch c.flagApprovalRequired {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultApprovalRequired())
+	default:
+		required, err := strconv.ParseBool(c.flagApprovalRequired)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagApprovalRequired, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithApprovalRequired(required))
+	}
+
+	switch len(c.flagApproverIds) {
+	case 0:
+	case 1:
+		if c.flagApproverIds[0] == "null" {
+			*opts = append(*opts, targets.DefaultApproverIds())
+			break
+		}
+		fallthrough
+	default:
+		*opts = append(*opts, targets.WithApproverIds(c.flagApproverIds))
+	}
+
+	switch c.flagMaxConcurrentSessions {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultMaxConcurrentSessions())
+	default:
+		limit, err := strconv.ParseInt(c.flagMaxConcurrentSessions, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagMaxConcurrentSessions, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithMaxConcurrentSessions(int32(limit)))
+	}
+
 	return true
 }
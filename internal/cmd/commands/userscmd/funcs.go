@@ -2,6 +2,7 @@ package userscmd
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -21,6 +22,7 @@ func init() {
 
 type extraCmdVars struct {
 	flagAccounts []string
+	flagDisabled string
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
@@ -28,6 +30,8 @@ func extraActionsFlagsMapFuncImpl() map[string][]string {
 		"add-accounts":    {"id", "account", "version"},
 		"set-accounts":    {"id", "account", "version"},
 		"remove-accounts": {"id", "account", "version"},
+		"create":          {"disabled"},
+		"update":          {"disabled"},
 	}
 }
 
@@ -100,6 +104,12 @@ func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagAccounts,
 				Usage:  "The accounts to add, remove, or set. May be specified multiple times.",
 			})
+		case "disabled":
+			f.StringVar(&base.StringVar{
+				Name:   "disabled",
+				Target: &c.flagDisabled,
+				Usage:  "Whether the user is disabled. A disabled user cannot authenticate, and disabling a user revokes its auth tokens.",
+			})
 		}
 	}
 }
@@ -124,6 +134,19 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]users.Opti
 		}
 	}
 
+	switch c.flagDisabled {
+	case "":
+	case "null":
+		*opts = append(*opts, users.DefaultDisabled())
+	default:
+		disabled, err := strconv.ParseBool(c.flagDisabled)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagDisabled, err))
+			return false
+		}
+		*opts = append(*opts, users.WithDisabled(disabled))
+	}
+
 	return true
 }
 
@@ -203,6 +226,11 @@ func (c *Command) printListTable(items []*users.User) string {
 				fmt.Sprintf("    Email:               %s", item.Email),
 			)
 		}
+		if item.Disabled {
+			output = append(output,
+				fmt.Sprintf("    Disabled:            %t", item.Disabled),
+			)
+		}
 
 		if len(item.AuthorizedActions) > 0 {
 			output = append(output,
@@ -248,6 +276,9 @@ func printItemTable(result api.GenericResult) string {
 	if item.Email != "" {
 		nonAttributeMap["Email"] = item.Email
 	}
+	if item.Disabled {
+		nonAttributeMap["Disabled"] = item.Disabled
+	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
 
@@ -0,0 +1,229 @@
+package workerscmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/workers"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+func init() {
+	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
+	extraFlagsFunc = extraFlagsFuncImpl
+	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
+}
+
+type extraCmdVars struct {
+	flagTags []string
+}
+
+func extraActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"update": {"tag"},
+	}
+}
+
+func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary workers [sub command] [options] [args]",
+			"",
+			"  This command allows operations on Boundary worker resources. Workers are added when they first report their status to a controller. Example:",
+			"",
+			"    Read a worker:",
+			"",
+			`      $ boundary workers read -id w_1234567890`,
+			"",
+			"  Please see the workers subcommand help for detailed usage information.",
+		})
+
+	default:
+		helpStr = helpMap["base"]()
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
+	for _, name := range flagsMap[c.Func] {
+		switch name {
+		case "tag":
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   "tag",
+				Target: &c.flagTags,
+				Usage:  `A tag of the worker, in the form "key=value". May be specified multiple times to set the full set of tags, or set to "null" to remove all tags. The tags are replaced by the tags in the configuration of the worker the next time it starts.`,
+			})
+		}
+	}
+}
+
+func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]workers.Option) bool {
+	switch c.Func {
+	case "update":
+		switch len(c.flagTags) {
+		case 0:
+		case 1:
+			if c.flagTags[0] == "null" {
+				*opts = append(*opts, workers.DefaultConfigTags())
+				break
+			}
+			fallthrough
+		default:
+			tags := make(map[string][]string, len(c.flagTags))
+			for _, t := range c.flagTags {
+				kv := strings.SplitN(t, "=", 2)
+				if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" {
+					c.UI.Error(fmt.Sprintf("Tag %q is not in the form key=value", t))
+					return false
+				}
+				key := strings.TrimSpace(kv[0])
+				tags[key] = append(tags[key], strings.TrimSpace(kv[1]))
+			}
+			*opts = append(*opts, workers.WithConfigTags(tags))
+		}
+	}
+
+	return true
+}
+
+func (c *Command) printListTable(items []*workers.Worker) string {
+	if len(items) == 0 {
+		return "No workers found"
+	}
+
+	var output []string
+	output = []string{
+		"",
+		"Worker information:",
+	}
+
+	for i, item := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		if item.Id != "" {
+			output = append(output,
+				fmt.Sprintf("  ID:                       %s", item.Id),
+			)
+		} else {
+			output = append(output,
+				fmt.Sprintf("  ID:                       %s", "(not available)"),
+			)
+		}
+		if item.Version > 0 {
+			output = append(output,
+				fmt.Sprintf("    Version:                %d", item.Version),
+			)
+		}
+		if item.Name != "" {
+			output = append(output,
+				fmt.Sprintf("    Name:                   %s", item.Name),
+			)
+		}
+		if item.Description != "" {
+			output = append(output,
+				fmt.Sprintf("    Description:            %s", item.Description),
+			)
+		}
+		if item.Address != "" {
+			output = append(output,
+				fmt.Sprintf("    Address:                %s", item.Address),
+			)
+		}
+		if !item.LastStatusTime.IsZero() {
+			output = append(output,
+				fmt.Sprintf("    Last Status Time:       %s", item.LastStatusTime.Local().Format(time.RFC1123)),
+			)
+		}
+		output = append(output,
+			fmt.Sprintf("    Active Session Count:   %d", item.ActiveSessionCount),
+		)
+
+		if len(item.AuthorizedActions) > 0 {
+			output = append(output,
+				"    Authorized Actions:",
+				base.WrapSlice(6, item.AuthorizedActions),
+			)
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
+
+func printItemTable(result api.GenericResult) string {
+	item := result.GetItem().(*workers.Worker)
+	nonAttributeMap := map[string]interface{}{}
+	if item.Id != "" {
+		nonAttributeMap["ID"] = item.Id
+	}
+	if item.Version != 0 {
+		nonAttributeMap["Version"] = item.Version
+	}
+	if !item.CreatedTime.IsZero() {
+		nonAttributeMap["Created Time"] = item.CreatedTime.Local().Format(time.RFC1123)
+	}
+	if !item.UpdatedTime.IsZero() {
+		nonAttributeMap["Updated Time"] = item.UpdatedTime.Local().Format(time.RFC1123)
+	}
+	if item.Name != "" {
+		nonAttributeMap["Name"] = item.Name
+	}
+	if item.Description != "" {
+		nonAttributeMap["Description"] = item.Description
+	}
+	if item.Address != "" {
+		nonAttributeMap["Address"] = item.Address
+	}
+	if !item.LastStatusTime.IsZero() {
+		nonAttributeMap["Last Status Time"] = item.LastStatusTime.Local().Format(time.RFC1123)
+	}
+	nonAttributeMap["Active Session Count"] = item.ActiveSessionCount
+
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+
+	ret := []string{
+		"",
+		"Worker information:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+
+	if item.Scope != nil {
+		ret = append(ret,
+			"",
+			"  Scope:",
+			base.ScopeInfoForOutput(item.Scope, maxLength),
+		)
+	}
+
+	if len(item.AuthorizedActions) > 0 {
+		ret = append(ret,
+			"",
+			"  Authorized Actions:",
+			base.WrapSlice(4, item.AuthorizedActions),
+		)
+	}
+
+	if len(item.ConfigTags) > 0 {
+		keys := make([]string, 0, len(item.ConfigTags))
+		for k := range item.ConfigTags {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		ret = append(ret,
+			"",
+			"  Config Tags:",
+		)
+		for _, k := range keys {
+			ret = append(ret,
+				fmt.Sprintf("    %s: %s", k, strings.Join(item.ConfigTags[k], ", ")),
+			)
+		}
+	}
+
+	return base.WrapForHelpText(ret)
+}
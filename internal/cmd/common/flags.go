@@ -111,6 +111,12 @@ func PopulateCommonFlags(c *base.Command, f *base.FlagSet, resourceType string,
 					Target: &c.FlagFilter,
 					Usage:  "If set, the list operation will be filtered before being returned. The filter operates against each item in the list. Using single quotes is recommended as filters contain double quotes. See https://www.boundaryproject.io/docs/concepts/filtering/resource-listing for details.",
 				})
+			case "page-size":
+				f.UintVar(&base.UintVar{
+					Name:   "page-size",
+					Target: &c.FlagPageSize,
+					Usage:  "If set, items are requested from the server in pages of at most this many items. All pages are still read and output.",
+				})
 			}
 		}
 	}
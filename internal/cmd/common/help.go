@@ -36,8 +36,10 @@ func HelpMap(resType string) map[string]func() string {
 		resource.HostCatalog.String(): "hc",
 		resource.HostSet.String():     "hs",
 		resource.Host.String():        "h",
+		resource.Plugin.String():      "pl",
 		resource.Session.String():     "s",
 		resource.Target.String():      "t",
+		resource.Worker.String():      "w",
 	}
 	return map[string]func() string{
 		"base": func() string {
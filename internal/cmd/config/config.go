@@ -3,7 +3,9 @@ package config
 import (
 	"bytes"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -12,6 +14,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/types/scope"
 	wrapping "github.com/hashicorp/go-kms-wrapping"
 	"github.com/hashicorp/go-secure-stdlib/base62"
 	"github.com/hashicorp/go-secure-stdlib/configutil"
@@ -137,6 +140,21 @@ type Controller struct {
 	//
 	// TODO: This field is currently internal.
 	SchedulerRunJobInterval time.Duration `hcl:"-"`
+
+	// SessionRecording configures the storage recordings are read from when
+	// they are requested through the API.
+	SessionRecording *SessionRecording `hcl:"session_recording"`
+
+	// HostInventoryDir is the directory the inventory files of catalogs using
+	// the built-in file host plugin must be located in. If not set, the
+	// plugin rejects every catalog.
+	HostInventoryDir string `hcl:"host_inventory_dir"`
+
+	// Scim enables the SCIM provisioning endpoint on the API listeners. Each
+	// block grants a bearer token access to the users and groups of a scope.
+	// The blocks are decoded by parseScim as HCL merges the attributes of
+	// repeated unlabeled blocks when decoding them into a slice.
+	Scim []*Scim `hcl:"-"`
 }
 
 func (c *Controller) InitNameIfEmpty() (string, error) {
@@ -167,6 +185,16 @@ type Worker struct {
 	//
 	// TODO: This field is currently internal.
 	StatusGracePeriodDuration time.Duration `hcl:"-"`
+
+	// SessionRecording enables the recording of proxied connections and
+	// configures the storage the recordings are written to.
+	SessionRecording *SessionRecording `hcl:"session_recording"`
+
+	// Reverse makes the worker keep tunnels open to the controllers instead
+	// of accepting connections at its public address. Client connections are
+	// relayed to it through the tunnels by ingress workers, so it can run
+	// behind NAT.
+	Reverse bool `hcl:"reverse"`
 }
 
 func (w *Worker) InitNameIfEmpty() (string, error) {
@@ -195,8 +223,56 @@ type Database struct {
 	MaxOpenConnections int    `hcl:"max_open_connections"`
 }
 
+// SessionRecording configures where session recordings are stored. Workers
+// write recordings and controllers read them, so both must be configured to
+// use the same storage.
+type SessionRecording struct {
+	// StorageType is the type of storage backend. Currently only "local" is
+	// supported, which is also the default.
+	StorageType string `hcl:"storage_type"`
+
+	// StoragePath is the location recordings are stored in. For local storage
+	// this is a directory.
+	StoragePath string `hcl:"storage_path"`
+}
+
+// Scim configures a bearer token an identity provider uses to provision the
+// users and groups of a scope through the SCIM endpoint.
+type Scim struct {
+	// ScopeId is the global or org scope the token provisions users and
+	// groups in.
+	ScopeId string `hcl:"scope_id"`
+
+	// Token is the bearer token. It may refer to an environment variable or
+	// file using the env:// or file:// syntax.
+	Token string `hcl:"token"`
+}
+
 type Plugins struct {
 	ExecutionDir string `hcl:"execution_dir"`
+
+	// Host contains the external host plugins to load from ExecutionDir in
+	// addition to the built-in ones.
+	Host []*HostPlugin `hcl:"host"`
+}
+
+// HostPlugin configures an external host plugin. The plugin binary must be
+// named boundary-plugin-host-<name>, optionally gzip compressed with a .gz
+// suffix, and be located in the plugin execution directory.
+type HostPlugin struct {
+	Name string `hcl:",key"`
+
+	// Sha256 is the hex-encoded SHA-256 checksum of the uncompressed plugin
+	// binary. The plugin is not executed if the binary does not match it.
+	Sha256    string `hcl:"sha256"`
+	Sha256Sum []byte `hcl:"-"`
+
+	// Version is the version of the plugin shown when reading it.
+	Version string `hcl:"version"`
+
+	// CatalogAttributes are the names of the attributes supported by host
+	// catalogs using the plugin.
+	CatalogAttributes []string `hcl:"catalog_attributes"`
 }
 
 // DevWorker is a Config that is used for dev mode of Boundary
@@ -323,6 +399,28 @@ func Parse(d string) (*Config, error) {
 			}
 			result.Controller.AuthTokenTimeToStaleDuration = t
 		}
+
+		if result.Controller.Scim, err = parseScim(obj); err != nil {
+			return nil, err
+		}
+		tokens := make(map[string]bool, len(result.Controller.Scim))
+		for _, sc := range result.Controller.Scim {
+			if sc.ScopeId != scope.Global.String() && !strings.HasPrefix(sc.ScopeId, scope.Org.Prefix()+"_") {
+				return nil, fmt.Errorf("SCIM scope_id %q must be %q or an org scope id", sc.ScopeId, scope.Global.String())
+			}
+			sc.Token, err = parseutil.ParsePath(sc.Token)
+			if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+				return nil, fmt.Errorf("Error parsing SCIM token: %w", err)
+			}
+			sc.Token = strings.TrimSpace(sc.Token)
+			if sc.Token == "" {
+				return nil, fmt.Errorf("SCIM token for scope %q is empty", sc.ScopeId)
+			}
+			if tokens[sc.Token] {
+				return nil, errors.New("SCIM tokens must be unique")
+			}
+			tokens[sc.Token] = true
+		}
 	}
 
 	// Parse worker tags
@@ -392,6 +490,31 @@ func Parse(d string) (*Config, error) {
 		}
 	}
 
+	// Validate external host plugins
+	if len(result.Plugins.Host) > 0 && result.Plugins.ExecutionDir == "" {
+		return nil, errors.New("External host plugins require the plugins execution_dir to be set")
+	}
+	hostPluginNames := make(map[string]bool, len(result.Plugins.Host))
+	for _, hp := range result.Plugins.Host {
+		if hp.Name == "" {
+			return nil, errors.New("Host plugin name must not be empty")
+		}
+		if hp.Name != strings.ToLower(hp.Name) {
+			return nil, fmt.Errorf("Host plugin name %q must be all lower-case", hp.Name)
+		}
+		if hostPluginNames[hp.Name] {
+			return nil, fmt.Errorf("Host plugin %q is configured more than once", hp.Name)
+		}
+		hostPluginNames[hp.Name] = true
+		if hp.Sha256 == "" {
+			return nil, fmt.Errorf("Host plugin %q is missing its sha256 checksum", hp.Name)
+		}
+		hp.Sha256Sum, err = hex.DecodeString(hp.Sha256)
+		if err != nil || len(hp.Sha256Sum) != sha256.Size {
+			return nil, fmt.Errorf("Host plugin %q sha256 checksum must be %d hex-encoded bytes", hp.Name, sha256.Size)
+		}
+	}
+
 	sharedConfig, err := configutil.ParseConfig(d)
 	if err != nil {
 		return nil, err
@@ -441,6 +564,29 @@ func Parse(d string) (*Config, error) {
 	return result, nil
 }
 
+// parseScim decodes the scim blocks of the controller block.
+func parseScim(obj *ast.File) ([]*Scim, error) {
+	list, ok := obj.Node.(*ast.ObjectList)
+	if !ok {
+		return nil, fmt.Errorf("error parsing: file doesn't contain a root object")
+	}
+	var result []*Scim
+	for _, controllerObj := range list.Filter("controller").Items {
+		controllerObjType, ok := controllerObj.Val.(*ast.ObjectType)
+		if !ok {
+			return nil, fmt.Errorf(`error interpreting "controller" node as an object type`)
+		}
+		for i, item := range controllerObjType.List.Filter("scim").Items {
+			sc := new(Scim)
+			if err := hcl.DecodeObject(sc, item.Val); err != nil {
+				return nil, fmt.Errorf(`error decoding "scim" entry %d: %w`, i, err)
+			}
+			result = append(result, sc)
+		}
+	}
+	return result, nil
+}
+
 func parseEventing(eventObj *ast.ObjectItem) (*event.EventerConfig, error) {
 	// Decode the outside struct
 	var result event.EventerConfig
@@ -474,6 +620,10 @@ func parseEventing(eventObj *ast.ObjectItem) (*event.EventerConfig, error) {
 				s.Type = event.StderrSink
 			case s.FileConfig != nil:
 				s.Type = event.FileSink
+			case s.SyslogConfig != nil:
+				s.Type = event.SyslogSink
+			case s.WebhookConfig != nil:
+				s.Type = event.WebhookSink
 			default:
 				return nil, fmt.Errorf("sink type could not be determined")
 			}
@@ -495,6 +645,24 @@ func parseEventing(eventObj *ast.ObjectItem) (*event.EventerConfig, error) {
 			}
 		}
 
+		// parse the duration strings specified in a webhook config into
+		// time.Durations
+		if s.WebhookConfig != nil {
+			var err error
+			if s.WebhookConfig.FlushIntervalHCL != "" {
+				s.WebhookConfig.FlushInterval, err = parseutil.ParseDurationSecond(s.WebhookConfig.FlushIntervalHCL)
+				if err != nil {
+					return nil, fmt.Errorf("can't parse webhook flush interval %s", s.WebhookConfig.FlushIntervalHCL)
+				}
+			}
+			if s.WebhookConfig.TimeoutHCL != "" {
+				s.WebhookConfig.Timeout, err = parseutil.ParseDurationSecond(s.WebhookConfig.TimeoutHCL)
+				if err != nil {
+					return nil, fmt.Errorf("can't parse webhook timeout %s", s.WebhookConfig.TimeoutHCL)
+				}
+			}
+		}
+
 		if err := s.Validate(); err != nil {
 			return nil, err
 		}
@@ -1,8 +1,10 @@
 package config
 
 import (
+	"bytes"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -152,6 +154,61 @@ func TestDevController(t *testing.T) {
 		actual, err = Parse(conf)
 		assert.NoError(t, err)
 		assert.Equal(t, actual.Plugins.ExecutionDir, "/tmp/foobar")
+		assert.Empty(t, actual.Plugins.Host)
+	}
+
+	// Test external host plugins
+	{
+		sum := strings.Repeat("ab", 32)
+		conf := fmt.Sprintf(`
+		plugins {
+			execution_dir = "/tmp/foobar"
+			host "gcp" {
+				sha256 = "%s"
+				version = "0.1.0"
+				catalog_attributes = ["project", "zone"]
+			}
+		}
+		`, sum)
+		actual, err = Parse(conf)
+		require.NoError(t, err)
+		require.Len(t, actual.Plugins.Host, 1)
+		hp := actual.Plugins.Host[0]
+		assert.Equal(t, "gcp", hp.Name)
+		assert.Equal(t, sum, hp.Sha256)
+		assert.Equal(t, bytes.Repeat([]byte{0xab}, 32), hp.Sha256Sum)
+		assert.Equal(t, "0.1.0", hp.Version)
+		assert.Equal(t, []string{"project", "zone"}, hp.CatalogAttributes)
+
+		// Missing execution dir
+		_, err = Parse(fmt.Sprintf(`
+		plugins {
+			host "gcp" {
+				sha256 = "%s"
+			}
+		}
+		`, sum))
+		assert.Error(t, err)
+
+		// Missing checksum
+		_, err = Parse(`
+		plugins {
+			execution_dir = "/tmp/foobar"
+			host "gcp" {}
+		}
+		`)
+		assert.Error(t, err)
+
+		// Short checksum
+		_, err = Parse(`
+		plugins {
+			execution_dir = "/tmp/foobar"
+			host "gcp" {
+				sha256 = "abab"
+			}
+		}
+		`)
+		assert.Error(t, err)
 	}
 }
 
@@ -337,6 +394,93 @@ func TestParsingName(t *testing.T) {
 	}
 }
 
+func TestParsingSessionRecording(t *testing.T) {
+	t.Parallel()
+	config := `
+	controller {
+		name = "controller"
+		session_recording {
+			storage_path = "/var/lib/boundary/recordings"
+		}
+	}
+	worker {
+		name = "worker"
+		session_recording {
+			storage_type = "local"
+			storage_path = "/var/lib/boundary/recordings"
+		}
+	}
+	`
+	out, err := Parse(config)
+	require.NoError(t, err)
+	require.NotNil(t, out.Controller.SessionRecording)
+	assert.Equal(t, &SessionRecording{StoragePath: "/var/lib/boundary/recordings"}, out.Controller.SessionRecording)
+	require.NotNil(t, out.Worker.SessionRecording)
+	assert.Equal(t, &SessionRecording{StorageType: "local", StoragePath: "/var/lib/boundary/recordings"}, out.Worker.SessionRecording)
+}
+
+func TestParsingScim(t *testing.T) {
+	config := `
+	controller {
+		name = "controller"
+		scim {
+			scope_id = "o_1234567890"
+			token = "env://BOUNDARY_TEST_SCIM_TOKEN"
+		}
+		scim {
+			scope_id = "global"
+			token = "global-token"
+		}
+	}
+	`
+	t.Setenv("BOUNDARY_TEST_SCIM_TOKEN", "org-token")
+	out, err := Parse(config)
+	require.NoError(t, err)
+	assert.Equal(t, []*Scim{
+		{ScopeId: "o_1234567890", Token: "org-token"},
+		{ScopeId: "global", Token: "global-token"},
+	}, out.Controller.Scim)
+
+	_, err = Parse(`
+	controller {
+		name = "controller"
+		scim {
+			scope_id = "p_1234567890"
+			token = "project-token"
+		}
+	}
+	`)
+	assert.Error(t, err)
+
+	_, err = Parse(`
+	controller {
+		name = "controller"
+		scim {
+			scope_id = "global"
+			token = "same-token"
+		}
+		scim {
+			scope_id = "o_1234567890"
+			token = "same-token"
+		}
+	}
+	`)
+	assert.Error(t, err)
+}
+
+func TestParsingReverse(t *testing.T) {
+	t.Parallel()
+	config := `
+	worker {
+		name = "worker"
+		reverse = true
+	}
+	`
+	out, err := Parse(config)
+	require.NoError(t, err)
+	assert.True(t, out.Worker.Reverse)
+}
+
 func TestController_EventingConfig(t *testing.T) {
 	t.Parallel()
 
@@ -505,6 +649,88 @@ func TestController_EventingConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "syslog-and-webhook-sinks-configured",
+			config: []string{
+				`events {
+				audit_enabled = true
+				sink "syslog" {
+					format = "cloudevents-json"
+					name = "syslog-sink"
+					event_types = [ "audit" ]
+					syslog {
+						network = "udp"
+						address = "127.0.0.1:514"
+						facility = "local0"
+					}
+				}
+				sink {
+					format = "hclog-json"
+					name = "webhook-sink"
+					event_types = [ "audit" ]
+					webhook {
+						url = "https://events.example.com/boundary"
+						headers = {
+							Authorization = "Bearer token"
+						}
+						batch_size = 10
+						flush_interval = "30s"
+						timeout = "5s"
+						max_retries = 0
+					}
+				}
+			}`,
+			},
+			wantEventerConfig: &event.EventerConfig{
+				AuditEnabled: true,
+				Sinks: []*event.SinkConfig{
+					{
+						Type:       "syslog",
+						Name:       "syslog-sink",
+						Format:     "cloudevents-json",
+						EventTypes: []event.Type{"audit"},
+						SyslogConfig: &event.SyslogSinkTypeConfig{
+							Network:  "udp",
+							Address:  "127.0.0.1:514",
+							Facility: "local0",
+						},
+					},
+					{
+						Type:       "webhook",
+						Name:       "webhook-sink",
+						Format:     "hclog-json",
+						EventTypes: []event.Type{"audit"},
+						WebhookConfig: &event.WebhookSinkTypeConfig{
+							Url:              "https://events.example.com/boundary",
+							Headers:          map[string]string{"Authorization": "Bearer token"},
+							BatchSize:        10,
+							FlushIntervalHCL: "30s",
+							FlushInterval:    30 * time.Second,
+							TimeoutHCL:       "5s",
+							Timeout:          5 * time.Second,
+							MaxRetries:       func() *int { i := 0; return &i }(),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "webhook-sink-invalid-flush-interval",
+			config: []string{
+				`events {
+				sink "webhook" {
+					format = "cloudevents-json"
+					name = "webhook-sink"
+					event_types = [ "audit" ]
+					webhook {
+						url = "https://events.example.com/boundary"
+						flush_interval = "soon"
+					}
+				}
+			}`,
+			},
+			wantErr: `error parsing "events": can't parse webhook flush interval soon`,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
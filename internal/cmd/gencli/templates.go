@@ -185,7 +185,7 @@ var flags{{ camelCase .SubActionPrefix }}Map = map[string][]string{
 	"delete": {"id"},
 	{{ end }}
 	{{ if eq $action "list" }}
-	"list": { "{{ kebabCase $input.Container }}-id", "filter" {{ if (eq $input.Container "Scope") }}, "recursive"{{ end }} },
+	"list": { "{{ kebabCase $input.Container }}-id", "filter" {{ if (eq $input.Container "Scope") }}, "recursive"{{ end }} {{ if $input.PaginatedListing }}, "page-size"{{ end }} },
 	{{ end }}
 	{{ end }}
 	{{ end }}
@@ -314,6 +314,12 @@ func (c *{{ camelCase .SubActionPrefix }}Command) Run(args []string) int {
 		opts = append(opts, {{ .Pkg }}.WithFilter(c.FlagFilter))
 	}
 
+	{{ if .PaginatedListing }}
+	if c.FlagPageSize != 0 {
+		opts = append(opts, {{ .Pkg }}.WithPageSize(uint32(c.FlagPageSize)))
+	}
+	{{ end }}
+
 	{{ if .HasScopeName }}
 	switch c.FlagScopeName {
 	case "":
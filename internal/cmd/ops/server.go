@@ -0,0 +1,150 @@
+// Package ops provides the server for listeners with the "ops" purpose. Ops
+// listeners expose operational endpoints, such as Prometheus metrics, and are
+// shared by a controller and a worker running in the same process.
+package ops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/libs/alpnmux"
+	"github.com/hashicorp/boundary/internal/observability/metric"
+	"github.com/hashicorp/go-hclog"
+)
+
+// Server serves the ops endpoints on all of the ops listeners it was created
+// with.
+type Server struct {
+	bundles []*opsBundle
+}
+
+type opsBundle struct {
+	ln         *base.ServerListener
+	httpServer *http.Server
+	listeners  []net.Listener
+}
+
+// NewServer creates a Server for each listener with the "ops" purpose in
+// listeners. Listeners with any other purpose are ignored. The Server does not
+// serve requests until Start is called.
+func NewServer(l hclog.Logger, listeners ...*base.ServerListener) (*Server, error) {
+	const op = "ops.NewServer"
+	if l == nil {
+		return nil, fmt.Errorf("%s: missing logger", op)
+	}
+	s := &Server{}
+	for _, ln := range listeners {
+		if ln == nil || ln.Config == nil || len(ln.Config.Purpose) != 1 || ln.Config.Purpose[0] != "ops" {
+			continue
+		}
+		b, err := newOpsBundle(l, ln)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		s.bundles = append(s.bundles, b)
+	}
+	return s, nil
+}
+
+func newOpsBundle(l hclog.Logger, ln *base.ServerListener) (*opsBundle, error) {
+	server := &http.Server{
+		Handler:           NewHandler(),
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       30 * time.Second,
+		IdleTimeout:       5 * time.Minute,
+		ErrorLog:          l.StandardLogger(nil),
+	}
+	if ln.Config.HTTPReadHeaderTimeout > 0 {
+		server.ReadHeaderTimeout = ln.Config.HTTPReadHeaderTimeout
+	}
+	if ln.Config.HTTPReadTimeout > 0 {
+		server.ReadTimeout = ln.Config.HTTPReadTimeout
+	}
+	if ln.Config.HTTPWriteTimeout > 0 {
+		server.WriteTimeout = ln.Config.HTTPWriteTimeout
+	}
+	if ln.Config.HTTPIdleTimeout > 0 {
+		server.IdleTimeout = ln.Config.HTTPIdleTimeout
+	}
+
+	b := &opsBundle{
+		ln:         ln,
+		httpServer: server,
+	}
+	switch ln.Config.TLSDisable {
+	case true:
+		l, err := ln.Mux.RegisterProto(alpnmux.NoProto, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error getting non-tls listener: %w", err)
+		}
+		if l == nil {
+			return nil, errors.New("could not get non-tls listener")
+		}
+		b.listeners = append(b.listeners, l)
+
+	default:
+		for _, v := range []string{"", "http/1.1", "h2"} {
+			l := ln.Mux.GetListener(v)
+			if l == nil {
+				return nil, fmt.Errorf("could not get tls proto %q listener", v)
+			}
+			b.listeners = append(b.listeners, l)
+		}
+	}
+	return b, nil
+}
+
+// NewHandler returns the handler for the ops endpoints. Currently the only
+// endpoint is /metrics, which serves the Prometheus metrics of the controller
+// and worker.
+func NewHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metric.Handler())
+	return mux
+}
+
+// Start serves requests on all of the ops listeners.
+func (s *Server) Start() {
+	for _, b := range s.bundles {
+		for _, l := range b.listeners {
+			go b.httpServer.Serve(l)
+		}
+	}
+}
+
+// Shutdown gracefully shuts down the servers of all of the ops listeners.
+func (s *Server) Shutdown() error {
+	const op = "ops.(Server).Shutdown"
+	var wg sync.WaitGroup
+	errs := make([]error, len(s.bundles))
+	for i, b := range s.bundles {
+		i, b := i, b
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			timeout := b.ln.Config.MaxRequestDuration
+			if timeout == 0 {
+				timeout = globals.DefaultMaxRequestDuration
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+			if err := b.httpServer.Shutdown(ctx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				errs[i] = err
+			}
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package ops
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/libs/alpnmux"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-secure-stdlib/listenerutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHandler(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	rec := httptest.NewRecorder()
+	NewHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(http.StatusOK, rec.Code)
+	assert.Contains(rec.Body.String(), "boundary_worker_active_sessions")
+
+	rec = httptest.NewRecorder()
+	NewHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/scopes", nil))
+	assert.Equal(http.StatusNotFound, rec.Code)
+}
+
+func TestNewServer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing-logger", func(t *testing.T) {
+		_, err := NewServer(nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing logger")
+	})
+
+	t.Run("ignores-other-purposes", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		apiLn := testListener(t, "api")
+		s, err := NewServer(hclog.NewNullLogger(), apiLn, nil)
+		require.NoError(err)
+		assert.Empty(s.bundles)
+	})
+
+	t.Run("serves-metrics", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		opsLn := testListener(t, "ops")
+		s, err := NewServer(hclog.NewNullLogger(), testListener(t, "api"), opsLn)
+		require.NoError(err)
+		require.Len(s.bundles, 1)
+		s.Start()
+
+		resp, err := http.Get(fmt.Sprintf("http://%s/metrics", opsLn.Mux.Addr().String()))
+		require.NoError(err)
+		defer resp.Body.Close()
+		assert.Equal(http.StatusOK, resp.StatusCode)
+		body, err := ioutil.ReadAll(resp.Body)
+		require.NoError(err)
+		assert.Contains(string(body), "boundary_worker_active_sessions")
+
+		assert.NoError(s.Shutdown())
+	})
+}
+
+// testListener returns a tcp ServerListener with TLS disabled and the
+// provided purpose.
+func testListener(t *testing.T, purpose string) *base.ServerListener {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	mux := alpnmux.New(l)
+	t.Cleanup(func() { _ = mux.Close() })
+	return &base.ServerListener{
+		Mux: mux,
+		Config: &listenerutil.ListenerConfig{
+			Type:       "tcp",
+			Purpose:    []string{purpose},
+			TLSDisable: true,
+		},
+	}
+}
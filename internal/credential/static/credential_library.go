@@ -0,0 +1,209 @@
+package static
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/static/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-kms-wrapping/structwrapping"
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/protobuf/proto"
+)
+
+// A CredentialType is the type of credential provided by a static
+// credential library.
+type CredentialType string
+
+// Credential types provided by static credential libraries.
+const (
+	UsernamePasswordType CredentialType = "username_password"
+	SshPrivateKeyType    CredentialType = "ssh_private_key"
+	JsonType             CredentialType = "json"
+)
+
+// ValidCredentialTypes are the set of all CredentialTypes.
+var ValidCredentialTypes = []CredentialType{
+	UsernamePasswordType,
+	SshPrivateKeyType,
+	JsonType,
+}
+
+func (t CredentialType) String() string {
+	return string(t)
+}
+
+// A CredentialLibrary contains a single credential whose secret data is
+// stored encrypted in Boundary. It is owned by a credential store.
+type CredentialLibrary struct {
+	*store.CredentialLibrary
+	tableName string `gorm:"-"`
+}
+
+// NewCredentialLibrary creates a new in memory CredentialLibrary of
+// credentialType assigned to storeId. Name, description, username, and
+// the secret option matching credentialType are the only valid options:
+// WithPassword for UsernamePasswordType, WithPrivateKey for
+// SshPrivateKeyType, and WithObject for JsonType. All other options are
+// ignored.
+func NewCredentialLibrary(storeId string, credentialType CredentialType, opt ...Option) (*CredentialLibrary, error) {
+	const op = "static.NewCredentialLibrary"
+	opts := getOpts(opt...)
+
+	l := &CredentialLibrary{
+		CredentialLibrary: &store.CredentialLibrary{
+			StoreId:        storeId,
+			Name:           opts.withName,
+			Description:    opts.withDescription,
+			CredentialType: string(credentialType),
+			Username:       opts.withUsername,
+		},
+	}
+
+	switch credentialType {
+	case UsernamePasswordType:
+		if opts.withPassword != "" {
+			l.Secret = []byte(opts.withPassword)
+		}
+	case SshPrivateKeyType:
+		if len(opts.withPrivateKey) > 0 {
+			l.Secret = []byte(opts.withPrivateKey)
+		}
+	case JsonType:
+		if opts.withObject != nil {
+			object, err := json.Marshal(opts.withObject)
+			if err != nil {
+				return nil, errors.WrapDeprecated(err, op, errors.WithCode(errors.InvalidParameter))
+			}
+			l.Secret = object
+		}
+	}
+	return l, nil
+}
+
+func allocCredentialLibrary() *CredentialLibrary {
+	return &CredentialLibrary{
+		CredentialLibrary: &store.CredentialLibrary{},
+	}
+}
+
+func (l *CredentialLibrary) clone() *CredentialLibrary {
+	cp := proto.Clone(l.CredentialLibrary)
+	return &CredentialLibrary{
+		CredentialLibrary: cp.(*store.CredentialLibrary),
+	}
+}
+
+// validateSecret returns an error if the secret of l is not valid for the
+// credential type of l.
+func (l *CredentialLibrary) validateSecret(ctx context.Context) error {
+	const op = "static.(CredentialLibrary).validateSecret"
+	if len(l.Secret) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "no secret")
+	}
+	switch CredentialType(l.CredentialType) {
+	case SshPrivateKeyType:
+		if _, err := ssh.ParsePrivateKey(l.Secret); err != nil {
+			return errors.New(ctx, errors.InvalidParameter, op, "secret is not a valid ssh private key")
+		}
+	case JsonType:
+		var object map[string]interface{}
+		if err := json.Unmarshal(l.Secret, &object); err != nil || object == nil {
+			return errors.New(ctx, errors.InvalidParameter, op, "secret is not a json object")
+		}
+	}
+	return nil
+}
+
+// secretData returns the secret data of the credential provided by l. l
+// must have been decrypted.
+func (l *CredentialLibrary) secretData(ctx context.Context) (map[string]interface{}, error) {
+	const op = "static.(CredentialLibrary).secretData"
+	switch CredentialType(l.CredentialType) {
+	case UsernamePasswordType:
+		return map[string]interface{}{
+			"username": l.Username,
+			"password": string(l.Secret),
+		}, nil
+	case SshPrivateKeyType:
+		return map[string]interface{}{
+			"username":    l.Username,
+			"private_key": string(l.Secret),
+		}, nil
+	case JsonType:
+		var object map[string]interface{}
+		if err := json.Unmarshal(l.Secret, &object); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Decode))
+		}
+		return object, nil
+	default:
+		return nil, errors.New(ctx, errors.Internal, op, "unknown credential type")
+	}
+}
+
+func (l *CredentialLibrary) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(CredentialLibrary).encrypt"
+	if len(l.Secret) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "no secret defined")
+	}
+	if err := structwrapping.WrapStruct(ctx, cipher, l.CredentialLibrary, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	l.KeyId = cipher.KeyID()
+	if err := l.hmacSecret(ctx, cipher); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+func (l *CredentialLibrary) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(CredentialLibrary).decrypt"
+	if err := structwrapping.UnwrapStruct(ctx, cipher, l.CredentialLibrary, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
+
+func (l *CredentialLibrary) hmacSecret(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(CredentialLibrary).hmacSecret"
+	if cipher == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing cipher")
+	}
+	hm, err := crypto.HmacSha256(ctx, l.Secret, cipher, []byte(l.PublicId), nil)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	l.SecretHmac = []byte(hm)
+	return nil
+}
+
+// TableName returns the table name.
+func (l *CredentialLibrary) TableName() string {
+	if l.tableName != "" {
+		return l.tableName
+	}
+	return "credential_static_library"
+}
+
+// SetTableName sets the table name.
+func (l *CredentialLibrary) SetTableName(n string) {
+	l.tableName = n
+}
+
+func (l *CredentialLibrary) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{l.PublicId},
+		"resource-type":      []string{"credential-static-library"},
+		"op-type":            []string{op.String()},
+	}
+	if l.StoreId != "" {
+		metadata["store-id"] = []string{l.StoreId}
+	}
+	return metadata
+}
+
+var _ credential.Library = (*CredentialLibrary)(nil)
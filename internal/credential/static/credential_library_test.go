@@ -0,0 +1,83 @@
+package static
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCredentialLibrary_New(t *testing.T) {
+	t.Parallel()
+	key := TestSshPrivateKey(t)
+
+	tests := []struct {
+		name           string
+		credentialType CredentialType
+		opts           []Option
+		wantSecret     []byte
+		wantValid      bool
+		wantData       map[string]interface{}
+	}{
+		{
+			name:           "username-password",
+			credentialType: UsernamePasswordType,
+			opts:           []Option{WithUsername("user"), WithPassword("pass")},
+			wantSecret:     []byte("pass"),
+			wantValid:      true,
+			wantData:       map[string]interface{}{"username": "user", "password": "pass"},
+		},
+		{
+			name:           "username-password-ignores-private-key",
+			credentialType: UsernamePasswordType,
+			opts:           []Option{WithUsername("user"), WithPrivateKey(key)},
+		},
+		{
+			name:           "ssh-private-key",
+			credentialType: SshPrivateKeyType,
+			opts:           []Option{WithUsername("user"), WithPrivateKey(key)},
+			wantSecret:     []byte(key),
+			wantValid:      true,
+			wantData:       map[string]interface{}{"username": "user", "private_key": string(key)},
+		},
+		{
+			name:           "ssh-invalid-private-key",
+			credentialType: SshPrivateKeyType,
+			opts:           []Option{WithUsername("user"), WithPrivateKey(credential.PrivateKey("not a key"))},
+			wantSecret:     []byte("not a key"),
+		},
+		{
+			name:           "json",
+			credentialType: JsonType,
+			opts:           []Option{WithObject(map[string]interface{}{"token": "secret"})},
+			wantSecret:     []byte(`{"token":"secret"}`),
+			wantValid:      true,
+			wantData:       map[string]interface{}{"token": "secret"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			ctx := context.Background()
+			got, err := NewCredentialLibrary("csst_store", tt.credentialType, tt.opts...)
+			require.NoError(err)
+			require.NotNil(got)
+			assert.Equal("csst_store", got.GetStoreId())
+			assert.Equal(tt.credentialType.String(), got.GetCredentialType())
+			assert.Equal(tt.wantSecret, got.GetSecret())
+
+			err = got.validateSecret(ctx)
+			if !tt.wantValid {
+				assert.Error(err)
+				return
+			}
+			require.NoError(err)
+			data, err := got.secretData(ctx)
+			require.NoError(err)
+			assert.Equal(tt.wantData, data)
+		})
+	}
+}
@@ -0,0 +1,4 @@
+// Package static provides credentials which are stored in Boundary. The
+// secret data of each credential is encrypted with the database key of the
+// scope which owns the credential store.
+package static
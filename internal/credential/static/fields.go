@@ -0,0 +1,9 @@
+package static
+
+// These constants are the field names used in the static related field masks.
+const (
+	nameField        = "Name"
+	descriptionField = "Description"
+	usernameField    = "Username"
+	secretField      = "Secret"
+)
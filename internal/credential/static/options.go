@@ -0,0 +1,84 @@
+package static
+
+import "github.com/hashicorp/boundary/internal/credential"
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withName        string
+	withDescription string
+	withLimit       int
+	withUsername    string
+	withPassword    credential.Password
+	withPrivateKey  credential.PrivateKey
+	withObject      map[string]interface{}
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithDescription provides an optional description.
+func WithDescription(desc string) Option {
+	return func(o *options) {
+		o.withDescription = desc
+	}
+}
+
+// WithName provides an optional name.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.withName = name
+	}
+}
+
+// WithLimit provides an option to provide a limit. Intentionally allowing
+// negative integers. If WithLimit < 0, then unlimited results are
+// returned. If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) {
+		o.withLimit = l
+	}
+}
+
+// WithUsername provides the username of a username_password or
+// ssh_private_key credential library.
+func WithUsername(username string) Option {
+	return func(o *options) {
+		o.withUsername = username
+	}
+}
+
+// WithPassword provides the password of a username_password credential
+// library.
+func WithPassword(password credential.Password) Option {
+	return func(o *options) {
+		o.withPassword = password
+	}
+}
+
+// WithPrivateKey provides the PEM encoded private key of an
+// ssh_private_key credential library.
+func WithPrivateKey(key credential.PrivateKey) Option {
+	return func(o *options) {
+		o.withPrivateKey = key
+	}
+}
+
+// WithObject provides the JSON object of a json credential library.
+func WithObject(object map[string]interface{}) Option {
+	return func(o *options) {
+		o.withObject = object
+	}
+}
@@ -0,0 +1,54 @@
+package static
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetOpts(t *testing.T) {
+	t.Parallel()
+	t.Run("WithName", func(t *testing.T) {
+		opts := getOpts(WithName("test"))
+		testOpts := getDefaultOptions()
+		testOpts.withName = "test"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithDescription", func(t *testing.T) {
+		opts := getOpts(WithDescription("test desc"))
+		testOpts := getDefaultOptions()
+		testOpts.withDescription = "test desc"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithLimit", func(t *testing.T) {
+		opts := getOpts(WithLimit(5))
+		testOpts := getDefaultOptions()
+		testOpts.withLimit = 5
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithUsername", func(t *testing.T) {
+		opts := getOpts(WithUsername("user"))
+		testOpts := getDefaultOptions()
+		testOpts.withUsername = "user"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithPassword", func(t *testing.T) {
+		opts := getOpts(WithPassword("pass"))
+		testOpts := getDefaultOptions()
+		testOpts.withPassword = credential.Password("pass")
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithPrivateKey", func(t *testing.T) {
+		opts := getOpts(WithPrivateKey(credential.PrivateKey("key")))
+		testOpts := getDefaultOptions()
+		testOpts.withPrivateKey = credential.PrivateKey("key")
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithObject", func(t *testing.T) {
+		opts := getOpts(WithObject(map[string]interface{}{"key": "value"}))
+		testOpts := getDefaultOptions()
+		testOpts.withObject = map[string]interface{}{"key": "value"}
+		assert.Equal(t, opts, testOpts)
+	})
+}
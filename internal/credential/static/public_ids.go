@@ -0,0 +1,47 @@
+package static
+
+import (
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
+)
+
+func init() {
+	if err := credential.Register(Subtype, CredentialStorePrefix, CredentialLibraryPrefix, DynamicCredentialPrefix); err != nil {
+		panic(err)
+	}
+}
+
+// PublicId prefixes for the resources in the static package.
+const (
+	CredentialStorePrefix   = "csst"
+	CredentialLibraryPrefix = "clst"
+	DynamicCredentialPrefix = "cdst"
+
+	Subtype = subtypes.Subtype("static")
+)
+
+func newCredentialStoreId() (string, error) {
+	id, err := db.NewPublicId(CredentialStorePrefix)
+	if err != nil {
+		return "", errors.WrapDeprecated(err, "static.newCredentialStoreId")
+	}
+	return id, nil
+}
+
+func newCredentialId() (string, error) {
+	id, err := db.NewPublicId(DynamicCredentialPrefix)
+	if err != nil {
+		return "", errors.WrapDeprecated(err, "static.newCredentialId")
+	}
+	return id, nil
+}
+
+func newCredentialLibraryId() (string, error) {
+	id, err := db.NewPublicId(CredentialLibraryPrefix)
+	if err != nil {
+		return "", errors.WrapDeprecated(err, "static.newCredentialLibraryId")
+	}
+	return id, nil
+}
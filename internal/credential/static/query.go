@@ -0,0 +1,25 @@
+package static
+
+const (
+	insertCredentialQuery = `
+insert into credential_static_credential (
+  public_id, -- $1
+  library_id, -- $2
+  session_id -- $3
+) values (
+  @public_id, -- public_id
+  @library_id, -- library_id
+  @session_id -- session_id
+);
+`
+
+	updateSessionCredentialQuery = `
+update session_credential_dynamic
+   set credential_id = @public_id
+ where library_id = @library_id
+   and session_id = @session_id
+   and credential_purpose = @purpose
+   and credential_id is null
+returning *;
+`
+)
@@ -0,0 +1,283 @@
+package static
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateCredentialLibrary(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+	key := TestSshPrivateKey(t)
+
+	newLib := func(credentialType CredentialType, opt ...Option) *CredentialLibrary {
+		l, err := NewCredentialLibrary(cs.GetPublicId(), credentialType, opt...)
+		require.NoError(t, err)
+		return l
+	}
+
+	tests := []struct {
+		name    string
+		scopeId string
+		in      *CredentialLibrary
+		wantErr errors.Code
+	}{
+		{
+			name:    "nil-credential-library",
+			scopeId: prj.GetPublicId(),
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "no-scope-id",
+			in:      newLib(UsernamePasswordType, WithUsername("user"), WithPassword("pass")),
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "unknown-credential-type",
+			scopeId: prj.GetPublicId(),
+			in:      newLib(CredentialType("unknown"), WithUsername("user")),
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "username-password-no-username",
+			scopeId: prj.GetPublicId(),
+			in:      newLib(UsernamePasswordType, WithPassword("pass")),
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "username-password-no-password",
+			scopeId: prj.GetPublicId(),
+			in:      newLib(UsernamePasswordType, WithUsername("user")),
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "ssh-private-key-invalid-key",
+			scopeId: prj.GetPublicId(),
+			in:      newLib(SshPrivateKeyType, WithUsername("user"), WithPrivateKey(credential.PrivateKey("not a key"))),
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "json-with-username",
+			scopeId: prj.GetPublicId(),
+			in:      newLib(JsonType, WithUsername("user"), WithObject(map[string]interface{}{"token": "secret"})),
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "username-password",
+			scopeId: prj.GetPublicId(),
+			in:      newLib(UsernamePasswordType, WithName("up"), WithUsername("user"), WithPassword("pass")),
+		},
+		{
+			name:    "ssh-private-key",
+			scopeId: prj.GetPublicId(),
+			in:      newLib(SshPrivateKeyType, WithName("key"), WithUsername("user"), WithPrivateKey(key)),
+		},
+		{
+			name:    "json",
+			scopeId: prj.GetPublicId(),
+			in:      newLib(JsonType, WithName("json"), WithObject(map[string]interface{}{"token": "secret"})),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			ctx := context.Background()
+			repo, err := NewRepository(rw, rw, kms)
+			require.NoError(err)
+			got, err := repo.CreateCredentialLibrary(ctx, tt.scopeId, tt.in)
+			if tt.wantErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErr), err), "want err: %q got: %q", tt.wantErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			require.NotNil(got)
+			assert.True(len(got.GetPublicId()) > len(CredentialLibraryPrefix))
+			assert.Equal(tt.in.GetCredentialType(), got.GetCredentialType())
+			assert.Equal(tt.in.GetUsername(), got.GetUsername())
+			assert.Empty(got.GetSecret())
+			assert.NotEmpty(got.GetCtSecret())
+			assert.NotEqual(tt.in.GetSecret(), got.GetCtSecret())
+			assert.NotEmpty(got.GetSecretHmac())
+			assert.NotEmpty(got.GetKeyId())
+			assert.NoError(db.TestVerifyOplog(t, rw, got.GetPublicId(), db.WithOperation(oplog.OpType_OP_TYPE_CREATE), db.WithCreateNotBefore(10*time.Second)))
+
+			lookup, err := repo.LookupCredentialLibrary(ctx, got.GetPublicId())
+			require.NoError(err)
+			require.NotNil(lookup)
+			assert.Empty(lookup.GetSecret())
+			assert.Equal(got.GetSecretHmac(), lookup.GetSecretHmac())
+		})
+	}
+}
+
+func TestRepository_UpdateCredentialLibrary(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+
+	tests := []struct {
+		name         string
+		orig         CredentialType
+		origOpts     []Option
+		chg          []Option
+		masks        []string
+		wantUsername string
+		wantNewHmac  bool
+		wantErr      errors.Code
+	}{
+		{
+			name:         "change-username",
+			orig:         UsernamePasswordType,
+			origOpts:     []Option{WithUsername("user"), WithPassword("pass")},
+			chg:          []Option{WithUsername("new-user")},
+			masks:        []string{usernameField},
+			wantUsername: "new-user",
+		},
+		{
+			name:         "change-password",
+			orig:         UsernamePasswordType,
+			origOpts:     []Option{WithUsername("user"), WithPassword("pass")},
+			chg:          []Option{WithPassword("new-pass")},
+			masks:        []string{secretField},
+			wantUsername: "user",
+			wantNewHmac:  true,
+		},
+		{
+			name:     "null-secret",
+			orig:     UsernamePasswordType,
+			origOpts: []Option{WithUsername("user"), WithPassword("pass")},
+			masks:    []string{secretField},
+			wantErr:  errors.InvalidParameter,
+		},
+		{
+			name:     "invalid-ssh-private-key",
+			orig:     SshPrivateKeyType,
+			origOpts: []Option{WithUsername("user"), WithPrivateKey(TestSshPrivateKey(t))},
+			chg:      []Option{WithPrivateKey(credential.PrivateKey("not a key"))},
+			masks:    []string{secretField},
+			wantErr:  errors.InvalidParameter,
+		},
+		{
+			name:     "invalid-field",
+			orig:     UsernamePasswordType,
+			origOpts: []Option{WithUsername("user"), WithPassword("pass")},
+			masks:    []string{"CredentialType"},
+			wantErr:  errors.InvalidFieldMask,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			ctx := context.Background()
+			repo, err := NewRepository(rw, rw, kms)
+			require.NoError(err)
+			orig := TestCredentialLibrary(t, conn, wrapper, cs.GetPublicId(), tt.orig, tt.origOpts...)
+
+			in, err := NewCredentialLibrary(cs.GetPublicId(), tt.orig, tt.chg...)
+			require.NoError(err)
+			in.PublicId = orig.GetPublicId()
+			got, gotCount, err := repo.UpdateCredentialLibrary(ctx, prj.GetPublicId(), in, orig.GetVersion(), tt.masks)
+			if tt.wantErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErr), err), "want err: %q got: %q", tt.wantErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(1, gotCount)
+			require.NotNil(got)
+			assert.Empty(got.GetSecret())
+			assert.Equal(orig.GetVersion()+1, got.GetVersion())
+
+			lookup, err := repo.LookupCredentialLibrary(ctx, orig.GetPublicId())
+			require.NoError(err)
+			require.NotNil(lookup)
+			assert.Equal(tt.wantUsername, lookup.GetUsername())
+			if tt.wantNewHmac {
+				assert.NotEqual(orig.GetSecretHmac(), lookup.GetSecretHmac())
+			} else {
+				assert.Equal(orig.GetSecretHmac(), lookup.GetSecretHmac())
+			}
+			assert.NoError(db.TestVerifyOplog(t, rw, got.GetPublicId(), db.WithOperation(oplog.OpType_OP_TYPE_UPDATE), db.WithCreateNotBefore(10*time.Second)))
+		})
+	}
+}
+
+func TestRepository_ListCredentialLibraries(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	stores := TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 2)
+	TestCredentialLibraries(t, conn, wrapper, stores[0].GetPublicId(), 3)
+	TestCredentialLibraries(t, conn, wrapper, stores[1].GetPublicId(), 1)
+
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+
+	got, err := repo.ListCredentialLibraries(ctx, stores[0].GetPublicId())
+	require.NoError(err)
+	assert.Len(got, 3)
+	for _, l := range got {
+		assert.Empty(l.GetSecret())
+	}
+
+	got, err = repo.ListCredentialLibraries(ctx, stores[0].GetPublicId(), WithLimit(2))
+	require.NoError(err)
+	assert.Len(got, 2)
+
+	_, err = repo.ListCredentialLibraries(ctx, "")
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+}
+
+func TestRepository_DeleteCredentialLibrary(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+	l := TestCredentialLibraries(t, conn, wrapper, cs.GetPublicId(), 1)[0]
+
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+
+	deleted, err := repo.DeleteCredentialLibrary(ctx, prj.GetPublicId(), l.GetPublicId())
+	require.NoError(err)
+	assert.Equal(1, deleted)
+
+	deleted, err = repo.DeleteCredentialLibrary(ctx, prj.GetPublicId(), l.GetPublicId())
+	require.NoError(err)
+	assert.Equal(0, deleted)
+
+	_, err = repo.DeleteCredentialLibrary(ctx, "", l.GetPublicId())
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+}
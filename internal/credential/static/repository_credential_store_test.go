@@ -0,0 +1,265 @@
+package static
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateCredentialStore(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	tests := []struct {
+		name    string
+		in      *CredentialStore
+		want    *CredentialStore
+		wantErr errors.Code
+	}{
+		{
+			name:    "nil-credential-store",
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "no-scope-id",
+			in:      &CredentialStore{CredentialStore: allocCredentialStore().CredentialStore},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "public-id-set",
+			in: func() *CredentialStore {
+				cs, err := NewCredentialStore(prj.GetPublicId())
+				require.NoError(t, err)
+				cs.PublicId = "csst_abcd"
+				return cs
+			}(),
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "valid",
+			in: func() *CredentialStore {
+				cs, err := NewCredentialStore(prj.GetPublicId(), WithName("name"), WithDescription("desc"))
+				require.NoError(t, err)
+				return cs
+			}(),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			ctx := context.Background()
+			repo, err := NewRepository(rw, rw, kms)
+			require.NoError(err)
+			got, err := repo.CreateCredentialStore(ctx, tt.in)
+			if tt.wantErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErr), err), "want err: %q got: %q", tt.wantErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			require.NotNil(got)
+			assert.Empty(tt.in.PublicId)
+			assert.True(len(got.PublicId) > len(CredentialStorePrefix))
+			assert.Equal(tt.in.Name, got.Name)
+			assert.Equal(tt.in.Description, got.Description)
+			assert.Equal(got.CreateTime, got.UpdateTime)
+			assert.NoError(db.TestVerifyOplog(t, rw, got.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_CREATE), db.WithCreateNotBefore(10*time.Second)))
+		})
+	}
+
+	t.Run("duplicate-name", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ctx := context.Background()
+		repo, err := NewRepository(rw, rw, kms)
+		require.NoError(err)
+		in, err := NewCredentialStore(prj.GetPublicId(), WithName("dup"))
+		require.NoError(err)
+		_, err = repo.CreateCredentialStore(ctx, in)
+		require.NoError(err)
+		got, err := repo.CreateCredentialStore(ctx, in)
+		assert.Truef(errors.Match(errors.T(errors.NotUnique), err), "want err: %q got: %q", errors.NotUnique, err)
+		assert.Nil(got)
+	})
+}
+
+func TestRepository_LookupCredentialStore(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+
+	got, err := repo.LookupCredentialStore(ctx, cs.GetPublicId())
+	require.NoError(err)
+	require.NotNil(got)
+	assert.Equal(cs.GetPublicId(), got.GetPublicId())
+	assert.Equal(prj.GetPublicId(), got.GetScopeId())
+
+	got, err = repo.LookupCredentialStore(ctx, "csst_doesnotexist")
+	assert.NoError(err)
+	assert.Nil(got)
+
+	got, err = repo.LookupCredentialStore(ctx, "")
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+	assert.Nil(got)
+}
+
+func TestRepository_UpdateCredentialStore(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	tests := []struct {
+		name      string
+		orig      []Option
+		chgName   string
+		chgDesc   string
+		masks     []string
+		wantName  string
+		wantDesc  string
+		wantCount int
+		wantErr   errors.Code
+	}{
+		{
+			name:      "change-name",
+			orig:      []Option{WithName("name"), WithDescription("desc")},
+			chgName:   "new-name",
+			masks:     []string{nameField},
+			wantName:  "new-name",
+			wantDesc:  "desc",
+			wantCount: 1,
+		},
+		{
+			name:      "null-description",
+			orig:      []Option{WithName("name2"), WithDescription("desc")},
+			masks:     []string{descriptionField},
+			wantName:  "name2",
+			wantCount: 1,
+		},
+		{
+			name:    "invalid-field",
+			masks:   []string{"ScopeId"},
+			wantErr: errors.InvalidFieldMask,
+		},
+		{
+			name:    "empty-field-mask",
+			wantErr: errors.EmptyFieldMask,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			ctx := context.Background()
+			repo, err := NewRepository(rw, rw, kms)
+			require.NoError(err)
+			orig := TestCredentialStore(t, conn, wrapper, prj.GetPublicId(), tt.orig...)
+
+			in, err := NewCredentialStore(prj.GetPublicId(), WithName(tt.chgName), WithDescription(tt.chgDesc))
+			require.NoError(err)
+			in.PublicId = orig.GetPublicId()
+			got, gotCount, err := repo.UpdateCredentialStore(ctx, in, orig.GetVersion(), tt.masks)
+			if tt.wantErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErr), err), "want err: %q got: %q", tt.wantErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantCount, gotCount)
+			require.NotNil(got)
+			assert.Equal(tt.wantName, got.GetName())
+			assert.Equal(tt.wantDesc, got.GetDescription())
+			assert.Equal(orig.GetVersion()+1, got.GetVersion())
+		})
+	}
+}
+
+func TestRepository_ListCredentialStores(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, prj1 := iam.TestScopes(t, iamRepo)
+	_, prj2 := iam.TestScopes(t, iamRepo)
+	TestCredentialStores(t, conn, wrapper, prj1.GetPublicId(), 3)
+	TestCredentialStores(t, conn, wrapper, prj2.GetPublicId(), 2)
+
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+
+	got, err := repo.ListCredentialStores(ctx, []string{prj1.GetPublicId()})
+	require.NoError(err)
+	assert.Len(got, 3)
+
+	got, err = repo.ListCredentialStores(ctx, []string{prj1.GetPublicId(), prj2.GetPublicId()})
+	require.NoError(err)
+	assert.Len(got, 5)
+
+	got, err = repo.ListCredentialStores(ctx, []string{prj1.GetPublicId(), prj2.GetPublicId()}, WithLimit(1))
+	require.NoError(err)
+	assert.Len(got, 1)
+
+	_, err = repo.ListCredentialStores(ctx, nil)
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+}
+
+func TestRepository_DeleteCredentialStore(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+	libs := TestCredentialLibraries(t, conn, wrapper, cs.GetPublicId(), 2)
+
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+
+	deleted, err := repo.DeleteCredentialStore(ctx, cs.GetPublicId())
+	require.NoError(err)
+	assert.Equal(1, deleted)
+
+	// Deleting the store deletes all of its libraries.
+	for _, l := range libs {
+		got, err := repo.LookupCredentialLibrary(ctx, l.GetPublicId())
+		require.NoError(err)
+		assert.Nil(got)
+	}
+
+	deleted, err = repo.DeleteCredentialStore(ctx, cs.GetPublicId())
+	require.NoError(err)
+	assert.Equal(0, deleted)
+
+	_, err = repo.DeleteCredentialStore(ctx, "")
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+}
@@ -0,0 +1,157 @@
+package static
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+)
+
+var _ credential.Dynamic = (*issuedCredential)(nil)
+
+// issuedCredential is a credential from a static credential library
+// issued for a session.
+type issuedCredential struct {
+	id         string
+	lib        *CredentialLibrary
+	sessionId  string
+	secretData map[string]interface{}
+	purpose    credential.Purpose
+}
+
+func (c *issuedCredential) GetPublicId() string           { return c.id }
+func (c *issuedCredential) GetSessionId() string          { return c.sessionId }
+func (c *issuedCredential) Secret() credential.SecretData { return c.secretData }
+func (c *issuedCredential) Library() credential.Library   { return c.lib }
+func (c *issuedCredential) Purpose() credential.Purpose   { return c.purpose }
+
+var _ credential.Issuer = (*Repository)(nil)
+
+// Issue returns the credentials stored in the static credential libraries
+// of all of the requests for sessionId. The secret data of each
+// credential is decrypted with the database key of the scope owning the
+// library's credential store. Each issued credential is recorded and
+// assigned to the session's dynamic credential for its library and
+// purpose, the same as credentials issued from Vault.
+func (r *Repository) Issue(ctx context.Context, sessionId string, requests []credential.Request) ([]credential.Dynamic, error) {
+	const op = "static.(Repository).Issue"
+	if sessionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no session id")
+	}
+	if len(requests) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no requests")
+	}
+
+	purposes := make(map[string][]credential.Purpose, len(requests))
+	var libIds []string
+	for _, req := range requests {
+		purps, ok := purposes[req.SourceId]
+		if !ok {
+			libIds = append(libIds, req.SourceId)
+		}
+		for _, purp := range purps {
+			if purp == req.Purpose {
+				return nil, errors.New(ctx, errors.InvalidParameter, op, "duplicate library and purpose")
+			}
+		}
+		purposes[req.SourceId] = append(purps, req.Purpose)
+	}
+
+	var libs []*CredentialLibrary
+	if err := r.reader.SearchWhere(ctx, &libs, "public_id in (?)", []interface{}{libIds}); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(libs) != len(libIds) {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "unknown library")
+	}
+
+	var storeIds []string
+	for _, l := range libs {
+		storeIds = append(storeIds, l.GetStoreId())
+	}
+	var stores []*CredentialStore
+	if err := r.reader.SearchWhere(ctx, &stores, "public_id in (?)", []interface{}{storeIds}); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	scopeIds := make(map[string]string, len(stores))
+	for _, cs := range stores {
+		scopeIds[cs.GetPublicId()] = cs.GetScopeId()
+	}
+
+	var issued []*issuedCredential
+	for _, l := range libs {
+		scopeId, ok := scopeIds[l.GetStoreId()]
+		if !ok {
+			return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("unknown credential store for library: %s", l.GetPublicId()))
+		}
+		databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase, kms.WithKeyId(l.GetKeyId()))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+		}
+		if err := l.decrypt(ctx, databaseWrapper); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		secret, err := l.secretData(ctx)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		l.Secret = nil
+
+		for _, purp := range purposes[l.GetPublicId()] {
+			credId, err := newCredentialId()
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			issued = append(issued, &issuedCredential{
+				id:         credId,
+				lib:        l,
+				sessionId:  sessionId,
+				secretData: secret,
+				purpose:    purp,
+			})
+		}
+	}
+
+	if _, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			for _, c := range issued {
+				insertValues := []interface{}{
+					sql.Named("public_id", c.id),
+					sql.Named("library_id", c.lib.GetPublicId()),
+					sql.Named("session_id", c.sessionId),
+				}
+				rowsInserted, err := w.Exec(ctx, insertCredentialQuery, insertValues)
+				switch {
+				case err != nil:
+					return errors.Wrap(ctx, err, op)
+				case rowsInserted > 1:
+					return errors.New(ctx, errors.MultipleRecords, op, "more than 1 credential would have been inserted")
+				}
+
+				updateValues := append(insertValues, sql.Named("purpose", string(c.purpose)))
+				rowsUpdated, err := w.Exec(ctx, updateSessionCredentialQuery, updateValues)
+				switch {
+				case err != nil:
+					return errors.Wrap(ctx, err, op)
+				case rowsUpdated == 0:
+					return errors.New(ctx, errors.InvalidDynamicCredential, op, "no matching dynamic credential for session found")
+				case rowsUpdated > 1:
+					return errors.New(ctx, errors.MultipleRecords, op, "more than 1 session credential would have been updated")
+				}
+			}
+			return nil
+		},
+	); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	creds := make([]credential.Dynamic, 0, len(issued))
+	for _, c := range issued {
+		creds = append(creds, c)
+	}
+	return creds, nil
+}
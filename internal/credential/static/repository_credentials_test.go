@@ -0,0 +1,161 @@
+package static
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	hoststatic "github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Issue(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	org, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+	key := TestSshPrivateKey(t)
+
+	upLib := TestCredentialLibrary(t, conn, wrapper, cs.GetPublicId(), UsernamePasswordType,
+		WithUsername("user"), WithPassword("pass"))
+	keyLib := TestCredentialLibrary(t, conn, wrapper, cs.GetPublicId(), SshPrivateKeyType,
+		WithUsername("user"), WithPrivateKey(key))
+	jsonLib := TestCredentialLibrary(t, conn, wrapper, cs.GetPublicId(), JsonType,
+		WithObject(map[string]interface{}{"token": "secret"}))
+
+	at := authtoken.TestAuthToken(t, conn, kms, org.GetPublicId())
+	hc := hoststatic.TestCatalogs(t, conn, prj.GetPublicId(), 1)[0]
+	hs := hoststatic.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	h := hoststatic.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	hoststatic.TestSetMembers(t, conn, hs.GetPublicId(), []*hoststatic.Host{h})
+	tar := tcp.TestTarget(context.Background(), t, conn, prj.GetPublicId(), "test", target.WithHostSources([]string{hs.GetPublicId()}))
+
+	rc2dc := func(rcs []credential.Request) []*session.DynamicCredential {
+		var dcs []*session.DynamicCredential
+		for _, rc := range rcs {
+			dcs = append(dcs, session.NewDynamicCredential(rc.SourceId, rc.Purpose))
+		}
+		return dcs
+	}
+
+	tests := []struct {
+		name          string
+		reqs          []credential.Request
+		noSessionCred bool
+		want          map[string]credential.Credential
+		wantErr       errors.Code
+	}{
+		{
+			name:    "no-requests",
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "duplicate-request",
+			reqs: []credential.Request{
+				{SourceId: upLib.GetPublicId(), Purpose: credential.ApplicationPurpose},
+				{SourceId: upLib.GetPublicId(), Purpose: credential.ApplicationPurpose},
+			},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "unknown-library",
+			reqs: []credential.Request{
+				{SourceId: "clst_doesnotexist", Purpose: credential.ApplicationPurpose},
+			},
+			wantErr: errors.RecordNotFound,
+		},
+		{
+			name: "no-session-dynamic-credentials",
+			reqs: []credential.Request{
+				{SourceId: upLib.GetPublicId(), Purpose: credential.ApplicationPurpose},
+			},
+			noSessionCred: true,
+			wantErr:       errors.InvalidDynamicCredential,
+		},
+		{
+			name: "all-types",
+			reqs: []credential.Request{
+				{SourceId: upLib.GetPublicId(), Purpose: credential.ApplicationPurpose},
+				{SourceId: upLib.GetPublicId(), Purpose: credential.EgressPurpose},
+				{SourceId: keyLib.GetPublicId(), Purpose: credential.EgressPurpose},
+				{SourceId: jsonLib.GetPublicId(), Purpose: credential.ApplicationPurpose},
+			},
+			want: map[string]credential.Credential{
+				upLib.GetPublicId():  credential.NewUserPassword(upLib.GetPublicId(), "user", "pass"),
+				keyLib.GetPublicId(): credential.NewKeyPair(keyLib.GetPublicId(), "user", key),
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			ctx := context.Background()
+			repo, err := NewRepository(rw, rw, kms)
+			require.NoError(err)
+
+			composedOf := session.ComposedOf{
+				UserId:      at.GetIamUserId(),
+				HostId:      h.GetPublicId(),
+				TargetId:    tar.GetPublicId(),
+				HostSetId:   hs.GetPublicId(),
+				AuthTokenId: at.GetPublicId(),
+				ScopeId:     prj.GetPublicId(),
+				Endpoint:    "tcp://127.0.0.1:22",
+			}
+			if !tt.noSessionCred {
+				composedOf.DynamicCredentials = rc2dc(tt.reqs)
+			}
+			sess := session.TestSession(t, conn, wrapper, composedOf)
+
+			got, err := repo.Issue(ctx, sess.GetPublicId(), tt.reqs)
+			if tt.wantErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErr), err), "want err: %q got: %q", tt.wantErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.Len(got, len(tt.reqs))
+			for _, c := range got {
+				assert.True(strings.HasPrefix(c.GetPublicId(), DynamicCredentialPrefix+"_"))
+				assert.Equal(sess.GetPublicId(), c.GetSessionId())
+				libId := c.Library().GetPublicId()
+				assert.Empty(c.Library().(*CredentialLibrary).GetSecret())
+				if want, ok := tt.want[libId]; ok {
+					typed, ok := credential.FromSecret(libId, c.Secret())
+					require.True(ok)
+					assert.Equal(want, typed)
+					continue
+				}
+				assert.Equal(jsonLib.GetPublicId(), libId)
+				assert.Equal(map[string]interface{}{"token": "secret"}, c.Secret())
+			}
+
+			// Each issued credential is assigned to the session.
+			var sessCreds []*session.DynamicCredential
+			require.NoError(rw.SearchWhere(ctx, &sessCreds, "session_id = ?", []interface{}{sess.GetPublicId()}))
+			require.Len(sessCreds, len(got))
+			var gotIds, sessCredIds []string
+			for _, c := range got {
+				gotIds = append(gotIds, c.GetPublicId())
+			}
+			for _, sc := range sessCreds {
+				sessCredIds = append(sessCredIds, sc.CredentialId)
+			}
+			assert.ElementsMatch(gotIds, sessCredIds)
+		})
+	}
+}
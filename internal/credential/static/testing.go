@@ -0,0 +1,122 @@
+package static
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/kms"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCredentialStore creates a static credential store in the provided DB
+// with the provided scope and any values passed in through the Options
+// vargs. If any errors are encountered during the creation of the store,
+// the test will fail.
+func TestCredentialStore(t *testing.T, conn *db.DB, _ wrapping.Wrapper, scopeId string, opts ...Option) *CredentialStore {
+	t.Helper()
+	ctx := context.Background()
+	w := db.New(conn)
+
+	cs, err := NewCredentialStore(scopeId, opts...)
+	assert.NoError(t, err)
+	require.NotNil(t, cs)
+	id, err := newCredentialStoreId()
+	assert.NoError(t, err)
+	require.NotEmpty(t, id)
+	cs.PublicId = id
+
+	_, err2 := w.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, iw db.Writer) error {
+			return iw.Create(ctx, cs)
+		},
+	)
+	require.NoError(t, err2)
+	return cs
+}
+
+// TestCredentialStores creates count number of static credential stores in
+// the provided DB with the provided scope id. If any errors are
+// encountered during the creation of the credential stores, the test will
+// fail.
+func TestCredentialStores(t *testing.T, conn *db.DB, wrapper wrapping.Wrapper, scopeId string, count int) []*CredentialStore {
+	t.Helper()
+	var css []*CredentialStore
+	for i := 0; i < count; i++ {
+		css = append(css, TestCredentialStore(t, conn, wrapper, scopeId))
+	}
+	return css
+}
+
+// TestCredentialLibrary creates a static credential library of
+// credentialType in the provided DB with the provided store id and any
+// values passed in through the Options vargs. The secret of the library is
+// encrypted with the database key of the store's scope. If any errors are
+// encountered during the creation of the credential library, the test
+// will fail.
+func TestCredentialLibrary(t *testing.T, conn *db.DB, wrapper wrapping.Wrapper, storeId string, credentialType CredentialType, opts ...Option) *CredentialLibrary {
+	t.Helper()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	w := db.New(conn)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+
+	cs := allocCredentialStore()
+	cs.PublicId = storeId
+	require.NoError(w.LookupByPublicId(ctx, cs))
+
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, cs.GetScopeId(), kms.KeyPurposeDatabase)
+	require.NoError(err)
+
+	lib, err := NewCredentialLibrary(storeId, credentialType, opts...)
+	assert.NoError(err)
+	require.NotNil(lib)
+	id, err := newCredentialLibraryId()
+	assert.NoError(err)
+	require.NotEmpty(id)
+	lib.PublicId = id
+	require.NoError(lib.encrypt(ctx, databaseWrapper))
+
+	_, err2 := w.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, iw db.Writer) error {
+			return iw.Create(ctx, lib)
+		},
+	)
+	require.NoError(err2)
+	lib.Secret = nil
+	return lib
+}
+
+// TestCredentialLibraries creates count number of static username_password
+// credential libraries in the provided DB with the provided store id. If
+// any errors are encountered during the creation of the credential
+// libraries, the test will fail.
+func TestCredentialLibraries(t *testing.T, conn *db.DB, wrapper wrapping.Wrapper, storeId string, count int) []*CredentialLibrary {
+	t.Helper()
+	var libs []*CredentialLibrary
+	for i := 0; i < count; i++ {
+		libs = append(libs, TestCredentialLibrary(t, conn, wrapper, storeId, UsernamePasswordType,
+			WithUsername(fmt.Sprintf("user%d", i)),
+			WithPassword(credential.Password(fmt.Sprintf("password%d", i)))))
+	}
+	return libs
+}
+
+// TestSshPrivateKey returns a new PEM encoded ed25519 private key which can
+// be used for ssh_private_key credential libraries.
+func TestSshPrivateKey(t *testing.T) credential.PrivateKey {
+	t.Helper()
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
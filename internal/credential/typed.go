@@ -0,0 +1,114 @@
+package credential
+
+import "encoding/json"
+
+// Well known field names in the secret data of a credential.
+const (
+	usernameField   = "username"
+	passwordField   = "password"
+	privateKeyField = "private_key"
+	dataField       = "data"
+)
+
+var (
+	_ UserPassword = (*userPassword)(nil)
+	_ KeyPair      = (*keyPair)(nil)
+)
+
+type userPassword struct {
+	id       string
+	username string
+	password Password
+}
+
+// NewUserPassword returns a UserPassword credential with the given id,
+// username, and password.
+func NewUserPassword(id, username string, password Password) UserPassword {
+	return &userPassword{
+		id:       id,
+		username: username,
+		password: password,
+	}
+}
+
+func (c *userPassword) GetPublicId() string { return c.id }
+func (c *userPassword) Username() string    { return c.username }
+func (c *userPassword) Password() Password  { return c.password }
+
+func (c *userPassword) Secret() SecretData {
+	return map[string]interface{}{
+		usernameField: c.username,
+		passwordField: string(c.password),
+	}
+}
+
+type keyPair struct {
+	id         string
+	username   string
+	privateKey PrivateKey
+}
+
+// NewKeyPair returns a KeyPair credential with the given id, username, and
+// private key.
+func NewKeyPair(id, username string, privateKey PrivateKey) KeyPair {
+	return &keyPair{
+		id:         id,
+		username:   username,
+		privateKey: privateKey,
+	}
+}
+
+func (c *keyPair) GetPublicId() string { return c.id }
+func (c *keyPair) Username() string    { return c.username }
+func (c *keyPair) Private() PrivateKey { return c.privateKey }
+
+func (c *keyPair) Secret() SecretData {
+	return map[string]interface{}{
+		usernameField:   c.username,
+		privateKeyField: string(c.privateKey),
+	}
+}
+
+// FromSecret returns a UserPassword or a KeyPair credential with the given id
+// built from the well known fields of the secret data s. A secret containing
+// a username and a password is a UserPassword and a secret containing a
+// username and a private_key is a KeyPair. If the fields are not found at the
+// top level of s, they are looked up in a nested data object, which is how
+// the Vault KV version 2 secrets engine returns its secrets. s can be the
+// decoded secret or its JSON encoding. The second return value is false if s
+// does not contain the fields of either credential type.
+func FromSecret(id string, s SecretData) (Credential, bool) {
+	var m map[string]interface{}
+	switch v := s.(type) {
+	case map[string]interface{}:
+		m = v
+	case []byte:
+		if err := json.Unmarshal(v, &m); err != nil {
+			return nil, false
+		}
+	default:
+		return nil, false
+	}
+
+	if c, ok := fromFields(id, m); ok {
+		return c, true
+	}
+	if data, ok := m[dataField].(map[string]interface{}); ok {
+		return fromFields(id, data)
+	}
+	return nil, false
+}
+
+func fromFields(id string, m map[string]interface{}) (Credential, bool) {
+	username, _ := m[usernameField].(string)
+	if username == "" {
+		return nil, false
+	}
+	if pk, ok := m[privateKeyField].(string); ok && pk != "" {
+		return NewKeyPair(id, username, PrivateKey(pk)), true
+	}
+	if pw, ok := m[passwordField].(string); ok && pw != "" {
+		return NewUserPassword(id, username, Password(pw)), true
+	}
+	return nil, false
+}
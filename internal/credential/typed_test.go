@@ -0,0 +1,98 @@
+package credential
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromSecret(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		secret SecretData
+		want   Credential
+	}{
+		{
+			name:   "nil",
+			secret: nil,
+		},
+		{
+			name:   "unsupported-type",
+			secret: "username",
+		},
+		{
+			name:   "invalid-json",
+			secret: []byte(`{"username":`),
+		},
+		{
+			name:   "missing-username",
+			secret: map[string]interface{}{"password": "pass"},
+		},
+		{
+			name:   "missing-secret",
+			secret: map[string]interface{}{"username": "user"},
+		},
+		{
+			name:   "wrong-type",
+			secret: map[string]interface{}{"username": "user", "password": 1234},
+		},
+		{
+			name:   "user-password",
+			secret: map[string]interface{}{"username": "user", "password": "pass"},
+			want:   NewUserPassword("id", "user", "pass"),
+		},
+		{
+			name:   "key-pair",
+			secret: map[string]interface{}{"username": "user", "private_key": "key"},
+			want:   NewKeyPair("id", "user", PrivateKey("key")),
+		},
+		{
+			name:   "key-pair-preferred",
+			secret: map[string]interface{}{"username": "user", "password": "pass", "private_key": "key"},
+			want:   NewKeyPair("id", "user", PrivateKey("key")),
+		},
+		{
+			name:   "json",
+			secret: []byte(`{"username":"user","password":"pass"}`),
+			want:   NewUserPassword("id", "user", "pass"),
+		},
+		{
+			name: "kv-v2",
+			secret: map[string]interface{}{
+				"data":     map[string]interface{}{"username": "user", "password": "pass"},
+				"metadata": map[string]interface{}{"version": 1},
+			},
+			want: NewUserPassword("id", "user", "pass"),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := FromSecret("id", tt.secret)
+			if tt.want == nil {
+				assert.False(t, ok)
+				assert.Nil(t, got)
+				return
+			}
+			require.True(t, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTypedCredentialSecret(t *testing.T) {
+	t.Parallel()
+
+	up := NewUserPassword("id", "user", "pass")
+	c, ok := FromSecret("id", up.Secret())
+	require.True(t, ok)
+	assert.Equal(t, up, c)
+
+	kp := NewKeyPair("id", "user", PrivateKey("key"))
+	c, ok = FromSecret("id", kp.Secret())
+	require.True(t, ok)
+	assert.Equal(t, kp, c)
+}
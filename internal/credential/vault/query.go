@@ -186,4 +186,43 @@ delete from credential_vault_credential
  where session_id is null
    and status not in ('active', 'revoke')
 `
+
+	rewrapTokensQuery = `
+select tk.token_hmac,
+       tk.token,
+       tk.key_id,
+       cs.scope_id
+  from credential_vault_token tk
+  join credential_vault_store cs
+    on tk.store_id = cs.public_id
+ where tk.key_id not in (select private_id from kms_latest_database_key_version);
+`
+
+	updateTokenKeyQuery = `
+update credential_vault_token
+   set token  = @token,
+       key_id = @key_id
+ where token_hmac = @token_hmac
+   and key_id = @old_key_id;
+`
+
+	rewrapClientCertificatesQuery = `
+select cc.store_id,
+       cc.certificate_key,
+       cc.key_id,
+       cs.scope_id
+  from credential_vault_client_certificate cc
+  join credential_vault_store cs
+    on cc.store_id = cs.public_id
+ where cc.key_id not in (select private_id from kms_latest_database_key_version);
+`
+
+	updateClientCertificateKeyQuery = `
+update credential_vault_client_certificate
+   set certificate_key      = @certificate_key,
+       certificate_key_hmac = @certificate_key_hmac,
+       key_id               = @key_id
+ where store_id = @store_id
+   and key_id = @old_key_id;
+`
 )
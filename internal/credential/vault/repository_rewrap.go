@@ -0,0 +1,136 @@
+package vault
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+)
+
+// rewrapToken is a vault token which is not encrypted with the latest
+// version of the database key, along with the scope of its credential store.
+type rewrapToken struct {
+	TokenHmac []byte
+	CtToken   []byte `gorm:"column:token"`
+	KeyId     string
+	ScopeId   string
+}
+
+// rewrapClientCertificate is a client certificate key which is not encrypted
+// with the latest version of the database key, along with the scope of its
+// credential store.
+type rewrapClientCertificate struct {
+	StoreId          string
+	CtCertificateKey []byte `gorm:"column:certificate_key"`
+	KeyId            string
+	ScopeId          string
+}
+
+// RewrapTokens re-encrypts the vault tokens which are not encrypted with the
+// latest version of the database key of the scope of their credential store,
+// so older versions of the key can be destroyed. It returns the number of
+// tokens updated.
+func (r *Repository) RewrapTokens(ctx context.Context, _ ...Option) (int, error) {
+	const op = "vault.(Repository).RewrapTokens"
+	rows, err := r.reader.Query(ctx, rewrapTokensQuery, nil)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var toks []*rewrapToken
+	for rows.Next() {
+		var tk rewrapToken
+		if err := r.reader.ScanRows(rows, &tk); err != nil {
+			return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		toks = append(toks, &tk)
+	}
+
+	var rowsUpdated int
+	for _, rt := range toks {
+		tk := allocToken()
+		tk.TokenHmac, tk.CtToken, tk.KeyId = rt.TokenHmac, rt.CtToken, rt.KeyId
+		oldWrapper, err := r.kms.GetWrapper(ctx, rt.ScopeId, kms.KeyPurposeDatabase, kms.WithKeyId(rt.KeyId))
+		if err != nil {
+			return rowsUpdated, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+		}
+		if err := tk.decrypt(ctx, oldWrapper); err != nil {
+			return rowsUpdated, errors.Wrap(ctx, err, op)
+		}
+		databaseWrapper, err := r.kms.GetWrapper(ctx, rt.ScopeId, kms.KeyPurposeDatabase)
+		if err != nil {
+			return rowsUpdated, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+		}
+		if err := tk.encrypt(ctx, databaseWrapper); err != nil {
+			return rowsUpdated, errors.Wrap(ctx, err, op)
+		}
+		n, err := r.writer.Exec(ctx, updateTokenKeyQuery, []interface{}{
+			sql.Named("token", tk.CtToken),
+			sql.Named("key_id", tk.KeyId),
+			sql.Named("token_hmac", rt.TokenHmac),
+			sql.Named("old_key_id", rt.KeyId),
+		})
+		if err != nil {
+			return rowsUpdated, errors.Wrap(ctx, err, op)
+		}
+		rowsUpdated += n
+	}
+	return rowsUpdated, nil
+}
+
+// RewrapClientCertificates re-encrypts the client certificate keys which are
+// not encrypted with the latest version of the database key of the scope of
+// their credential store, so older versions of the key can be destroyed. The
+// HMAC of the key is recomputed with the new key version. It returns the
+// number of client certificates updated.
+func (r *Repository) RewrapClientCertificates(ctx context.Context, _ ...Option) (int, error) {
+	const op = "vault.(Repository).RewrapClientCertificates"
+	rows, err := r.reader.Query(ctx, rewrapClientCertificatesQuery, nil)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var certs []*rewrapClientCertificate
+	for rows.Next() {
+		var c rewrapClientCertificate
+		if err := r.reader.ScanRows(rows, &c); err != nil {
+			return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		certs = append(certs, &c)
+	}
+
+	var rowsUpdated int
+	for _, rc := range certs {
+		cert := allocClientCertificate()
+		cert.StoreId, cert.CtCertificateKey, cert.KeyId = rc.StoreId, rc.CtCertificateKey, rc.KeyId
+		oldWrapper, err := r.kms.GetWrapper(ctx, rc.ScopeId, kms.KeyPurposeDatabase, kms.WithKeyId(rc.KeyId))
+		if err != nil {
+			return rowsUpdated, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+		}
+		if err := cert.decrypt(ctx, oldWrapper); err != nil {
+			return rowsUpdated, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", rc.StoreId)))
+		}
+		databaseWrapper, err := r.kms.GetWrapper(ctx, rc.ScopeId, kms.KeyPurposeDatabase)
+		if err != nil {
+			return rowsUpdated, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+		}
+		if err := cert.encrypt(ctx, databaseWrapper); err != nil {
+			return rowsUpdated, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", rc.StoreId)))
+		}
+		n, err := r.writer.Exec(ctx, updateClientCertificateKeyQuery, []interface{}{
+			sql.Named("certificate_key", cert.CtCertificateKey),
+			sql.Named("certificate_key_hmac", cert.CertificateKeyHmac),
+			sql.Named("key_id", cert.KeyId),
+			sql.Named("store_id", rc.StoreId),
+			sql.Named("old_key_id", rc.KeyId),
+		})
+		if err != nil {
+			return rowsUpdated, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", rc.StoreId)))
+		}
+		rowsUpdated += n
+	}
+	return rowsUpdated, nil
+}
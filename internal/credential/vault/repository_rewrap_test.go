@@ -0,0 +1,75 @@
+package vault
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_RewrapTokensAndClientCertificates(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	ctx := context.Background()
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kmsCache, scheduler.TestScheduler(t, conn, wrapper))
+	require.NoError(err)
+
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	css := TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 2)
+
+	before := make(map[string]*privateStore, len(css))
+	for _, cs := range css {
+		ps, err := repo.lookupPrivateStore(ctx, cs.GetPublicId())
+		require.NoError(err)
+		require.NotNil(ps)
+		before[cs.GetPublicId()] = ps
+	}
+
+	n, err := repo.RewrapTokens(ctx)
+	require.NoError(err)
+	assert.Equal(0, n)
+	n, err = repo.RewrapClientCertificates(ctx)
+	require.NoError(err)
+	assert.Equal(0, n)
+
+	require.NoError(kmsCache.RotateKeys(ctx, prj.GetPublicId(), rand.Reader))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, prj.GetPublicId(), kms.KeyPurposeDatabase)
+	require.NoError(err)
+
+	n, err = repo.RewrapTokens(ctx)
+	require.NoError(err)
+	assert.Equal(len(css), n)
+	n, err = repo.RewrapClientCertificates(ctx)
+	require.NoError(err)
+	assert.Equal(len(css), n)
+
+	for _, cs := range css {
+		ps, err := repo.lookupPrivateStore(ctx, cs.GetPublicId())
+		require.NoError(err)
+		require.NotNil(ps)
+		want := before[cs.GetPublicId()]
+		assert.Equal(want.Token, ps.Token)
+		assert.Equal(want.ClientKey, ps.ClientKey)
+		assert.NotEqual(want.CtToken, ps.CtToken)
+		assert.NotEqual(want.ClientCertKeyHmac, ps.ClientCertKeyHmac)
+		assert.Equal(databaseWrapper.KeyID(), ps.TokenKeyId)
+		assert.Equal(databaseWrapper.KeyID(), ps.ClientKeyId)
+	}
+
+	n, err = repo.RewrapTokens(ctx)
+	require.NoError(err)
+	assert.Equal(0, n)
+	n, err = repo.RewrapClientCertificates(ctx)
+	require.NoError(err)
+	assert.Equal(0, n)
+}
@@ -0,0 +1,50 @@
+begin;
+
+  create table session_credential (
+    session_id wt_public_id not null
+      constraint session_fkey
+        references session (public_id)
+        on delete cascade
+        on update cascade,
+    credential bytea not null -- encrypted value
+      constraint credential_must_not_be_empty
+        check(length(credential) > 0),
+    key_id text not null
+      constraint kms_database_key_version_fkey
+        references kms_database_key_version (private_id)
+        on delete restrict
+        on update cascade,
+    credential_sha256 bytea not null -- digest of the plaintext credential
+      constraint credential_sha256_must_be_32_bytes
+        check(length(credential_sha256) = 32),
+    create_time wt_timestamp,
+    constraint session_credential_session_id_credential_sha256_uq
+      unique(session_id, credential_sha256)
+  );
+  comment on table session_credential is
+    'session_credential contains the encrypted secret data of credentials issued for a session '
+    'which are given to the worker handling the session but never to the user, such as egress credentials.';
+
+  create trigger default_create_time_column before insert on session_credential
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on session_credential
+    for each row execute procedure immutable_columns('session_id', 'credential', 'key_id', 'credential_sha256', 'create_time');
+
+  -- delete_session_credentials deletes the secret data of all credentials for
+  -- a session when the session enters the canceling or terminated states.
+  create function delete_session_credentials()
+    returns trigger
+  as $$
+  begin
+    if new.state in ('canceling', 'terminated') then
+      delete from session_credential
+       where session_id = new.session_id;
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+  create trigger delete_session_credentials after insert on session_state
+    for each row execute procedure delete_session_credentials();
+
+commit;
@@ -0,0 +1,212 @@
+begin;
+
+  -- credential_static_store is a credential store subtype whose credentials
+  -- are stored in Boundary instead of being retrieved from an external
+  -- system.
+  create table credential_static_store (
+    public_id wt_public_id primary key,
+    scope_id wt_scope_id not null
+      constraint iam_scope_fkey
+        references iam_scope (public_id)
+        on delete cascade
+        on update cascade,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    constraint credential_store_fkey
+      foreign key (scope_id, public_id)
+      references credential_store (scope_id, public_id)
+      on delete cascade
+      on update cascade,
+    constraint credential_static_store_scope_id_name_uq
+      unique(scope_id, name)
+  );
+  comment on table credential_static_store is
+    'credential_static_store is a table where each row is a resource that represents a static credential store. '
+    'It is a credential_store subtype.';
+
+  create trigger update_version_column after update on credential_static_store
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on credential_static_store
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on credential_static_store
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on credential_static_store
+    for each row execute procedure immutable_columns('public_id', 'scope_id', 'create_time');
+
+  create trigger insert_credential_store_subtype before insert on credential_static_store
+    for each row execute procedure insert_credential_store_subtype();
+
+  create trigger delete_credential_store_subtype after delete on credential_static_store
+    for each row execute procedure delete_credential_store_subtype();
+
+  create table credential_static_credential_type_enm (
+    name text primary key
+      constraint only_predefined_credential_types_allowed
+      check (
+        name in (
+          'username_password',
+          'ssh_private_key',
+          'json'
+        )
+      )
+  );
+  comment on table credential_static_credential_type_enm is
+    'credential_static_credential_type_enm is an enumeration table for the type of credential stored in a static credential library.';
+
+  insert into credential_static_credential_type_enm (name)
+  values
+    ('username_password'),
+    ('ssh_private_key'),
+    ('json');
+
+  create table credential_static_library (
+    public_id wt_public_id primary key,
+    store_id wt_public_id not null
+      constraint credential_static_store_fkey
+        references credential_static_store (public_id)
+        on delete cascade
+        on update cascade,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    credential_type text not null
+      constraint credential_static_credential_type_enm_fkey
+        references credential_static_credential_type_enm (name)
+        on delete restrict
+        on update cascade,
+    username text
+      constraint username_must_not_be_empty
+        check(length(trim(username)) > 0),
+    secret bytea not null -- encrypted value
+      constraint secret_must_not_be_empty
+        check(length(secret) > 0),
+    secret_hmac bytea not null
+      constraint secret_hmac_must_not_be_empty
+        check(length(secret_hmac) > 0),
+    key_id text not null
+      constraint kms_database_key_version_fkey
+        references kms_database_key_version (private_id)
+        on delete restrict
+        on update cascade,
+    constraint username_only_allowed_for_username_types
+      check(
+        (credential_type = 'json' and username is null)
+        or
+        (credential_type != 'json' and username is not null)
+      ),
+    constraint credential_static_library_store_id_name_uq
+      unique(store_id, name),
+    constraint credential_library_fkey
+      foreign key (store_id, public_id)
+      references credential_library (store_id, public_id)
+      on delete cascade
+      on update cascade,
+    constraint credential_static_library_store_id_public_id_uq
+      unique(store_id, public_id)
+  );
+  comment on table credential_static_library is
+    'credential_static_library is a table where each row is a resource that represents a static credential library. '
+    'Each library contains a single credential whose secret is encrypted with the database key of the scope. '
+    'It is a credential_library subtype and a child table of credential_static_store.';
+
+  create trigger update_version_column after update on credential_static_library
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on credential_static_library
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on credential_static_library
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on credential_static_library
+    for each row execute procedure immutable_columns('public_id', 'store_id', 'credential_type', 'create_time');
+
+  create trigger insert_credential_library_subtype before insert on credential_static_library
+    for each row execute procedure insert_credential_library_subtype();
+
+  create trigger delete_credential_library_subtype after delete on credential_static_library
+    for each row execute procedure delete_credential_library_subtype();
+
+  insert into oplog_ticket (name, version)
+  values
+    ('credential_static_store', 1),
+    ('credential_static_library', 1);
+
+  -- Replaces the view created in 23/01 to support all credential library
+  -- subtypes.
+  drop view whx_credential_dimension_source;
+  create view whx_credential_dimension_source as
+       select -- id is the first column in the target view
+              s.public_id                              as session_id,
+              coalesce(scd.credential_purpose, 'None') as credential_purpose,
+              cl.public_id                             as credential_library_id,
+              case
+                when vcl.public_id is not null then 'vault credential library'
+                when scl.public_id is not null then 'static credential library'
+                else 'None'
+                end                                    as credential_library_type,
+              coalesce(vcl.name, scl.name, 'None')     as credential_library_name,
+              coalesce(vcl.description, scl.description, 'None')
+                                                       as credential_library_description,
+              coalesce(vcl.vault_path, 'None')         as credential_library_vault_path,
+              coalesce(vcl.http_method, 'None')        as credential_library_vault_http_method,
+              coalesce(vcl.http_request_body, 'None')  as credential_library_vault_http_request_body,
+              cs.public_id                             as credential_store_id,
+              case
+                when vcs.public_id is not null then 'vault credential store'
+                when scs.public_id is not null then 'static credential store'
+                else 'None'
+                end                                    as credential_store_type,
+              coalesce(vcs.name, scs.name, 'None')     as credential_store_name,
+              coalesce(vcs.description, scs.description, 'None')
+                                                       as credential_store_description,
+              coalesce(vcs.namespace, 'None')          as credential_store_vault_namespace,
+              coalesce(vcs.vault_address, 'None')      as credential_store_vault_address,
+              t.public_id                              as target_id,
+              tt.type || ' target'                     as target_type,
+              coalesce(tt.name, 'None')                as target_name,
+              coalesce(tt.description, 'None')         as target_description,
+              coalesce(tt.default_port, 0)             as target_default_port_number,
+              tt.session_max_seconds                   as target_session_max_seconds,
+              tt.session_connection_limit              as target_session_connection_limit,
+              p.public_id                              as project_id,
+              coalesce(p.name, 'None')                 as project_name,
+              coalesce(p.description, 'None')          as project_description,
+              o.public_id                              as organization_id,
+              coalesce(o.name, 'None')                 as organization_name,
+              coalesce(o.description, 'None')          as organization_description
+         from session_credential_dynamic as scd
+         join session as s
+           on s.public_id = scd.session_id
+         join credential_library as cl
+           on scd.library_id = cl.public_id
+         join credential_store as cs
+           on cl.store_id = cs.public_id
+    left join credential_vault_library as vcl
+           on vcl.public_id = cl.public_id
+    left join credential_vault_store as vcs
+           on vcs.public_id = cs.public_id
+    left join credential_static_library as scl
+           on scl.public_id = cl.public_id
+    left join credential_static_store as scs
+           on scs.public_id = cs.public_id
+         join target as t
+           on s.target_id = t.public_id
+         join target_all_subtypes as tt
+           on t.public_id = tt.public_id
+         join iam_scope as p
+           on p.public_id = t.scope_id
+          and p.type = 'project'
+         join iam_scope as o
+           on o.public_id = p.parent_id
+          and o.type = 'org';
+
+commit;
@@ -0,0 +1,325 @@
+begin;
+
+-- approval_required is true for targets whose sessions must be approved by
+-- one of the target's approvers before they can be activated.
+alter table target_tcp
+  add column approval_required boolean not null default false;
+alter table target_ssh
+  add column approval_required boolean not null default false;
+
+-- target_approver_user contains the users which can approve sessions for a
+-- target.
+create table target_approver_user (
+  target_id wt_public_id not null
+    references target(public_id)
+    on delete cascade
+    on update cascade,
+  principal_id wt_user_id not null
+    references iam_user(public_id)
+    on delete cascade
+    on update cascade,
+  create_time wt_timestamp,
+  primary key(target_id, principal_id)
+);
+
+create trigger immutable_columns before update on target_approver_user
+  for each row execute procedure immutable_columns('target_id', 'principal_id', 'create_time');
+
+create trigger default_create_time_column before insert on target_approver_user
+  for each row execute procedure default_create_time();
+
+-- target_approver_group contains the groups whose members can approve
+-- sessions for a target.
+create table target_approver_group (
+  target_id wt_public_id not null
+    references target(public_id)
+    on delete cascade
+    on update cascade,
+  principal_id wt_public_id not null
+    references iam_group(public_id)
+    on delete cascade
+    on update cascade,
+  create_time wt_timestamp,
+  primary key(target_id, principal_id)
+);
+
+create trigger immutable_columns before update on target_approver_group
+  for each row execute procedure immutable_columns('target_id', 'principal_id', 'create_time');
+
+create trigger default_create_time_column before insert on target_approver_group
+  for each row execute procedure default_create_time();
+
+create view target_approver as
+select
+  target_id,
+  principal_id,
+  create_time
+from target_approver_user
+union
+select
+  target_id,
+  principal_id,
+  create_time
+from target_approver_group;
+
+-- Replaces the view created in 23/01 to include approval_required. The view
+-- is replaced rather than dropped since the warehouse views created in 23/01
+-- and 24/01 depend on it, so the new column must be added last.
+create or replace view target_all_subtypes
+as
+select
+  public_id,
+  scope_id,
+  name,
+  description,
+  default_port,
+  session_max_seconds,
+  session_connection_limit,
+  version,
+  create_time,
+  update_time,
+  worker_filter,
+  'tcp' as type,
+  approval_required
+from target_tcp
+union
+select
+  public_id,
+  scope_id,
+  name,
+  description,
+  default_port,
+  session_max_seconds,
+  session_connection_limit,
+  version,
+  create_time,
+  update_time,
+  worker_filter,
+  'ssh' as type,
+  approval_required
+from target_ssh;
+
+-- approval_required is copied from the target when a session is created.
+alter table session
+  add column approval_required boolean not null default false;
+
+drop trigger immutable_columns on session;
+create trigger immutable_columns before update on session
+  for each row execute procedure immutable_columns('public_id', 'certificate', 'expiration_time', 'connection_limit', 'create_time', 'endpoint', 'approval_required');
+
+/*
+  A session which requires approval starts in the pending_approval state. It
+  moves to the pending state once approved, and to the terminated state with a
+  termination reason of denied if it is denied.
+
+         start
+           .
+          (●)
+           '
+           │ approval_required
+           ▼
+  ┌────────────────┐  approved   ┌────────────────┐
+  │                │────────────▶│                │
+  │Pending Approval│             │    Pending     │────▶ ...
+  │                │             │                │
+  └────────────────┘             └────────────────┘
+           │ denied
+           ▼
+  ┌────────────────┐
+  │   Terminated   │
+  └────────────────┘
+*/
+alter table session_state_enm
+  drop constraint only_predefined_session_states_allowed;
+alter table session_state_enm
+  add constraint only_predefined_session_states_allowed
+    check (
+      name in ('pending_approval', 'pending', 'active', 'canceling', 'terminated')
+    );
+
+insert into session_state_enm (name)
+values
+  ('pending_approval');
+
+alter table session_termination_reason_enm
+  drop constraint only_predefined_session_termination_reasons_allowed;
+alter table session_termination_reason_enm
+  add constraint only_predefined_session_termination_reasons_allowed
+    check (
+      name in (
+        'unknown',
+        'timed out',
+        'closed by end-user',
+        'terminated',
+        'network error',
+        'system error',
+        'connection limit',
+        'canceled',
+        'denied'
+      )
+    );
+
+insert into session_termination_reason_enm (name)
+values
+  ('denied');
+
+-- Replaces the function created in 0/50 to start sessions which require
+-- approval in the pending_approval state.
+create or replace function insert_new_session_state()
+  returns trigger
+as $$
+begin
+  if new.approval_required then
+    insert into session_state (session_id, state)
+    values
+      (new.public_id, 'pending_approval');
+    return new;
+  end if;
+
+  insert into session_state (session_id, state)
+  values
+    (new.public_id, 'pending');
+  return new;
+end;
+$$ language plpgsql;
+
+-- Replaces the function created in 16/04 to use the pending_approval state as
+-- the pending time of sessions which require approval.
+drop trigger wh_insert_session on session;
+drop function wh_insert_session;
+create function wh_insert_session()
+  returns trigger
+as $$
+declare
+  new_row wh_session_accumulating_fact%rowtype;
+begin
+  with
+  pending_timestamp (date_dim_key, time_dim_key, ts) as (
+    select wh_date_key(start_time), wh_time_key(start_time), start_time
+      from session_state
+     where session_id = new.public_id
+       and state      in ('pending', 'pending_approval')
+  )
+  insert into wh_session_accumulating_fact (
+         session_id,
+         auth_token_id,
+         host_key,
+         user_key,
+         credential_group_key,
+         session_pending_date_key,
+         session_pending_time_key,
+         session_pending_time
+  )
+  select new.public_id,
+         new.auth_token_id,
+         wh_upsert_host(new.host_id, new.host_set_id, new.target_id),
+         wh_upsert_user(new.user_id, new.auth_token_id),
+         'no credentials', -- will be updated by wh_upsert_credentail_group
+         pending_timestamp.date_dim_key,
+         pending_timestamp.time_dim_key,
+         pending_timestamp.ts
+    from pending_timestamp
+    returning * into strict new_row;
+  return null;
+end;
+$$ language plpgsql;
+create trigger wh_insert_session
+  after insert on session
+  for each row
+  execute function wh_insert_session();
+
+-- Replaces the function created in 15/01 to ignore the pending state of
+-- sessions which were approved, since their pending time is the time of the
+-- pending_approval state.
+drop trigger wh_insert_session_state on session_state;
+drop function wh_insert_session_state;
+create function wh_insert_session_state()
+  returns trigger
+as $$
+declare
+  date_col text;
+  time_col text;
+  ts_col text;
+  q text;
+  session_row wh_session_accumulating_fact%rowtype;
+begin
+  if new.state in ('pending', 'pending_approval') then
+    -- The pending or pending_approval state is the first state which is
+    -- handled by the wh_insert_session trigger. A session which required
+    -- approval moves to the pending state once it is approved, which is not
+    -- recorded in the warehouse.
+    return null;
+  end if;
+
+  date_col = 'session_' || new.state || '_date_key';
+  time_col = 'session_' || new.state || '_time_key';
+  ts_col   = 'session_' || new.state || '_time';
+
+  q = format('update wh_session_accumulating_fact
+                 set (%I, %I, %I) = (select wh_date_key(%L), wh_time_key(%L), %L::timestamptz)
+               where session_id = %L
+              returning *',
+              date_col,       time_col,       ts_col,
+              new.start_time, new.start_time, new.start_time,
+              new.session_id);
+  execute q into strict session_row;
+
+  return null;
+end;
+$$ language plpgsql;
+
+create trigger wh_insert_session_state
+  after insert on session_state
+  for each row
+  execute function wh_insert_session_state();
+
+-- Replaces the view created in 22/01 to include approval_required
+drop view session_list;
+create view session_list as
+  select
+    s.public_id,
+    s.user_id,
+    s.host_id,
+    s.server_id,
+    s.server_type,
+    s.target_id,
+    s.host_set_id,
+    s.auth_token_id,
+    s.scope_id,
+    s.certificate,
+    s.expiration_time,
+    s.connection_limit,
+    s.tofu_token,
+    s.key_id,
+    s.termination_reason,
+    s.version,
+    s.create_time,
+    s.update_time,
+    s.endpoint,
+    s.worker_filter,
+    s.approval_required,
+    ss.state,
+    ss.previous_end_time,
+    ss.start_time,
+    ss.end_time,
+    sc.public_id as connection_id,
+    sc.client_tcp_address,
+    sc.client_tcp_port,
+    sc.endpoint_tcp_address,
+    sc.endpoint_tcp_port,
+    sc.bytes_up,
+    sc.bytes_down,
+    sc.closed_reason,
+    sc.recording_id
+  from
+    session s
+  join
+    session_state ss
+  on
+    s.public_id = ss.session_id
+  left join
+    session_connection sc
+  on
+    s.public_id = sc.session_id;
+
+commit;
@@ -0,0 +1,85 @@
+begin;
+
+  -- expiration_time is the time after which a principal's assignment to a
+  -- role no longer grants anything. A null expiration_time never expires.
+  -- Expired assignments are deleted periodically by the controller.
+  alter table iam_user_role
+    add column expiration_time timestamp with time zone,
+    add constraint expiration_time_after_create_time
+      check (expiration_time > create_time);
+
+  alter table iam_group_role
+    add column expiration_time timestamp with time zone,
+    add constraint expiration_time_after_create_time
+      check (expiration_time > create_time);
+
+  alter table iam_managed_group_role
+    add column expiration_time timestamp with time zone,
+    add constraint expiration_time_after_create_time
+      check (expiration_time > create_time);
+
+  create index iam_user_role_expiration_time_ix
+    on iam_user_role (expiration_time)
+    where expiration_time is not null;
+  create index iam_group_role_expiration_time_ix
+    on iam_group_role (expiration_time)
+    where expiration_time is not null;
+  create index iam_managed_group_role_expiration_time_ix
+    on iam_managed_group_role (expiration_time)
+    where expiration_time is not null;
+
+  -- Replaces view from 9/04_oidc_managed_group_principal_role to add
+  -- expiration_time.
+  create or replace view iam_principal_role as
+  select
+    ur.create_time,
+    ur.principal_id,
+    ur.role_id,
+    u.scope_id as principal_scope_id,
+    r.scope_id as role_scope_id,
+    get_scoped_principal_id(r.scope_id, u.scope_id, ur.principal_id) as scoped_principal_id,
+    'user' as type,
+    ur.expiration_time
+  from
+    iam_user_role ur,
+    iam_role r,
+    iam_user u
+  where
+    ur.role_id = r.public_id and
+    u.public_id = ur.principal_id
+  union
+  select
+    gr.create_time,
+    gr.principal_id,
+    gr.role_id,
+    g.scope_id as principal_scope_id,
+    r.scope_id as role_scope_id,
+    get_scoped_principal_id(r.scope_id, g.scope_id, gr.principal_id) as scoped_principal_id,
+    'group' as type,
+    gr.expiration_time
+  from
+    iam_group_role gr,
+    iam_role r,
+    iam_group g
+  where
+    gr.role_id = r.public_id and
+    g.public_id = gr.principal_id
+  union
+  select
+    mgr.create_time,
+    mgr.principal_id,
+    mgr.role_id,
+    (select scope_id from auth_method am where am.public_id = amg.auth_method_id) as principal_scope_id,
+    r.scope_id as role_scope_id,
+    get_scoped_principal_id(r.scope_id, (select scope_id from auth_method am where am.public_id = amg.auth_method_id), mgr.principal_id) as scoped_principal_id,
+    'managed group' as type,
+    mgr.expiration_time
+  from
+    iam_managed_group_role mgr,
+    iam_role r,
+    auth_managed_group amg
+  where
+    mgr.role_id = r.public_id and
+    amg.public_id = mgr.principal_id;
+
+commit;
@@ -0,0 +1,66 @@
+begin;
+
+  -- host_keys contains the public keys of the hosts of an ssh target in the
+  -- format of an OpenSSH authorized_keys file. The worker only authenticates
+  -- to a host using the egress credentials of a session if the host presents
+  -- one of these keys.
+  alter table target_ssh
+    add column host_keys text
+      constraint host_keys_must_not_be_empty
+        check(length(trim(host_keys)) > 0);
+
+  -- Replaces the view created in 28/01 to include the host keys of ssh targets
+  create or replace view target_all_subtypes
+  as
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    'tcp' as type,
+    approval_required,
+    null as host_keys
+  from target_tcp
+  union
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    'ssh' as type,
+    approval_required,
+    host_keys
+  from target_ssh
+  union
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    'postgres' as type,
+    approval_required,
+    null as host_keys
+  from target_postgres;
+
+commit;
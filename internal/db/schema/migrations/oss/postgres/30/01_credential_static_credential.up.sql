@@ -0,0 +1,46 @@
+begin;
+
+  -- credential_static_credential records each credential issued from a
+  -- static credential library for a session. Like credential_vault_credential
+  -- it is a credential_dynamic subtype, so the issued credential can be
+  -- referenced by session_credential_dynamic.
+  create table credential_static_credential (
+    public_id wt_public_id primary key,
+    library_id wt_public_id
+      constraint credential_static_library_fkey
+        references credential_static_library (public_id)
+        on delete set null
+        on update cascade,
+    session_id wt_public_id
+      constraint session_fkey
+        references session (public_id)
+        on delete set null
+        on update cascade,
+    create_time wt_timestamp,
+    constraint credential_dynamic_fkey
+      foreign key (library_id, public_id)
+      references credential_dynamic (library_id, public_id)
+      on delete cascade
+      on update cascade,
+    constraint credential_static_credential_library_id_public_id_uq
+      unique(library_id, public_id)
+  );
+  comment on table credential_static_credential is
+    'credential_static_credential is a table where each row records a credential issued from a static credential library for a session.';
+
+  create trigger not_null_columns before insert on credential_static_credential
+    for each row execute procedure not_null_columns('library_id', 'session_id');
+
+  create trigger default_create_time_column before insert on credential_static_credential
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on credential_static_credential
+    for each row execute procedure immutable_columns('public_id', 'create_time');
+
+  create trigger insert_credential_dynamic_subtype before insert on credential_static_credential
+    for each row execute procedure insert_credential_dynamic_subtype();
+
+  create trigger delete_credential_dynamic_subtype after delete on credential_static_credential
+    for each row execute procedure delete_credential_dynamic_subtype();
+
+commit;
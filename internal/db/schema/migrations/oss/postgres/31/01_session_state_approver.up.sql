@@ -0,0 +1,62 @@
+begin;
+
+-- approver_id is the user which approved or denied a session which required
+-- approval. It is set on the pending state entered when the session was
+-- approved and on the terminated state entered when it was denied.
+alter table session_state
+  add column approver_id wt_user_id
+    references iam_user(public_id)
+    on delete set null
+    on update cascade;
+
+-- Replaces the view created in 25/01 to include the approver of each state.
+drop view session_list;
+create view session_list as
+  select
+    s.public_id,
+    s.user_id,
+    s.host_id,
+    s.server_id,
+    s.server_type,
+    s.target_id,
+    s.host_set_id,
+    s.auth_token_id,
+    s.scope_id,
+    s.certificate,
+    s.expiration_time,
+    s.connection_limit,
+    s.tofu_token,
+    s.key_id,
+    s.termination_reason,
+    s.version,
+    s.create_time,
+    s.update_time,
+    s.endpoint,
+    s.worker_filter,
+    s.approval_required,
+    ss.state,
+    ss.previous_end_time,
+    ss.start_time,
+    ss.end_time,
+    ss.approver_id,
+    sc.public_id as connection_id,
+    sc.client_tcp_address,
+    sc.client_tcp_port,
+    sc.endpoint_tcp_address,
+    sc.endpoint_tcp_port,
+    sc.bytes_up,
+    sc.bytes_down,
+    sc.closed_reason,
+    sc.recording_id
+  from
+    session s
+  join
+    session_state ss
+  on
+    s.public_id = ss.session_id
+  left join
+    session_connection sc
+  on
+    s.public_id = sc.session_id;
+
+commit;
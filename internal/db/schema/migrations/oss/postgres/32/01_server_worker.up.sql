@@ -0,0 +1,71 @@
+begin;
+
+  -- server_worker entries hold the operator managed fields of the workers
+  -- which have reported their status to a controller. They are exposed through
+  -- the public workers API under their public_id.
+  create table server_worker (
+    public_id wt_public_id primary key,
+    server_id text not null
+      constraint server_fkey
+        references server (private_id)
+        on delete cascade
+        on update cascade
+      constraint server_worker_server_id_uq
+        unique,
+    name wt_name
+      constraint server_worker_name_uq
+        unique,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version
+  );
+  comment on table server_worker is
+    'server_worker entries hold the operator managed fields of the workers which have reported their status to a controller.';
+
+  create trigger default_create_time_column before insert on server_worker
+    for each row execute procedure default_create_time();
+
+  create trigger update_time_column before update on server_worker
+    for each row execute procedure update_time_column();
+
+  create trigger update_version_column after update on server_worker
+    for each row execute procedure update_version_column();
+
+  create trigger immutable_columns before update on server_worker
+    for each row execute procedure immutable_columns('public_id', 'server_id', 'create_time');
+
+  -- Give the workers which already reported their status a public id.
+  insert into server_worker (public_id, server_id)
+    select 'w_' || substr(md5(random()::text || private_id), 1, 10), private_id
+      from server
+     where type = 'worker';
+
+  -- server_worker_aggregate adds the fields reported by a worker in its
+  -- status updates and the number of its active sessions to server_worker.
+  create view server_worker_aggregate as
+    select
+      w.public_id,
+      w.server_id,
+      w.name,
+      w.description,
+      w.create_time,
+      w.update_time,
+      w.version,
+      s.address,
+      s.update_time as last_status_time,
+      (select count(*)
+         from session sess
+         join session_state ss
+           on sess.public_id = ss.session_id
+        where sess.server_id = w.server_id
+          and ss.state = 'active'
+          and ss.end_time is null
+      ) as active_session_count
+    from server_worker w
+    join server s
+      on w.server_id = s.private_id;
+  comment on view server_worker_aggregate is
+    'server_worker_aggregate contains the server_worker entries along with the address and last status time of the worker and its number of active sessions.';
+
+commit;
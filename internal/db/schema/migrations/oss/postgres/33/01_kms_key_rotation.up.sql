@@ -0,0 +1,127 @@
+begin;
+
+  -- kms_scope_key_version contains the versions of the root key and the DEKs
+  -- of every scope, along with the purpose of the key they belong to. It is
+  -- used when listing the keys of a scope and when destroying key versions.
+  create view kms_scope_key_version as
+    select rk.scope_id,
+           'root' as purpose,
+           rkv.root_key_id as key_id,
+           rkv.private_id,
+           rkv.version,
+           rkv.create_time,
+           null as root_key_version_id
+      from kms_root_key_version rkv
+      join kms_root_key rk
+        on rkv.root_key_id = rk.private_id
+    union all
+    select rk.scope_id,
+           'database' as purpose,
+           kv.database_key_id as key_id,
+           kv.private_id,
+           kv.version,
+           kv.create_time,
+           kv.root_key_version_id
+      from kms_database_key_version kv
+      join kms_database_key k
+        on kv.database_key_id = k.private_id
+      join kms_root_key rk
+        on k.root_key_id = rk.private_id
+    union all
+    select rk.scope_id,
+           'oplog' as purpose,
+           kv.oplog_key_id as key_id,
+           kv.private_id,
+           kv.version,
+           kv.create_time,
+           kv.root_key_version_id
+      from kms_oplog_key_version kv
+      join kms_oplog_key k
+        on kv.oplog_key_id = k.private_id
+      join kms_root_key rk
+        on k.root_key_id = rk.private_id
+    union all
+    select rk.scope_id,
+           'tokens' as purpose,
+           kv.token_key_id as key_id,
+           kv.private_id,
+           kv.version,
+           kv.create_time,
+           kv.root_key_version_id
+      from kms_token_key_version kv
+      join kms_token_key k
+        on kv.token_key_id = k.private_id
+      join kms_root_key rk
+        on k.root_key_id = rk.private_id
+    union all
+    select rk.scope_id,
+           'sessions' as purpose,
+           kv.session_key_id as key_id,
+           kv.private_id,
+           kv.version,
+           kv.create_time,
+           kv.root_key_version_id
+      from kms_session_key_version kv
+      join kms_session_key k
+        on kv.session_key_id = k.private_id
+      join kms_root_key rk
+        on k.root_key_id = rk.private_id
+    union all
+    select rk.scope_id,
+           'oidc' as purpose,
+           kv.oidc_key_id as key_id,
+           kv.private_id,
+           kv.version,
+           kv.create_time,
+           kv.root_key_version_id
+      from kms_oidc_key_version kv
+      join kms_oidc_key k
+        on kv.oidc_key_id = k.private_id
+      join kms_root_key rk
+        on k.root_key_id = rk.private_id
+    union all
+    select rk.scope_id,
+           'audit' as purpose,
+           kv.audit_key_id as key_id,
+           kv.private_id,
+           kv.version,
+           kv.create_time,
+           kv.root_key_version_id
+      from kms_audit_key_version kv
+      join kms_audit_key k
+        on kv.audit_key_id = k.private_id
+      join kms_root_key rk
+        on k.root_key_id = rk.private_id;
+  comment on view kms_scope_key_version is
+    'kms_scope_key_version contains the versions of the root key and the DEKs of every scope along with the purpose of their key.';
+
+  -- kms_latest_database_key_version contains the latest version of the
+  -- database key of every scope. Values encrypted with an older version are
+  -- rewrapped with it by the kms_rewrap job.
+  create view kms_latest_database_key_version as
+    select distinct on (scope_id)
+           scope_id,
+           private_id
+      from kms_scope_key_version
+     where purpose = 'database'
+     order by scope_id, version desc;
+  comment on view kms_latest_database_key_version is
+    'kms_latest_database_key_version contains the latest version of the database key of every scope.';
+
+  -- tofu_token_key_id is the database key version used to encrypt the
+  -- tofu_token of a session. The key_id column of a session holds the
+  -- session key version used to derive the session's private key.
+  alter table session
+    add column tofu_token_key_id text
+      constraint kms_database_key_version_fkey
+        references kms_database_key_version (private_id)
+        on delete restrict
+        on update cascade;
+
+  -- Replaces the trigger from 10/04_vault_credential so the token can be
+  -- rewrapped with a newer version of the database key.
+  drop trigger immutable_columns on credential_vault_token;
+  create trigger immutable_columns before update on credential_vault_token
+    for each row execute procedure immutable_columns('token_hmac', 'store_id', 'create_time');
+
+commit;
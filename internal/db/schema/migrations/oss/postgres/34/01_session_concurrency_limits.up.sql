@@ -0,0 +1,85 @@
+begin;
+
+  -- max_concurrent_sessions is the maximum number of sessions which can be
+  -- open for a target at the same time. -1 means there is no limit.
+  alter table target_tcp
+    add column max_concurrent_sessions int not null default -1
+      constraint max_concurrent_sessions_must_be_greater_than_0_or_negative_1
+        check(max_concurrent_sessions > 0 or max_concurrent_sessions = -1);
+  alter table target_ssh
+    add column max_concurrent_sessions int not null default -1
+      constraint max_concurrent_sessions_must_be_greater_than_0_or_negative_1
+        check(max_concurrent_sessions > 0 or max_concurrent_sessions = -1);
+  alter table target_postgres
+    add column max_concurrent_sessions int not null default -1
+      constraint max_concurrent_sessions_must_be_greater_than_0_or_negative_1
+        check(max_concurrent_sessions > 0 or max_concurrent_sessions = -1);
+
+  -- Replaces the view created in 29/01 to include max_concurrent_sessions
+  create or replace view target_all_subtypes
+  as
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    'tcp' as type,
+    approval_required,
+    null as host_keys,
+    max_concurrent_sessions
+  from target_tcp
+  union
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    'ssh' as type,
+    approval_required,
+    host_keys,
+    max_concurrent_sessions
+  from target_ssh
+  union
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    'postgres' as type,
+    approval_required,
+    null as host_keys,
+    max_concurrent_sessions
+  from target_postgres;
+
+  -- max_concurrent_sessions_per_user is the maximum number of sessions a user
+  -- can have open at the same time for the targets of an org or project
+  -- scope. -1 means there is no limit.
+  alter table iam_scope
+    add column max_concurrent_sessions_per_user int not null default -1
+      constraint max_concurrent_sessions_per_user_must_be_greater_than_0_or_negative_1
+        check(max_concurrent_sessions_per_user > 0 or max_concurrent_sessions_per_user = -1)
+      constraint global_scope_max_concurrent_sessions_per_user_must_be_negative_1
+        check(type != 'global' or max_concurrent_sessions_per_user = -1);
+
+commit;
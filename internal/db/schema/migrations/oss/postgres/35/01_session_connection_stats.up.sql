@@ -0,0 +1,61 @@
+begin;
+
+-- Replaces the view created in 31/01 to include the time each connection was
+-- created and the time it was closed, if it has been closed.
+drop view session_list;
+create view session_list as
+  select
+    s.public_id,
+    s.user_id,
+    s.host_id,
+    s.server_id,
+    s.server_type,
+    s.target_id,
+    s.host_set_id,
+    s.auth_token_id,
+    s.scope_id,
+    s.certificate,
+    s.expiration_time,
+    s.connection_limit,
+    s.tofu_token,
+    s.key_id,
+    s.termination_reason,
+    s.version,
+    s.create_time,
+    s.update_time,
+    s.endpoint,
+    s.worker_filter,
+    s.approval_required,
+    ss.state,
+    ss.previous_end_time,
+    ss.start_time,
+    ss.end_time,
+    ss.approver_id,
+    sc.public_id as connection_id,
+    sc.client_tcp_address,
+    sc.client_tcp_port,
+    sc.endpoint_tcp_address,
+    sc.endpoint_tcp_port,
+    sc.bytes_up,
+    sc.bytes_down,
+    sc.closed_reason,
+    sc.recording_id,
+    sc.create_time as connection_create_time,
+    scs.start_time as connection_closed_time
+  from
+    session s
+  join
+    session_state ss
+  on
+    s.public_id = ss.session_id
+  left join
+    session_connection sc
+  on
+    s.public_id = sc.session_id
+  left join
+    session_connection_state scs
+  on
+    sc.public_id = scs.connection_id and
+    scs.state = 'closed';
+
+commit;
@@ -0,0 +1,110 @@
+begin;
+
+  -- ingress_worker_filter selects the workers clients connect to for the
+  -- sessions of a target. Those which don't match the worker_filter forward
+  -- connections to a worker which does.
+  alter table target_tcp
+    add column ingress_worker_filter wt_bexprfilter;
+  alter table target_ssh
+    add column ingress_worker_filter wt_bexprfilter;
+  alter table target_postgres
+    add column ingress_worker_filter wt_bexprfilter;
+
+  -- Replaces the view created in 34/01 to include ingress_worker_filter
+  create or replace view target_all_subtypes
+  as
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    'tcp' as type,
+    approval_required,
+    null as host_keys,
+    max_concurrent_sessions,
+    ingress_worker_filter
+  from target_tcp
+  union
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    'ssh' as type,
+    approval_required,
+    host_keys,
+    max_concurrent_sessions,
+    ingress_worker_filter
+  from target_ssh
+  union
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    'postgres' as type,
+    approval_required,
+    null as host_keys,
+    max_concurrent_sessions,
+    ingress_worker_filter
+  from target_postgres;
+
+  -- The target's ingress_worker_filter when the session was created, used to
+  -- validate the workers forwarding the session's connections.
+  alter table session
+    add column ingress_worker_filter wt_bexprfilter;
+
+  -- Replaces the trigger from 25/01 to add ingress_worker_filter
+  drop trigger immutable_columns on session;
+  create trigger immutable_columns before update on session
+    for each row execute procedure immutable_columns('public_id', 'certificate', 'expiration_time', 'connection_limit', 'create_time', 'endpoint', 'approval_required', 'ingress_worker_filter');
+
+  -- session_connection_worker records the chain of workers a connection was
+  -- forwarded through, starting with the worker the client connected to at
+  -- hop 0 and ending with the worker which connected to the endpoint. It is
+  -- only populated for connections forwarded between workers.
+  create table session_connection_worker (
+    connection_id wt_public_id not null
+      references session_connection (public_id)
+      on delete cascade
+      on update cascade,
+    hop integer not null
+      constraint hop_must_be_greater_than_or_equal_to_0
+        check(hop >= 0),
+    -- worker_id isn't a foreign key so the chain is kept when a worker is
+    -- deleted
+    worker_id text not null
+      constraint worker_id_must_not_be_empty
+        check(length(trim(worker_id)) > 0),
+    create_time wt_timestamp,
+    primary key(connection_id, hop)
+  );
+
+  create trigger immutable_columns before update on session_connection_worker
+    for each row execute procedure immutable_columns('connection_id', 'hop', 'worker_id', 'create_time');
+
+  create trigger default_create_time_column before insert on session_connection_worker
+    for each row execute procedure default_create_time();
+
+commit;
@@ -0,0 +1,9 @@
+begin;
+
+  -- reverse is true for workers which can't be dialed at their address and
+  -- instead keep tunnels open to the controllers. Connections are relayed to
+  -- them through the controllers by ingress workers.
+  alter table server
+    add column reverse boolean not null default false;
+
+commit;
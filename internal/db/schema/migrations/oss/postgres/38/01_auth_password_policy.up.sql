@@ -0,0 +1,119 @@
+begin;
+
+  -- min_character_classes is the number of character classes (lowercase,
+  -- uppercase, digits and symbols) a password must contain.
+  -- password_history_count is the number of previous passwords of an
+  -- account which cannot be reused. max_password_age_seconds is how long a
+  -- password can be used before it must be changed; 0 means passwords do not
+  -- expire. An account is locked for lockout_duration_seconds after
+  -- lockout_threshold failed authentication attempts; a lockout_threshold of
+  -- 0 disables lockout and a lockout_duration_seconds of 0 locks the account
+  -- until it is unlocked.
+  alter table auth_password_method
+    add column min_character_classes int not null default 0
+      constraint min_character_classes_must_be_between_0_and_4
+        check(min_character_classes between 0 and 4),
+    add column password_history_count int not null default 0
+      constraint password_history_count_must_not_be_negative
+        check(password_history_count >= 0),
+    add column max_password_age_seconds int not null default 0
+      constraint max_password_age_seconds_must_not_be_negative
+        check(max_password_age_seconds >= 0),
+    add column lockout_threshold int not null default 0
+      constraint lockout_threshold_must_not_be_negative
+        check(lockout_threshold >= 0),
+    add column lockout_duration_seconds int not null default 0
+      constraint lockout_duration_seconds_must_not_be_negative
+        check(lockout_duration_seconds >= 0);
+
+  -- Replaces the view created in 2/20 to include the password policy
+  create or replace view auth_password_method_with_is_primary as
+  select
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.password_conf_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.min_login_name_length,
+    am.min_password_length,
+    am.min_character_classes,
+    am.password_history_count,
+    am.max_password_age_seconds,
+    am.lockout_threshold,
+    am.lockout_duration_seconds
+  from
+    auth_password_method am
+    left outer join iam_scope s on am.public_id = s.primary_auth_method_id;
+
+  -- Replaces the view created in 0/14 to include the password policy
+  create or replace view auth_password_current_conf as
+      select pm.min_login_name_length, pm.min_password_length, c.*,
+             pm.min_character_classes, pm.password_history_count
+        from auth_password_method pm
+  inner join auth_password_conf_union c
+          on pm.password_conf_id = c.password_conf_id;
+
+  -- auth_password_account_lockout holds the failed authentication attempts
+  -- of a password account since its last successful authentication and the
+  -- time until which the account is locked. Rows are deleted when the
+  -- account authenticates successfully or is unlocked.
+  create table auth_password_account_lockout (
+    password_account_id wt_public_id primary key
+      references auth_password_account (public_id)
+      on delete cascade
+      on update cascade,
+    failed_attempts int not null default 0
+      constraint failed_attempts_must_not_be_negative
+        check(failed_attempts >= 0),
+    locked_until timestamp with time zone,
+    create_time wt_timestamp,
+    update_time wt_timestamp
+  );
+
+  create trigger
+    update_time_column
+  before update on auth_password_account_lockout
+    for each row execute procedure update_time_column();
+
+  create trigger
+    default_create_time_column
+  before insert on auth_password_account_lockout
+    for each row execute procedure default_create_time();
+
+  -- auth_password_argon2_cred_history holds the previous Argon2 credentials
+  -- of a password account. The credentials are only used to prevent
+  -- passwords from being reused and are pruned to the auth method's
+  -- password_history_count.
+  create table auth_password_argon2_cred_history (
+    private_id wt_private_id primary key,
+    password_account_id wt_public_id not null
+      references auth_password_account (public_id)
+      on delete cascade
+      on update cascade,
+    password_conf_id wt_private_id not null
+      references auth_password_argon2_conf (private_id)
+      on delete cascade
+      on update cascade,
+    create_time wt_timestamp,
+    salt bytea not null
+      constraint salt_must_not_be_empty
+        check(length(salt) > 0),
+    derived_key bytea not null
+      constraint derived_key_must_not_be_empty
+        check(length(derived_key) > 0),
+    key_id text not null
+      constraint key_id_must_not_be_empty
+        check(length(trim(key_id)) > 0)
+  );
+
+  create index auth_password_argon2_cred_history_account_create_time_ix
+    on auth_password_argon2_cred_history (password_account_id, create_time desc);
+
+commit;
@@ -0,0 +1,88 @@
+begin;
+
+  -- require_mfa requires accounts to authenticate with a TOTP code in
+  -- addition to their password.
+  alter table auth_password_method
+    add column require_mfa boolean not null default false;
+
+  -- Replaces the view created in 38/01 to include require_mfa
+  create or replace view auth_password_method_with_is_primary as
+  select
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.password_conf_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.min_login_name_length,
+    am.min_password_length,
+    am.min_character_classes,
+    am.password_history_count,
+    am.max_password_age_seconds,
+    am.lockout_threshold,
+    am.lockout_duration_seconds,
+    am.require_mfa
+  from
+    auth_password_method am
+    left outer join iam_scope s on am.public_id = s.primary_auth_method_id;
+
+  -- auth_password_totp holds the TOTP enrollment of a password account. The
+  -- secret is encrypted with the scope's database key. An enrollment is
+  -- only used to authenticate once it is confirmed with a valid code.
+  -- last_used_step is the most recent time step for which a code was
+  -- accepted and prevents codes from being replayed.
+  create table auth_password_totp (
+    password_account_id wt_public_id primary key
+      references auth_password_account (public_id)
+      on delete cascade
+      on update cascade,
+    secret bytea not null
+      constraint secret_must_not_be_empty
+        check(length(secret) > 0),
+    key_id text not null
+      constraint key_id_must_not_be_empty
+        check(length(trim(key_id)) > 0),
+    confirmed boolean not null default false,
+    last_used_step bigint not null default 0,
+    create_time wt_timestamp,
+    update_time wt_timestamp
+  );
+
+  create trigger
+    update_time_column
+  before update on auth_password_totp
+    for each row execute procedure update_time_column();
+
+  create trigger
+    default_create_time_column
+  before insert on auth_password_totp
+    for each row execute procedure default_create_time();
+
+  -- auth_password_recovery_code holds the SHA-256 hashes of the single use
+  -- recovery codes of a password account. A code is used once used_time is
+  -- set.
+  create table auth_password_recovery_code (
+    password_account_id wt_public_id not null
+      references auth_password_account (public_id)
+      on delete cascade
+      on update cascade,
+    code_hash bytea not null
+      constraint code_hash_must_not_be_empty
+        check(length(code_hash) > 0),
+    used_time timestamp with time zone,
+    create_time wt_timestamp,
+    primary key(password_account_id, code_hash)
+  );
+
+  create trigger
+    default_create_time_column
+  before insert on auth_password_recovery_code
+    for each row execute procedure default_create_time();
+
+commit;
@@ -0,0 +1,97 @@
+begin;
+
+  -- A disabled user cannot authenticate. Disabling or deleting a user deletes
+  -- the auth tokens of all of the user's accounts.
+  alter table iam_user
+    add column disabled boolean not null default false;
+
+  -- external_id is the identifier of a user or group assigned by the
+  -- identity provider which provisions it through SCIM.
+  alter table iam_user
+    add column external_id text
+      constraint external_id_must_not_be_empty
+        check(length(trim(external_id)) > 0),
+    add constraint iam_user_scope_id_external_id_uq
+      unique(scope_id, external_id);
+
+  alter table iam_group
+    add column external_id text
+      constraint external_id_must_not_be_empty
+        check(length(trim(external_id)) > 0),
+    add constraint iam_group_scope_id_external_id_uq
+      unique(scope_id, external_id);
+
+  -- Replaces the view created in 4/01 to include disabled and external_id
+  drop view iam_user_acct_info;
+  create view iam_user_acct_info as
+  select
+    u.public_id,
+    u.scope_id,
+    u.name,
+    u.description,
+    u.create_time,
+    u.update_time,
+    u.version,
+    u.disabled,
+    u.external_id,
+    i.primary_account_id,
+    i.login_name,
+    i.full_name,
+    i.email
+  from
+    iam_user u
+    left outer join iam_acct_info i on u.public_id = i.iam_user_id;
+
+  -- delete_iam_user_auth_tokens deletes the auth tokens of the accounts of a
+  -- user which is disabled or deleted. Without it, the tokens of a deleted
+  -- user would remain valid for accounts which are no longer associated with
+  -- any user.
+  create function delete_iam_user_auth_tokens()
+    returns trigger
+  as $$
+  begin
+    delete from auth_token
+     where auth_account_id in (select public_id
+                                 from auth_account
+                                where iam_user_id = old.public_id);
+    if tg_op = 'DELETE' then
+      return old;
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+
+  create trigger delete_disabled_iam_user_auth_tokens
+    after update of disabled on iam_user
+    for each row when (new.disabled and not old.disabled)
+    execute procedure delete_iam_user_auth_tokens();
+
+  -- This must run before the accounts are disassociated from the user by
+  -- the on delete set null foreign key.
+  create trigger delete_deleted_iam_user_auth_tokens
+    before delete on iam_user
+    for each row execute procedure delete_iam_user_auth_tokens();
+
+  -- auth_token_user_not_disabled prevents issuing auth tokens to a disabled
+  -- user if the user is disabled while authenticating.
+  create function auth_token_user_not_disabled()
+    returns trigger
+  as $$
+  begin
+    perform 1
+       from auth_account aa
+       join iam_user u on u.public_id = aa.iam_user_id
+      where aa.public_id = new.auth_account_id
+        and u.disabled;
+    if found then
+      raise exception 'user of account % is disabled', new.auth_account_id;
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+
+  create trigger auth_token_user_not_disabled
+    before insert on auth_token
+    for each row execute procedure auth_token_user_not_disabled();
+
+commit;
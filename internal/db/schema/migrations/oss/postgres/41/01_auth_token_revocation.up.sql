@@ -0,0 +1,44 @@
+begin;
+
+  -- Auth tokens are deleted when the credentials or the auth method they were
+  -- issued for are no longer valid. Tokens of deleted accounts are deleted by
+  -- the on delete cascade foreign key and tokens of disabled or deleted users
+  -- by the triggers added in 40/01.
+
+  -- delete_password_account_auth_tokens deletes the auth tokens of a password
+  -- account when its password is changed, set or removed, all of which
+  -- delete the account's current credential.
+  create function delete_password_account_auth_tokens()
+    returns trigger
+  as $$
+  begin
+    delete from auth_token
+     where auth_account_id = old.password_account_id;
+    return old;
+  end;
+  $$ language plpgsql;
+
+  create trigger delete_password_account_auth_tokens
+    after delete on auth_password_argon2_cred
+    for each row execute procedure delete_password_account_auth_tokens();
+
+  -- delete_inactive_auth_method_auth_tokens deletes the auth tokens of all
+  -- accounts of an auth method which is made inactive.
+  create function delete_inactive_auth_method_auth_tokens()
+    returns trigger
+  as $$
+  begin
+    delete from auth_token
+     where auth_account_id in (select public_id
+                                 from auth_account
+                                where auth_method_id = new.public_id);
+    return new;
+  end;
+  $$ language plpgsql;
+
+  create trigger delete_inactive_auth_oidc_method_auth_tokens
+    after update of state on auth_oidc_method
+    for each row when (new.state = 'inactive' and old.state <> 'inactive')
+    execute procedure delete_inactive_auth_method_auth_tokens();
+
+commit;
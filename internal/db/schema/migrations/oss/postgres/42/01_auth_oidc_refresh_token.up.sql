@@ -0,0 +1,90 @@
+begin;
+
+  -- store_refresh_tokens indicates whether the refresh tokens issued by the
+  -- oidc provider are stored so the provider can be asked periodically
+  -- whether the user is still allowed to authenticate.
+  alter table auth_oidc_method
+    add column store_refresh_tokens boolean not null default false;
+
+  -- Replaces the view created in 6/01 to include store_refresh_tokens
+  drop view oidc_auth_method_with_value_obj;
+
+  create view oidc_auth_method_with_value_obj as
+  select
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.state,
+    am.api_url,
+    am.disable_discovered_config_validation,
+    am.issuer,
+    am.client_id,
+    am.client_secret,
+    am.client_secret_hmac,
+    am.key_id,
+    am.max_age,
+    am.store_refresh_tokens,
+    -- the string_agg(..) column will be null if there are no associated value objects
+    string_agg(distinct alg.signing_alg_name, '|') as algs,
+    string_agg(distinct aud.aud_claim, '|') as auds,
+    string_agg(distinct cert.certificate, '|') as certs,
+    string_agg(distinct cs.scope, '|') as claims_scopes,
+    string_agg(distinct concat_ws('=', acm.from_claim, acm.to_claim), '|') as account_claim_maps
+  from
+    auth_oidc_method am
+    left outer join iam_scope                   s     on am.public_id = s.primary_auth_method_id
+    left outer join auth_oidc_signing_alg       alg   on am.public_id = alg.oidc_method_id
+    left outer join auth_oidc_aud_claim         aud   on am.public_id = aud.oidc_method_id
+    left outer join auth_oidc_certificate       cert  on am.public_id = cert.oidc_method_id
+    left outer join auth_oidc_scope             cs    on am.public_id = cs.oidc_method_id
+    left outer join auth_oidc_account_claim_map acm   on am.public_id = acm.oidc_method_id
+  group by am.public_id, is_primary_auth_method;
+  comment on view oidc_auth_method_with_value_obj is
+  'oidc auth method with its associated value objects (algs, auds, certs, scopes) as columns with | delimited values';
+
+  -- auth_oidc_refresh_token holds the refresh token issued by the oidc
+  -- provider when an auth token was issued. The refresh token is encrypted
+  -- with the scope's database key and is deleted with its auth token.
+  -- check_time is the last time the refresh token was used to check that the
+  -- user is still allowed to authenticate.
+  create table auth_oidc_refresh_token (
+    auth_token_id wt_public_id primary key
+      references auth_token (public_id)
+      on delete cascade
+      on update cascade,
+    oidc_method_id wt_public_id not null
+      references auth_oidc_method (public_id)
+      on delete cascade
+      on update cascade,
+    refresh_token bytea not null
+      constraint refresh_token_must_not_be_empty
+        check(length(refresh_token) > 0),
+    key_id text not null
+      constraint key_id_must_not_be_empty
+        check(length(trim(key_id)) > 0),
+    check_time wt_timestamp,
+    create_time wt_timestamp
+  );
+
+  create trigger
+    default_create_time_column
+  before insert on auth_oidc_refresh_token
+    for each row execute procedure default_create_time();
+
+  create trigger
+    immutable_columns
+  before update on auth_oidc_refresh_token
+    for each row execute procedure immutable_columns('auth_token_id', 'oidc_method_id', 'create_time');
+
+  create index auth_oidc_refresh_token_check_time_ix
+    on auth_oidc_refresh_token (check_time);
+
+commit;
@@ -40,6 +40,8 @@ const (
 	InvalidDynamicCredential Code = 116 // InvalidDynamicCredential represents that a dynamic credential for a session was in an invalid state
 	JobAlreadyRunning        Code = 117 // JobAlreadyRunning represents that a Job is already running when an attempt to run again was made
 	SubtypeAlreadyRegistered Code = 118 // SubtypeAlreadyRegistered represents that a value has already been registered in the subtype registry system.
+	KeyInUse                 Code = 119 // KeyInUse represents that a key/version is still in use and cannot be destroyed
+	UserDisabled             Code = 120 // UserDisabled represents that the user is disabled and cannot authenticate
 
 	AuthAttemptExpired Code = 198 // AuthAttemptExpired represents an expired authentication attempt
 	AuthMethodInactive Code = 199 // AuthMethodInactive represents an error that means the auth method is not active.
@@ -59,6 +61,22 @@ const (
 	// new passwords are equal.
 	PasswordsEqual Code = 203
 
+	// PasswordTooWeak results from attempting to set a password which does
+	// not contain enough of the character classes required by the auth method.
+	PasswordTooWeak Code = 204
+
+	// PasswordReused results from attempting to set a password which is one
+	// of the account's previous passwords.
+	PasswordReused Code = 205
+
+	// PasswordExpired is returned from Authenticate when the account's
+	// password is older than the maximum age allowed by the auth method.
+	PasswordExpired Code = 206
+
+	// AccountLocked is returned from Authenticate when the account is locked
+	// out after too many failed authentication attempts.
+	AccountLocked Code = 207
+
 	Encrypt Code = 300 // Encrypt represents an error occurred during the underlying encryption process
 	Decrypt Code = 301 // Decrypt represents an error occurred during the underlying decryption process
 	Encode  Code = 302 // Encode represents an error occurred during the underlying encoding/marshaling process
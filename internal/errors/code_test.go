@@ -117,6 +117,16 @@ func TestCode_Both_String_Info(t *testing.T) {
 			c:    SubtypeAlreadyRegistered,
 			want: SubtypeAlreadyRegistered,
 		},
+		{
+			name: "KeyInUse",
+			c:    KeyInUse,
+			want: KeyInUse,
+		},
+		{
+			name: "UserDisabled",
+			c:    UserDisabled,
+			want: UserDisabled,
+		},
 		{
 			name: "InvalidDynamicCredential",
 			c:    InvalidDynamicCredential,
@@ -162,6 +172,26 @@ func TestCode_Both_String_Info(t *testing.T) {
 			c:    PasswordsEqual,
 			want: PasswordsEqual,
 		},
+		{
+			name: "PasswordTooWeak",
+			c:    PasswordTooWeak,
+			want: PasswordTooWeak,
+		},
+		{
+			name: "PasswordReused",
+			c:    PasswordReused,
+			want: PasswordReused,
+		},
+		{
+			name: "PasswordExpired",
+			c:    PasswordExpired,
+			want: PasswordExpired,
+		},
+		{
+			name: "AccountLocked",
+			c:    AccountLocked,
+			want: AccountLocked,
+		},
 		{
 			name: "Encrypt",
 			c:    Encrypt,
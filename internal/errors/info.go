@@ -96,6 +96,14 @@ var errorCodeInfo = map[Code]Info{
 		Message: "subtype already registered",
 		Kind:    Parameter,
 	},
+	KeyInUse: {
+		Message: "key/version in use",
+		Kind:    State,
+	},
+	UserDisabled: {
+		Message: "user is disabled",
+		Kind:    State,
+	},
 	InvalidDynamicCredential: {
 		Message: "dynamic credential for session is in an invalid state",
 		Kind:    Integrity,
@@ -116,6 +124,22 @@ var errorCodeInfo = map[Code]Info{
 		Message: "old and new password are equal",
 		Kind:    Password,
 	},
+	PasswordTooWeak: {
+		Message: "too weak",
+		Kind:    Password,
+	},
+	PasswordReused: {
+		Message: "password was used previously",
+		Kind:    Password,
+	},
+	PasswordExpired: {
+		Message: "password expired",
+		Kind:    Password,
+	},
+	AccountLocked: {
+		Message: "account locked",
+		Kind:    Password,
+	},
 	Encrypt: {
 		Message: "error occurred during encrypt",
 		Kind:    Encryption,
@@ -30,6 +30,13 @@ type AuthTokenServiceClient interface {
 	// DeleteAuthToken removes a Auth Token from Boundary. If the provided
 	// Auth Token id is malformed or not provided an error is returned.
 	DeleteAuthToken(ctx context.Context, in *DeleteAuthTokenRequest, opts ...grpc.CallOption) (*DeleteAuthTokenResponse, error)
+	// RevokeAllAuthTokensForUser deletes all Auth Tokens of a User, signing the
+	// User out of every client.
+	RevokeAllAuthTokensForUser(ctx context.Context, in *RevokeAllAuthTokensForUserRequest, opts ...grpc.CallOption) (*RevokeAllAuthTokensForUserResponse, error)
+	// IntrospectAuthToken returns whether the provided token is a valid Auth
+	// Token along with its expiration and the principals of its User. Tokens
+	// which are malformed, expired or unknown are returned as inactive.
+	IntrospectAuthToken(ctx context.Context, in *IntrospectAuthTokenRequest, opts ...grpc.CallOption) (*IntrospectAuthTokenResponse, error)
 }
 
 type authTokenServiceClient struct {
@@ -67,6 +74,24 @@ func (c *authTokenServiceClient) DeleteAuthToken(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *authTokenServiceClient) RevokeAllAuthTokensForUser(ctx context.Context, in *RevokeAllAuthTokensForUserRequest, opts ...grpc.CallOption) (*RevokeAllAuthTokensForUserResponse, error) {
+	out := new(RevokeAllAuthTokensForUserResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.AuthTokenService/RevokeAllAuthTokensForUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authTokenServiceClient) IntrospectAuthToken(ctx context.Context, in *IntrospectAuthTokenRequest, opts ...grpc.CallOption) (*IntrospectAuthTokenResponse, error) {
+	out := new(IntrospectAuthTokenResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.AuthTokenService/IntrospectAuthToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthTokenServiceServer is the server API for AuthTokenService service.
 // All implementations must embed UnimplementedAuthTokenServiceServer
 // for forward compatibility
@@ -83,6 +108,13 @@ type AuthTokenServiceServer interface {
 	// DeleteAuthToken removes a Auth Token from Boundary. If the provided
 	// Auth Token id is malformed or not provided an error is returned.
 	DeleteAuthToken(context.Context, *DeleteAuthTokenRequest) (*DeleteAuthTokenResponse, error)
+	// RevokeAllAuthTokensForUser deletes all Auth Tokens of a User, signing the
+	// User out of every client.
+	RevokeAllAuthTokensForUser(context.Context, *RevokeAllAuthTokensForUserRequest) (*RevokeAllAuthTokensForUserResponse, error)
+	// IntrospectAuthToken returns whether the provided token is a valid Auth
+	// Token along with its expiration and the principals of its User. Tokens
+	// which are malformed, expired or unknown are returned as inactive.
+	IntrospectAuthToken(context.Context, *IntrospectAuthTokenRequest) (*IntrospectAuthTokenResponse, error)
 	mustEmbedUnimplementedAuthTokenServiceServer()
 }
 
@@ -99,6 +131,12 @@ func (UnimplementedAuthTokenServiceServer) ListAuthTokens(context.Context, *List
 func (UnimplementedAuthTokenServiceServer) DeleteAuthToken(context.Context, *DeleteAuthTokenRequest) (*DeleteAuthTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAuthToken not implemented")
 }
+func (UnimplementedAuthTokenServiceServer) RevokeAllAuthTokensForUser(context.Context, *RevokeAllAuthTokensForUserRequest) (*RevokeAllAuthTokensForUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllAuthTokensForUser not implemented")
+}
+func (UnimplementedAuthTokenServiceServer) IntrospectAuthToken(context.Context, *IntrospectAuthTokenRequest) (*IntrospectAuthTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntrospectAuthToken not implemented")
+}
 func (UnimplementedAuthTokenServiceServer) mustEmbedUnimplementedAuthTokenServiceServer() {}
 
 // UnsafeAuthTokenServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -166,6 +204,42 @@ func _AuthTokenService_DeleteAuthToken_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthTokenService_RevokeAllAuthTokensForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAllAuthTokensForUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthTokenServiceServer).RevokeAllAuthTokensForUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.AuthTokenService/RevokeAllAuthTokensForUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthTokenServiceServer).RevokeAllAuthTokensForUser(ctx, req.(*RevokeAllAuthTokensForUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthTokenService_IntrospectAuthToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntrospectAuthTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthTokenServiceServer).IntrospectAuthToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.AuthTokenService/IntrospectAuthToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthTokenServiceServer).IntrospectAuthToken(ctx, req.(*IntrospectAuthTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthTokenService_ServiceDesc is the grpc.ServiceDesc for AuthTokenService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -185,6 +259,14 @@ var AuthTokenService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAuthToken",
 			Handler:    _AuthTokenService_DeleteAuthToken_Handler,
 		},
+		{
+			MethodName: "RevokeAllAuthTokensForUser",
+			Handler:    _AuthTokenService_RevokeAllAuthTokensForUser_Handler,
+		},
+		{
+			MethodName: "IntrospectAuthToken",
+			Handler:    _AuthTokenService_IntrospectAuthToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/authtokens_service.proto",
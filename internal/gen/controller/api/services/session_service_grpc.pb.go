@@ -32,6 +32,27 @@ type SessionServiceClient interface {
 	// is returned if the request attempts to cancel a Session that does
 	// not exist.
 	CancelSession(ctx context.Context, in *CancelSessionRequest, opts ...grpc.CallOption) (*CancelSessionResponse, error)
+	// ReadSessionRecording returns the recording of a connection of a
+	// Session. The request must include the Session ID and the ID of the
+	// connection whose recording is being retrieved. An error is returned if
+	// the connection does not exist or was not recorded. Recordings are
+	// returned a page at a time, starting with the chunk at the requested
+	// offset; the offset of the next page is returned in the recording.
+	ReadSessionRecording(ctx context.Context, in *ReadSessionRecordingRequest, opts ...grpc.CallOption) (*ReadSessionRecordingResponse, error)
+	// ApproveSession approves a Session which is pending approval, allowing
+	// it to be activated. An error is returned if the Session is not pending
+	// approval or if the requester is the user of the Session.
+	ApproveSession(ctx context.Context, in *ApproveSessionRequest, opts ...grpc.CallOption) (*ApproveSessionResponse, error)
+	// DenySession denies a Session which is pending approval, terminating
+	// it. An error is returned if the Session is not pending approval or if
+	// the requester is the user of the Session.
+	DenySession(ctx context.Context, in *DenySessionRequest, opts ...grpc.CallOption) (*DenySessionResponse, error)
+	// GetSessionUsage returns the usage of the Sessions which exist inside the
+	// scope referenced inside the request, aggregated per User and Target.
+	// Only Sessions created in the requested time range which the requester
+	// is allowed to list are counted. The request must include the scope ID
+	// and follows the same rules as ListSessions for recursive requests.
+	GetSessionUsage(ctx context.Context, in *GetSessionUsageRequest, opts ...grpc.CallOption) (*GetSessionUsageResponse, error)
 }
 
 type sessionServiceClient struct {
@@ -69,6 +90,42 @@ func (c *sessionServiceClient) CancelSession(ctx context.Context, in *CancelSess
 	return out, nil
 }
 
+func (c *sessionServiceClient) ReadSessionRecording(ctx context.Context, in *ReadSessionRecordingRequest, opts ...grpc.CallOption) (*ReadSessionRecordingResponse, error) {
+	out := new(ReadSessionRecordingResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.SessionService/ReadSessionRecording", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) ApproveSession(ctx context.Context, in *ApproveSessionRequest, opts ...grpc.CallOption) (*ApproveSessionResponse, error) {
+	out := new(ApproveSessionResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.SessionService/ApproveSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) DenySession(ctx context.Context, in *DenySessionRequest, opts ...grpc.CallOption) (*DenySessionResponse, error) {
+	out := new(DenySessionResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.SessionService/DenySession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) GetSessionUsage(ctx context.Context, in *GetSessionUsageRequest, opts ...grpc.CallOption) (*GetSessionUsageResponse, error) {
+	out := new(GetSessionUsageResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.SessionService/GetSessionUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SessionServiceServer is the server API for SessionService service.
 // All implementations must embed UnimplementedSessionServiceServer
 // for forward compatibility
@@ -87,6 +144,27 @@ type SessionServiceServer interface {
 	// is returned if the request attempts to cancel a Session that does
 	// not exist.
 	CancelSession(context.Context, *CancelSessionRequest) (*CancelSessionResponse, error)
+	// ReadSessionRecording returns the recording of a connection of a
+	// Session. The request must include the Session ID and the ID of the
+	// connection whose recording is being retrieved. An error is returned if
+	// the connection does not exist or was not recorded. Recordings are
+	// returned a page at a time, starting with the chunk at the requested
+	// offset; the offset of the next page is returned in the recording.
+	ReadSessionRecording(context.Context, *ReadSessionRecordingRequest) (*ReadSessionRecordingResponse, error)
+	// ApproveSession approves a Session which is pending approval, allowing
+	// it to be activated. An error is returned if the Session is not pending
+	// approval or if the requester is the user of the Session.
+	ApproveSession(context.Context, *ApproveSessionRequest) (*ApproveSessionResponse, error)
+	// DenySession denies a Session which is pending approval, terminating
+	// it. An error is returned if the Session is not pending approval or if
+	// the requester is the user of the Session.
+	DenySession(context.Context, *DenySessionRequest) (*DenySessionResponse, error)
+	// GetSessionUsage returns the usage of the Sessions which exist inside the
+	// scope referenced inside the request, aggregated per User and Target.
+	// Only Sessions created in the requested time range which the requester
+	// is allowed to list are counted. The request must include the scope ID
+	// and follows the same rules as ListSessions for recursive requests.
+	GetSessionUsage(context.Context, *GetSessionUsageRequest) (*GetSessionUsageResponse, error)
 	mustEmbedUnimplementedSessionServiceServer()
 }
 
@@ -103,6 +181,18 @@ func (UnimplementedSessionServiceServer) ListSessions(context.Context, *ListSess
 func (UnimplementedSessionServiceServer) CancelSession(context.Context, *CancelSessionRequest) (*CancelSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelSession not implemented")
 }
+func (UnimplementedSessionServiceServer) ReadSessionRecording(context.Context, *ReadSessionRecordingRequest) (*ReadSessionRecordingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadSessionRecording not implemented")
+}
+func (UnimplementedSessionServiceServer) ApproveSession(context.Context, *ApproveSessionRequest) (*ApproveSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveSession not implemented")
+}
+func (UnimplementedSessionServiceServer) DenySession(context.Context, *DenySessionRequest) (*DenySessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenySession not implemented")
+}
+func (UnimplementedSessionServiceServer) GetSessionUsage(context.Context, *GetSessionUsageRequest) (*GetSessionUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionUsage not implemented")
+}
 func (UnimplementedSessionServiceServer) mustEmbedUnimplementedSessionServiceServer() {}
 
 // UnsafeSessionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -170,6 +260,78 @@ func _SessionService_CancelSession_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionService_ReadSessionRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadSessionRecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).ReadSessionRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.SessionService/ReadSessionRecording",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).ReadSessionRecording(ctx, req.(*ReadSessionRecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_ApproveSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).ApproveSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.SessionService/ApproveSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).ApproveSession(ctx, req.(*ApproveSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_DenySession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenySessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).DenySession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.SessionService/DenySession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).DenySession(ctx, req.(*DenySessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_GetSessionUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).GetSessionUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.SessionService/GetSessionUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).GetSessionUsage(ctx, req.(*GetSessionUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SessionService_ServiceDesc is the grpc.ServiceDesc for SessionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -189,6 +351,22 @@ var SessionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelSession",
 			Handler:    _SessionService_CancelSession_Handler,
 		},
+		{
+			MethodName: "ReadSessionRecording",
+			Handler:    _SessionService_ReadSessionRecording_Handler,
+		},
+		{
+			MethodName: "ApproveSession",
+			Handler:    _SessionService_ApproveSession_Handler,
+		},
+		{
+			MethodName: "DenySession",
+			Handler:    _SessionService_DenySession_Handler,
+		},
+		{
+			MethodName: "GetSessionUsage",
+			Handler:    _SessionService_GetSessionUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/session_service.proto",
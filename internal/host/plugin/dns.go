@@ -0,0 +1,215 @@
+package plugin
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strings"
+
+	hcpb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostcatalogs"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
+	"github.com/mitchellh/mapstructure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	dnsRecordTypeA   = "a"
+	dnsRecordTypeSrv = "srv"
+)
+
+var _ plgpb.HostPluginServiceServer = (*dnsPlugin)(nil)
+
+// dnsPlugin provides a host plugin which discovers the hosts of a catalog
+// from DNS records listed in the catalog's attributes, for example:
+//
+//   {
+//     "server": "10.0.0.2:53",
+//     "records": [
+//       {"name": "_ssh._tcp.example.com", "type": "srv", "labels": {"role": "ssh"}},
+//       {"name": "db.example.com", "labels": {"role": "db"}}
+//     ]
+//   }
+//
+// The targets of SRV records and the names of A records (the default type)
+// become hosts with the addresses they resolve to, carrying the labels of
+// their record. Hosts are selected into sets by these labels. If no server is
+// given the system resolver is used.
+type dnsPlugin struct {
+	plgpb.UnimplementedHostPluginServiceServer
+
+	resolverFn func(server string) dnsResolver
+}
+
+// dnsResolver is the subset of net.Resolver used by the dns plugin.
+type dnsResolver interface {
+	LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+type dnsCatalogAttributes struct {
+	Server  string       `mapstructure:"server"`
+	Records []*dnsRecord `mapstructure:"records"`
+}
+
+type dnsRecord struct {
+	Name   string            `mapstructure:"name"`
+	Type   string            `mapstructure:"type"`
+	Labels map[string]string `mapstructure:"labels"`
+}
+
+// NewDnsPlugin returns a new dns plugin
+func NewDnsPlugin() plgpb.HostPluginServiceServer {
+	return &dnsPlugin{resolverFn: newDnsResolver}
+}
+
+func newDnsResolver(server string) dnsResolver {
+	if server == "" {
+		return net.DefaultResolver
+	}
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, network, server)
+		},
+	}
+}
+
+func (p *dnsPlugin) OnCreateCatalog(_ context.Context, req *plgpb.OnCreateCatalogRequest) (*plgpb.OnCreateCatalogResponse, error) {
+	if _, err := dnsAttributes(req.GetCatalog()); err != nil {
+		return nil, err
+	}
+	return &plgpb.OnCreateCatalogResponse{}, nil
+}
+
+func (p *dnsPlugin) OnUpdateCatalog(_ context.Context, req *plgpb.OnUpdateCatalogRequest) (*plgpb.OnUpdateCatalogResponse, error) {
+	if _, err := dnsAttributes(req.GetNewCatalog()); err != nil {
+		return nil, err
+	}
+	return &plgpb.OnUpdateCatalogResponse{}, nil
+}
+
+func (p *dnsPlugin) OnDeleteCatalog(context.Context, *plgpb.OnDeleteCatalogRequest) (*plgpb.OnDeleteCatalogResponse, error) {
+	return &plgpb.OnDeleteCatalogResponse{}, nil
+}
+
+func (p *dnsPlugin) OnCreateSet(_ context.Context, req *plgpb.OnCreateSetRequest) (*plgpb.OnCreateSetResponse, error) {
+	if _, err := setSelector(req.GetSet()); err != nil {
+		return nil, err
+	}
+	return &plgpb.OnCreateSetResponse{}, nil
+}
+
+func (p *dnsPlugin) OnUpdateSet(_ context.Context, req *plgpb.OnUpdateSetRequest) (*plgpb.OnUpdateSetResponse, error) {
+	if _, err := setSelector(req.GetNewSet()); err != nil {
+		return nil, err
+	}
+	return &plgpb.OnUpdateSetResponse{}, nil
+}
+
+func (p *dnsPlugin) OnDeleteSet(context.Context, *plgpb.OnDeleteSetRequest) (*plgpb.OnDeleteSetResponse, error) {
+	return &plgpb.OnDeleteSetResponse{}, nil
+}
+
+func (p *dnsPlugin) ListHosts(ctx context.Context, req *plgpb.ListHostsRequest) (*plgpb.ListHostsResponse, error) {
+	attrs, err := dnsAttributes(req.GetCatalog())
+	if err != nil {
+		return nil, err
+	}
+	selectors := make(map[string]map[string]string, len(req.GetSets()))
+	for _, set := range req.GetSets() {
+		if selectors[set.GetId()], err = setSelector(set); err != nil {
+			return nil, err
+		}
+	}
+
+	resolver := p.resolverFn(attrs.Server)
+	hosts := make(map[string]*plgpb.ListHostsResponseHost)
+	var names []string
+	for _, rec := range attrs.Records {
+		var setIds []string
+		for setId, selector := range selectors {
+			if matchLabels(selector, rec.Labels) {
+				setIds = append(setIds, setId)
+			}
+		}
+		if len(setIds) == 0 {
+			continue
+		}
+
+		targets := []string{rec.Name}
+		if rec.Type == dnsRecordTypeSrv {
+			_, srvs, err := resolver.LookupSRV(ctx, "", "", rec.Name)
+			if err != nil {
+				return nil, status.Errorf(codes.Unavailable, "unable to look up SRV record %q: %v", rec.Name, err)
+			}
+			targets = targets[:0]
+			for _, srv := range srvs {
+				targets = append(targets, srv.Target)
+			}
+		}
+
+		for _, target := range targets {
+			name := strings.TrimSuffix(target, ".")
+			h, ok := hosts[name]
+			if !ok {
+				addrs, err := resolver.LookupIPAddr(ctx, name)
+				if err != nil {
+					return nil, status.Errorf(codes.Unavailable, "unable to resolve %q: %v", name, err)
+				}
+				h = &plgpb.ListHostsResponseHost{
+					ExternalId: name,
+					Name:       name,
+					DnsNames:   []string{name},
+				}
+				for _, addr := range addrs {
+					h.IpAddresses = append(h.IpAddresses, addr.IP.String())
+				}
+				hosts[name] = h
+				names = append(names, name)
+			}
+			for _, setId := range setIds {
+				h.SetIds = strutil.AppendIfMissing(h.SetIds, setId)
+			}
+		}
+	}
+
+	// Return hosts in a stable order.
+	sort.Strings(names)
+	resp := new(plgpb.ListHostsResponse)
+	for _, name := range names {
+		resp.Hosts = append(resp.Hosts, hosts[name])
+	}
+	return resp, nil
+}
+
+// dnsAttributes decodes and validates the attributes of the catalog.
+func dnsAttributes(cat *hcpb.HostCatalog) (*dnsCatalogAttributes, error) {
+	attrs := new(dnsCatalogAttributes)
+	if err := mapstructure.Decode(cat.GetAttributes().AsMap(), attrs); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to decode attributes: %v", err)
+	}
+	if attrs.Server != "" {
+		if _, _, err := net.SplitHostPort(attrs.Server); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "attributes.server: must be a host and port: %v", err)
+		}
+	}
+	if len(attrs.Records) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "attributes.records: must contain at least one record")
+	}
+	for i, rec := range attrs.Records {
+		if rec == nil || rec.Name == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "attributes.records[%d].name: must be set", i)
+		}
+		switch rec.Type {
+		case "":
+			rec.Type = dnsRecordTypeA
+		case dnsRecordTypeA, dnsRecordTypeSrv:
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "attributes.records[%d].type: must be %q or %q", i, dnsRecordTypeA, dnsRecordTypeSrv)
+		}
+	}
+	return attrs, nil
+}
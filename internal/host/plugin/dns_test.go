@@ -0,0 +1,155 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostcatalogs"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostsets"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	ta "github.com/stretchr/testify/assert"
+	tr "github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testDnsResolver struct {
+	srvs  map[string][]*net.SRV
+	addrs map[string][]net.IPAddr
+}
+
+func (r *testDnsResolver) LookupSRV(_ context.Context, _, _, name string) (string, []*net.SRV, error) {
+	srvs, ok := r.srvs[name]
+	if !ok {
+		return "", nil, fmt.Errorf("no such host %s", name)
+	}
+	return name, srvs, nil
+}
+
+func (r *testDnsResolver) LookupIPAddr(_ context.Context, host string) ([]net.IPAddr, error) {
+	addrs, ok := r.addrs[host]
+	if !ok {
+		return nil, fmt.Errorf("no such host %s", host)
+	}
+	return addrs, nil
+}
+
+func TestDnsPlugin_Catalog(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		attrs   map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			attrs: map[string]interface{}{
+				"server": "10.0.0.2:53",
+				"records": []interface{}{
+					map[string]interface{}{"name": "_ssh._tcp.example.com", "type": "srv"},
+					map[string]interface{}{"name": "db.example.com", "labels": map[string]interface{}{"role": "db"}},
+				},
+			},
+		},
+		{
+			name:    "no records",
+			attrs:   map[string]interface{}{},
+			wantErr: true,
+		},
+		{
+			name: "bad server",
+			attrs: map[string]interface{}{
+				"server":  "10.0.0.2",
+				"records": []interface{}{map[string]interface{}{"name": "db.example.com"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing name",
+			attrs: map[string]interface{}{
+				"records": []interface{}{map[string]interface{}{"type": "srv"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "bad type",
+			attrs: map[string]interface{}{
+				"records": []interface{}{map[string]interface{}{"name": "db.example.com", "type": "mx"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := ta.New(t)
+			plg := NewDnsPlugin()
+			cat := &hostcatalogs.HostCatalog{Id: "hc_1234567890", Attributes: testStruct(t, tt.attrs)}
+			_, err := plg.OnCreateCatalog(ctx, &plgpb.OnCreateCatalogRequest{Catalog: cat})
+			_, upErr := plg.OnUpdateCatalog(ctx, &plgpb.OnUpdateCatalogRequest{CurrentCatalog: cat, NewCatalog: cat})
+			if tt.wantErr {
+				assert.Equal(codes.InvalidArgument, status.Code(err))
+				assert.Equal(codes.InvalidArgument, status.Code(upErr))
+				return
+			}
+			assert.NoError(err)
+			assert.NoError(upErr)
+		})
+	}
+}
+
+func TestDnsPlugin_ListHosts(t *testing.T) {
+	require, assert := tr.New(t), ta.New(t)
+	ctx := context.Background()
+
+	resolver := &testDnsResolver{
+		srvs: map[string][]*net.SRV{
+			"_ssh._tcp.example.com": {
+				{Target: "web-1.example.com.", Port: 22},
+				{Target: "web-2.example.com.", Port: 22},
+			},
+		},
+		addrs: map[string][]net.IPAddr{
+			"web-1.example.com": {{IP: net.ParseIP("10.0.0.1")}},
+			"web-2.example.com": {{IP: net.ParseIP("10.0.0.2")}, {IP: net.ParseIP("fd00::2")}},
+			"db.example.com":    {{IP: net.ParseIP("10.0.1.1")}},
+		},
+	}
+	var gotServer string
+	plg := &dnsPlugin{resolverFn: func(server string) dnsResolver {
+		gotServer = server
+		return resolver
+	}}
+	cat := &hostcatalogs.HostCatalog{Id: "hc_1234567890", Attributes: testStruct(t, map[string]interface{}{
+		"server": "10.0.0.2:53",
+		"records": []interface{}{
+			map[string]interface{}{"name": "_ssh._tcp.example.com", "type": "srv", "labels": map[string]interface{}{"role": "ssh"}},
+			map[string]interface{}{"name": "web-1.example.com", "labels": map[string]interface{}{"role": "web"}},
+			map[string]interface{}{"name": "db.example.com", "labels": map[string]interface{}{"role": "db"}},
+		},
+	})}
+
+	all := testLabelSet(t, "hsplg_all", nil)
+	ssh := testLabelSet(t, "hsplg_ssh", map[string]interface{}{"role": "ssh"})
+	web := testLabelSet(t, "hsplg_web", map[string]interface{}{"role": "web"})
+
+	resp, err := plg.ListHosts(ctx, &plgpb.ListHostsRequest{Catalog: cat, Sets: []*hostsets.HostSet{ssh, web}})
+	require.NoError(err)
+	assert.Equal("10.0.0.2:53", gotServer)
+	assert.Equal([]string{"web-1.example.com", "web-2.example.com"}, sortedHostIds(resp))
+	web1, web2 := resp.GetHosts()[0], resp.GetHosts()[1]
+	assert.ElementsMatch([]string{"hsplg_ssh", "hsplg_web"}, web1.GetSetIds())
+	assert.Equal([]string{"10.0.0.1"}, web1.GetIpAddresses())
+	assert.Equal([]string{"web-1.example.com"}, web1.GetDnsNames())
+	assert.Equal([]string{"hsplg_ssh"}, web2.GetSetIds())
+	assert.Equal([]string{"10.0.0.2", "fd00::2"}, web2.GetIpAddresses())
+
+	resp, err = plg.ListHosts(ctx, &plgpb.ListHostsRequest{Catalog: cat, Sets: []*hostsets.HostSet{all}})
+	require.NoError(err)
+	assert.Equal([]string{"db.example.com", "web-1.example.com", "web-2.example.com"}, sortedHostIds(resp))
+
+	delete(resolver.addrs, "db.example.com")
+	_, err = plg.ListHosts(ctx, &plgpb.ListHostsRequest{Catalog: cat, Sets: []*hostsets.HostSet{all}})
+	assert.Equal(codes.Unavailable, status.Code(err))
+}
@@ -0,0 +1,304 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	hcpb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostcatalogs"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gopkg.in/yaml.v2"
+)
+
+// filePluginPathAttrField is the host catalog attribute holding the path of
+// the inventory file on the controllers.
+const filePluginPathAttrField = "path"
+
+var (
+	_ plgpb.HostPluginServiceServer = (*filePlugin)(nil)
+	_ catalogWatcher                = (*filePlugin)(nil)
+)
+
+// filePlugin provides a host plugin which reads the hosts of a catalog from a
+// JSON or YAML inventory file local to the controllers, for example:
+//
+//   hosts:
+//   - id: web-1
+//     ip_addresses: [10.0.0.1]
+//     dns_names: [web-1.example.com]
+//     labels:
+//       role: web
+//
+// Hosts are selected into sets by their labels. The plugin watches the
+// inventory files of the catalogs whose hosts it listed so the set sync job
+// can pick up changes without waiting for the sets' sync interval.
+//
+// Inventory files must be located in the directory configured on the
+// controllers, so users able to manage catalogs can't make the controllers
+// read arbitrary files.
+type filePlugin struct {
+	plgpb.UnimplementedHostPluginServiceServer
+
+	// dir is the directory inventory files must be located in. If empty,
+	// every catalog is rejected.
+	dir string
+
+	mu sync.Mutex
+	// listed maps the ids of the catalogs whose hosts were listed to the state
+	// of their inventory file when they were last listed.
+	listed map[string]fileState
+}
+
+type fileState struct {
+	path    string
+	modTime time.Time
+	size    int64
+}
+
+type fileInventory struct {
+	Hosts []*fileInventoryHost `yaml:"hosts"`
+}
+
+type fileInventoryHost struct {
+	Id          string            `yaml:"id"`
+	Name        string            `yaml:"name"`
+	Description string            `yaml:"description"`
+	IpAddresses []string          `yaml:"ip_addresses"`
+	DnsNames    []string          `yaml:"dns_names"`
+	Labels      map[string]string `yaml:"labels"`
+}
+
+// NewFilePlugin returns a new file plugin reading the inventory files of
+// catalogs from dir.
+func NewFilePlugin(dir string) plgpb.HostPluginServiceServer {
+	return &filePlugin{
+		dir:    dir,
+		listed: make(map[string]fileState),
+	}
+}
+
+func (p *filePlugin) OnCreateCatalog(_ context.Context, req *plgpb.OnCreateCatalogRequest) (*plgpb.OnCreateCatalogResponse, error) {
+	if err := p.validateInventory(req.GetCatalog()); err != nil {
+		return nil, err
+	}
+	return &plgpb.OnCreateCatalogResponse{}, nil
+}
+
+func (p *filePlugin) OnUpdateCatalog(_ context.Context, req *plgpb.OnUpdateCatalogRequest) (*plgpb.OnUpdateCatalogResponse, error) {
+	if err := p.validateInventory(req.GetNewCatalog()); err != nil {
+		return nil, err
+	}
+	// The sets of an updated catalog are synced right away.
+	p.forget(req.GetNewCatalog().GetId())
+	return &plgpb.OnUpdateCatalogResponse{}, nil
+}
+
+func (p *filePlugin) OnDeleteCatalog(_ context.Context, req *plgpb.OnDeleteCatalogRequest) (*plgpb.OnDeleteCatalogResponse, error) {
+	p.forget(req.GetCatalog().GetId())
+	return &plgpb.OnDeleteCatalogResponse{}, nil
+}
+
+func (p *filePlugin) OnCreateSet(_ context.Context, req *plgpb.OnCreateSetRequest) (*plgpb.OnCreateSetResponse, error) {
+	if _, err := setSelector(req.GetSet()); err != nil {
+		return nil, err
+	}
+	return &plgpb.OnCreateSetResponse{}, nil
+}
+
+func (p *filePlugin) OnUpdateSet(_ context.Context, req *plgpb.OnUpdateSetRequest) (*plgpb.OnUpdateSetResponse, error) {
+	if _, err := setSelector(req.GetNewSet()); err != nil {
+		return nil, err
+	}
+	return &plgpb.OnUpdateSetResponse{}, nil
+}
+
+func (p *filePlugin) OnDeleteSet(context.Context, *plgpb.OnDeleteSetRequest) (*plgpb.OnDeleteSetResponse, error) {
+	return &plgpb.OnDeleteSetResponse{}, nil
+}
+
+func (p *filePlugin) ListHosts(_ context.Context, req *plgpb.ListHostsRequest) (*plgpb.ListHostsResponse, error) {
+	path, err := p.inventoryPath(req.GetCatalog())
+	if err != nil {
+		// The path was valid when the catalog was created or updated, so the
+		// file or the controllers' configuration changed since.
+		return nil, status.Error(codes.FailedPrecondition, status.Convert(err).Message())
+	}
+	selectors := make(map[string]map[string]string, len(req.GetSets()))
+	for _, set := range req.GetSets() {
+		if selectors[set.GetId()], err = setSelector(set); err != nil {
+			return nil, err
+		}
+	}
+
+	// Stat the file before reading it so a change made while reading it is
+	// picked up by the next sync.
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, "unable to stat inventory file")
+	}
+	inv, err := loadInventory(path)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	resp := new(plgpb.ListHostsResponse)
+	for i, h := range inv.Hosts {
+		var setIds []string
+		for setId, selector := range selectors {
+			if matchLabels(selector, h.Labels) {
+				setIds = append(setIds, setId)
+			}
+		}
+		if len(setIds) == 0 {
+			continue
+		}
+		attrs, err := labelsAttributes(h.Labels)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to convert labels of host %d of inventory file", i)
+		}
+		resp.Hosts = append(resp.Hosts, &plgpb.ListHostsResponseHost{
+			ExternalId:  h.Id,
+			Name:        h.Name,
+			Description: h.Description,
+			IpAddresses: h.IpAddresses,
+			DnsNames:    h.DnsNames,
+			SetIds:      setIds,
+			Attributes:  attrs,
+		})
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.listed[req.GetCatalog().GetId()] = fileState{path: path, modTime: fi.ModTime(), size: fi.Size()}
+	return resp, nil
+}
+
+// changedCatalogs implements catalogWatcher. A catalog changed if its
+// inventory file was modified, removed or created since its hosts were last
+// listed.
+func (p *filePlugin) changedCatalogs() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var ids []string
+	for id, st := range p.listed {
+		var cur fileState
+		if fi, err := os.Stat(st.path); err == nil {
+			cur = fileState{path: st.path, modTime: fi.ModTime(), size: fi.Size()}
+		}
+		if !cur.modTime.Equal(st.modTime) || cur.size != st.size {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func (p *filePlugin) forget(catalogId string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.listed, catalogId)
+}
+
+// inventoryPath returns the path of the inventory file of the catalog with
+// symlinks resolved. The path must be located in the inventory directory.
+func (p *filePlugin) inventoryPath(cat *hcpb.HostCatalog) (string, error) {
+	if p.dir == "" {
+		return "", status.Error(codes.FailedPrecondition, "no inventory directory is configured on the controllers")
+	}
+	path, ok := cat.GetAttributes().AsMap()[filePluginPathAttrField].(string)
+	switch {
+	case !ok || path == "":
+		return "", status.Errorf(codes.InvalidArgument, "attributes.%s: must be set to the path of the inventory file", filePluginPathAttrField)
+	case !filepath.IsAbs(path):
+		return "", status.Errorf(codes.InvalidArgument, "attributes.%s: must be an absolute path", filePluginPathAttrField)
+	}
+	dir, err := filepath.EvalSymlinks(p.dir)
+	if err == nil {
+		dir, err = filepath.Abs(dir)
+	}
+	if err != nil {
+		return "", status.Error(codes.FailedPrecondition, "unable to resolve the inventory directory configured on the controllers")
+	}
+	// Check the path before resolving it so the existence of files outside
+	// the directory isn't revealed, and again after resolving it as symlinks
+	// may point out of the directory.
+	outsideErr := status.Errorf(codes.InvalidArgument, "attributes.%s: must be located in the inventory directory configured on the controllers", filePluginPathAttrField)
+	path = filepath.Clean(path)
+	if !inDir(p.dir, path) && !inDir(dir, path) {
+		return "", outsideErr
+	}
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "attributes.%s: unable to resolve inventory file", filePluginPathAttrField)
+	}
+	if !inDir(dir, resolved) {
+		return "", outsideErr
+	}
+	return resolved, nil
+}
+
+// inDir returns whether the cleaned path is located in dir.
+func inDir(dir, path string) bool {
+	rel, err := filepath.Rel(filepath.Clean(dir), path)
+	if err != nil {
+		return false
+	}
+	return rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// validateInventory verifies the inventory file of the catalog can be loaded.
+func (p *filePlugin) validateInventory(cat *hcpb.HostCatalog) error {
+	path, err := p.inventoryPath(cat)
+	if err != nil {
+		return err
+	}
+	if _, err := loadInventory(path); err != nil {
+		return status.Errorf(codes.InvalidArgument, "attributes.%s: %v", filePluginPathAttrField, err)
+	}
+	return nil
+}
+
+// loadInventory reads and validates the inventory file at path. As JSON is a
+// subset of YAML, the file may use either format. Errors don't include the
+// contents of the file.
+func loadInventory(path string) (*fileInventory, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.New("unable to read inventory file")
+	}
+	inv := new(fileInventory)
+	if err := yaml.UnmarshalStrict(b, inv); err != nil {
+		return nil, errors.New("unable to parse inventory file")
+	}
+	ids := make(map[string]struct{}, len(inv.Hosts))
+	for i, h := range inv.Hosts {
+		if h == nil || h.Id == "" {
+			return nil, fmt.Errorf("host %d of inventory file has no id", i)
+		}
+		if _, ok := ids[h.Id]; ok {
+			return nil, fmt.Errorf("host %d of inventory file has the id of an earlier host", i)
+		}
+		ids[h.Id] = struct{}{}
+	}
+	return inv, nil
+}
+
+// labelsAttributes returns host attributes holding labels.
+func labelsAttributes(labels map[string]string) (*structpb.Struct, error) {
+	if len(labels) == 0 {
+		return nil, nil
+	}
+	m := make(map[string]interface{}, len(labels))
+	for k, v := range labels {
+		m[k] = v
+	}
+	return structpb.NewStruct(map[string]interface{}{setLabelsAttrField: m})
+}
@@ -0,0 +1,273 @@
+package plugin
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostcatalogs"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostsets"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	ta "github.com/stretchr/testify/assert"
+	tr "github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const testYamlInventory = `
+hosts:
+- id: web-1
+  name: web one
+  ip_addresses: [10.0.0.1]
+  dns_names: [web-1.example.com]
+  labels:
+    role: web
+    env: prod
+- id: web-2
+  ip_addresses: [10.0.0.2]
+  labels:
+    role: web
+    env: dev
+- id: db-1
+  ip_addresses: [10.0.1.1]
+  labels:
+    role: db
+    env: prod
+`
+
+const testJsonInventory = `{"hosts": [{"id": "web-3", "ip_addresses": ["10.0.0.3"], "labels": {"role": "web"}}]}`
+
+func testStruct(t *testing.T, m map[string]interface{}) *structpb.Struct {
+	t.Helper()
+	s, err := structpb.NewStruct(m)
+	tr.NoError(t, err)
+	return s
+}
+
+func testLabelSet(t *testing.T, id string, labels map[string]interface{}) *hostsets.HostSet {
+	t.Helper()
+	set := &hostsets.HostSet{Id: id}
+	if labels != nil {
+		set.Attributes = testStruct(t, map[string]interface{}{"labels": labels})
+	}
+	return set
+}
+
+func sortedHostIds(resp *plgpb.ListHostsResponse) []string {
+	var ids []string
+	for _, h := range resp.GetHosts() {
+		ids = append(ids, h.GetExternalId())
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func TestFilePlugin_Catalog(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	valid := filepath.Join(dir, "valid.yaml")
+	tr.NoError(t, ioutil.WriteFile(valid, []byte(testYamlInventory), 0o600))
+	duplicate := filepath.Join(dir, "duplicate.yaml")
+	tr.NoError(t, ioutil.WriteFile(duplicate, []byte("hosts: [{id: a}, {id: a}]"), 0o600))
+	noId := filepath.Join(dir, "noid.yaml")
+	tr.NoError(t, ioutil.WriteFile(noId, []byte("hosts: [{ip_addresses: [10.0.0.1]}]"), 0o600))
+	unknownField := filepath.Join(dir, "unknown.yaml")
+	tr.NoError(t, ioutil.WriteFile(unknownField, []byte("hosts: [{id: a, ip: 10.0.0.1}]"), 0o600))
+	notInventory := filepath.Join(dir, "secret.txt")
+	tr.NoError(t, ioutil.WriteFile(notInventory, []byte("super-secret-value"), 0o600))
+	validLink := filepath.Join(dir, "link.yaml")
+	tr.NoError(t, os.Symlink(valid, validLink))
+
+	outsideDir := t.TempDir()
+	outside := filepath.Join(outsideDir, "outside.yaml")
+	tr.NoError(t, ioutil.WriteFile(outside, []byte(testYamlInventory), 0o600))
+	outsideLink := filepath.Join(dir, "outside.yaml")
+	tr.NoError(t, os.Symlink(outside, outsideLink))
+	dirLink := filepath.Join(t.TempDir(), "inventory")
+	tr.NoError(t, os.Symlink(dir, dirLink))
+
+	tests := []struct {
+		name    string
+		dir     string
+		attrs   map[string]interface{}
+		wantErr bool
+		errCode codes.Code
+	}{
+		{
+			name:  "valid",
+			attrs: map[string]interface{}{"path": valid},
+		},
+		{
+			name:  "symlink in directory",
+			attrs: map[string]interface{}{"path": validLink},
+		},
+		{
+			name:  "symlinked directory",
+			dir:   dirLink,
+			attrs: map[string]interface{}{"path": filepath.Join(dirLink, "valid.yaml")},
+		},
+		{
+			name:    "no directory configured",
+			dir:     "-",
+			attrs:   map[string]interface{}{"path": valid},
+			wantErr: true,
+			errCode: codes.FailedPrecondition,
+		},
+		{
+			name:    "outside directory",
+			attrs:   map[string]interface{}{"path": outside},
+			wantErr: true,
+		},
+		{
+			name:    "traversal out of directory",
+			attrs:   map[string]interface{}{"path": filepath.Join(dir, "..", filepath.Base(outsideDir), "outside.yaml")},
+			wantErr: true,
+		},
+		{
+			name:    "directory itself",
+			attrs:   map[string]interface{}{"path": dir},
+			wantErr: true,
+		},
+		{
+			name:    "symlink out of directory",
+			attrs:   map[string]interface{}{"path": outsideLink},
+			wantErr: true,
+		},
+		{
+			name:    "not an inventory file",
+			attrs:   map[string]interface{}{"path": notInventory},
+			wantErr: true,
+		},
+		{
+			name:    "missing path",
+			attrs:   map[string]interface{}{},
+			wantErr: true,
+		},
+		{
+			name:    "relative path",
+			attrs:   map[string]interface{}{"path": "hosts.yaml"},
+			wantErr: true,
+		},
+		{
+			name:    "missing file",
+			attrs:   map[string]interface{}{"path": filepath.Join(dir, "missing.yaml")},
+			wantErr: true,
+		},
+		{
+			name:    "duplicate id",
+			attrs:   map[string]interface{}{"path": duplicate},
+			wantErr: true,
+		},
+		{
+			name:    "missing id",
+			attrs:   map[string]interface{}{"path": noId},
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			attrs:   map[string]interface{}{"path": unknownField},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := ta.New(t)
+			pluginDir := dir
+			switch tt.dir {
+			case "":
+			case "-":
+				pluginDir = ""
+			default:
+				pluginDir = tt.dir
+			}
+			plg := NewFilePlugin(pluginDir)
+			cat := &hostcatalogs.HostCatalog{Id: "hc_1234567890", Attributes: testStruct(t, tt.attrs)}
+			_, err := plg.OnCreateCatalog(ctx, &plgpb.OnCreateCatalogRequest{Catalog: cat})
+			_, upErr := plg.OnUpdateCatalog(ctx, &plgpb.OnUpdateCatalogRequest{CurrentCatalog: cat, NewCatalog: cat})
+			if tt.wantErr {
+				errCode := codes.InvalidArgument
+				if tt.errCode != codes.OK {
+					errCode = tt.errCode
+				}
+				assert.Equal(errCode, status.Code(err))
+				assert.Equal(errCode, status.Code(upErr))
+				// File contents are never echoed back
+				assert.NotContains(err.Error(), "super-secret-value")
+				assert.NotContains(err.Error(), "10.0.0.1")
+				return
+			}
+			assert.NoError(err)
+			assert.NoError(upErr)
+		})
+	}
+}
+
+func TestFilePlugin_ListHosts(t *testing.T) {
+	require, assert := tr.New(t), ta.New(t)
+	ctx := context.Background()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "hosts.yaml")
+	require.NoError(ioutil.WriteFile(path, []byte(testYamlInventory), 0o600))
+
+	plg := NewFilePlugin(dir)
+	cat := &hostcatalogs.HostCatalog{Id: "hc_1234567890", Attributes: testStruct(t, map[string]interface{}{"path": path})}
+
+	_, err := plg.OnCreateSet(ctx, &plgpb.OnCreateSetRequest{Catalog: cat, Set: testLabelSet(t, "hsplg_bad", map[string]interface{}{"role": 1})})
+	assert.Equal(codes.InvalidArgument, status.Code(err))
+
+	all := testLabelSet(t, "hsplg_all", nil)
+	web := testLabelSet(t, "hsplg_web", map[string]interface{}{"role": "web"})
+	prod := testLabelSet(t, "hsplg_prod", map[string]interface{}{"role": "web", "env": "prod"})
+	none := testLabelSet(t, "hsplg_none", map[string]interface{}{"role": "cache"})
+	for _, set := range []*hostsets.HostSet{all, web, prod, none} {
+		_, err := plg.OnCreateSet(ctx, &plgpb.OnCreateSetRequest{Catalog: cat, Set: set})
+		require.NoError(err)
+	}
+
+	resp, err := plg.ListHosts(ctx, &plgpb.ListHostsRequest{Catalog: cat, Sets: []*hostsets.HostSet{web, none}})
+	require.NoError(err)
+	assert.Equal([]string{"web-1", "web-2"}, sortedHostIds(resp))
+
+	resp, err = plg.ListHosts(ctx, &plgpb.ListHostsRequest{Catalog: cat, Sets: []*hostsets.HostSet{all, web, prod, none}})
+	require.NoError(err)
+	require.Len(resp.GetHosts(), 3)
+	got := make(map[string]*plgpb.ListHostsResponseHost)
+	for _, h := range resp.GetHosts() {
+		sort.Strings(h.SetIds)
+		got[h.GetExternalId()] = h
+	}
+	assert.Equal([]string{"hsplg_all", "hsplg_prod", "hsplg_web"}, got["web-1"].GetSetIds())
+	assert.Equal("web one", got["web-1"].GetName())
+	assert.Equal([]string{"10.0.0.1"}, got["web-1"].GetIpAddresses())
+	assert.Equal([]string{"web-1.example.com"}, got["web-1"].GetDnsNames())
+	assert.Equal(map[string]interface{}{"labels": map[string]interface{}{"role": "web", "env": "prod"}}, got["web-1"].GetAttributes().AsMap())
+	assert.Equal([]string{"hsplg_all", "hsplg_web"}, got["web-2"].GetSetIds())
+	assert.Equal([]string{"hsplg_all"}, got["db-1"].GetSetIds())
+
+	// The file is only reported as changed once it is modified
+	fp := plg.(*filePlugin)
+	assert.Empty(fp.changedCatalogs())
+	require.NoError(ioutil.WriteFile(path, []byte(testJsonInventory), 0o600))
+	later := time.Now().Add(time.Minute)
+	require.NoError(os.Chtimes(path, later, later))
+	assert.Equal([]string{cat.GetId()}, fp.changedCatalogs())
+
+	resp, err = plg.ListHosts(ctx, &plgpb.ListHostsRequest{Catalog: cat, Sets: []*hostsets.HostSet{web}})
+	require.NoError(err)
+	assert.Equal([]string{"web-3"}, sortedHostIds(resp))
+	assert.Empty(fp.changedCatalogs())
+
+	require.NoError(os.Remove(path))
+	assert.Equal([]string{cat.GetId()}, fp.changedCatalogs())
+	_, err = plg.ListHosts(ctx, &plgpb.ListHostsRequest{Catalog: cat, Sets: []*hostsets.HostSet{web}})
+	assert.Equal(codes.FailedPrecondition, status.Code(err))
+
+	_, err = plg.OnDeleteCatalog(ctx, &plgpb.OnDeleteCatalogRequest{Catalog: cat})
+	require.NoError(err)
+	assert.Empty(fp.changedCatalogs())
+}
@@ -20,8 +20,20 @@ import (
 const (
 	setSyncJobName        = "plugin_host_set_sync"
 	setSyncJobRunInterval = 10 * time.Minute
+
+	// setSyncJobWatchInterval is the longest interval between runs of the job
+	// when a plugin watches the hosts of its catalogs.
+	setSyncJobWatchInterval = time.Minute
 )
 
+// catalogWatcher is implemented by in-memory host plugins which can tell when
+// the hosts of a catalog changed without listing them.
+type catalogWatcher interface {
+	// changedCatalogs returns the ids of the catalogs whose hosts may have
+	// changed since they were last listed.
+	changedCatalogs() []string
+}
+
 // SetSyncJob is the recurring job that syncs hosts from sets that are.
 // The SetSyncJob is not thread safe,
 // an attempt to Run the job concurrently will result in an JobAlreadyRunning error.
@@ -32,6 +44,9 @@ type SetSyncJob struct {
 	plugins map[string]plgpb.HostPluginServiceClient
 	limit   int
 
+	// watchers contains the plugins which implement catalogWatcher.
+	watchers []catalogWatcher
+
 	running      ua.Bool
 	numSets      int
 	numProcessed int
@@ -58,12 +73,21 @@ func newSetSyncJob(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms,
 		// zero signals the boundary defaults should be used.
 		opts.withLimit = db.DefaultLimit
 	}
+	var watchers []catalogWatcher
+	for _, plg := range plgm {
+		if wc, ok := plg.(*WrappingPluginClient); ok {
+			if cw, ok := wc.Server.(catalogWatcher); ok {
+				watchers = append(watchers, cw)
+			}
+		}
+	}
 	return &SetSyncJob{
-		reader:  r,
-		writer:  w,
-		kms:     kms,
-		plugins: plgm,
-		limit:   opts.withLimit,
+		reader:   r,
+		writer:   w,
+		kms:      kms,
+		plugins:  plgm,
+		limit:    opts.withLimit,
+		watchers: watchers,
 	}, nil
 }
 
@@ -92,6 +116,10 @@ func (r *SetSyncJob) Run(ctx context.Context) error {
 		return errors.Wrap(ctx, err, op)
 	}
 
+	if err := r.markChangedSets(ctx); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
 	var setAggs []*hostSetAgg
 	// Fetch all sets that will reach their sync point within the syncWindow.
 	// This is done to avoid constantly scheduling the set sync job when there
@@ -121,9 +149,36 @@ func (r *SetSyncJob) NextRunIn() (time.Duration, error) {
 	if err != nil {
 		return setSyncJobRunInterval, errors.WrapDeprecated(err, op)
 	}
+	if len(r.watchers) > 0 && next > setSyncJobWatchInterval {
+		// Check for changed catalogs regularly
+		next = setSyncJobWatchInterval
+	}
 	return next, nil
 }
 
+// markChangedSets flags the sets of the catalogs whose hosts changed according
+// to their plugin as needing to be synced.
+func (r *SetSyncJob) markChangedSets(ctx context.Context) error {
+	const op = "plugin.(SetSyncJob).markChangedSets"
+	var catalogIds []string
+	for _, w := range r.watchers {
+		catalogIds = append(catalogIds, w.changedCatalogs()...)
+	}
+	if len(catalogIds) == 0 {
+		return nil
+	}
+	const markChangedSetsQuery = `
+update host_plugin_set
+set
+	need_sync = true
+where catalog_id in (?)
+`
+	if _, err := r.writer.Exec(ctx, markChangedSetsQuery, []interface{}{catalogIds}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("marking sets of changed catalogs"))
+	}
+	return nil
+}
+
 // Name is the unique name of the job.
 func (r *SetSyncJob) Name() string {
 	return setSyncJobName
@@ -0,0 +1,55 @@
+package plugin
+
+import (
+	"fmt"
+
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostsets"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// setLabelsAttrField is the host set attribute used by the built-in file and
+// dns plugins to select the hosts of a set. A host is a member of the set if
+// it has every label in the selector. A set without labels selects every host
+// of its catalog.
+const setLabelsAttrField = "labels"
+
+// setSelector returns the labels selecting the hosts of the set.
+func setSelector(set *pb.HostSet) (map[string]string, error) {
+	field, ok := set.GetAttributes().AsMap()[setLabelsAttrField]
+	if !ok || field == nil {
+		return nil, nil
+	}
+	labels, err := toLabels(field)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "attributes.%s: %v", setLabelsAttrField, err)
+	}
+	return labels, nil
+}
+
+// toLabels converts a decoded attribute value to labels.
+func toLabels(v interface{}) (map[string]string, error) {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("must be an object, got %T", v)
+	}
+	labels := make(map[string]string, len(m))
+	for k, lv := range m {
+		s, ok := lv.(string)
+		if !ok {
+			return nil, fmt.Errorf("value of label %q must be a string, got %T", k, lv)
+		}
+		labels[k] = s
+	}
+	return labels, nil
+}
+
+// matchLabels returns whether labels contains every label in selector.
+func matchLabels(selector, labels map[string]string) bool {
+	for k, v := range selector {
+		if lv, ok := labels[k]; !ok || lv != v {
+			return false
+		}
+	}
+	return true
+}
@@ -1,6 +1,9 @@
 package iam
 
-import "io"
+import (
+	"io"
+	"time"
+)
 
 // getOpts - iterate the inbound Options and return a struct
 func getOpts(opt ...Option) options {
@@ -16,19 +19,21 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	withPublicId                string
-	withName                    string
-	withDescription             string
-	withLimit                   int
-	withGrantScopeId            string
-	withSkipVetForWrite         bool
-	withDisassociate            bool
-	withSkipAdminRoleCreation   bool
-	withSkipDefaultRoleCreation bool
-	withUserId                  string
-	withRandomReader            io.Reader
-	withAccountIds              []string
-	withPrimaryAuthMethodId     string
+	withPublicId                     string
+	withName                         string
+	withDescription                  string
+	withLimit                        int
+	withGrantScopeId                 string
+	withSkipVetForWrite              bool
+	withDisassociate                 bool
+	withSkipAdminRoleCreation        bool
+	withSkipDefaultRoleCreation      bool
+	withUserId                       string
+	withRandomReader                 io.Reader
+	withAccountIds                   []string
+	withPrimaryAuthMethodId          string
+	withMaxConcurrentSessionsPerUser int32
+	withExpirationTime               time.Time
 }
 
 func getDefaultOptions() options {
@@ -141,3 +146,20 @@ func WithPrimaryAuthMethodId(id string) Option {
 		o.withPrimaryAuthMethodId = id
 	}
 }
+
+// WithMaxConcurrentSessionsPerUser provides an option to specify the maximum
+// number of sessions a user can have open at the same time for the targets of
+// the scope.
+func WithMaxConcurrentSessionsPerUser(limit int32) Option {
+	return func(o *options) {
+		o.withMaxConcurrentSessionsPerUser = limit
+	}
+}
+
+// WithExpirationTime provides an option to specify the time after which a
+// principal's assignment to a role expires. A zero time never expires.
+func WithExpirationTime(t time.Time) Option {
+	return func(o *options) {
+		o.withExpirationTime = t
+	}
+}
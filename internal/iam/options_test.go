@@ -2,6 +2,7 @@ package iam
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -79,4 +80,19 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withPrimaryAuthMethodId = "test"
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithMaxConcurrentSessionsPerUser", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithMaxConcurrentSessionsPerUser(5))
+		testOpts := getDefaultOptions()
+		testOpts.withMaxConcurrentSessionsPerUser = 5
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithExpirationTime", func(t *testing.T) {
+		assert := assert.New(t)
+		exp := time.Now().Add(time.Hour)
+		opts := getOpts(WithExpirationTime(exp))
+		testOpts := getDefaultOptions()
+		testOpts.withExpirationTime = exp
+		assert.Equal(opts, testOpts)
+	})
 }
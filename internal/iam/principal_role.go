@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/iam/store"
 	"google.golang.org/protobuf/proto"
@@ -75,9 +76,9 @@ var (
 	_ db.VetForWriter = (*UserRole)(nil)
 )
 
-// NewUserRole creates a new user role in memory. No options are supported
-// currently.
-func NewUserRole(roleId, userId string, _ ...Option) (*UserRole, error) {
+// NewUserRole creates a new user role in memory. WithExpirationTime is the
+// only option supported.
+func NewUserRole(roleId, userId string, opt ...Option) (*UserRole, error) {
 	const op = "iam.NewUserRole"
 	if roleId == "" {
 		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing role id")
@@ -85,12 +86,17 @@ func NewUserRole(roleId, userId string, _ ...Option) (*UserRole, error) {
 	if userId == "" {
 		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing user id")
 	}
-	return &UserRole{
+	opts := getOpts(opt...)
+	r := &UserRole{
 		UserRole: &store.UserRole{
 			PrincipalId: userId,
 			RoleId:      roleId,
 		},
-	}, nil
+	}
+	if !opts.withExpirationTime.IsZero() {
+		r.ExpirationTime = timestamp.New(opts.withExpirationTime)
+	}
+	return r, nil
 }
 
 func allocUserRole() UserRole {
@@ -152,8 +158,8 @@ var (
 	_ db.VetForWriter = (*GroupRole)(nil)
 )
 
-// NewGroupRole creates a new group role in memory. No options are supported
-// currently.
+// NewGroupRole creates a new group role in memory. WithExpirationTime is the
+// only option supported.
 func NewGroupRole(roleId, groupId string, opt ...Option) (*GroupRole, error) {
 	const op = "iam.NewGroupRole"
 	if roleId == "" {
@@ -162,12 +168,17 @@ func NewGroupRole(roleId, groupId string, opt ...Option) (*GroupRole, error) {
 	if groupId == "" {
 		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing group id")
 	}
-	return &GroupRole{
+	opts := getOpts(opt...)
+	r := &GroupRole{
 		GroupRole: &store.GroupRole{
 			PrincipalId: groupId,
 			RoleId:      roleId,
 		},
-	}, nil
+	}
+	if !opts.withExpirationTime.IsZero() {
+		r.ExpirationTime = timestamp.New(opts.withExpirationTime)
+	}
+	return r, nil
 }
 
 func allocGroupRole() GroupRole {
@@ -229,8 +240,8 @@ var (
 	_ db.VetForWriter = (*ManagedGroupRole)(nil)
 )
 
-// NewGroupRole creates a new group role in memory. No options are supported
-// currently.
+// NewManagedGroupRole creates a new managed group role in memory.
+// WithExpirationTime is the only option supported.
 func NewManagedGroupRole(roleId, managedGroupId string, opt ...Option) (*ManagedGroupRole, error) {
 	const op = "iam.NewManagedGroupRole"
 	if roleId == "" {
@@ -239,12 +250,17 @@ func NewManagedGroupRole(roleId, managedGroupId string, opt ...Option) (*Managed
 	if managedGroupId == "" {
 		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing managed group id")
 	}
-	return &ManagedGroupRole{
+	opts := getOpts(opt...)
+	r := &ManagedGroupRole{
 		ManagedGroupRole: &store.ManagedGroupRole{
 			PrincipalId: managedGroupId,
 			RoleId:      roleId,
 		},
-	}, nil
+	}
+	if !opts.withExpirationTime.IsZero() {
+		r.ExpirationTime = timestamp.New(opts.withExpirationTime)
+	}
+	return r, nil
 }
 
 // AllocManagedGroupRole returns a new ManagedGroupRole with an initialized
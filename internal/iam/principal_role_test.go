@@ -3,8 +3,10 @@ package iam
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/iam/store"
 	"github.com/stretchr/testify/assert"
@@ -21,6 +23,7 @@ func TestNewUserRole(t *testing.T) {
 	orgRole := TestRole(t, conn, org.PublicId)
 	projRole := TestRole(t, conn, proj.PublicId)
 	user := TestUser(t, repo, org.PublicId)
+	expiration := time.Now().Add(time.Hour)
 
 	type args struct {
 		roleId string
@@ -60,6 +63,21 @@ func TestNewUserRole(t *testing.T) {
 				return &r
 			}(),
 		},
+		{
+			name: "valid-with-expiration",
+			args: args{
+				roleId: orgRole.PublicId,
+				userId: user.PublicId,
+				opt:    []Option{WithExpirationTime(expiration)},
+			},
+			want: func() *UserRole {
+				r := allocUserRole()
+				r.RoleId = orgRole.PublicId
+				r.PrincipalId = user.PublicId
+				r.ExpirationTime = timestamp.New(expiration)
+				return &r
+			}(),
+		},
 		{
 			name: "empty-role-id",
 			args: args{
@@ -108,4 +108,30 @@ const (
 	select * from final
 	order by action, member_id;
 	`
+
+	// principalsForUserQuery returns the IDs of a user and the groups and
+	// managed groups it is a member of.
+	principalsForUserQuery = `
+	with
+	user_groups (id) as (
+	  select group_id
+		from iam_group_member_user
+	   where member_id = ?
+	),
+	user_managed_groups (id) as (
+	  select managed_group_id
+		from auth_managed_group_member_account
+	   where member_id in (select public_id
+							 from auth_account
+							where iam_user_id = ?)
+	)
+	select public_id as id
+	  from iam_user
+	 where public_id = ?
+	 union
+	select id from user_groups
+	 union
+	select id from user_managed_groups
+	order by id;
+	`
 )
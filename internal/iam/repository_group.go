@@ -47,8 +47,9 @@ func (r *Repository) CreateGroup(ctx context.Context, group *Group, _ ...Option)
 // UpdateGroup will update a group in the repository and return the written
 // group. fieldMaskPaths provides field_mask.proto paths for fields that should
 // be updated.  Fields will be set to NULL if the field is a zero value and
-// included in fieldMask. Name and Description are the only updatable fields,
-// If no updatable fields are included in the fieldMaskPaths, then an error is returned.
+// included in fieldMask. Name, Description and ExternalId are the only
+// updatable fields, If no updatable fields are included in the fieldMaskPaths,
+// then an error is returned.
 func (r *Repository) UpdateGroup(ctx context.Context, group *Group, version uint32, fieldMaskPaths []string, _ ...Option) (*Group, []*GroupMember, int, error) {
 	const op = "iam.(Repository).UpdateGroup"
 	if group == nil {
@@ -64,6 +65,7 @@ func (r *Repository) UpdateGroup(ctx context.Context, group *Group, version uint
 		switch {
 		case strings.EqualFold("name", f):
 		case strings.EqualFold("description", f):
+		case strings.EqualFold("externalid", f):
 		default:
 			return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
 		}
@@ -73,6 +75,7 @@ func (r *Repository) UpdateGroup(ctx context.Context, group *Group, version uint
 		map[string]interface{}{
 			"name":        group.Name,
 			"description": group.Description,
+			"externalid":  group.ExternalId,
 		},
 		fieldMaskPaths,
 		nil,
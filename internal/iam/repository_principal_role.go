@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/intglobals"
 	"github.com/hashicorp/boundary/internal/kms"
@@ -16,8 +18,9 @@ import (
 // groupIds) to a role (roleId).  The role's current db version must match the
 // roleVersion or an error will be returned.  The list of current PrincipalRoles
 // after the adds will be returned on success. Zero is not a valid value for
-// the WithVersion option and will return an error.
-func (r *Repository) AddPrincipalRoles(ctx context.Context, roleId string, roleVersion uint32, principalIds []string, _ ...Option) ([]PrincipalRole, error) {
+// the WithVersion option and will return an error. The WithExpirationTime
+// option can be used to have the added principals' roles expire.
+func (r *Repository) AddPrincipalRoles(ctx context.Context, roleId string, roleVersion uint32, principalIds []string, opt ...Option) ([]PrincipalRole, error) {
 	const op = "iam.(Repository).AddPrincipalRoles"
 	if roleId == "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing role id")
@@ -25,6 +28,10 @@ func (r *Repository) AddPrincipalRoles(ctx context.Context, roleId string, roleV
 	if roleVersion == 0 {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing version")
 	}
+	opts := getOpts(opt...)
+	if err := validateExpirationTime(ctx, opts.withExpirationTime); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
 	userIds, groupIds, managedGroupIds, err := splitPrincipals(ctx, principalIds)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
@@ -35,7 +42,7 @@ func (r *Repository) AddPrincipalRoles(ctx context.Context, roleId string, roleV
 
 	newUserRoles := make([]interface{}, 0, len(userIds))
 	for _, id := range userIds {
-		usrRole, err := NewUserRole(roleId, id)
+		usrRole, err := NewUserRole(roleId, id, opt...)
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory user role"))
 		}
@@ -43,7 +50,7 @@ func (r *Repository) AddPrincipalRoles(ctx context.Context, roleId string, roleV
 	}
 	newGrpRoles := make([]interface{}, 0, len(groupIds))
 	for _, id := range groupIds {
-		grpRole, err := NewGroupRole(roleId, id)
+		grpRole, err := NewGroupRole(roleId, id, opt...)
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory group role"))
 		}
@@ -51,7 +58,7 @@ func (r *Repository) AddPrincipalRoles(ctx context.Context, roleId string, roleV
 	}
 	newManagedGrpRoles := make([]interface{}, 0, len(managedGroupIds))
 	for _, id := range managedGroupIds {
-		managedGrpRole, err := NewManagedGroupRole(roleId, id)
+		managedGrpRole, err := NewManagedGroupRole(roleId, id, opt...)
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory managed group role"))
 		}
@@ -148,8 +155,10 @@ func (r *Repository) AddPrincipalRoles(ctx context.Context, roleId string, roleV
 // principals as need to reconcile the existing principals with the principals
 // requested. If both userIds and groupIds are empty, the principal roles will
 // be cleared. Zero is not a valid value for the WithVersion option and will
-// return an error.
-func (r *Repository) SetPrincipalRoles(ctx context.Context, roleId string, roleVersion uint32, principalIds []string, _ ...Option) ([]PrincipalRole, int, error) {
+// return an error. The WithExpirationTime option can be used to have the
+// principals' roles expire; existing principals whose expiration time differs
+// from the one requested are replaced.
+func (r *Repository) SetPrincipalRoles(ctx context.Context, roleId string, roleVersion uint32, principalIds []string, opt ...Option) ([]PrincipalRole, int, error) {
 	const op = "iam.(Repository).SetPrincipalRoles"
 	if roleId == "" {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing role id")
@@ -157,6 +166,10 @@ func (r *Repository) SetPrincipalRoles(ctx context.Context, roleId string, roleV
 	if roleVersion == 0 {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
 	}
+	opts := getOpts(opt...)
+	if err := validateExpirationTime(ctx, opts.withExpirationTime); err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
 	role := allocRole()
 	role.PublicId = roleId
 
@@ -167,7 +180,7 @@ func (r *Repository) SetPrincipalRoles(ctx context.Context, roleId string, roleV
 	if err != nil {
 		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
 	}
-	toSet, err := r.PrincipalsToSet(ctx, &role, userIds, groupIds, managedGroupIds)
+	toSet, err := r.PrincipalsToSet(ctx, &role, userIds, groupIds, managedGroupIds, opt...)
 	if err != nil {
 		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
 	}
@@ -474,13 +487,20 @@ type PrincipalSet struct {
 }
 
 // TODO: Should this be moved inside the transaction, at this point?
-// PrincipalsToSet sets principals on a role from the given lists.
-func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, groupIds, managedGroupIds []string) (*PrincipalSet, error) {
+// PrincipalsToSet sets principals on a role from the given lists. The
+// WithExpirationTime option sets the expiration time of the principals'
+// roles; existing principals with a different expiration time are deleted and
+// added again.
+func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, groupIds, managedGroupIds []string, opt ...Option) (*PrincipalSet, error) {
 	const op = "iam.(Repository).PrincipalsToSet"
 	// TODO(mgaffney) 08/2020: Use SQL to calculate changes.
 	if role == nil {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing role")
 	}
+	opts := getOpts(opt...)
+	unchanged := func(p PrincipalRole) bool {
+		return expirationTimeEqual(p.GetExpirationTime(), opts.withExpirationTime)
+	}
 	existing, err := r.ListPrincipalRoles(ctx, role.PublicId)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to list existing principal role %s", role.PublicId)))
@@ -504,8 +524,8 @@ func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, g
 	userIdsMap := map[string]struct{}{}
 	for _, id := range userIds {
 		userIdsMap[id] = struct{}{}
-		if _, ok := existingUsers[id]; !ok {
-			usrRole, err := NewUserRole(role.PublicId, id)
+		if p, ok := existingUsers[id]; !ok || !unchanged(p) {
+			usrRole, err := NewUserRole(role.PublicId, id, opt...)
 			if err != nil {
 				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory user role for add"))
 			}
@@ -516,8 +536,8 @@ func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, g
 	groupIdsMap := map[string]struct{}{}
 	for _, id := range groupIds {
 		groupIdsMap[id] = struct{}{}
-		if _, ok := existingGroups[id]; !ok {
-			grpRole, err := NewGroupRole(role.PublicId, id)
+		if p, ok := existingGroups[id]; !ok || !unchanged(p) {
+			grpRole, err := NewGroupRole(role.PublicId, id, opt...)
 			if err != nil {
 				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory group role for add"))
 			}
@@ -528,8 +548,8 @@ func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, g
 	managedGroupIdsMap := map[string]struct{}{}
 	for _, id := range managedGroupIds {
 		managedGroupIdsMap[id] = struct{}{}
-		if _, ok := existingManagedGroups[id]; !ok {
-			managedGrpRole, err := NewManagedGroupRole(role.PublicId, id)
+		if p, ok := existingManagedGroups[id]; !ok || !unchanged(p) {
+			managedGrpRole, err := NewManagedGroupRole(role.PublicId, id, opt...)
 			if err != nil {
 				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory managed group role for add"))
 			}
@@ -538,7 +558,7 @@ func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, g
 	}
 	var deleteUserRoles []interface{}
 	for _, p := range existingUsers {
-		if _, ok := userIdsMap[p.PrincipalId]; !ok {
+		if _, ok := userIdsMap[p.PrincipalId]; !ok || !unchanged(p) {
 			usrRole, err := NewUserRole(p.GetRoleId(), p.GetPrincipalId())
 			if err != nil {
 				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory user role for delete"))
@@ -548,7 +568,7 @@ func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, g
 	}
 	var deleteGrpRoles []interface{}
 	for _, p := range existingGroups {
-		if _, ok := groupIdsMap[p.PrincipalId]; !ok {
+		if _, ok := groupIdsMap[p.PrincipalId]; !ok || !unchanged(p) {
 			grpRole, err := NewGroupRole(p.GetRoleId(), p.GetPrincipalId())
 			if err != nil {
 				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory group role for delete"))
@@ -558,7 +578,7 @@ func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, g
 	}
 	var deleteManagedGrpRoles []interface{}
 	for _, p := range existingManagedGroups {
-		if _, ok := managedGroupIdsMap[p.PrincipalId]; !ok {
+		if _, ok := managedGroupIdsMap[p.PrincipalId]; !ok || !unchanged(p) {
 			managedGrpRole, err := NewManagedGroupRole(p.GetRoleId(), p.GetPrincipalId())
 			if err != nil {
 				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory managed group role for delete"))
@@ -602,7 +622,8 @@ func splitPrincipals(ctx context.Context, principals []string) (users, groups, m
 				groups = make([]string, 0, len(principals))
 			}
 			groups = append(groups, principal)
-		case strings.HasPrefix(principal, intglobals.OidcManagedGroupPrefix):
+		case strings.HasPrefix(principal, intglobals.OidcManagedGroupPrefix),
+			strings.HasPrefix(principal, intglobals.LdapManagedGroupPrefix):
 			if managedGroups == nil {
 				managedGroups = make([]string, 0, len(principals))
 			}
@@ -614,3 +635,66 @@ func splitPrincipals(ctx context.Context, principals []string) (users, groups, m
 
 	return
 }
+
+// validateExpirationTime returns an error if the expiration time t of a
+// principal's role is set and not in the future.
+func validateExpirationTime(ctx context.Context, t time.Time) error {
+	const op = "iam.validateExpirationTime"
+	if !t.IsZero() && !t.After(time.Now()) {
+		return errors.New(ctx, errors.InvalidParameter, op, "expiration time must be in the future")
+	}
+	return nil
+}
+
+// expirationTimeEqual reports whether the stored expiration time ts is the
+// same as t. The database only stores microseconds so t is compared at that
+// precision.
+func expirationTimeEqual(ts *timestamp.Timestamp, t time.Time) bool {
+	if ts.GetTimestamp() == nil || t.IsZero() {
+		return ts.GetTimestamp() == nil && t.IsZero()
+	}
+	return ts.AsTime().Truncate(time.Microsecond).Equal(t.Truncate(time.Microsecond))
+}
+
+// DeleteExpiredPrincipalRoles deletes all principal roles whose expiration
+// time has passed and returns the number deleted. The principals of each role
+// are deleted with DeletePrincipalRoles so the role's version is incremented.
+// An error deleting the principals of one role does not prevent the
+// principals of the other roles from being deleted; the first error is
+// returned along with the number of principal roles that were deleted.
+func (r *Repository) DeleteExpiredPrincipalRoles(ctx context.Context, _ ...Option) (int, error) {
+	const op = "iam.(Repository).DeleteExpiredPrincipalRoles"
+	var expired []PrincipalRole
+	if err := r.reader.SearchWhere(ctx, &expired, "expiration_time <= now()", nil, db.WithLimit(-1)); err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to search for expired principal roles"))
+	}
+	principalIds := make(map[string][]string)
+	for _, p := range expired {
+		principalIds[p.GetRoleId()] = append(principalIds[p.GetRoleId()], p.GetPrincipalId())
+	}
+
+	var totalRowsDeleted int
+	var retErr error
+	for roleId, ids := range principalIds {
+		role, _, _, err := r.LookupRole(ctx, roleId)
+		switch {
+		case err != nil:
+			if retErr == nil {
+				retErr = errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to lookup role %s", roleId)))
+			}
+			continue
+		case role == nil:
+			// The role was deleted along with its principals.
+			continue
+		}
+		rowsDeleted, err := r.DeletePrincipalRoles(ctx, roleId, role.GetVersion(), ids)
+		if err != nil {
+			if retErr == nil {
+				retErr = errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to delete expired principals of role %s", roleId)))
+			}
+			continue
+		}
+		totalRowsDeleted += rowsDeleted
+	}
+	return totalRowsDeleted, retErr
+}
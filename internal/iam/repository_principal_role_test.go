@@ -677,3 +677,140 @@ func TestRepository_SetPrincipalRoles(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_PrincipalRoleExpiration(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := TestRepo(t, conn, wrapper)
+	ctx := context.Background()
+	org, _ := TestScopes(t, repo)
+	user := TestUser(t, repo, org.PublicId)
+	group := TestGroup(t, conn, org.PublicId)
+
+	t.Run("past-expiration", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		role := TestRole(t, conn, org.PublicId)
+		_, err := repo.AddPrincipalRoles(ctx, role.PublicId, role.Version, []string{user.PublicId}, WithExpirationTime(time.Now().Add(-time.Minute)))
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+
+		_, _, err = repo.SetPrincipalRoles(ctx, role.PublicId, role.Version, []string{user.PublicId}, WithExpirationTime(time.Now().Add(-time.Minute)))
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+	t.Run("add", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		role := TestRole(t, conn, org.PublicId)
+		expiration := time.Now().Add(time.Hour).Truncate(time.Microsecond)
+		got, err := repo.AddPrincipalRoles(ctx, role.PublicId, role.Version, []string{user.PublicId, group.PublicId}, WithExpirationTime(expiration))
+		require.NoError(err)
+		require.Len(got, 2)
+		for _, p := range got {
+			require.NotNil(p.GetExpirationTime())
+			assert.True(expiration.Equal(p.GetExpirationTime().AsTime()))
+		}
+	})
+	t.Run("set", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		role := TestRole(t, conn, org.PublicId)
+		TestUserRole(t, conn, role.PublicId, user.PublicId)
+		TestGroupRole(t, conn, role.PublicId, group.PublicId)
+		role, _, _, err := repo.LookupRole(ctx, role.PublicId)
+		require.NoError(err)
+
+		// Setting an expiration replaces the principals which do not expire.
+		expiration := time.Now().Add(time.Hour).Truncate(time.Microsecond)
+		got, rowsAffected, err := repo.SetPrincipalRoles(ctx, role.PublicId, role.Version, []string{user.PublicId, group.PublicId}, WithExpirationTime(expiration))
+		require.NoError(err)
+		assert.Equal(4, rowsAffected)
+		require.Len(got, 2)
+		for _, p := range got {
+			require.NotNil(p.GetExpirationTime())
+			assert.True(expiration.Equal(p.GetExpirationTime().AsTime()))
+		}
+
+		// Setting the same expiration is not a change.
+		_, rowsAffected, err = repo.SetPrincipalRoles(ctx, role.PublicId, role.Version+1, []string{user.PublicId, group.PublicId}, WithExpirationTime(expiration))
+		require.NoError(err)
+		assert.Equal(0, rowsAffected)
+
+		// Setting no expiration replaces the principals again.
+		got, rowsAffected, err = repo.SetPrincipalRoles(ctx, role.PublicId, role.Version+1, []string{user.PublicId})
+		require.NoError(err)
+		assert.Equal(3, rowsAffected)
+		require.Len(got, 1)
+		assert.Nil(got[0].GetExpirationTime())
+	})
+}
+
+func TestRepository_DeleteExpiredPrincipalRoles(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := TestRepo(t, conn, wrapper)
+	ctx := context.Background()
+	org, _ := TestScopes(t, repo)
+	user := TestUser(t, repo, org.PublicId)
+	group := TestGroup(t, conn, org.PublicId)
+	TestGroupMember(t, conn, group.PublicId, user.PublicId)
+
+	expiringRole := TestRole(t, conn, org.PublicId)
+	TestRoleGrant(t, conn, expiringRole.PublicId, "id=*;type=*;actions=read")
+	expiration := time.Now().Add(time.Second)
+	TestUserRole(t, conn, expiringRole.PublicId, user.PublicId, WithExpirationTime(expiration))
+	TestGroupRole(t, conn, expiringRole.PublicId, group.PublicId, WithExpirationTime(expiration))
+
+	role := TestRole(t, conn, org.PublicId)
+	TestRoleGrant(t, conn, role.PublicId, "id=*;type=*;actions=update")
+	TestUserRole(t, conn, role.PublicId, user.PublicId)
+	TestGroupRole(t, conn, role.PublicId, group.PublicId, WithExpirationTime(time.Now().Add(time.Hour)))
+
+	grantRoles := func() []string {
+		tuples, err := repo.GrantsForUser(ctx, user.PublicId)
+		require.NoError(err)
+		var roleIds []string
+		for _, g := range tuples {
+			if g.RoleId == expiringRole.PublicId || g.RoleId == role.PublicId {
+				roleIds = append(roleIds, g.RoleId)
+				if g.RoleId == expiringRole.PublicId {
+					require.NotNil(g.ExpirationTime)
+				} else {
+					// The user's role never expires even though the group's
+					// role does.
+					assert.Nil(g.ExpirationTime)
+				}
+			}
+		}
+		sort.Strings(roleIds)
+		return roleIds
+	}
+	want := []string{expiringRole.PublicId, role.PublicId}
+	sort.Strings(want)
+	assert.Equal(want, grantRoles())
+
+	rowsDeleted, err := repo.DeleteExpiredPrincipalRoles(ctx)
+	require.NoError(err)
+	assert.Equal(0, rowsDeleted)
+
+	time.Sleep(time.Until(expiration))
+
+	// Expired roles are ignored before they are deleted.
+	assert.Equal([]string{role.PublicId}, grantRoles())
+
+	rowsDeleted, err = repo.DeleteExpiredPrincipalRoles(ctx)
+	require.NoError(err)
+	assert.Equal(2, rowsDeleted)
+
+	principals, err := repo.ListPrincipalRoles(ctx, expiringRole.PublicId)
+	require.NoError(err)
+	assert.Empty(principals)
+	principals, err = repo.ListPrincipalRoles(ctx, role.PublicId)
+	require.NoError(err)
+	assert.Len(principals, 2)
+
+	got, _, _, err := repo.LookupRole(ctx, expiringRole.PublicId)
+	require.NoError(err)
+	assert.Equal(expiringRole.Version+1, got.Version)
+}
@@ -419,51 +419,66 @@ user_managed_groups (id) as (
          user_accounts
    where member_id in (user_accounts.id)
 ),
-managed_group_roles (role_id) as (
-  select role_id
+managed_group_roles (role_id, expiration_time) as (
+  select role_id,
+         expiration_time
     from iam_managed_group_role,
          user_managed_groups
    where principal_id in (user_managed_groups.id)
+     and (expiration_time is null or expiration_time > now())
 ),
-group_roles (role_id) as (
-  select role_id
+group_roles (role_id, expiration_time) as (
+  select role_id,
+         expiration_time
     from iam_group_role,
          user_groups
    where principal_id in (user_groups.id)
+     and (expiration_time is null or expiration_time > now())
 ),
-user_roles (role_id) as (
-  select role_id
+user_roles (role_id, expiration_time) as (
+  select role_id,
+         expiration_time
     from iam_user_role,
          users
    where principal_id in (users.id)
+     and (expiration_time is null or expiration_time > now())
 ),
-user_group_roles (role_id) as (
-  select role_id
-    from group_roles
-   union
-  select role_id
-    from user_roles
-   union
-  select role_id
-    from managed_group_roles
+user_group_roles (role_id, expiration_time) as (
+  -- a role held through more than one principal expires with the last of
+  -- them, and never if any of them does not expire
+  select role_id,
+         case when bool_or(expiration_time is null) then null
+              else max(expiration_time)
+         end
+    from (select role_id, expiration_time
+            from group_roles
+           union
+          select role_id, expiration_time
+            from user_roles
+           union
+          select role_id, expiration_time
+            from managed_group_roles) as principal_roles
+   group by role_id
 ),
-roles (role_id, grant_scope_id) as (
+roles (role_id, grant_scope_id, expiration_time) as (
   select iam_role.public_id,
-         iam_role.grant_scope_id
+         iam_role.grant_scope_id,
+         user_group_roles.expiration_time
     from iam_role,
          user_group_roles
    where public_id in (user_group_roles.role_id)
 ),
-final (role_id, role_scope, role_grant) as (
+final (role_id, role_scope, role_grant, expiration_time) as (
   select roles.role_id,
          roles.grant_scope_id,
-         iam_role_grant.canonical_grant
+         iam_role_grant.canonical_grant,
+         roles.expiration_time
     from roles
    inner
     join iam_role_grant
       on roles.role_id = iam_role_grant.role_id
 )
-select role_id as role_id, role_scope as scope_id, role_grant as grant from final;
+select role_id as role_id, role_scope as scope_id, role_grant as grant, expiration_time as expiration_time from final;
 	`
 	)
 
@@ -392,12 +392,13 @@ func (r *Repository) UpdateScope(ctx context.Context, scope *Scope, version uint
 	var dbMask, nullFields []string
 	dbMask, nullFields = dbcommon.BuildUpdatePaths(
 		map[string]interface{}{
-			"name":                scope.Name,
-			"description":         scope.Description,
-			"PrimaryAuthMethodId": scope.PrimaryAuthMethodId, // gorm: it's important that the field start with a capital letter.
+			"name":                         scope.Name,
+			"description":                  scope.Description,
+			"PrimaryAuthMethodId":          scope.PrimaryAuthMethodId, // gorm: it's important that the field start with a capital letter.
+			"MaxConcurrentSessionsPerUser": scope.MaxConcurrentSessionsPerUser,
 		},
 		fieldMaskPaths,
-		nil,
+		[]string{"MaxConcurrentSessionsPerUser"},
 	)
 	// nada to update, so reload scope from db and return it
 	if len(dbMask) == 0 && len(nullFields) == 0 {
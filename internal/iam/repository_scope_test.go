@@ -179,6 +179,30 @@ func Test_Repository_Scope_Update(t *testing.T) {
 		assert.Equal("test2", s.GetName())
 		assert.Equal("desc-id-2", s.GetDescription())
 	})
+	t.Run("max-concurrent-sessions-per-user", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s := testOrg(t, repo, testId(t), "")
+
+		foundScope, err := repo.LookupScope(context.Background(), s.PublicId)
+		require.NoError(err)
+		assert.Equal(int32(-1), foundScope.GetMaxConcurrentSessionsPerUser())
+
+		foundScope.MaxConcurrentSessionsPerUser = 2
+		updated, updatedRows, err := repo.UpdateScope(context.Background(), foundScope, foundScope.Version, []string{"MaxConcurrentSessionsPerUser"})
+		require.NoError(err)
+		assert.Equal(1, updatedRows)
+		assert.Equal(int32(2), updated.GetMaxConcurrentSessionsPerUser())
+
+		updated.MaxConcurrentSessionsPerUser = 0
+		_, _, err = repo.UpdateScope(context.Background(), updated, updated.Version, []string{"MaxConcurrentSessionsPerUser"})
+		assert.Error(err)
+
+		global, err := repo.LookupScope(context.Background(), scope.Global.String())
+		require.NoError(err)
+		global.MaxConcurrentSessionsPerUser = 2
+		_, _, err = repo.UpdateScope(context.Background(), global, global.Version, []string{"MaxConcurrentSessionsPerUser"})
+		assert.Error(err)
+	})
 	t.Run("bad-parent-scope", func(t *testing.T) {
 		assert, require := assert.New(t), require.New(t)
 		id := testId(t)
@@ -62,9 +62,10 @@ func (r *Repository) CreateUser(ctx context.Context, user *User, opt ...Option)
 // UpdateUser will update a user in the repository and return the written user
 // plus its associated account ids. fieldMaskPaths provides field_mask.proto
 // paths for fields that should be updated.  Fields will be set to NULL if the
-// field is a zero value and included in fieldMask. Name and Description are the
-// only updatable fields, if no updatable fields are included in the
-// fieldMaskPaths, then an error is returned.
+// field is a zero value and included in fieldMask. Name, Description, Disabled
+// and ExternalId are the only updatable fields, if no updatable fields are
+// included in the fieldMaskPaths, then an error is returned. Disabling a user
+// deletes the auth tokens of all of the user's accounts.
 func (r *Repository) UpdateUser(ctx context.Context, user *User, version uint32, fieldMaskPaths []string, opt ...Option) (*User, []string, int, error) {
 	const op = "iam.(Repository).UpdateUser"
 	if user == nil {
@@ -77,6 +78,8 @@ func (r *Repository) UpdateUser(ctx context.Context, user *User, version uint32,
 		switch {
 		case strings.EqualFold("name", f):
 		case strings.EqualFold("description", f):
+		case strings.EqualFold("disabled", f):
+		case strings.EqualFold("externalid", f):
 		default:
 			return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
 		}
@@ -86,9 +89,11 @@ func (r *Repository) UpdateUser(ctx context.Context, user *User, version uint32,
 		map[string]interface{}{
 			"name":        user.Name,
 			"description": user.Description,
+			"disabled":    user.Disabled,
+			"externalid":  user.ExternalId,
 		},
 		fieldMaskPaths,
-		nil,
+		[]string{"disabled"},
 	)
 	if len(dbMask) == 0 && len(nullFields) == 0 {
 		return nil, nil, db.NoRowsAffected, errors.E(ctx, errors.WithCode(errors.EmptyFieldMask), errors.WithOp(op))
@@ -219,7 +224,8 @@ func (r *Repository) ListUsers(ctx context.Context, withScopeIds []string, opt .
 }
 
 // LookupUserWithLogin will attempt to lookup the user with a matching
-// account id and return the user if found. If a user is not found and the
+// account id and return the user if found. If the user is disabled, an error
+// with code UserDisabled is returned. If a user is not found and the
 // account's scope is not the PrimaryAuthMethod, then an error is returned.
 // If the account's scope is the PrimaryAuthMethod, then a new iam User will be
 // created (autovivified) in the scope of the account, and associated with the
@@ -235,6 +241,9 @@ func (r *Repository) LookupUserWithLogin(ctx context.Context, accountId string,
 		return nil, errors.Wrap(ctx, err, op)
 	}
 	if u != nil {
+		if u.Disabled {
+			return nil, errors.New(ctx, errors.UserDisabled, op, fmt.Sprintf("user %s is disabled", u.PublicId), errors.WithoutEvent())
+		}
 		return u, nil
 	}
 
@@ -388,6 +397,33 @@ func (r *Repository) ListUserAccounts(ctx context.Context, userId string, opt ..
 	return ids, nil
 }
 
+// PrincipalsForUser returns the IDs of the user with userId and of the groups
+// and managed groups the user is a member of, which are the principals roles
+// are granted to. No options are currently supported.
+func (r *Repository) PrincipalsForUser(ctx context.Context, userId string, _ ...Option) ([]string, error) {
+	const op = "iam.(Repository).PrincipalsForUser"
+	if userId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	}
+	rows, err := r.reader.Query(ctx, principalsForUserQuery, []interface{}{userId, userId, userId})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ids, nil
+}
+
 // AddUserAccounts will associate a user with existing accounts and
 // return a list of all associated account ids for the user. The accounts must
 // not already be associated with different users.  No options are currently
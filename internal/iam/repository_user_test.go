@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/auth/store"
+	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/db"
 	dbassert "github.com/hashicorp/boundary/internal/db/assert"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -888,6 +889,48 @@ func TestRepository_LookupUserWithLogin(t *testing.T) {
 	}
 }
 
+func TestRepository_DisabledUser(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	repo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, repo)
+
+	at := authtoken.TestAuthToken(t, conn, kmsCache, org.PublicId)
+	tokenRepo, err := authtoken.NewRepository(rw, rw, kmsCache)
+	require.NoError(err)
+
+	u, _, err := repo.LookupUser(ctx, at.IamUserId)
+	require.NoError(err)
+	u.Disabled = true
+	u, _, _, err = repo.UpdateUser(ctx, u, u.Version, []string{"Disabled"})
+	require.NoError(err)
+	assert.True(u.Disabled)
+
+	// Disabling the user deletes its auth tokens.
+	found, err := tokenRepo.LookupAuthToken(ctx, at.PublicId)
+	require.NoError(err)
+	assert.Nil(found)
+
+	_, err = repo.LookupUserWithLogin(ctx, at.AuthAccountId)
+	assert.Truef(errors.Match(errors.T(errors.UserDisabled), err), "want err code: %q got: %q", errors.UserDisabled, err)
+
+	_, err = tokenRepo.CreateAuthToken(ctx, u, at.AuthAccountId)
+	assert.Error(err)
+
+	u.Disabled = false
+	u, _, _, err = repo.UpdateUser(ctx, u, u.Version, []string{"Disabled"})
+	require.NoError(err)
+	_, err = repo.LookupUserWithLogin(ctx, at.AuthAccountId)
+	assert.NoError(err)
+	_, err = tokenRepo.CreateAuthToken(ctx, u, at.AuthAccountId)
+	assert.NoError(err)
+}
+
 func TestRepository_AssociateAccounts(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
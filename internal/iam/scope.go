@@ -56,7 +56,8 @@ func NewProject(orgPublicId string, opt ...Option) (*Scope, error) {
 // friendly name. WithDescription specifies the scope's description. WithScope
 // specifies the Scope's parent and must be filled in. The type of the parent is
 // used to determine the type of the child. WithPrimaryAuthMethodId specifies
-// the primary auth method for the scope
+// the primary auth method for the scope. WithMaxConcurrentSessionsPerUser
+// specifies the per user session limit of the scope
 func newScope(parent *Scope, opt ...Option) (*Scope, error) {
 	const op = "iam.newScope"
 	if parent == nil || parent.PublicId == "" {
@@ -76,11 +77,12 @@ func newScope(parent *Scope, opt ...Option) (*Scope, error) {
 	opts := getOpts(opt...)
 	s := &Scope{
 		Scope: &store.Scope{
-			Type:                typ.String(),
-			Name:                opts.withName,
-			Description:         opts.withDescription,
-			ParentId:            parent.PublicId,
-			PrimaryAuthMethodId: opts.withPrimaryAuthMethodId,
+			Type:                         typ.String(),
+			Name:                         opts.withName,
+			Description:                  opts.withDescription,
+			ParentId:                     parent.PublicId,
+			PrimaryAuthMethodId:          opts.withPrimaryAuthMethodId,
+			MaxConcurrentSessionsPerUser: opts.withMaxConcurrentSessionsPerUser,
 		},
 	}
 
@@ -271,6 +271,79 @@ func (k *Kms) loadRoot(ctx context.Context, scopeId string, opt ...Option) (*mul
 	return multi, rootKeyId, nil
 }
 
+// ClearCache removes all the cached scope/purpose wrappers so that the next
+// call to GetWrapper reads the current key versions from the database.
+func (k *Kms) ClearCache() {
+	k.scopePurposeCache.Range(func(key, _ interface{}) bool {
+		k.scopePurposeCache.Delete(key)
+		return true
+	})
+}
+
+// RotateKeys creates a new version of the root key and of every DEK of the
+// scope, encrypting the new root key version with the root KMS key. The new
+// versions are used for encryption from then on. Supports the WithRepository
+// option.
+func (k *Kms) RotateKeys(ctx context.Context, scopeId string, randomReader io.Reader, opt ...Option) error {
+	const op = "kms.(Kms).RotateKeys"
+	if scopeId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	if isNil(randomReader) {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing rand reader")
+	}
+	opts := getOpts(opt...)
+	repo := opts.withRepository
+	if repo == nil {
+		repo = k.repo
+	}
+
+	k.externalScopeCacheMutex.RLock()
+	externalWrappers := k.externalScopeCache[scope.Global.String()]
+	k.externalScopeCacheMutex.RUnlock()
+	if externalWrappers == nil || externalWrappers.Root() == nil {
+		return errors.New(ctx, errors.KeyNotFound, op, "missing root key wrapper")
+	}
+	if err := repo.RotateKeys(ctx, externalWrappers.Root(), randomReader, scopeId); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	k.ClearCache()
+	return nil
+}
+
+// ListScopeKeyVersions returns the versions of the root key and the DEKs of
+// the scope. Supports the WithRepository option.
+func (k *Kms) ListScopeKeyVersions(ctx context.Context, scopeId string, opt ...Option) ([]*ScopeKeyVersion, error) {
+	const op = "kms.(Kms).ListScopeKeyVersions"
+	opts := getOpts(opt...)
+	repo := opts.withRepository
+	if repo == nil {
+		repo = k.repo
+	}
+	versions, err := repo.ListScopeKeyVersions(ctx, scopeId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return versions, nil
+}
+
+// DestroyKeyVersion destroys the key version of the scope once nothing needs
+// it for decryption anymore. A KeyInUse error is returned while it is still
+// needed. Supports the WithRepository option.
+func (k *Kms) DestroyKeyVersion(ctx context.Context, scopeId, keyVersionId string, opt ...Option) error {
+	const op = "kms.(Kms).DestroyKeyVersion"
+	opts := getOpts(opt...)
+	repo := opts.withRepository
+	if repo == nil {
+		repo = k.repo
+	}
+	if _, err := repo.DestroyKeyVersion(ctx, scopeId, keyVersionId); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	k.ClearCache()
+	return nil
+}
+
 // ReconcileKeys will reconcile the keys in the kms against known possible issues.
 func (k *Kms) ReconcileKeys(ctx context.Context, randomReader io.Reader) error {
 	const op = "kms.ReconcileKeys"
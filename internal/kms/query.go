@@ -0,0 +1,54 @@
+package kms
+
+const (
+	// countDekVersionsForRootKeyVersionQuery counts the DEK versions which are
+	// encrypted by a root key version.
+	countDekVersionsForRootKeyVersionQuery = `
+select count(*)
+  from kms_scope_key_version
+ where root_key_version_id = @key_version_id;
+`
+
+	// countDatabaseKeyVersionUsesQuery counts the values encrypted by a
+	// database key version which are not protected by a foreign key.
+	countDatabaseKeyVersionUsesQuery = `
+select (select count(*) from auth_token where key_id = @key_version_id)
+     + (select count(*) from auth_password_argon2_cred where key_id = @key_version_id)
+     + (select count(*) from auth_password_argon2_cred_history where key_id = @key_version_id)
+     + (select count(*) from auth_password_totp where key_id = @key_version_id)
+     + (select count(*) from auth_oidc_refresh_token where key_id = @key_version_id);
+`
+
+	// countSessionKeyVersionUsesQuery counts the sessions which have not
+	// been terminated and whose private key is derived from a session key
+	// version.
+	countSessionKeyVersionUsesQuery = `
+select count(*)
+  from session s
+ where s.key_id = @key_version_id
+   and not exists (
+     select 1
+       from session_state ss
+      where ss.session_id = s.public_id
+        and ss.state = 'terminated'
+   );
+`
+
+	// countTokenKeyVersionUsesQuery counts the unexpired auth tokens of the
+	// users of a scope which were issued before the next version of the token
+	// key was created and so may be encrypted by the token key version.
+	countTokenKeyVersionUsesQuery = `
+select count(*)
+  from auth_token at
+  join auth_account aa
+    on at.auth_account_id = aa.public_id
+ where aa.scope_id = @scope_id
+   and at.expiration_time > now()
+   and at.create_time < coalesce(
+     (select min(create_time)
+        from kms_token_key_version
+       where token_key_id = @key_id
+         and version > @version),
+     'infinity');
+`
+)
@@ -0,0 +1,310 @@
+package kms
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-kms-wrapping/wrappers/aead"
+)
+
+// RotateKeys creates a new version of the root key of the scope, encrypted by
+// the rootWrapper, and a new version of every DEK of the scope, encrypted by
+// the new root key version. The new versions are used for all encryption
+// operations from then on, while the previous versions remain available for
+// decryption. There are no valid options at this time.
+func (r *Repository) RotateKeys(ctx context.Context, rootWrapper wrapping.Wrapper, randomReader io.Reader, scopeId string, _ ...Option) error {
+	const op = "kms.(Repository).RotateKeys"
+	if rootWrapper == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing root wrapper")
+	}
+	if isNil(randomReader) {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing random reader")
+	}
+	if scopeId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			rk := AllocRootKey()
+			if err := reader.LookupWhere(ctx, &rk, "scope_id = ?", scopeId); err != nil {
+				if errors.IsNotFoundError(err) {
+					return errors.New(ctx, errors.KeyNotFound, op, fmt.Sprintf("missing root key for scope %s", scopeId))
+				}
+				return errors.Wrap(ctx, err, op)
+			}
+			k, err := generateKey(ctx, randomReader)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("error generating random bytes for root key in scope %s", scopeId)))
+			}
+			rkv := AllocRootKeyVersion()
+			if rkv.PrivateId, err = newRootKeyVersionId(); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			rkv.RootKeyId = rk.PrivateId
+			rkv.Key = k
+			if err := rkv.Encrypt(ctx, rootWrapper); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			// no oplog entries for root key version
+			if err := w.Create(ctx, &rkv); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("root key version create"))
+			}
+
+			rkvWrapper := aead.NewWrapper(nil)
+			if _, err := rkvWrapper.SetConfig(map[string]string{
+				"key_id": rkv.GetPrivateId(),
+			}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("error setting config on aead root wrapper in scope %s", scopeId)))
+			}
+			if err := rkvWrapper.SetAESGCMKeyBytes(k); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("error setting key bytes on aead root wrapper in scope %s", scopeId)))
+			}
+
+			for _, purpose := range []KeyPurpose{KeyPurposeDatabase, KeyPurposeOplog, KeyPurposeTokens, KeyPurposeSessions, KeyPurposeOidc, KeyPurposeAudit} {
+				k, err := generateKey(ctx, randomReader)
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("error generating random bytes for %s key in scope %s", purpose.String(), scopeId)))
+				}
+				if err := createDekVersionTx(ctx, reader, w, rkvWrapper, rk.PrivateId, purpose, k); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to create %s key version in scope %s", purpose.String(), scopeId)))
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// createDekVersionTx creates a new version of the DEK with the purpose which
+// belongs to the root key, encrypted by the rkvWrapper. The audit DEK only
+// exists in the global scope, so no version is created for it when the root
+// key has none.
+func createDekVersionTx(ctx context.Context, r db.Reader, w db.Writer, rkvWrapper wrapping.Wrapper, rootKeyId string, purpose KeyPurpose, key []byte) error {
+	const op = "kms.createDekVersionTx"
+	var dek interface{}
+	switch purpose {
+	case KeyPurposeDatabase:
+		dk := AllocDatabaseKey()
+		dek = &dk
+	case KeyPurposeOplog:
+		dk := AllocOplogKey()
+		dek = &dk
+	case KeyPurposeTokens:
+		dk := AllocTokenKey()
+		dek = &dk
+	case KeyPurposeSessions:
+		dk := AllocSessionKey()
+		dek = &dk
+	case KeyPurposeOidc:
+		dk := AllocOidcKey()
+		dek = &dk
+	case KeyPurposeAudit:
+		dk := AllocAuditKey()
+		dek = &dk
+	default:
+		return errors.New(ctx, errors.InvalidParameter, op, "unknown or invalid DEK purpose specified")
+	}
+	if err := r.LookupWhere(ctx, dek, "root_key_id = ?", rootKeyId); err != nil {
+		if purpose == KeyPurposeAudit && errors.IsNotFoundError(err) {
+			return nil
+		}
+		return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to lookup %s key", purpose.String())))
+	}
+	keyId := dek.(Dek).GetPrivateId()
+
+	var kv interface {
+		Encrypt(context.Context, wrapping.Wrapper) error
+	}
+	var err error
+	switch purpose {
+	case KeyPurposeDatabase:
+		v := AllocDatabaseKeyVersion()
+		v.PrivateId, err = newDatabaseKeyVersionId()
+		v.DatabaseKeyId, v.RootKeyVersionId, v.Key = keyId, rkvWrapper.KeyID(), key
+		kv = &v
+	case KeyPurposeOplog:
+		v := AllocOplogKeyVersion()
+		v.PrivateId, err = newOplogKeyVersionId()
+		v.OplogKeyId, v.RootKeyVersionId, v.Key = keyId, rkvWrapper.KeyID(), key
+		kv = &v
+	case KeyPurposeTokens:
+		v := AllocTokenKeyVersion()
+		v.PrivateId, err = newTokenKeyVersionId()
+		v.TokenKeyId, v.RootKeyVersionId, v.Key = keyId, rkvWrapper.KeyID(), key
+		kv = &v
+	case KeyPurposeSessions:
+		v := AllocSessionKeyVersion()
+		v.PrivateId, err = newSessionKeyVersionId()
+		v.SessionKeyId, v.RootKeyVersionId, v.Key = keyId, rkvWrapper.KeyID(), key
+		kv = &v
+	case KeyPurposeOidc:
+		v := AllocOidcKeyVersion()
+		v.PrivateId, err = newOidcKeyVersionId()
+		v.OidcKeyId, v.RootKeyVersionId, v.Key = keyId, rkvWrapper.KeyID(), key
+		kv = &v
+	case KeyPurposeAudit:
+		v := AllocAuditKeyVersion()
+		v.PrivateId, err = newAuditKeyVersionId(ctx)
+		v.AuditKeyId, v.RootKeyVersionId, v.Key = keyId, rkvWrapper.KeyID(), key
+		kv = &v
+	}
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err := kv.Encrypt(ctx, rkvWrapper); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	// no oplog entries for key versions
+	if err := w.Create(ctx, kv); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("key version create"))
+	}
+	return nil
+}
+
+// ListScopeKeyVersions returns the versions of the root key and the DEKs of
+// the scope ordered by purpose and descending version. The key material is
+// not included. There are no valid options at this time.
+func (r *Repository) ListScopeKeyVersions(ctx context.Context, scopeId string, _ ...Option) ([]*ScopeKeyVersion, error) {
+	const op = "kms.(Repository).ListScopeKeyVersions"
+	if scopeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	var versions []*ScopeKeyVersion
+	if err := r.reader.SearchWhere(ctx, &versions, "scope_id = ?", []interface{}{scopeId}, db.WithLimit(-1), db.WithOrder("purpose asc, version desc")); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", scopeId)))
+	}
+	return versions, nil
+}
+
+// DestroyKeyVersion deletes the key version of the scope with the
+// keyVersionId, returning a count of the number of records deleted. The
+// latest version of a key, the versions of the oplog key and versions which
+// are still needed to decrypt values cannot be destroyed; a KeyInUse error is
+// returned for them. A RecordNotFound error is returned when the scope has no
+// key version with the id. All options are ignored.
+func (r *Repository) DestroyKeyVersion(ctx context.Context, scopeId, keyVersionId string, _ ...Option) (int, error) {
+	const op = "kms.(Repository).DestroyKeyVersion"
+	if scopeId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	if keyVersionId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing key version id")
+	}
+
+	var rowsDeleted int
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			var kv ScopeKeyVersion
+			if err := reader.LookupWhere(ctx, &kv, "scope_id = ? and private_id = ?", scopeId, keyVersionId); err != nil {
+				if errors.IsNotFoundError(err) {
+					return errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("key version %s not found in scope %s", keyVersionId, scopeId))
+				}
+				return errors.Wrap(ctx, err, op)
+			}
+			if kv.Purpose == KeyPurposeOplog.String() {
+				return errors.New(ctx, errors.KeyInUse, op, "oplog key versions cannot be destroyed")
+			}
+			var latest []*ScopeKeyVersion
+			if err := reader.SearchWhere(ctx, &latest, "key_id = ?", []interface{}{kv.KeyId}, db.WithLimit(1), db.WithOrder("version desc")); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if len(latest) == 0 || latest[0].PrivateId == kv.PrivateId {
+				return errors.New(ctx, errors.KeyInUse, op, fmt.Sprintf("%s is the latest version of the %s key", kv.PrivateId, kv.Purpose))
+			}
+
+			var query string
+			switch kv.Purpose {
+			case KeyPurposeRootName:
+				query = countDekVersionsForRootKeyVersionQuery
+			case KeyPurposeDatabase.String():
+				query = countDatabaseKeyVersionUsesQuery
+			case KeyPurposeSessions.String():
+				query = countSessionKeyVersionUsesQuery
+			case KeyPurposeTokens.String():
+				query = countTokenKeyVersionUsesQuery
+			}
+			if query != "" {
+				rows, err := reader.Query(ctx, query, []interface{}{
+					sql.Named("key_version_id", kv.PrivateId),
+					sql.Named("key_id", kv.KeyId),
+					sql.Named("scope_id", kv.ScopeId),
+					sql.Named("version", kv.Version),
+				})
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				defer rows.Close()
+				var uses int
+				for rows.Next() {
+					if err := rows.Scan(&uses); err != nil {
+						return errors.Wrap(ctx, err, op)
+					}
+				}
+				if uses > 0 {
+					return errors.New(ctx, errors.KeyInUse, op, fmt.Sprintf("%s is still used by %d values", kv.PrivateId, uses))
+				}
+			}
+
+			var dkv interface{}
+			switch kv.Purpose {
+			case KeyPurposeRootName:
+				v := AllocRootKeyVersion()
+				v.PrivateId = kv.PrivateId
+				dkv = &v
+			case KeyPurposeDatabase.String():
+				v := AllocDatabaseKeyVersion()
+				v.PrivateId = kv.PrivateId
+				dkv = &v
+			case KeyPurposeTokens.String():
+				v := AllocTokenKeyVersion()
+				v.PrivateId = kv.PrivateId
+				dkv = &v
+			case KeyPurposeSessions.String():
+				v := AllocSessionKeyVersion()
+				v.PrivateId = kv.PrivateId
+				dkv = &v
+			case KeyPurposeOidc.String():
+				v := AllocOidcKeyVersion()
+				v.PrivateId = kv.PrivateId
+				dkv = &v
+			case KeyPurposeAudit.String():
+				v := AllocAuditKeyVersion()
+				v.PrivateId = kv.PrivateId
+				dkv = &v
+			default:
+				return errors.New(ctx, errors.Internal, op, fmt.Sprintf("unknown key purpose %q", kv.Purpose))
+			}
+			var err error
+			// no oplog entries for key versions
+			rowsDeleted, err = w.Delete(ctx, dkv)
+			if err != nil {
+				if errors.Match(errors.T(errors.NotSpecificIntegrity), err) {
+					return errors.New(ctx, errors.KeyInUse, op, fmt.Sprintf("%s is still used to encrypt values", kv.PrivateId))
+				}
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", keyVersionId)))
+	}
+	return rowsDeleted, nil
+}
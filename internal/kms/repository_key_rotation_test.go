@@ -0,0 +1,239 @@
+package kms_test
+
+import (
+	"context"
+	"crypto/rand"
+	"io"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_RotateKeys(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	repo, err := kms.NewRepository(rw, rw)
+	require.NoError(t, err)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	type args struct {
+		rootWrapper  wrapping.Wrapper
+		randomReader io.Reader
+		scopeId      string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantErr   bool
+		wantErrIs errors.Code
+	}{
+		{
+			name: "valid",
+			args: args{
+				rootWrapper:  wrapper,
+				randomReader: rand.Reader,
+				scopeId:      org.PublicId,
+			},
+		},
+		{
+			name: "nil-wrapper",
+			args: args{
+				randomReader: rand.Reader,
+				scopeId:      org.PublicId,
+			},
+			wantErr:   true,
+			wantErrIs: errors.InvalidParameter,
+		},
+		{
+			name: "nil-random-reader",
+			args: args{
+				rootWrapper: wrapper,
+				scopeId:     org.PublicId,
+			},
+			wantErr:   true,
+			wantErrIs: errors.InvalidParameter,
+		},
+		{
+			name: "empty-scope",
+			args: args{
+				rootWrapper:  wrapper,
+				randomReader: rand.Reader,
+			},
+			wantErr:   true,
+			wantErrIs: errors.InvalidParameter,
+		},
+		{
+			name: "unknown-scope",
+			args: args{
+				rootWrapper:  wrapper,
+				randomReader: rand.Reader,
+				scopeId:      "o_1234567890",
+			},
+			wantErr:   true,
+			wantErrIs: errors.KeyNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			ctx := context.Background()
+			var before []*kms.ScopeKeyVersion
+			if !tt.wantErr {
+				before, err = repo.ListScopeKeyVersions(ctx, tt.args.scopeId)
+				require.NoError(err)
+			}
+			err := repo.RotateKeys(ctx, tt.args.rootWrapper, tt.args.randomReader, tt.args.scopeId)
+			if tt.wantErr {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tt.wantErrIs), err), "unexpected error: %s", err.Error())
+				return
+			}
+			require.NoError(err)
+			after, err := repo.ListScopeKeyVersions(ctx, tt.args.scopeId)
+			require.NoError(err)
+			assert.Len(after, 2*len(before))
+
+			// Every purpose has a new latest version wrapped by the new root
+			// key version.
+			latest := map[string]*kms.ScopeKeyVersion{}
+			for _, kv := range after {
+				if _, ok := latest[kv.Purpose]; !ok {
+					latest[kv.Purpose] = kv
+				}
+			}
+			rootVersion := latest[kms.KeyPurposeRootName]
+			require.NotNil(rootVersion)
+			assert.Equal(uint32(2), rootVersion.Version)
+			for purpose, kv := range latest {
+				assert.Equal(uint32(2), kv.Version, purpose)
+				if purpose != kms.KeyPurposeRootName {
+					assert.Equal(rootVersion.PrivateId, kv.RootKeyVersionId, purpose)
+				}
+			}
+		})
+	}
+}
+
+func TestRepository_DestroyKeyVersion(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	repo, err := kms.NewRepository(rw, rw)
+	require.NoError(t, err)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	ctx := context.Background()
+
+	before, err := repo.ListScopeKeyVersions(ctx, org.PublicId)
+	require.NoError(t, err)
+	require.NoError(t, repo.RotateKeys(ctx, wrapper, rand.Reader, org.PublicId))
+
+	oldVersions := map[string]string{}
+	for _, kv := range before {
+		oldVersions[kv.Purpose] = kv.PrivateId
+	}
+	after, err := repo.ListScopeKeyVersions(ctx, org.PublicId)
+	require.NoError(t, err)
+	newVersions := map[string]string{}
+	for _, kv := range after {
+		if _, ok := newVersions[kv.Purpose]; !ok {
+			newVersions[kv.Purpose] = kv.PrivateId
+		}
+	}
+
+	tests := []struct {
+		name         string
+		scopeId      string
+		keyVersionId string
+		wantRows     int
+		wantErr      bool
+		wantErrIs    errors.Code
+	}{
+		{
+			name:         "missing-scope",
+			keyVersionId: oldVersions[kms.KeyPurposeDatabase.String()],
+			wantErr:      true,
+			wantErrIs:    errors.InvalidParameter,
+		},
+		{
+			name:      "missing-key-version",
+			scopeId:   org.PublicId,
+			wantErr:   true,
+			wantErrIs: errors.InvalidParameter,
+		},
+		{
+			name:         "not-found",
+			scopeId:      org.PublicId,
+			keyVersionId: "kdkv_1234567890",
+			wantErr:      true,
+			wantErrIs:    errors.RecordNotFound,
+		},
+		{
+			name:         "wrong-scope",
+			scopeId:      "global",
+			keyVersionId: oldVersions[kms.KeyPurposeDatabase.String()],
+			wantErr:      true,
+			wantErrIs:    errors.RecordNotFound,
+		},
+		{
+			name:         "latest-version",
+			scopeId:      org.PublicId,
+			keyVersionId: newVersions[kms.KeyPurposeDatabase.String()],
+			wantErr:      true,
+			wantErrIs:    errors.KeyInUse,
+		},
+		{
+			name:         "oplog-version",
+			scopeId:      org.PublicId,
+			keyVersionId: oldVersions[kms.KeyPurposeOplog.String()],
+			wantErr:      true,
+			wantErrIs:    errors.KeyInUse,
+		},
+		{
+			name:         "root-version-with-dek-versions",
+			scopeId:      org.PublicId,
+			keyVersionId: oldVersions[kms.KeyPurposeRootName],
+			wantErr:      true,
+			wantErrIs:    errors.KeyInUse,
+		},
+		{
+			name:         "valid-database",
+			scopeId:      org.PublicId,
+			keyVersionId: oldVersions[kms.KeyPurposeDatabase.String()],
+			wantRows:     1,
+		},
+		{
+			name:         "valid-oidc",
+			scopeId:      org.PublicId,
+			keyVersionId: oldVersions[kms.KeyPurposeOidc.String()],
+			wantRows:     1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := repo.DestroyKeyVersion(ctx, tt.scopeId, tt.keyVersionId)
+			if tt.wantErr {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tt.wantErrIs), err), "unexpected error: %s", err.Error())
+				assert.Equal(db.NoRowsAffected, got)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantRows, got)
+			versions, err := repo.ListScopeKeyVersions(ctx, tt.scopeId)
+			require.NoError(err)
+			for _, kv := range versions {
+				assert.NotEqual(tt.keyVersionId, kv.PrivateId)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package kms
+
+import (
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+)
+
+const (
+	// KeyPurposeRootName is the purpose reported for the versions of a
+	// scope's root key in a ScopeKeyVersion.
+	KeyPurposeRootName = "root"
+)
+
+// ScopeKeyVersion is a version of the root key or of one of the DEKs of a
+// scope, as read from the kms_scope_key_version view. The key material is not
+// included.
+type ScopeKeyVersion struct {
+	// ScopeId is the scope of the key.
+	ScopeId string
+	// Purpose is "root" for the versions of the root key and the purpose of
+	// the DEK otherwise.
+	Purpose string
+	// KeyId is the private id of the key the version belongs to.
+	KeyId string
+	// PrivateId is the private id of the key version.
+	PrivateId string `gorm:"primary_key"`
+	// Version is the version of the key version.
+	Version uint32
+	// CreateTime is the time the key version was created.
+	CreateTime *timestamp.Timestamp
+	// RootKeyVersionId is the root key version which encrypts the DEK
+	// version. It is empty for root key versions.
+	RootKeyVersionId string `gorm:"default:null"`
+}
+
+// TableName returns the view name of the ScopeKeyVersion.
+func (*ScopeKeyVersion) TableName() string {
+	return "kms_scope_key_version"
+}
@@ -0,0 +1,31 @@
+package ldap
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EscapeDN escapes s for use as an attribute value of a distinguished name
+// (RFC 4514 2.4).
+func EscapeDN(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == ',' || c == '+' || c == '"' || c == '\\' || c == '<' || c == '>' || c == ';' || c == '=':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case (c == ' ' || c == '#') && i == 0:
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c == ' ' && i == len(s)-1:
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c < 0x20 || c == 0x7f:
+			fmt.Fprintf(&b, "\\%02x", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,16 @@
+package ldap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEscapeDN(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	assert.Equal("alice", EscapeDN("alice"))
+	assert.Equal(`smith\, john`, EscapeDN("smith, john"))
+	assert.Equal(`\#a\=b\ `, EscapeDN("#a=b "))
+	assert.Equal(`\ a\00`, EscapeDN(" a\x00"))
+}
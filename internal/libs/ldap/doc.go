@@ -0,0 +1,8 @@
+package ldap
+
+// This package contains helpers for use with github.com/go-ldap/ldap/v3 which
+// are not provided by that package: parsing of ldap:// and ldaps:// urls and
+// escaping of distinguished name attribute values.
+//
+// It also contains an in-memory TestServer which can be used to test clients
+// of a directory without an external LDAP server.
@@ -0,0 +1,568 @@
+package ldap
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+// TestServer is an in-process LDAP server for testing.  It supports simple
+// binds, searches, and the StartTLS extended operation and can listen for
+// either ldap:// or ldaps:// connections.  Search filters support the
+// and, or, not, equality, substrings, ordering, approximate and present
+// choices, all of which are matched case insensitively.  Entries are held in memory and the
+// "userPassword" attribute of an entry is used to authenticate binds as the
+// entry's DN.  The userPassword attribute is never returned by searches.
+type TestServer struct {
+	listener  net.Listener
+	tlsConfig *tls.Config
+	caCert    string
+	ldaps     bool
+	anonymous bool
+
+	mu      sync.Mutex
+	entries []*goldap.Entry
+	binds   []string
+	conns   map[net.Conn]struct{}
+	wg      sync.WaitGroup
+	stopped bool
+}
+
+// TestOption defines a common functional options type which can be used in a
+// variadic parameter pattern.
+type TestOption func(*testOptions)
+
+type testOptions struct {
+	withLDAPS             bool
+	withNoAnonymousSearch bool
+	withEntries           []*goldap.Entry
+}
+
+// WithTestLDAPS configures the server to listen for ldaps:// connections.
+func WithTestLDAPS() TestOption {
+	return func(o *testOptions) {
+		o.withLDAPS = true
+	}
+}
+
+// WithTestNoAnonymousSearch configures the server to reject searches on
+// connections which have not bound.
+func WithTestNoAnonymousSearch() TestOption {
+	return func(o *testOptions) {
+		o.withNoAnonymousSearch = true
+	}
+}
+
+// WithTestEntries provides the initial entries of the server.
+func WithTestEntries(e ...*goldap.Entry) TestOption {
+	return func(o *testOptions) {
+		o.withEntries = append(o.withEntries, e...)
+	}
+}
+
+// NewTestServer starts a TestServer listening on the loopback interface.
+// The server is stopped when the test completes.
+func NewTestServer(t testing.TB, opt ...TestOption) *TestServer {
+	t.Helper()
+	opts := testOptions{}
+	for _, o := range opt {
+		o(&opts)
+	}
+	caPem, cert := testServerCertificate(t)
+	s := &TestServer{
+		tlsConfig: &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12},
+		caCert:    caPem,
+		ldaps:     opts.withLDAPS,
+		anonymous: !opts.withNoAnonymousSearch,
+		entries:   opts.withEntries,
+		conns:     make(map[net.Conn]struct{}),
+	}
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unable to listen: %s", err)
+	}
+	if s.ldaps {
+		l = tls.NewListener(l, s.tlsConfig)
+	}
+	s.listener = l
+	s.wg.Add(1)
+	go s.serve()
+	t.Cleanup(s.Stop)
+	return s
+}
+
+// URL returns the url of the server.
+func (s *TestServer) URL() string {
+	scheme := "ldap"
+	if s.ldaps {
+		scheme = "ldaps"
+	}
+	return fmt.Sprintf("%s://%s", scheme, s.listener.Addr().String())
+}
+
+// CACert returns the PEM encoded certificate of the CA which issued the
+// server's certificate.
+func (s *TestServer) CACert() string {
+	return s.caCert
+}
+
+// AddEntry adds an entry to the server.
+func (s *TestServer) AddEntry(dn string, attrs map[string][]string) {
+	e := goldap.NewEntry(dn, attrs)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = append(s.entries, e)
+}
+
+// Binds returns the DNs of the successful binds made to the server.
+func (s *TestServer) Binds() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.binds...)
+}
+
+// Stop stops the server and closes all connections.
+func (s *TestServer) Stop() {
+	s.mu.Lock()
+	if s.stopped {
+		s.mu.Unlock()
+		return
+	}
+	s.stopped = true
+	s.listener.Close()
+	for c := range s.conns {
+		c.Close()
+	}
+	s.mu.Unlock()
+	s.wg.Wait()
+}
+
+func (s *TestServer) serve() {
+	defer s.wg.Done()
+	for {
+		c, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		if s.stopped {
+			s.mu.Unlock()
+			c.Close()
+			return
+		}
+		s.conns[c] = struct{}{}
+		s.wg.Add(1)
+		s.mu.Unlock()
+		go s.handle(c)
+	}
+}
+
+const startTLSOID = "1.3.6.1.4.1.1466.20037"
+
+func (s *TestServer) handle(c net.Conn) {
+	defer s.wg.Done()
+	raw := c
+	defer func() {
+		s.mu.Lock()
+		delete(s.conns, raw)
+		s.mu.Unlock()
+		c.Close()
+	}()
+	r := bufio.NewReader(c)
+	isTLS := s.ldaps
+	var bound bool
+	for {
+		msg, err := ber.ReadPacket(r)
+		if err != nil || len(msg.Children) < 2 {
+			return
+		}
+		id, ok := msg.Children[0].Value.(int64)
+		if !ok {
+			return
+		}
+		write := func(op *ber.Packet) bool {
+			p := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+			p.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, id, "MessageID"))
+			p.AppendChild(op)
+			_, err := c.Write(p.Bytes())
+			return err == nil
+		}
+		op := msg.Children[1]
+		if op.ClassType != ber.ClassApplication {
+			return
+		}
+		switch op.Tag {
+		case goldap.ApplicationBindRequest:
+			code, msg := s.bind(op)
+			bound = code == goldap.LDAPResultSuccess && packetString(op, 1) != ""
+			if !write(newResult(goldap.ApplicationBindResponse, code, msg)) {
+				return
+			}
+		case goldap.ApplicationUnbindRequest:
+			return
+		case goldap.ApplicationSearchRequest:
+			if !s.anonymous && !bound {
+				if !write(newResult(goldap.ApplicationSearchResultDone, goldap.LDAPResultInsufficientAccessRights, "anonymous search is not allowed")) {
+					return
+				}
+				continue
+			}
+			entries, code, msg := s.search(op)
+			for _, e := range entries {
+				if !write(encodeEntry(e)) {
+					return
+				}
+			}
+			if !write(newResult(goldap.ApplicationSearchResultDone, code, msg)) {
+				return
+			}
+		case goldap.ApplicationExtendedRequest:
+			if packetString(op, 0) != startTLSOID {
+				if !write(newResult(goldap.ApplicationExtendedResponse, goldap.LDAPResultProtocolError, "unsupported extended operation")) {
+					return
+				}
+				continue
+			}
+			if isTLS {
+				if !write(newResult(goldap.ApplicationExtendedResponse, goldap.LDAPResultOperationsError, "tls already established")) {
+					return
+				}
+				continue
+			}
+			if !write(newResult(goldap.ApplicationExtendedResponse, goldap.LDAPResultSuccess, "")) {
+				return
+			}
+			tc := tls.Server(c, s.tlsConfig)
+			if err := tc.Handshake(); err != nil {
+				return
+			}
+			c, r, isTLS = tc, bufio.NewReader(tc), true
+		default:
+			return
+		}
+	}
+}
+
+func (s *TestServer) bind(op *ber.Packet) (uint16, string) {
+	if len(op.Children) != 3 {
+		return goldap.LDAPResultProtocolError, "malformed bind request"
+	}
+	if v, ok := op.Children[0].Value.(int64); !ok || v != 3 {
+		return goldap.LDAPResultProtocolError, "only ldap v3 is supported"
+	}
+	dn, auth := packetString(op, 1), op.Children[2]
+	if auth.ClassType != ber.ClassContext || auth.Tag != 0 {
+		return goldap.LDAPResultAuthMethodNotSupported, "only simple binds are supported"
+	}
+	pw := auth.Data.String()
+	if dn == "" && pw == "" {
+		return goldap.LDAPResultSuccess, ""
+	}
+	e := s.lookup(dn)
+	if e == nil || pw == "" {
+		return goldap.LDAPResultInvalidCredentials, "invalid credentials"
+	}
+	for _, v := range e.GetEqualFoldAttributeValues("userPassword") {
+		if v == pw {
+			s.mu.Lock()
+			s.binds = append(s.binds, e.DN)
+			s.mu.Unlock()
+			return goldap.LDAPResultSuccess, ""
+		}
+	}
+	return goldap.LDAPResultInvalidCredentials, "invalid credentials"
+}
+
+func (s *TestServer) search(op *ber.Packet) ([]*goldap.Entry, uint16, string) {
+	if len(op.Children) != 8 {
+		return nil, goldap.LDAPResultProtocolError, "malformed search request"
+	}
+	base := normalizeDN(packetString(op, 0))
+	scope, ok := op.Children[1].Value.(int64)
+	if !ok {
+		return nil, goldap.LDAPResultProtocolError, "malformed scope"
+	}
+	sizeLimit, ok := op.Children[3].Value.(int64)
+	if !ok {
+		return nil, goldap.LDAPResultProtocolError, "malformed size limit"
+	}
+	f := op.Children[6]
+	var attrs []string
+	for _, a := range op.Children[7].Children {
+		attrs = append(attrs, a.Data.String())
+	}
+	if base != "" && s.lookup(base) == nil {
+		return nil, goldap.LDAPResultNoSuchObject, "no such object"
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var found []*goldap.Entry
+	for _, e := range s.entries {
+		dn := normalizeDN(e.DN)
+		var inScope bool
+		switch scope {
+		case goldap.ScopeBaseObject:
+			inScope = dn == base
+		case goldap.ScopeSingleLevel:
+			inScope = parentDN(dn) == base
+		default:
+			inScope = base == "" || dn == base || strings.HasSuffix(dn, ","+base)
+		}
+		if !inScope {
+			continue
+		}
+		match, err := matchFilter(f, e)
+		if err != nil {
+			return nil, goldap.LDAPResultProtocolError, err.Error()
+		}
+		if !match {
+			continue
+		}
+		if sizeLimit > 0 && len(found) == int(sizeLimit) {
+			return found, goldap.LDAPResultSizeLimitExceeded, "size limit exceeded"
+		}
+		found = append(found, selectAttributes(e, attrs))
+	}
+	return found, goldap.LDAPResultSuccess, ""
+}
+
+func (s *TestServer) lookup(dn string) *goldap.Entry {
+	dn = normalizeDN(dn)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, e := range s.entries {
+		if normalizeDN(e.DN) == dn {
+			return e
+		}
+	}
+	return nil
+}
+
+// matchFilter reports whether the entry matches the BER encoded search
+// filter (RFC 4511 4.5.1.7).
+func matchFilter(f *ber.Packet, e *goldap.Entry) (bool, error) {
+	if f.ClassType != ber.ClassContext {
+		return false, fmt.Errorf("malformed filter")
+	}
+	switch f.Tag {
+	case goldap.FilterAnd:
+		for _, c := range f.Children {
+			m, err := matchFilter(c, e)
+			if err != nil || !m {
+				return false, err
+			}
+		}
+		return true, nil
+	case goldap.FilterOr:
+		for _, c := range f.Children {
+			m, err := matchFilter(c, e)
+			if err != nil || m {
+				return m, err
+			}
+		}
+		return false, nil
+	case goldap.FilterNot:
+		if len(f.Children) != 1 {
+			return false, fmt.Errorf("malformed not filter")
+		}
+		m, err := matchFilter(f.Children[0], e)
+		return !m, err
+	case goldap.FilterPresent:
+		attr := f.Data.String()
+		if strings.EqualFold(attr, "objectClass") {
+			return true, nil
+		}
+		return len(e.GetEqualFoldAttributeValues(attr)) > 0, nil
+	case goldap.FilterEqualityMatch, goldap.FilterApproxMatch, goldap.FilterGreaterOrEqual, goldap.FilterLessOrEqual, goldap.FilterSubstrings:
+	default:
+		return false, fmt.Errorf("unsupported filter choice %d", f.Tag)
+	}
+	if len(f.Children) != 2 {
+		return false, fmt.Errorf("malformed %s filter", goldap.FilterMap[uint64(f.Tag)])
+	}
+	for _, v := range e.GetEqualFoldAttributeValues(packetString(f, 0)) {
+		v := strings.ToLower(v)
+		if f.Tag == goldap.FilterSubstrings {
+			if matchSubstrings(v, f.Children[1]) {
+				return true, nil
+			}
+			continue
+		}
+		want := strings.ToLower(packetString(f, 1))
+		switch f.Tag {
+		case goldap.FilterEqualityMatch, goldap.FilterApproxMatch:
+			if v == want {
+				return true, nil
+			}
+		case goldap.FilterGreaterOrEqual:
+			if v >= want {
+				return true, nil
+			}
+		case goldap.FilterLessOrEqual:
+			if v <= want {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func matchSubstrings(v string, subs *ber.Packet) bool {
+	for _, s := range subs.Children {
+		sub := strings.ToLower(s.Data.String())
+		switch s.Tag {
+		case goldap.FilterSubstringsInitial:
+			if !strings.HasPrefix(v, sub) {
+				return false
+			}
+			v = v[len(sub):]
+		case goldap.FilterSubstringsAny:
+			i := strings.Index(v, sub)
+			if i < 0 {
+				return false
+			}
+			v = v[i+len(sub):]
+		case goldap.FilterSubstringsFinal:
+			if !strings.HasSuffix(v, sub) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func packetString(p *ber.Packet, child int) string {
+	if len(p.Children) <= child {
+		return ""
+	}
+	return p.Children[child].Data.String()
+}
+
+func newResult(tag ber.Tag, code uint16, msg string) *ber.Packet {
+	p := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, goldap.ApplicationMap[uint8(tag)])
+	p.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(code), "resultCode"))
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "matchedDN"))
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, msg, "diagnosticMessage"))
+	return p
+}
+
+func encodeEntry(e *goldap.Entry) *ber.Packet {
+	p := ber.Encode(ber.ClassApplication, ber.TypeConstructed, goldap.ApplicationSearchResultEntry, nil, "Search Result Entry")
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, e.DN, "objectName"))
+	attrs := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "attributes")
+	for _, a := range e.Attributes {
+		attr := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "attribute")
+		attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, a.Name, "type"))
+		vals := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "vals")
+		for _, v := range a.Values {
+			vals.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "value"))
+		}
+		attr.AppendChild(vals)
+		attrs.AppendChild(attr)
+	}
+	p.AppendChild(attrs)
+	return p
+}
+
+func selectAttributes(e *goldap.Entry, names []string) *goldap.Entry {
+	all := len(names) == 0
+	wanted := make(map[string]bool, len(names))
+	for _, n := range names {
+		if n == "*" {
+			all = true
+		}
+		wanted[strings.ToLower(n)] = true
+	}
+	out := &goldap.Entry{DN: e.DN}
+	for _, a := range e.Attributes {
+		if strings.EqualFold(a.Name, "userPassword") {
+			continue
+		}
+		if all || wanted[strings.ToLower(a.Name)] {
+			out.Attributes = append(out.Attributes, goldap.NewEntryAttribute(a.Name, append([]string(nil), a.Values...)))
+		}
+	}
+	return out
+}
+
+func normalizeDN(dn string) string {
+	rdns := strings.Split(dn, ",")
+	for i, r := range rdns {
+		rdns[i] = strings.ToLower(strings.TrimSpace(r))
+	}
+	return strings.Join(rdns, ",")
+}
+
+func parentDN(dn string) string {
+	i := strings.IndexByte(dn, ',')
+	if i < 0 {
+		return ""
+	}
+	return dn[i+1:]
+}
+
+// testServerCertificate returns a PEM encoded CA certificate and a server
+// certificate for 127.0.0.1 and localhost issued by the CA.
+func testServerCertificate(t testing.TB) (string, tls.Certificate) {
+	t.Helper()
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ldap test ca"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDer, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca, err := x509.ParseCertificate(caDer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1"), net.IPv6loopback},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDer})), tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+	}
+}
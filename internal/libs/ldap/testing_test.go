@@ -0,0 +1,202 @@
+package ldap
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"testing"
+	"time"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	goldap "github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testEntries() []*goldap.Entry {
+	return []*goldap.Entry{
+		goldap.NewEntry("dc=example,dc=com", nil),
+		goldap.NewEntry("ou=people,dc=example,dc=com", nil),
+		goldap.NewEntry("uid=alice,ou=people,dc=example,dc=com", map[string][]string{
+			"uid":          {"alice"},
+			"cn":           {"Alice"},
+			"userPassword": {"alice-password"},
+		}),
+		goldap.NewEntry("uid=bob,ou=people,dc=example,dc=com", map[string][]string{
+			"uid":          {"bob"},
+			"cn":           {"Bob"},
+			"userPassword": {"bob-password"},
+		}),
+	}
+}
+
+func testTLSConfig(t *testing.T, s *TestServer) *tls.Config {
+	t.Helper()
+	pool := x509.NewCertPool()
+	require.True(t, pool.AppendCertsFromPEM([]byte(s.CACert())))
+	return &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+}
+
+func testDial(t *testing.T, s *TestServer, cfg *tls.Config) (*goldap.Conn, error) {
+	t.Helper()
+	c, err := goldap.DialURL(s.URL(), goldap.DialWithDialer(&net.Dialer{Timeout: 5 * time.Second}), goldap.DialWithTLSConfig(cfg))
+	if err != nil {
+		return nil, err
+	}
+	c.SetTimeout(5 * time.Second)
+	t.Cleanup(c.Close)
+	return c, nil
+}
+
+func testSearch(base string, scope int, filter string, sizeLimit int, attrs ...string) *goldap.SearchRequest {
+	return goldap.NewSearchRequest(base, scope, goldap.NeverDerefAliases, sizeLimit, 0, false, filter, attrs, nil)
+}
+
+func TestTestServer_BindAndSearch(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	s := NewTestServer(t, WithTestEntries(testEntries()...))
+	c, err := testDial(t, s, nil)
+	require.NoError(err)
+
+	err = c.Bind("uid=alice,ou=people,dc=example,dc=com", "wrong")
+	assert.True(goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials))
+
+	err = c.Bind("uid=carol,ou=people,dc=example,dc=com", "carol-password")
+	assert.True(goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials))
+
+	require.NoError(c.Bind("uid=alice,ou=people,dc=example,dc=com", "alice-password"))
+	assert.Equal([]string{"uid=alice,ou=people,dc=example,dc=com"}, s.Binds())
+
+	res, err := c.Search(testSearch("ou=people,dc=example,dc=com", goldap.ScopeWholeSubtree, "(uid=*)", 0))
+	require.NoError(err)
+	require.Len(res.Entries, 2)
+	assert.Equal("alice", res.Entries[0].GetAttributeValue("uid"))
+	assert.Empty(res.Entries[0].GetAttributeValues("userPassword"))
+
+	res, err = c.Search(testSearch("ou=people,dc=example,dc=com", goldap.ScopeSingleLevel, "(cn=bob)", 0, "uid"))
+	require.NoError(err)
+	require.Len(res.Entries, 1)
+	assert.Equal("bob", res.Entries[0].GetAttributeValue("uid"))
+	assert.Empty(res.Entries[0].GetAttributeValue("cn"))
+
+	res, err = c.Search(testSearch("dc=example,dc=com", goldap.ScopeBaseObject, "(objectClass=*)", 0))
+	require.NoError(err)
+	require.Len(res.Entries, 1)
+	assert.Equal("dc=example,dc=com", res.Entries[0].DN)
+
+	_, err = c.Search(testSearch("ou=missing,dc=example,dc=com", goldap.ScopeWholeSubtree, "(uid=*)", 0))
+	assert.True(goldap.IsErrorWithCode(err, goldap.LDAPResultNoSuchObject))
+
+	res, err = c.Search(testSearch("ou=people,dc=example,dc=com", goldap.ScopeWholeSubtree, "(uid=*)", 1))
+	assert.True(goldap.IsErrorWithCode(err, goldap.LDAPResultSizeLimitExceeded))
+	require.NotNil(res)
+	assert.Len(res.Entries, 1)
+
+	s.AddEntry("uid=carol,ou=people,dc=example,dc=com", map[string][]string{
+		"uid":          {"carol"},
+		"userPassword": {"carol-password"},
+	})
+	require.NoError(c.Bind("uid=carol,ou=people,dc=example,dc=com", "carol-password"))
+}
+
+func TestTestServer_NoAnonymousSearch(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	s := NewTestServer(t, WithTestEntries(testEntries()...), WithTestNoAnonymousSearch())
+	c, err := testDial(t, s, nil)
+	require.NoError(err)
+
+	req := testSearch("dc=example,dc=com", goldap.ScopeWholeSubtree, "(uid=alice)", 0)
+	_, err = c.Search(req)
+	assert.True(goldap.IsErrorWithCode(err, goldap.LDAPResultInsufficientAccessRights))
+
+	require.NoError(c.Bind("uid=bob,ou=people,dc=example,dc=com", "bob-password"))
+	res, err := c.Search(req)
+	require.NoError(err)
+	assert.Len(res.Entries, 1)
+}
+
+func TestTestServer_StartTLS(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	s := NewTestServer(t, WithTestEntries(testEntries()...))
+
+	// the server's certificate is not trusted
+	c, err := testDial(t, s, nil)
+	require.NoError(err)
+	assert.Error(c.StartTLS(&tls.Config{ServerName: "127.0.0.1", MinVersion: tls.VersionTLS12}))
+
+	c, err = testDial(t, s, nil)
+	require.NoError(err)
+	cfg := testTLSConfig(t, s)
+	cfg.ServerName = "127.0.0.1"
+	require.NoError(c.StartTLS(cfg))
+	assert.Error(c.StartTLS(cfg))
+	require.NoError(c.Bind("uid=alice,ou=people,dc=example,dc=com", "alice-password"))
+}
+
+func TestTestServer_LDAPS(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	s := NewTestServer(t, WithTestEntries(testEntries()...), WithTestLDAPS())
+
+	_, err := testDial(t, s, &tls.Config{MinVersion: tls.VersionTLS12})
+	assert.Error(err)
+
+	c, err := testDial(t, s, testTLSConfig(t, s))
+	require.NoError(err)
+	require.NoError(c.Bind("uid=alice,ou=people,dc=example,dc=com", "alice-password"))
+	res, err := c.Search(testSearch("dc=example,dc=com", goldap.ScopeWholeSubtree, "(uid=alice)", 0))
+	require.NoError(err)
+	assert.Len(res.Entries, 1)
+}
+
+func Test_matchFilter(t *testing.T) {
+	t.Parallel()
+	e := goldap.NewEntry("uid=alice,ou=people,dc=example,dc=com", map[string][]string{
+		"uid":      {"alice"},
+		"cn":       {"Alice Liddell"},
+		"memberOf": {"cn=admins,ou=groups,dc=example,dc=com", "cn=users,ou=groups,dc=example,dc=com"},
+	})
+	tests := []struct {
+		filter string
+		want   bool
+	}{
+		{filter: "(uid=alice)", want: true},
+		{filter: "(UID=ALICE)", want: true},
+		{filter: "(uid=bob)", want: false},
+		{filter: "(uid~=alice)", want: true},
+		{filter: "(uid>=b)", want: false},
+		{filter: "(uid<=b)", want: true},
+		{filter: "(cn=alice*)", want: true},
+		{filter: "(cn=*liddell)", want: true},
+		{filter: "(cn=a*e*l)", want: true},
+		{filter: "(cn=*bob*)", want: false},
+		{filter: "(mail=*)", want: false},
+		{filter: "(objectClass=*)", want: true},
+		{filter: "(memberOf=cn=admins,ou=groups,dc=example,dc=com)", want: true},
+		{filter: "(&(uid=alice)(cn=*))", want: true},
+		{filter: "(&(uid=alice)(mail=*))", want: false},
+		{filter: "(|(uid=bob)(uid=alice))", want: true},
+		{filter: "(!(uid=alice))", want: false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.filter, func(t *testing.T) {
+			f, err := goldap.CompileFilter(tt.filter)
+			require.NoError(t, err)
+			// match the filter as the server receives it
+			p, err := ber.DecodePacketErr(f.Bytes())
+			require.NoError(t, err)
+			got, err := matchFilter(p, e)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	f, err := goldap.CompileFilter("(uid:caseExactMatch:=alice)")
+	require.NoError(t, err)
+	_, err = matchFilter(f, e)
+	assert.Error(t, err)
+}
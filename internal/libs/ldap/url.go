@@ -0,0 +1,39 @@
+package ldap
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+)
+
+const (
+	// DefaultPort is the default port of the ldap scheme.
+	DefaultPort = "389"
+	// DefaultTLSPort is the default port of the ldaps scheme.
+	DefaultTLSPort = "636"
+)
+
+// ParseURL parses an ldap:// or ldaps:// url and returns it with the default
+// port of its scheme applied when no port is specified.
+func ParseURL(u string) (*url.URL, error) {
+	pu, err := url.Parse(u)
+	if err != nil {
+		return nil, err
+	}
+	if pu.Host == "" {
+		return nil, fmt.Errorf("missing host in %q", u)
+	}
+	var port string
+	switch pu.Scheme {
+	case "ldap":
+		port = DefaultPort
+	case "ldaps":
+		port = DefaultTLSPort
+	default:
+		return nil, fmt.Errorf("unsupported scheme %q: must be ldap or ldaps", pu.Scheme)
+	}
+	if pu.Port() == "" {
+		pu.Host = net.JoinHostPort(pu.Hostname(), port)
+	}
+	return pu, nil
+}
@@ -0,0 +1,37 @@
+package ldap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseURL(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		url      string
+		wantHost string
+		wantErr  bool
+	}{
+		{url: "ldap://example.com", wantHost: "example.com:389"},
+		{url: "ldaps://example.com", wantHost: "example.com:636"},
+		{url: "ldap://example.com:1389", wantHost: "example.com:1389"},
+		{url: "ldaps://[::1]", wantHost: "[::1]:636"},
+		{url: "http://example.com", wantErr: true},
+		{url: "ldap://", wantErr: true},
+		{url: "ldap://exa mple.com", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.url, func(t *testing.T) {
+			u, err := ParseURL(tt.url)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantHost, u.Host)
+		})
+	}
+}
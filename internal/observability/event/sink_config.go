@@ -2,22 +2,26 @@ package event
 
 import (
 	"fmt"
+	"net/url"
+	"strings"
 	"time"
 )
 
 // SinkConfig defines the configuration for a Eventer sink
 type SinkConfig struct {
-	Name           string                `hcl:"name"`             // Name defines a name for the sink.
-	Description    string                `hcl:"description"`      // Description defines a description for the sink.
-	EventTypes     []Type                `hcl:"event_types"`      // EventTypes defines a list of event types that will be sent to the sink. See the docs for EventTypes for a list of accepted values.
-	EventSourceUrl string                `hcl:"event_source_url"` // EventSource defines an optional event source URL for the sink.  If not defined a default source will be composed of the https://hashicorp.com/boundary.io/ServerName/Path/FileName.
-	AllowFilters   []string              `hcl:"allow_filters"`    // AllowFilters define a set predicates for including an event in the sink. If any filter matches, the event will be included. The filter should be in a format supported by hashicorp/go-bexpr.
-	DenyFilters    []string              `hcl:"deny_filters"`     // DenyFilters define a set predicates for excluding an event in the sink. If any filter matches, the event will be excluded. The filter should be in a format supported by hashicorp/go-bexpr.
-	Format         SinkFormat            `hcl:"format"`           // Format defines the format for the sink (JSONSinkFormat or TextSinkFormat).
-	Type           SinkType              `hcl:"type"`             // Type defines the type of sink (StderrSink or FileSink).
-	StderrConfig   *StderrSinkTypeConfig `hcl:"stderr"`           // StderrConfig defines parameters for a stderr output.
-	FileConfig     *FileSinkTypeConfig   `hcl:"file"`             // FileConfig defines parameters for a file output.
-	AuditConfig    *AuditConfig          `hcl:"audit_config"`     // AuditConfig defines optional parameters for audit events (if EventTypes contains audit)
+	Name           string                 `hcl:"name"`             // Name defines a name for the sink.
+	Description    string                 `hcl:"description"`      // Description defines a description for the sink.
+	EventTypes     []Type                 `hcl:"event_types"`      // EventTypes defines a list of event types that will be sent to the sink. See the docs for EventTypes for a list of accepted values.
+	EventSourceUrl string                 `hcl:"event_source_url"` // EventSource defines an optional event source URL for the sink.  If not defined a default source will be composed of the https://hashicorp.com/boundary.io/ServerName/Path/FileName.
+	AllowFilters   []string               `hcl:"allow_filters"`    // AllowFilters define a set predicates for including an event in the sink. If any filter matches, the event will be included. The filter should be in a format supported by hashicorp/go-bexpr.
+	DenyFilters    []string               `hcl:"deny_filters"`     // DenyFilters define a set predicates for excluding an event in the sink. If any filter matches, the event will be excluded. The filter should be in a format supported by hashicorp/go-bexpr.
+	Format         SinkFormat             `hcl:"format"`           // Format defines the format for the sink (JSONSinkFormat or TextSinkFormat).
+	Type           SinkType               `hcl:"type"`             // Type defines the type of sink (StderrSink, FileSink, SyslogSink or WebhookSink).
+	StderrConfig   *StderrSinkTypeConfig  `hcl:"stderr"`           // StderrConfig defines parameters for a stderr output.
+	FileConfig     *FileSinkTypeConfig    `hcl:"file"`             // FileConfig defines parameters for a file output.
+	SyslogConfig   *SyslogSinkTypeConfig  `hcl:"syslog"`           // SyslogConfig defines parameters for a syslog output.
+	WebhookConfig  *WebhookSinkTypeConfig `hcl:"webhook"`          // WebhookConfig defines parameters for a webhook output.
+	AuditConfig    *AuditConfig           `hcl:"audit_config"`     // AuditConfig defines optional parameters for audit events (if EventTypes contains audit)
 }
 
 func (sc *SinkConfig) Validate() error {
@@ -36,6 +40,12 @@ func (sc *SinkConfig) Validate() error {
 	if sc.FileConfig != nil {
 		foundSinkTypeConfigs++
 	}
+	if sc.SyslogConfig != nil {
+		foundSinkTypeConfigs++
+	}
+	if sc.WebhookConfig != nil {
+		foundSinkTypeConfigs++
+	}
 	if foundSinkTypeConfigs > 1 {
 		return fmt.Errorf("%s: too many sink type config blocks: %w", op, ErrInvalidParameter)
 	}
@@ -56,6 +66,27 @@ func (sc *SinkConfig) Validate() error {
 		if sc.FileConfig.FileName == "" {
 			return fmt.Errorf("%s: missing file name: %w", op, ErrInvalidParameter)
 		}
+		if err := sc.FileConfig.Validate(); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	case SyslogSink:
+		// Like stderr, every syslog parameter has a default, so the block is
+		// optional as long as no other block is populated.
+		if foundSinkTypeConfigs == 1 && sc.SyslogConfig == nil {
+			return fmt.Errorf("%s: mismatch between sink type and sink configuration block: %w", op, ErrInvalidParameter)
+		}
+		if sc.SyslogConfig != nil {
+			if err := sc.SyslogConfig.Validate(); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+	case WebhookSink:
+		if sc.WebhookConfig == nil {
+			return fmt.Errorf(`%s: missing "webhook" block: %w`, op, ErrInvalidParameter)
+		}
+		if err := sc.WebhookConfig.Validate(); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
 	}
 	if sc.Name == "" {
 		return fmt.Errorf("%s: missing sink name: %w", op, ErrInvalidParameter)
@@ -95,6 +126,127 @@ type FileSinkTypeConfig struct {
 	RotateMaxFiles    int           `hcl:"rotate_max_files" mapstructure:"rotate_max_files"` // RotateMaxFiles defines how may historical rotated files should be kept for a FileSink
 }
 
+// Validate the file sink rotation parameters. A value of zero disables the
+// corresponding rotation behavior.
+func (c *FileSinkTypeConfig) Validate() error {
+	const op = "event.(FileSinkTypeConfig).Validate"
+	switch {
+	case c.RotateBytes < 0:
+		return fmt.Errorf("%s: rotate bytes must not be negative: %w", op, ErrInvalidParameter)
+	case c.RotateDuration < 0:
+		return fmt.Errorf("%s: rotate duration must not be negative: %w", op, ErrInvalidParameter)
+	case c.RotateMaxFiles < 0:
+		return fmt.Errorf("%s: rotate max files must not be negative: %w", op, ErrInvalidParameter)
+	}
+	return nil
+}
+
+const (
+	defaultSyslogFacility = "auth"     // defaultSyslogFacility is the facility used when a syslog sink doesn't specify one
+	defaultSyslogTag      = "boundary" // defaultSyslogTag is the tag used when a syslog sink doesn't specify one
+)
+
+// syslogFacilities maps the accepted facility names to their syslog facility
+// codes.
+var syslogFacilities = map[string]int{
+	"kern":     0,
+	"user":     1,
+	"mail":     2,
+	"daemon":   3,
+	"auth":     4,
+	"syslog":   5,
+	"lpr":      6,
+	"news":     7,
+	"uucp":     8,
+	"cron":     9,
+	"authpriv": 10,
+	"ftp":      11,
+	"local0":   16,
+	"local1":   17,
+	"local2":   18,
+	"local3":   19,
+	"local4":   20,
+	"local5":   21,
+	"local6":   22,
+	"local7":   23,
+}
+
+// SyslogSinkTypeConfig contains configuration structures for syslog sink types
+type SyslogSinkTypeConfig struct {
+	Network  string `hcl:"network"  mapstructure:"network"`  // Network defines the network used to reach syslog (unix, unixgram, udp or tcp). If empty the local syslog socket is used.
+	Address  string `hcl:"address"  mapstructure:"address"`  // Address defines the address of the syslog server and is required when Network is set.
+	Facility string `hcl:"facility" mapstructure:"facility"` // Facility defines the syslog facility (defaults to auth)
+	Tag      string `hcl:"tag"      mapstructure:"tag"`      // Tag defines the syslog tag (defaults to boundary)
+}
+
+// Validate a SyslogSinkTypeConfig
+func (c *SyslogSinkTypeConfig) Validate() error {
+	const op = "event.(SyslogSinkTypeConfig).Validate"
+	switch c.Network {
+	case "":
+		if c.Address != "" {
+			return fmt.Errorf("%s: address requires a network: %w", op, ErrInvalidParameter)
+		}
+	case "unix", "unixgram", "udp", "tcp":
+		if c.Address == "" {
+			return fmt.Errorf("%s: missing address: %w", op, ErrInvalidParameter)
+		}
+	default:
+		return fmt.Errorf("%s: '%s' is not a valid syslog network: %w", op, c.Network, ErrInvalidParameter)
+	}
+	if c.Facility != "" {
+		if _, ok := syslogFacilities[strings.ToLower(c.Facility)]; !ok {
+			return fmt.Errorf("%s: '%s' is not a valid syslog facility: %w", op, c.Facility, ErrInvalidParameter)
+		}
+	}
+	return nil
+}
+
+const (
+	defaultWebhookBatchSize     = 100              // defaultWebhookBatchSize is the number of events sent in each webhook request
+	defaultWebhookFlushInterval = 5 * time.Second  // defaultWebhookFlushInterval is the longest an event waits in a partial batch
+	defaultWebhookTimeout       = 10 * time.Second // defaultWebhookTimeout is the timeout of each webhook request
+	defaultWebhookQueueSize     = 10               // defaultWebhookQueueSize is the number of full batches waiting to be sent before more are dropped
+)
+
+// WebhookSinkTypeConfig contains configuration structures for webhook sink types
+type WebhookSinkTypeConfig struct {
+	Url              string            `hcl:"url"            mapstructure:"url"`         // Url defines the http(s) endpoint events are posted to
+	Headers          map[string]string `hcl:"headers"        mapstructure:"headers"`     // Headers defines additional headers sent with each request
+	BatchSize        int               `hcl:"batch_size"     mapstructure:"batch_size"`  // BatchSize defines the max number of events sent in a single request (defaults to 100)
+	FlushInterval    time.Duration     `mapstructure:"flush_interval"`                   // FlushInterval defines how long events are held waiting for a batch to fill (defaults to 5s)
+	FlushIntervalHCL string            `hcl:"flush_interval" json:"-"`                   // FlushIntervalHCL defines hcl string version of FlushInterval
+	Timeout          time.Duration     `hcl:"-"              mapstructure:"timeout"`     // Timeout defines the timeout of a single request (defaults to 10s)
+	TimeoutHCL       string            `hcl:"timeout"        json:"-"`                   // TimeoutHCL defines hcl string version of Timeout
+	MaxRetries       *int              `hcl:"max_retries"    mapstructure:"max_retries"` // MaxRetries defines how many times a failed request is retried (defaults to 3)
+}
+
+// Validate a WebhookSinkTypeConfig
+func (c *WebhookSinkTypeConfig) Validate() error {
+	const op = "event.(WebhookSinkTypeConfig).Validate"
+	if c.Url == "" {
+		return fmt.Errorf("%s: missing url: %w", op, ErrInvalidParameter)
+	}
+	u, err := url.Parse(c.Url)
+	if err != nil {
+		return fmt.Errorf("%s: invalid url: %w", op, ErrInvalidParameter)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%s: url must be an absolute http or https url: %w", op, ErrInvalidParameter)
+	}
+	switch {
+	case c.BatchSize < 0:
+		return fmt.Errorf("%s: batch size must not be negative: %w", op, ErrInvalidParameter)
+	case c.FlushInterval < 0:
+		return fmt.Errorf("%s: flush interval must not be negative: %w", op, ErrInvalidParameter)
+	case c.Timeout < 0:
+		return fmt.Errorf("%s: timeout must not be negative: %w", op, ErrInvalidParameter)
+	case c.MaxRetries != nil && *c.MaxRetries < 0:
+		return fmt.Errorf("%s: max retries must not be negative: %w", op, ErrInvalidParameter)
+	}
+	return nil
+}
+
 // FilterType defines a type for filters (allow or deny)
 type FilterType string
 
@@ -2,6 +2,7 @@ package event
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -204,6 +205,193 @@ func TestSinkConfig_Validate(t *testing.T) {
 			wantErrIs:       ErrInvalidParameter,
 			wantErrContains: `too many sink type config blocks`,
 		},
+		{
+			name: "file-sink-negative-rotate-bytes",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       FileSink,
+				Format:     JSONSinkFormat,
+				FileConfig: &FileSinkTypeConfig{
+					FileName:    "tmp.file",
+					RotateBytes: -1,
+				},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "rotate bytes must not be negative",
+		},
+		{
+			name: "file-sink-negative-rotate-duration",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       FileSink,
+				Format:     JSONSinkFormat,
+				FileConfig: &FileSinkTypeConfig{
+					FileName:       "tmp.file",
+					RotateDuration: -time.Second,
+				},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "rotate duration must not be negative",
+		},
+		{
+			name: "file-sink-negative-rotate-max-files",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       FileSink,
+				Format:     JSONSinkFormat,
+				FileConfig: &FileSinkTypeConfig{
+					FileName:       "tmp.file",
+					RotateMaxFiles: -1,
+				},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "rotate max files must not be negative",
+		},
+		{
+			name: "type mismatch syslog type file config",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       SyslogSink,
+				Format:     JSONSinkFormat,
+				FileConfig: &FileSinkTypeConfig{FileName: "tmp.file"},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: `mismatch between sink type and sink configuration block`,
+		},
+		{
+			name: "syslog-sink-invalid-network",
+			sc: SinkConfig{
+				Name:         "sink-name",
+				EventTypes:   []Type{EveryType},
+				Type:         SyslogSink,
+				Format:       JSONSinkFormat,
+				SyslogConfig: &SyslogSinkTypeConfig{Network: "invalid", Address: "localhost:514"},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "not a valid syslog network",
+		},
+		{
+			name: "syslog-sink-missing-address",
+			sc: SinkConfig{
+				Name:         "sink-name",
+				EventTypes:   []Type{EveryType},
+				Type:         SyslogSink,
+				Format:       JSONSinkFormat,
+				SyslogConfig: &SyslogSinkTypeConfig{Network: "tcp"},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "missing address",
+		},
+		{
+			name: "syslog-sink-address-without-network",
+			sc: SinkConfig{
+				Name:         "sink-name",
+				EventTypes:   []Type{EveryType},
+				Type:         SyslogSink,
+				Format:       JSONSinkFormat,
+				SyslogConfig: &SyslogSinkTypeConfig{Address: "localhost:514"},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "address requires a network",
+		},
+		{
+			name: "syslog-sink-invalid-facility",
+			sc: SinkConfig{
+				Name:         "sink-name",
+				EventTypes:   []Type{EveryType},
+				Type:         SyslogSink,
+				Format:       JSONSinkFormat,
+				SyslogConfig: &SyslogSinkTypeConfig{Facility: "invalid"},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "not a valid syslog facility",
+		},
+		{
+			name: "webhook-sink-missing-block",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       WebhookSink,
+				Format:     JSONSinkFormat,
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: `missing "webhook" block`,
+		},
+		{
+			name: "webhook-sink-missing-url",
+			sc: SinkConfig{
+				Name:          "sink-name",
+				EventTypes:    []Type{EveryType},
+				Type:          WebhookSink,
+				Format:        JSONSinkFormat,
+				WebhookConfig: &WebhookSinkTypeConfig{},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "missing url",
+		},
+		{
+			name: "webhook-sink-invalid-url",
+			sc: SinkConfig{
+				Name:          "sink-name",
+				EventTypes:    []Type{EveryType},
+				Type:          WebhookSink,
+				Format:        JSONSinkFormat,
+				WebhookConfig: &WebhookSinkTypeConfig{Url: "ftp://localhost"},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "url must be an absolute http or https url",
+		},
+		{
+			name: "webhook-sink-negative-batch-size",
+			sc: SinkConfig{
+				Name:          "sink-name",
+				EventTypes:    []Type{EveryType},
+				Type:          WebhookSink,
+				Format:        JSONSinkFormat,
+				WebhookConfig: &WebhookSinkTypeConfig{Url: "https://localhost", BatchSize: -1},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "batch size must not be negative",
+		},
+		{
+			name: "webhook-sink-negative-max-retries",
+			sc: SinkConfig{
+				Name:          "sink-name",
+				EventTypes:    []Type{EveryType},
+				Type:          WebhookSink,
+				Format:        JSONSinkFormat,
+				WebhookConfig: &WebhookSinkTypeConfig{Url: "https://localhost", MaxRetries: func() *int { i := -1; return &i }()},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "max retries must not be negative",
+		},
+		{
+			name: "valid-syslog",
+			sc: SinkConfig{
+				Name:       "valid",
+				EventTypes: []Type{AuditType},
+				Type:       SyslogSink,
+				Format:     JSONSinkFormat,
+			},
+		},
+		{
+			name: "valid-webhook",
+			sc: SinkConfig{
+				Name:       "valid",
+				EventTypes: []Type{AuditType},
+				Type:       WebhookSink,
+				Format:     JSONHclogSinkFormat,
+				WebhookConfig: &WebhookSinkTypeConfig{
+					Url:           "https://localhost/events",
+					BatchSize:     10,
+					FlushInterval: time.Second,
+				},
+			},
+		},
 		{
 			name: "valid",
 			sc: SinkConfig{
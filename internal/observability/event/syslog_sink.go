@@ -0,0 +1,107 @@
+//go:build !windows && !plan9
+// +build !windows,!plan9
+
+package event
+
+import (
+	"context"
+	"fmt"
+	"log/syslog"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/eventlogger"
+)
+
+// syslogSink writes the formatted representation of an event to syslog. The
+// connection to syslog is established when the first event is written, so a
+// syslog daemon that isn't available yet doesn't prevent the eventer from
+// being created.
+type syslogSink struct {
+	format   SinkFormat
+	network  string
+	address  string
+	priority syslog.Priority
+	tag      string
+
+	l sync.Mutex
+	w *syslog.Writer
+}
+
+// newSyslogSink creates a new syslog sink from the config. Events are written
+// with the informational severity and the configured facility.
+func newSyslogSink(format SinkFormat, c *SyslogSinkTypeConfig) (*syslogSink, error) {
+	const op = "event.newSyslogSink"
+	if c == nil {
+		c = &SyslogSinkTypeConfig{}
+	}
+	if err := c.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	facility := defaultSyslogFacility
+	if c.Facility != "" {
+		facility = strings.ToLower(c.Facility)
+	}
+	tag := defaultSyslogTag
+	if c.Tag != "" {
+		tag = c.Tag
+	}
+	return &syslogSink{
+		format:   format,
+		network:  c.Network,
+		address:  c.Address,
+		priority: syslog.Priority(syslogFacilities[facility]<<3) | syslog.LOG_INFO,
+		tag:      tag,
+	}, nil
+}
+
+// Process will write the event to syslog
+func (s *syslogSink) Process(_ context.Context, e *eventlogger.Event) (*eventlogger.Event, error) {
+	const op = "event.(syslogSink).Process"
+	if e == nil {
+		return nil, fmt.Errorf("%s: missing event: %w", op, ErrInvalidParameter)
+	}
+	val, ok := e.Format(string(s.format))
+	if !ok {
+		return nil, fmt.Errorf("%s: event was not formatted as %s", op, s.format)
+	}
+
+	s.l.Lock()
+	defer s.l.Unlock()
+	if s.w == nil {
+		w, err := syslog.Dial(s.network, s.address, s.priority, s.tag)
+		if err != nil {
+			return nil, fmt.Errorf("%s: unable to connect to syslog: %w", op, err)
+		}
+		s.w = w
+	}
+	// syslog.Writer reconnects and retries once on its own when a write fails
+	if _, err := s.w.Write(val); err != nil {
+		return nil, fmt.Errorf("%s: unable to write to syslog: %w", op, err)
+	}
+	// Sinks are leafs, so do not return the event, since nothing more can
+	// happen to it downstream.
+	return nil, nil
+}
+
+// Reopen closes the connection to syslog, which will be re-established when
+// the next event is written.
+func (s *syslogSink) Reopen() error {
+	const op = "event.(syslogSink).Reopen"
+	s.l.Lock()
+	defer s.l.Unlock()
+	if s.w == nil {
+		return nil
+	}
+	err := s.w.Close()
+	s.w = nil
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// Type describes the type of the node as a Sink.
+func (s *syslogSink) Type() eventlogger.NodeType {
+	return eventlogger.NodeTypeSink
+}
@@ -0,0 +1,81 @@
+//go:build !windows && !plan9
+// +build !windows,!plan9
+
+package event
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyslogSink(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(err)
+	t.Cleanup(func() { conn.Close() })
+
+	testLock := &sync.Mutex{}
+	testLogger := hclog.New(&hclog.LoggerOptions{
+		Mutex: testLock,
+		Name:  "test",
+	})
+	e, err := NewEventer(testLogger, testLock, "TestSyslogSink", EventerConfig{
+		Sinks: []*SinkConfig{
+			{
+				Name:       "syslog",
+				Type:       SyslogSink,
+				EventTypes: []Type{ErrorType},
+				Format:     JSONSinkFormat,
+				SyslogConfig: &SyslogSinkTypeConfig{
+					Network:  "udp",
+					Address:  conn.LocalAddr().String(),
+					Facility: "LOCAL0",
+					Tag:      "boundary-test",
+				},
+			},
+		},
+	})
+	require.NoError(err)
+
+	ev, err := newError("TestSyslogSink", ErrInvalidParameter)
+	require.NoError(err)
+	require.NoError(e.writeError(context.Background(), ev))
+
+	buf := make([]byte, 64*1024)
+	require.NoError(conn.SetReadDeadline(time.Now().Add(5 * time.Second)))
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(err)
+	msg := string(buf[:n])
+	// local0 (16) * 8 + info (6)
+	assert.Contains(msg, "<134>")
+	assert.Contains(msg, "boundary-test")
+	assert.Contains(msg, `"op":"TestSyslogSink"`)
+
+	require.NoError(e.Reopen())
+}
+
+func Test_newSyslogSink(t *testing.T) {
+	t.Parallel()
+	t.Run("defaults", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s, err := newSyslogSink(JSONSinkFormat, nil)
+		require.NoError(err)
+		// auth (4) * 8 + info (6)
+		assert.EqualValues(38, s.priority)
+		assert.Equal(defaultSyslogTag, s.tag)
+		assert.Empty(s.network)
+	})
+	t.Run("invalid", func(t *testing.T) {
+		_, err := newSyslogSink(JSONSinkFormat, &SyslogSinkTypeConfig{Network: "udp"})
+		assert.ErrorIs(t, err, ErrInvalidParameter)
+	})
+}
@@ -0,0 +1,32 @@
+//go:build windows || plan9
+// +build windows plan9
+
+package event
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/eventlogger"
+)
+
+// syslogSink is not supported on this platform since log/syslog isn't
+// available.
+type syslogSink struct{}
+
+// newSyslogSink always returns an error on this platform.
+func newSyslogSink(_ SinkFormat, _ *SyslogSinkTypeConfig) (*syslogSink, error) {
+	const op = "event.newSyslogSink"
+	return nil, fmt.Errorf("%s: syslog sinks are not supported on this platform: %w", op, ErrInvalidParameter)
+}
+
+func (s *syslogSink) Process(_ context.Context, _ *eventlogger.Event) (*eventlogger.Event, error) {
+	const op = "event.(syslogSink).Process"
+	return nil, fmt.Errorf("%s: syslog sinks are not supported on this platform", op)
+}
+
+func (s *syslogSink) Reopen() error { return nil }
+
+func (s *syslogSink) Type() eventlogger.NodeType {
+	return eventlogger.NodeTypeSink
+}
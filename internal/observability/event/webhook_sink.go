@@ -0,0 +1,294 @@
+package event
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/eventlogger"
+)
+
+// webhookSink batches the formatted representation of events and posts each
+// batch to an HTTP endpoint. A batch is sent once it contains the configured
+// number of events or once its oldest event has waited for the flush interval,
+// whichever happens first. Each request body contains the batch's events
+// separated by newlines.
+//
+// Batches are queued and sent in order by a background goroutine, so an
+// unavailable endpoint never blocks the processing of events. Failed requests
+// are retried using the eventer's retrySend. A batch that can't be delivered
+// after all the retries, or that can't be queued because the queue is full,
+// is dropped and logged, so that an unavailable endpoint can't cause unbounded
+// growth.
+type webhookSink struct {
+	eventer       *Eventer
+	format        SinkFormat
+	url           string
+	headers       map[string]string
+	batchSize     int
+	flushInterval time.Duration
+	maxRetries    uint
+	backoff       backoff
+	client        *http.Client
+
+	// queue contains the batches waiting to be sent by the sending
+	// goroutine, which runs while there are queued batches.
+	queue chan *webhookBatch
+
+	// l protects batch, timer and sending, and is held while a batch is being
+	// queued, so batches are queued in order.
+	l       sync.Mutex
+	batch   [][]byte
+	timer   *time.Timer
+	sending bool
+}
+
+// webhookBatch is a batch of events queued to be sent. If done is not nil,
+// the result of sending the batch is sent on it once all of the batches
+// queued before it have been sent.
+type webhookBatch struct {
+	ctx   context.Context
+	body  []byte
+	count int
+	done  chan error
+}
+
+// newWebhookSink creates a new webhook sink from the config, using defaults
+// for any optional parameters which were not specified.
+func newWebhookSink(e *Eventer, format SinkFormat, c *WebhookSinkTypeConfig) (*webhookSink, error) {
+	const op = "event.newWebhookSink"
+	if e == nil {
+		return nil, fmt.Errorf("%s: missing eventer: %w", op, ErrInvalidParameter)
+	}
+	if c == nil {
+		return nil, fmt.Errorf("%s: missing webhook config: %w", op, ErrInvalidParameter)
+	}
+	if err := c.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	s := &webhookSink{
+		eventer:       e,
+		format:        format,
+		url:           c.Url,
+		headers:       c.Headers,
+		batchSize:     c.BatchSize,
+		flushInterval: c.FlushInterval,
+		maxRetries:    stdRetryCount,
+		backoff:       expBackoff{},
+		client:        &http.Client{Timeout: c.Timeout},
+		queue:         make(chan *webhookBatch, defaultWebhookQueueSize),
+	}
+	if s.batchSize == 0 {
+		s.batchSize = defaultWebhookBatchSize
+	}
+	if s.flushInterval == 0 {
+		s.flushInterval = defaultWebhookFlushInterval
+	}
+	if s.client.Timeout == 0 {
+		s.client.Timeout = defaultWebhookTimeout
+	}
+	if c.MaxRetries != nil {
+		s.maxRetries = uint(*c.MaxRetries)
+	}
+	return s, nil
+}
+
+// Process adds the event to the current batch, sending the batch if it's
+// full.
+func (s *webhookSink) Process(_ context.Context, e *eventlogger.Event) (*eventlogger.Event, error) {
+	const op = "event.(webhookSink).Process"
+	if e == nil {
+		return nil, fmt.Errorf("%s: missing event: %w", op, ErrInvalidParameter)
+	}
+	val, ok := e.Format(string(s.format))
+	if !ok {
+		return nil, fmt.Errorf("%s: event was not formatted as %s", op, s.format)
+	}
+	entry := make([]byte, len(val), len(val)+1)
+	copy(entry, val)
+	if len(entry) == 0 || entry[len(entry)-1] != '\n' {
+		entry = append(entry, '\n')
+	}
+
+	s.l.Lock()
+	defer s.l.Unlock()
+	s.batch = append(s.batch, entry)
+	if len(s.batch) < s.batchSize {
+		if s.timer == nil {
+			s.timer = time.AfterFunc(s.flushInterval, s.flushOnTimer)
+		}
+		return nil, nil
+	}
+	// The batch includes events from other requests, so it's not sent using
+	// the context of this one. A failure to send it is logged rather than
+	// returned, since it isn't a failure to process this event.
+	s.enqueue(s.takeBatch(context.Background(), nil))
+	// Sinks are leafs, so do not return the event, since nothing more can
+	// happen to it downstream.
+	return nil, nil
+}
+
+// FlushAll sends any events waiting in a partial batch and waits until it
+// and all of the batches queued before it have been sent. An error is
+// returned if the partial batch can't be sent.
+func (s *webhookSink) FlushAll(ctx context.Context) error {
+	const op = "event.(webhookSink).FlushAll"
+	done := make(chan error, 1)
+	s.l.Lock()
+	b := s.takeBatch(ctx, done)
+	s.startSending()
+	select {
+	case s.queue <- b:
+		s.l.Unlock()
+	case <-ctx.Done():
+		s.l.Unlock()
+		return fmt.Errorf("%s: unable to queue %d events: %w", op, b.count, ctx.Err())
+	}
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("%s: %w", op, ctx.Err())
+	}
+}
+
+// Reopen does nothing for this type of Sink.
+func (s *webhookSink) Reopen() error { return nil }
+
+// Type describes the type of the node as a Sink.
+func (s *webhookSink) Type() eventlogger.NodeType {
+	return eventlogger.NodeTypeSink
+}
+
+// flushOnTimer queues the current batch when the flush interval expires.
+func (s *webhookSink) flushOnTimer() {
+	s.l.Lock()
+	defer s.l.Unlock()
+	s.enqueue(s.takeBatch(context.Background(), nil))
+}
+
+// takeBatch returns the current batch and resets it. s.l must be held by the
+// caller.
+func (s *webhookSink) takeBatch(ctx context.Context, done chan error) *webhookBatch {
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	b := &webhookBatch{
+		ctx:   ctx,
+		body:  bytes.Join(s.batch, nil),
+		count: len(s.batch),
+		done:  done,
+	}
+	s.batch = nil
+	return b
+}
+
+// enqueue queues b to be sent without blocking. The batch is dropped if the
+// queue is full. s.l must be held by the caller.
+func (s *webhookSink) enqueue(b *webhookBatch) {
+	const op = "event.(webhookSink).enqueue"
+	if b.count == 0 {
+		return
+	}
+	select {
+	case s.queue <- b:
+		s.startSending()
+	default:
+		s.eventer.logger.Error("unable to queue events for webhook, queue is full", "operation", op, "url", s.url, "dropped", b.count)
+	}
+}
+
+// startSending starts the sending goroutine if it isn't running. s.l must be
+// held by the caller.
+func (s *webhookSink) startSending() {
+	if s.sending {
+		return
+	}
+	s.sending = true
+	go s.sendQueued()
+}
+
+// sendQueued sends the queued batches in order and returns once the queue is
+// empty. There is no caller to return an error to for batches queued by
+// Process or by the flush interval, so failures to send them are logged.
+func (s *webhookSink) sendQueued() {
+	const op = "event.(webhookSink).sendQueued"
+	for {
+		var b *webhookBatch
+		select {
+		case b = <-s.queue:
+		default:
+			// Batches are only queued while s.l is held, so the queue
+			// can't be added to between checking it and clearing sending.
+			s.l.Lock()
+			if len(s.queue) == 0 {
+				s.sending = false
+				s.l.Unlock()
+				return
+			}
+			s.l.Unlock()
+			continue
+		}
+		err := s.send(b)
+		if b.done != nil {
+			b.done <- err
+			continue
+		}
+		if err != nil {
+			s.eventer.logger.Error("unable to send events to webhook", "operation", op, "url", s.url, "error", err)
+		}
+	}
+}
+
+// send sends the batch, retrying failed requests.
+func (s *webhookSink) send(b *webhookBatch) error {
+	const op = "event.(webhookSink).send"
+	if b.count == 0 {
+		return nil
+	}
+	err := s.eventer.retrySend(b.ctx, s.maxRetries, s.backoff, func() (eventlogger.Status, error) {
+		return eventlogger.Status{}, s.post(b.ctx, b.body)
+	})
+	if err != nil {
+		return fmt.Errorf("%s: dropped %d events: %w", op, b.count, err)
+	}
+	return nil
+}
+
+// post sends a single request containing body to the webhook url.
+func (s *webhookSink) post(ctx context.Context, body []byte) error {
+	const op = "event.(webhookSink).post"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("%s: unable to create request: %w", op, err)
+	}
+	switch s.format {
+	case JSONSinkFormat, JSONHclogSinkFormat:
+		req.Header.Set("Content-Type", "application/x-ndjson")
+	default:
+		req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	}
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer resp.Body.Close()
+	// drain the body so the connection can be reused
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s: unexpected status code %d", op, resp.StatusCode)
+	}
+	return nil
+}
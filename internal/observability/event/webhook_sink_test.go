@@ -0,0 +1,288 @@
+package event
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testWebhookServer records the body of each request and responds with the
+// next status in statuses, or http.StatusOK once they've been used.
+type testWebhookServer struct {
+	*httptest.Server
+
+	l        sync.Mutex
+	statuses []int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func newTestWebhookServer(t *testing.T, statuses ...int) *testWebhookServer {
+	t.Helper()
+	s := &testWebhookServer{statuses: statuses}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		s.l.Lock()
+		defer s.l.Unlock()
+		s.requests = append(s.requests, r)
+		status := http.StatusOK
+		if len(s.statuses) > 0 {
+			status, s.statuses = s.statuses[0], s.statuses[1:]
+		}
+		if status == http.StatusOK {
+			s.bodies = append(s.bodies, body)
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// delivered returns the events delivered in each successful request.
+func (s *testWebhookServer) delivered(t *testing.T) [][]map[string]interface{} {
+	t.Helper()
+	s.l.Lock()
+	defer s.l.Unlock()
+	var batches [][]map[string]interface{}
+	for _, b := range s.bodies {
+		var batch []map[string]interface{}
+		scanner := bufio.NewScanner(bytes.NewReader(b))
+		for scanner.Scan() {
+			var e map[string]interface{}
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &e))
+			batch = append(batch, e)
+		}
+		require.NoError(t, scanner.Err())
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
+func (s *testWebhookServer) requestCount() int {
+	s.l.Lock()
+	defer s.l.Unlock()
+	return len(s.requests)
+}
+
+func testWebhookEventer(t *testing.T, format SinkFormat, c *WebhookSinkTypeConfig, opt ...func(*SinkConfig)) *Eventer {
+	t.Helper()
+	testLock := &sync.Mutex{}
+	testLogger := hclog.New(&hclog.LoggerOptions{
+		Mutex: testLock,
+		Name:  "test",
+	})
+	sc := &SinkConfig{
+		Name:          "webhook",
+		Type:          WebhookSink,
+		EventTypes:    []Type{ErrorType},
+		Format:        format,
+		WebhookConfig: c,
+	}
+	for _, o := range opt {
+		o(sc)
+	}
+	e, err := NewEventer(testLogger, testLock, t.Name(), EventerConfig{Sinks: []*SinkConfig{sc}})
+	require.NoError(t, err)
+	return e
+}
+
+func testWriteError(t *testing.T, e *Eventer, op Op) {
+	t.Helper()
+	ev, err := newError(op, fmt.Errorf("%s: test error", op))
+	require.NoError(t, err)
+	require.NoError(t, e.writeError(context.Background(), ev))
+}
+
+func TestWebhookSink_Batching(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	srv := newTestWebhookServer(t)
+
+	e := testWebhookEventer(t, JSONSinkFormat, &WebhookSinkTypeConfig{
+		Url:           srv.URL,
+		Headers:       map[string]string{"Authorization": "Bearer test-token"},
+		BatchSize:     2,
+		FlushInterval: time.Hour,
+	})
+
+	testWriteError(t, e, "first")
+	assert.Equal(0, srv.requestCount())
+	testWriteError(t, e, "second")
+	testWriteError(t, e, "third")
+	// full batches are sent in the background
+	require.Eventually(func() bool { return srv.requestCount() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	// the partial batch is only sent when flushed
+	require.NoError(e.FlushNodes(context.Background()))
+	require.Equal(2, srv.requestCount())
+	require.NoError(e.FlushNodes(context.Background()))
+	require.Equal(2, srv.requestCount())
+
+	batches := srv.delivered(t)
+	require.Len(batches, 2)
+	require.Len(batches[0], 2)
+	require.Len(batches[1], 1)
+	var ops []interface{}
+	for _, b := range batches {
+		for _, ev := range b {
+			assert.Equal(string(ErrorType), ev["type"])
+			ops = append(ops, ev["data"].(map[string]interface{})["op"])
+		}
+	}
+	assert.Equal([]interface{}{"first", "second", "third"}, ops)
+
+	r := srv.requests[0]
+	assert.Equal(http.MethodPost, r.Method)
+	assert.Equal("application/x-ndjson", r.Header.Get("Content-Type"))
+	assert.Equal("Bearer test-token", r.Header.Get("Authorization"))
+}
+
+func TestWebhookSink_FlushInterval(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	srv := newTestWebhookServer(t)
+
+	e := testWebhookEventer(t, JSONHclogSinkFormat, &WebhookSinkTypeConfig{
+		Url:           srv.URL,
+		FlushInterval: 10 * time.Millisecond,
+	})
+	testWriteError(t, e, "timed")
+	require.Eventually(func() bool { return srv.requestCount() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	batches := srv.delivered(t)
+	require.Len(batches, 1)
+	require.Len(batches[0], 1)
+	assert.Equal("timed", batches[0][0]["Op"])
+}
+
+func TestWebhookSink_Filters(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	srv := newTestWebhookServer(t)
+
+	e := testWebhookEventer(t, JSONSinkFormat, &WebhookSinkTypeConfig{Url: srv.URL}, func(sc *SinkConfig) {
+		sc.DenyFilters = []string{`"/Data/Op" == "denied"`}
+	})
+	testWriteError(t, e, "denied")
+	testWriteError(t, e, "allowed")
+	require.NoError(e.FlushNodes(context.Background()))
+
+	batches := srv.delivered(t)
+	require.Len(batches, 1)
+	require.Len(batches[0], 1)
+	assert.Equal("allowed", batches[0][0]["data"].(map[string]interface{})["op"])
+}
+
+func TestWebhookSink_Retry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("retried", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		srv := newTestWebhookServer(t, http.StatusServiceUnavailable, http.StatusInternalServerError)
+
+		e := testWebhookEventer(t, JSONSinkFormat, &WebhookSinkTypeConfig{Url: srv.URL})
+		testWriteError(t, e, "retried")
+		require.NoError(e.FlushNodes(context.Background()))
+
+		assert.Equal(3, srv.requestCount())
+		batches := srv.delivered(t)
+		require.Len(batches, 1)
+		assert.Len(batches[0], 1)
+	})
+	t.Run("dropped", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		srv := newTestWebhookServer(t, http.StatusBadGateway, http.StatusBadGateway)
+
+		retries := 1
+		e := testWebhookEventer(t, JSONSinkFormat, &WebhookSinkTypeConfig{Url: srv.URL, MaxRetries: &retries})
+		testWriteError(t, e, "dropped")
+		err := e.FlushNodes(context.Background())
+		require.Error(err)
+		assert.ErrorIs(err, ErrMaxRetries)
+		assert.Contains(err.Error(), "dropped 1 events")
+		assert.Equal(2, srv.requestCount())
+
+		// the batch isn't sent again
+		require.NoError(e.FlushNodes(context.Background()))
+		assert.Equal(2, srv.requestCount())
+		assert.Empty(srv.delivered(t))
+	})
+}
+
+func TestWebhookSink_Unavailable(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+
+	// blocked is closed once the test is done so the handler returns
+	blocked := make(chan struct{})
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		<-blocked
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(srv.Close)
+	t.Cleanup(func() { close(blocked) })
+
+	retries := 0
+	e := testWebhookEventer(t, JSONSinkFormat, &WebhookSinkTypeConfig{
+		Url:        srv.URL,
+		BatchSize:  1,
+		MaxRetries: &retries,
+	})
+
+	// Writing events neither blocks on nor fails because of the endpoint,
+	// even once the queue of batches waiting to be sent is full.
+	for i := 0; i < defaultWebhookQueueSize+5; i++ {
+		testWriteError(t, e, Op(fmt.Sprintf("unavailable-%d", i)))
+	}
+	require.Eventually(func() bool { return atomic.LoadInt32(&requests) == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(e.FlushNodes(ctx), context.DeadlineExceeded)
+}
+
+func Test_newWebhookSink(t *testing.T) {
+	t.Parallel()
+	testLock := &sync.Mutex{}
+	testLogger := hclog.New(&hclog.LoggerOptions{Mutex: testLock})
+	e, err := NewEventer(testLogger, testLock, "Test_newWebhookSink", EventerConfig{})
+	require.NoError(t, err)
+
+	t.Run("defaults", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s, err := newWebhookSink(e, JSONSinkFormat, &WebhookSinkTypeConfig{Url: "https://localhost"})
+		require.NoError(err)
+		assert.Equal(defaultWebhookBatchSize, s.batchSize)
+		assert.Equal(defaultWebhookFlushInterval, s.flushInterval)
+		assert.Equal(defaultWebhookTimeout, s.client.Timeout)
+		assert.Equal(uint(stdRetryCount), s.maxRetries)
+	})
+	t.Run("missing-eventer", func(t *testing.T) {
+		_, err := newWebhookSink(nil, JSONSinkFormat, &WebhookSinkTypeConfig{Url: "https://localhost"})
+		assert.ErrorIs(t, err, ErrInvalidParameter)
+	})
+	t.Run("missing-config", func(t *testing.T) {
+		_, err := newWebhookSink(e, JSONSinkFormat, nil)
+		assert.ErrorIs(t, err, ErrInvalidParameter)
+	})
+	t.Run("invalid-config", func(t *testing.T) {
+		_, err := newWebhookSink(e, JSONSinkFormat, &WebhookSinkTypeConfig{Url: "localhost"})
+		assert.ErrorIs(t, err, ErrInvalidParameter)
+	})
+}
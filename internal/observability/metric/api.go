@@ -0,0 +1,36 @@
+package metric
+
+import (
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+)
+
+var apiRequestDuration = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "controller_api",
+		Name:      "request_duration_seconds",
+		Help:      "Histogram of the latency of requests handled by the controller API, by gRPC service, method and code.",
+		Buckets:   prometheus.DefBuckets,
+	},
+	[]string{LabelGrpcService, LabelGrpcMethod, LabelGrpcCode},
+)
+
+// ObserveApiRequest records the duration of a controller API request.
+// fullMethod is the gRPC method name in the form "/package.service/method".
+func ObserveApiRequest(fullMethod string, code codes.Code, d time.Duration) {
+	service, method := splitMethodName(fullMethod)
+	apiRequestDuration.WithLabelValues(service, method, code.String()).Observe(d.Seconds())
+}
+
+// splitMethodName splits a gRPC full method name into its service and method.
+func splitMethodName(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", "unknown"
+}
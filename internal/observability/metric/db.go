@@ -0,0 +1,37 @@
+package metric
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+var dbStats struct {
+	sync.Mutex
+	collector prometheus.Collector
+}
+
+// RegisterDbStats registers a collector for the connection pool statistics of
+// db. Any collector registered by a previous call is replaced, since only one
+// database is used by a controller.
+func RegisterDbStats(db *sql.DB) error {
+	const op = "metric.RegisterDbStats"
+	if db == nil {
+		return fmt.Errorf("%s: missing db", op)
+	}
+	dbStats.Lock()
+	defer dbStats.Unlock()
+	if dbStats.collector != nil {
+		prometheus.Unregister(dbStats.collector)
+		dbStats.collector = nil
+	}
+	c := collectors.NewDBStatsCollector(db, namespace)
+	if err := prometheus.Register(c); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	dbStats.collector = c
+	return nil
+}
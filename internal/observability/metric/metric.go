@@ -0,0 +1,49 @@
+// Package metric defines the Prometheus metrics exposed by controllers and
+// workers on listeners with the "ops" purpose. The metrics are registered
+// with the default Prometheus registry, so they are served by Handler along
+// with the standard Go runtime and process metrics.
+package metric
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	// namespace is the namespace of all of Boundary's metrics
+	namespace = "boundary"
+
+	// LabelGrpcService is the label for the gRPC service of a request
+	LabelGrpcService = "grpc_service"
+	// LabelGrpcMethod is the label for the gRPC method of a request
+	LabelGrpcMethod = "grpc_method"
+	// LabelGrpcCode is the label for the gRPC code of a response
+	LabelGrpcCode = "grpc_code"
+	// LabelJobName is the label for the name of a scheduled job
+	LabelJobName = "job_name"
+	// LabelStatus is the label for the final status of a job run
+	LabelStatus = "status"
+	// LabelProtocol is the label for the protocol of a proxied connection
+	LabelProtocol = "protocol"
+	// LabelDirection is the label for the direction of proxied bytes
+	LabelDirection = "direction"
+)
+
+func init() {
+	prometheus.MustRegister(
+		apiRequestDuration,
+		jobRunDuration,
+		jobRunFailures,
+		workerActiveSessions,
+		workerActiveConnections,
+		proxyBytes,
+	)
+}
+
+// Handler returns an http.Handler which serves all registered metrics in the
+// Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
@@ -0,0 +1,145 @@
+package metric
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func Test_splitMethodName(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		fullMethod  string
+		wantService string
+		wantMethod  string
+	}{
+		{
+			name:        "valid",
+			fullMethod:  "/controller.api.services.v1.TargetService/GetTarget",
+			wantService: "controller.api.services.v1.TargetService",
+			wantMethod:  "GetTarget",
+		},
+		{
+			name:        "no-leading-slash",
+			fullMethod:  "pkg.Service/Method",
+			wantService: "pkg.Service",
+			wantMethod:  "Method",
+		},
+		{
+			name:        "invalid",
+			fullMethod:  "invalid",
+			wantService: "unknown",
+			wantMethod:  "unknown",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			service, method := splitMethodName(tt.fullMethod)
+			assert.Equal(t, tt.wantService, service)
+			assert.Equal(t, tt.wantMethod, method)
+		})
+	}
+}
+
+func TestObserveApiRequest(t *testing.T) {
+	assert := assert.New(t)
+	ObserveApiRequest("/test.v1.MetricService/ObserveApiRequest", codes.NotFound, 10*time.Millisecond)
+	ObserveApiRequest("/test.v1.MetricService/ObserveApiRequest", codes.NotFound, 20*time.Millisecond)
+
+	body := testScrape(t)
+	assert.Contains(body, `boundary_controller_api_request_duration_seconds_count{grpc_code="NotFound",grpc_method="ObserveApiRequest",grpc_service="test.v1.MetricService"} 2`)
+}
+
+func TestObserveJobRun(t *testing.T) {
+	assert := assert.New(t)
+	ObserveJobRun("test_observe_job_run", time.Second, nil)
+	ObserveJobRun("test_observe_job_run", time.Second, errors.New("failed"))
+	ObserveJobRun("test_observe_job_run", time.Second, errors.New("failed"))
+
+	assert.Equal(float64(2), testutil.ToFloat64(jobRunFailures.WithLabelValues("test_observe_job_run")))
+	body := testScrape(t)
+	assert.Contains(body, `boundary_controller_scheduler_job_run_duration_seconds_count{job_name="test_observe_job_run",status="completed"} 1`)
+	assert.Contains(body, `boundary_controller_scheduler_job_run_duration_seconds_count{job_name="test_observe_job_run",status="failed"} 2`)
+}
+
+func TestAddProxyBytes(t *testing.T) {
+	assert := assert.New(t)
+	AddProxyBytes("test", DirectionUp, 10)
+	AddProxyBytes("test", DirectionUp, 5)
+	AddProxyBytes("test", DirectionDown, 0)
+	AddProxyBytes("test", DirectionDown, -1)
+
+	assert.Equal(float64(15), testutil.ToFloat64(proxyBytes.WithLabelValues("test", DirectionUp)))
+	assert.Equal(float64(0), testutil.ToFloat64(proxyBytes.WithLabelValues("test", DirectionDown)))
+}
+
+func TestSetWorkerSessions(t *testing.T) {
+	assert := assert.New(t)
+	SetWorkerSessions(3, 5)
+	assert.Equal(float64(3), testutil.ToFloat64(workerActiveSessions))
+	assert.Equal(float64(5), testutil.ToFloat64(workerActiveConnections))
+
+	SetWorkerSessions(0, 0)
+	assert.Equal(float64(0), testutil.ToFloat64(workerActiveSessions))
+	assert.Equal(float64(0), testutil.ToFloat64(workerActiveConnections))
+}
+
+// testConnector is a driver.Connector which never connects, allowing a sql.DB
+// to be created without a database.
+type testConnector struct{}
+
+func (testConnector) Connect(context.Context) (driver.Conn, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (c testConnector) Driver() driver.Driver { return testDriver{} }
+
+type testDriver struct{}
+
+func (testDriver) Open(string) (driver.Conn, error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestRegisterDbStats(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+
+	err := RegisterDbStats(nil)
+	require.Error(err)
+	assert.Contains(err.Error(), "missing db")
+
+	db := sql.OpenDB(testConnector{})
+	defer db.Close()
+	require.NoError(RegisterDbStats(db))
+	assert.Contains(testScrape(t), `go_sql_max_open_connections{db_name="boundary"}`)
+
+	// Registering again replaces the previous collector.
+	db2 := sql.OpenDB(testConnector{})
+	defer db2.Close()
+	require.NoError(RegisterDbStats(db2))
+	assert.Contains(testScrape(t), `go_sql_max_open_connections{db_name="boundary"}`)
+}
+
+// testScrape returns the body of a request to Handler.
+func testScrape(t *testing.T) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	b, err := ioutil.ReadAll(rec.Body)
+	require.NoError(t, err)
+	return strings.TrimSpace(string(b))
+}
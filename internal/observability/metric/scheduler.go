@@ -0,0 +1,47 @@
+package metric
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// JobRunCompleted is the status of a job run which returned no error
+	JobRunCompleted = "completed"
+	// JobRunFailed is the status of a job run which returned an error
+	JobRunFailed = "failed"
+)
+
+var (
+	jobRunDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "controller_scheduler",
+			Name:      "job_run_duration_seconds",
+			Help:      "Histogram of the duration of scheduled job runs, by job name and final status.",
+			Buckets:   []float64{0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30, 60, 300, 900, 3600},
+		},
+		[]string{LabelJobName, LabelStatus},
+	)
+	jobRunFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "controller_scheduler",
+			Name:      "job_run_failures_total",
+			Help:      "Count of scheduled job runs which failed, by job name.",
+		},
+		[]string{LabelJobName},
+	)
+)
+
+// ObserveJobRun records the duration of a job run and counts it as a failure
+// when runErr is not nil.
+func ObserveJobRun(jobName string, d time.Duration, runErr error) {
+	status := JobRunCompleted
+	if runErr != nil {
+		status = JobRunFailed
+		jobRunFailures.WithLabelValues(jobName).Inc()
+	}
+	jobRunDuration.WithLabelValues(jobName, status).Observe(d.Seconds())
+}
@@ -0,0 +1,58 @@
+package metric
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// DirectionUp is the direction of bytes sent from the client to the
+	// endpoint
+	DirectionUp = "up"
+	// DirectionDown is the direction of bytes sent from the endpoint to the
+	// client
+	DirectionDown = "down"
+)
+
+var (
+	workerActiveSessions = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "worker",
+			Name:      "active_sessions",
+			Help:      "Number of sessions the worker is tracking as of its last status report.",
+		},
+	)
+	workerActiveConnections = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "worker",
+			Name:      "active_connections",
+			Help:      "Number of connected session connections on the worker as of its last status report.",
+		},
+	)
+	proxyBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "worker_proxy",
+			Name:      "bytes_total",
+			Help:      "Count of bytes proxied by the worker, by protocol and direction. Bytes are counted when each connection closes.",
+		},
+		[]string{LabelProtocol, LabelDirection},
+	)
+)
+
+// SetWorkerSessions sets the number of sessions and connected connections on
+// the worker. It is called each time the worker reports its status.
+func SetWorkerSessions(sessions, connections int) {
+	workerActiveSessions.Set(float64(sessions))
+	workerActiveConnections.Set(float64(connections))
+}
+
+// AddProxyBytes adds n bytes proxied for protocol in direction, which is
+// either DirectionUp or DirectionDown.
+func AddProxyBytes(protocol, direction string, n int64) {
+	if n <= 0 {
+		return
+	}
+	proxyBytes.WithLabelValues(protocol, direction).Add(float64(n))
+}
@@ -15,6 +15,7 @@ construction is thus synthesizing something reasonable from a set of Grants.
 
 import (
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
@@ -81,8 +82,14 @@ func (a ACL) Allowed(r Resource, aType action.Type) (results ACLResults) {
 	if len(split) == 2 {
 		parentAction = action.Map[split[0]]
 	}
+	now := time.Now()
 	// Now, go through and check the cases indicated above
 	for _, grant := range grants {
+		if grant.expired(now) {
+			// The grant's role assignment has expired but has not been
+			// removed yet
+			continue
+		}
 		var outputFieldsOnly bool
 		switch {
 		case len(grant.actions) == 0:
@@ -183,11 +190,13 @@ func topLevelType(typ resource.Type) bool {
 		resource.CredentialStore,
 		resource.Group,
 		resource.HostCatalog,
+		resource.Plugin,
 		resource.Role,
 		resource.Scope,
 		resource.Session,
 		resource.Target,
-		resource.User:
+		resource.User,
+		resource.Worker:
 		return true
 	}
 	return false
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/intglobals"
 	"github.com/hashicorp/boundary/internal/types/action"
@@ -337,6 +338,43 @@ func Test_ACLAllowed(t *testing.T) {
 	}
 }
 
+func Test_ACLAllowedExpiration(t *testing.T) {
+	t.Parallel()
+	res := Resource{ScopeId: "o_a", Id: "a_bar"}
+	tests := []struct {
+		name           string
+		expirationTime time.Time
+		wantAuthorized bool
+	}{
+		{
+			name:           "no-expiration",
+			wantAuthorized: true,
+		},
+		{
+			name:           "not-expired",
+			expirationTime: time.Now().Add(time.Hour),
+			wantAuthorized: true,
+		},
+		{
+			name:           "expired",
+			expirationTime: time.Now().Add(-time.Second),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			grant, err := Parse("o_a", "id=a_bar;actions=read", WithExpirationTime(tt.expirationTime))
+			require.NoError(err)
+			assert.Equal(tt.expirationTime, grant.ExpirationTime())
+			assert.Equal(tt.expirationTime, grant.clone().ExpirationTime())
+
+			acl := NewACL(grant)
+			assert.Equal(tt.wantAuthorized, acl.Allowed(res, action.Read).Authorized)
+		})
+	}
+}
+
 func TestJsonMarshal(t *testing.T) {
 	res := &Resource{
 		ScopeId: "scope",
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/types/action"
@@ -18,6 +19,9 @@ type GrantTuple struct {
 	RoleId  string
 	ScopeId string
 	Grant   string
+	// ExpirationTime is the time after which the grant no longer applies. It
+	// is nil if the grant never expires.
+	ExpirationTime *time.Time
 }
 
 // Scope provides an in-memory representation of iam.Scope without the
@@ -50,6 +54,9 @@ type Grant struct {
 	// This is used as a temporary staging area before validating permissions to
 	// allow the same validation code across grant string formats
 	actionsBeingParsed []string
+
+	// The time after which the grant no longer applies, if set
+	expirationTime time.Time
 }
 
 func (g Grant) Id() string {
@@ -60,6 +67,17 @@ func (g Grant) Type() resource.Type {
 	return g.typ
 }
 
+// ExpirationTime returns the time after which the grant no longer applies. It
+// returns the zero time if the grant never expires.
+func (g Grant) ExpirationTime() time.Time {
+	return g.expirationTime
+}
+
+// expired reports whether the grant has expired as of t.
+func (g Grant) expired(t time.Time) bool {
+	return !g.expirationTime.IsZero() && !t.Before(g.expirationTime)
+}
+
 func (g Grant) Actions() (typs []action.Type, strs []string) {
 	typs = make([]action.Type, 0, len(g.actions))
 	strs = make([]string, 0, len(g.actions))
@@ -76,9 +94,10 @@ func (g Grant) Actions() (typs []action.Type, strs []string) {
 
 func (g Grant) clone() *Grant {
 	ret := &Grant{
-		scope: g.scope,
-		id:    g.id,
-		typ:   g.typ,
+		scope:          g.scope,
+		id:             g.id,
+		typ:            g.typ,
+		expirationTime: g.expirationTime,
 	}
 	if g.actionsBeingParsed != nil {
 		ret.actionsBeingParsed = append(ret.actionsBeingParsed, g.actionsBeingParsed...)
@@ -419,6 +438,10 @@ func Parse(scopeId, grantString string, opt ...Option) (Grant, error) {
 		}
 	}
 
+	// Set after the validation above, which would otherwise fail for a grant
+	// that has already expired.
+	grant.expirationTime = opts.withExpirationTime
+
 	return grant, nil
 }
 
@@ -429,7 +452,7 @@ func Parse(scopeId, grantString string, opt ...Option) (Grant, error) {
 func (g Grant) validateType() error {
 	const op = "perms.(Grant).validateType"
 	switch g.typ {
-	case resource.Controller, resource.Worker:
+	case resource.Controller:
 		return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("unknown type specifier %q", g.typ))
 	}
 	return nil
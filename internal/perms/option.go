@@ -1,5 +1,7 @@
 package perms
 
+import "time"
+
 // getOpts - iterate the inbound Options and return a struct
 func getOpts(opt ...Option) options {
 	opts := getDefaultOptions()
@@ -17,6 +19,7 @@ type options struct {
 	withUserId              string
 	withAccountId           string
 	withSkipFinalValidation bool
+	withExpirationTime      time.Time
 }
 
 func getDefaultOptions() options {
@@ -45,3 +48,11 @@ func WithSkipFinalValidation(skipFinalValidation bool) Option {
 		o.withSkipFinalValidation = skipFinalValidation
 	}
 }
+
+// WithExpirationTime provides the time after which the parsed grant no longer
+// applies. A zero time never expires.
+func WithExpirationTime(t time.Time) Option {
+	return func(o *options) {
+		o.withExpirationTime = t
+	}
+}
@@ -66,3 +66,33 @@ func newPluginMetadata(p *Plugin, op oplog.OpType) oplog.Metadata {
 	}
 	return metadata
 }
+
+// Info contains information about a host plugin loaded by a controller which
+// is not stored in the database. It can differ between controllers, for
+// instance while they are being upgraded.
+type Info struct {
+	// Version is the version of the loaded plugin.
+	Version string
+
+	// CatalogAttributes are the names of the attributes supported by host
+	// catalogs using the plugin.
+	CatalogAttributes []string
+}
+
+// GetVersion returns the version of the loaded plugin or an empty string if
+// i is nil.
+func (i *Info) GetVersion() string {
+	if i == nil {
+		return ""
+	}
+	return i.Version
+}
+
+// GetCatalogAttributes returns the names of the catalog attributes supported
+// by the loaded plugin or nil if i is nil.
+func (i *Info) GetCatalogAttributes() []string {
+	if i == nil {
+		return nil
+	}
+	return i.CatalogAttributes
+}
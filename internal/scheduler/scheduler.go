@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/observability/metric"
 	"github.com/hashicorp/boundary/internal/scheduler/job"
 	ua "go.uber.org/atomic"
 )
@@ -229,7 +230,9 @@ func (s *Scheduler) runJob(ctx context.Context, wg *sync.WaitGroup, r *job.Run)
 	go func() {
 		defer rj.cancelCtx()
 		defer wg.Done()
+		start := time.Now()
 		runErr := j.Run(jobContext)
+		metric.ObserveJobRun(j.Name(), time.Since(start), runErr)
 
 		// Get final status report to update run progress with
 		status := j.Status()
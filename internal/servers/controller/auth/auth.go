@@ -304,6 +304,53 @@ func Verify(ctx context.Context, opt ...Option) (ret VerifyResults) {
 	return
 }
 
+// DecryptToken decrypts the encrypted part of an auth token with the tokens
+// key of the scope the auth token was issued in and returns the token value
+// which ValidateToken expects.
+func DecryptToken(ctx context.Context, kmsCache *kms.Kms, scopeId, publicId, encryptedToken string) (string, error) {
+	const op = "auth.DecryptToken"
+	if publicId == "" {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	if len(encryptedToken) < len(globals.ServiceTokenV1) {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "encrypted token is too short")
+	}
+	tokenWrapper, err := kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeTokens)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op, errors.WithMsg("unable to get wrapper for tokens"))
+	}
+
+	version := encryptedToken[0:len(globals.ServiceTokenV1)]
+	switch version {
+	case globals.ServiceTokenV1:
+	default:
+		return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown token encryption version %q", version))
+	}
+	marshaledToken, err := base58.FastBase58Decoding(encryptedToken[len(globals.ServiceTokenV1):])
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op, errors.WithMsg("error unmarshaling base58 token"))
+	}
+
+	blobInfo := new(wrapping.EncryptedBlobInfo)
+	if err := proto.Unmarshal(marshaledToken, blobInfo); err != nil {
+		return "", errors.Wrap(ctx, err, op, errors.WithMsg("error decoding encrypted token"))
+	}
+
+	s1Bytes, err := tokenWrapper.Decrypt(ctx, blobInfo, []byte(publicId))
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt), errors.WithMsg("error decrypting encrypted token"))
+	}
+
+	var s1Info tokens.S1TokenInfo
+	if err := proto.Unmarshal(s1Bytes, &s1Info); err != nil {
+		return "", errors.Wrap(ctx, err, op, errors.WithMsg("error unmarshaling token info"))
+	}
+	if s1Info.Token == "" {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "after parsing, could not find valid token")
+	}
+	return s1Info.Token, nil
+}
+
 func (v *verifier) decryptToken(ctx context.Context) {
 	const op = "auth.(verifier).decryptToken"
 	switch v.requestInfo.TokenFormat {
@@ -337,56 +384,14 @@ func (v *verifier) decryptToken(ctx context.Context) {
 			return
 		}
 
-		tokenWrapper, err := v.kms.GetWrapper(v.ctx, at.GetScopeId(), kms.KeyPurposeTokens)
-		if err != nil {
-			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to get wrapper for tokens; continuing as anonymous user"))
-			v.requestInfo.TokenFormat = uint32(AuthTokenTypeUnknown)
-			return
-		}
-
-		version := v.requestInfo.EncryptedToken[0:len(globals.ServiceTokenV1)]
-		switch version {
-		case globals.ServiceTokenV1:
-		default:
-			event.WriteError(ctx, op, stderrors.New("unknown token encryption version; continuing as anonymous user"), event.WithInfo("version", version))
-			v.requestInfo.TokenFormat = uint32(AuthTokenTypeUnknown)
-			return
-		}
-		marshaledToken, err := base58.FastBase58Decoding(v.requestInfo.EncryptedToken[len(globals.ServiceTokenV1):])
-		if err != nil {
-			event.WriteError(ctx, op, err, event.WithInfoMsg("error unmarshaling base58 token; continuing as anonymous user"))
-			v.requestInfo.TokenFormat = uint32(AuthTokenTypeUnknown)
-			return
-		}
-
-		blobInfo := new(wrapping.EncryptedBlobInfo)
-		if err := proto.Unmarshal(marshaledToken, blobInfo); err != nil {
-			event.WriteError(ctx, op, err, event.WithInfoMsg("error decoding encrypted token; continuing as anonymous user"))
-			v.requestInfo.TokenFormat = uint32(AuthTokenTypeUnknown)
-			return
-		}
-
-		s1Bytes, err := tokenWrapper.Decrypt(v.ctx, blobInfo, []byte(v.requestInfo.PublicId))
+		token, err := DecryptToken(v.ctx, v.kms, at.GetScopeId(), v.requestInfo.PublicId, v.requestInfo.EncryptedToken)
 		if err != nil {
-			event.WriteError(ctx, op, err, event.WithInfoMsg("error decrypting encrypted token; continuing as anonymous user"))
-			v.requestInfo.TokenFormat = uint32(AuthTokenTypeUnknown)
-			return
-		}
-
-		var s1Info tokens.S1TokenInfo
-		if err := proto.Unmarshal(s1Bytes, &s1Info); err != nil {
-			event.WriteError(ctx, op, err, event.WithInfoMsg("error unmarshaling token info; continuing as anonymous user"))
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error decrypting token; continuing as anonymous user"))
 			v.requestInfo.TokenFormat = uint32(AuthTokenTypeUnknown)
 			return
 		}
 
-		if v.requestInfo.TokenFormat == uint32(AuthTokenTypeUnknown) || s1Info.Token == "" || v.requestInfo.PublicId == "" {
-			event.WriteError(ctx, op, stderrors.New("after parsing, could not find valid token; continuing as anonymous user"))
-			v.requestInfo.TokenFormat = uint32(AuthTokenTypeUnknown)
-			return
-		}
-
-		v.requestInfo.Token = s1Info.Token
+		v.requestInfo.Token = token
 		return
 
 	case uint32(AuthTokenTypeRecoveryKms):
@@ -553,12 +558,17 @@ func (v verifier) performAuthCheck(ctx context.Context) (
 	// that we've since restricted, e.g. "id=foo;actions=create,read". These
 	// will simply not have an effect.
 	for _, pair := range grantTuples {
+		var expirationTime time.Time
+		if pair.ExpirationTime != nil {
+			expirationTime = *pair.ExpirationTime
+		}
 		parsed, err := perms.Parse(
 			pair.ScopeId,
 			pair.Grant,
 			perms.WithUserId(userId),
 			perms.WithAccountId(accountId),
-			perms.WithSkipFinalValidation(true))
+			perms.WithSkipFinalValidation(true),
+			perms.WithExpirationTime(expirationTime))
 		if err != nil {
 			retErr = errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed to parse grant %#v", pair.Grant)))
 			return
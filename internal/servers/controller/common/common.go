@@ -1,8 +1,10 @@
 package common
 
 import (
+	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
+	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	pluginhost "github.com/hashicorp/boundary/internal/host/plugin"
 	"github.com/hashicorp/boundary/internal/host/static"
@@ -14,15 +16,17 @@ import (
 )
 
 type (
-	AuthTokenRepoFactory       = oidc.AuthTokenRepoFactory
-	VaultCredentialRepoFactory = func() (*vault.Repository, error)
-	IamRepoFactory             func() (*iam.Repository, error)
-	OidcAuthRepoFactory        = oidc.OidcRepoFactory
-	PasswordAuthRepoFactory    func() (*password.Repository, error)
-	ServersRepoFactory         func() (*servers.Repository, error)
-	StaticRepoFactory          func() (*static.Repository, error)
-	PluginHostRepoFactory      func() (*pluginhost.Repository, error)
-	HostPluginRepoFactory      func() (*hostplugin.Repository, error)
-	SessionRepoFactory         func() (*session.Repository, error)
-	TargetRepoFactory          func() (*target.Repository, error)
+	AuthTokenRepoFactory        = oidc.AuthTokenRepoFactory
+	VaultCredentialRepoFactory  = func() (*vault.Repository, error)
+	StaticCredentialRepoFactory = func() (*credstatic.Repository, error)
+	IamRepoFactory              func() (*iam.Repository, error)
+	LdapAuthRepoFactory         func() (*ldap.Repository, error)
+	OidcAuthRepoFactory         = oidc.OidcRepoFactory
+	PasswordAuthRepoFactory     func() (*password.Repository, error)
+	ServersRepoFactory          func() (*servers.Repository, error)
+	StaticRepoFactory           func() (*static.Repository, error)
+	PluginHostRepoFactory       func() (*pluginhost.Repository, error)
+	HostPluginRepoFactory       func() (*hostplugin.Repository, error)
+	SessionRepoFactory          func() (*session.Repository, error)
+	TargetRepoFactory           func() (*target.Repository, error)
 )
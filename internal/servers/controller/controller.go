@@ -4,16 +4,19 @@ import (
 	"context"
 	"crypto/rand"
 	"fmt"
+	"os"
 	"strings"
 	"sync"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 
+	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/boundary/internal/cmd/config"
+	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/db"
 	pluginhost "github.com/hashicorp/boundary/internal/host/plugin"
@@ -21,6 +24,7 @@ import (
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/observability/metric"
 	"github.com/hashicorp/boundary/internal/plugin/host"
 	hostplugin "github.com/hashicorp/boundary/internal/plugin/host"
 	"github.com/hashicorp/boundary/internal/scheduler"
@@ -28,17 +32,45 @@ import (
 	"github.com/hashicorp/boundary/internal/servers"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
 	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/session/recording"
 	"github.com/hashicorp/boundary/internal/target"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	host_plugin_assets "github.com/hashicorp/boundary/plugins/host"
 	"github.com/hashicorp/boundary/sdk/pbs/plugin"
 	external_host_plugins "github.com/hashicorp/boundary/sdk/plugins/host"
+	"github.com/hashicorp/boundary/version"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-secure-stdlib/mlock"
 	ua "go.uber.org/atomic"
 	"google.golang.org/grpc"
 )
 
+// builtinHostPluginCatalogAttributes contains the host catalog attributes
+// supported by the host plugins bundled with Boundary.
+var builtinHostPluginCatalogAttributes = map[base.EnabledPlugin][]string{
+	base.EnabledPluginHostAws:   {"disable_credential_rotation", "region"},
+	base.EnabledPluginHostAzure: {"client_id", "disable_credential_rotation", "subscription_id", "tenant_id"},
+	base.EnabledPluginHostFile:  {"path"},
+	base.EnabledPluginHostDns:   {"records", "server"},
+}
+
+// isBuiltinHostPlugin returns whether name is the name of a host plugin
+// bundled with Boundary.
+func isBuiltinHostPlugin(name string) bool {
+	for _, p := range []base.EnabledPlugin{
+		base.EnabledPluginHostLoopback,
+		base.EnabledPluginHostAws,
+		base.EnabledPluginHostAzure,
+		base.EnabledPluginHostFile,
+		base.EnabledPluginHostDns,
+	} {
+		if strings.ToLower(p.String()) == name {
+			return true
+		}
+	}
+	return false
+}
+
 type Controller struct {
 	conf   *Config
 	logger hclog.Logger
@@ -52,6 +84,10 @@ type Controller struct {
 
 	workerAuthCache *sync.Map
 
+	// reverseTunnels holds the tunnels reverse workers keep open to this
+	// controller
+	reverseTunnels *reverseTunnels
+
 	// Used for testing and tracking worker health
 	workerStatusUpdateTimes *sync.Map
 
@@ -62,22 +98,28 @@ type Controller struct {
 	gatewayMux      *runtime.ServeMux
 
 	// Repo factory methods
-	AuthTokenRepoFn       common.AuthTokenRepoFactory
-	VaultCredentialRepoFn common.VaultCredentialRepoFactory
-	IamRepoFn             common.IamRepoFactory
-	OidcRepoFn            common.OidcAuthRepoFactory
-	PasswordAuthRepoFn    common.PasswordAuthRepoFactory
-	ServersRepoFn         common.ServersRepoFactory
-	SessionRepoFn         common.SessionRepoFactory
-	StaticHostRepoFn      common.StaticRepoFactory
-	PluginHostRepoFn      common.PluginHostRepoFactory
-	HostPluginRepoFn      common.HostPluginRepoFactory
-	TargetRepoFn          common.TargetRepoFactory
+	AuthTokenRepoFn        common.AuthTokenRepoFactory
+	VaultCredentialRepoFn  common.VaultCredentialRepoFactory
+	StaticCredentialRepoFn common.StaticCredentialRepoFactory
+	IamRepoFn              common.IamRepoFactory
+	LdapRepoFn             common.LdapAuthRepoFactory
+	OidcRepoFn             common.OidcAuthRepoFactory
+	PasswordAuthRepoFn     common.PasswordAuthRepoFactory
+	ServersRepoFn          common.ServersRepoFactory
+	SessionRepoFn          common.SessionRepoFactory
+	StaticHostRepoFn       common.StaticRepoFactory
+	PluginHostRepoFn       common.PluginHostRepoFactory
+	HostPluginRepoFn       common.HostPluginRepoFactory
+	TargetRepoFn           common.TargetRepoFactory
 
 	scheduler *scheduler.Scheduler
 
 	kms *kms.Kms
 
+	// recordingStorage is where session recordings are read from, if session
+	// recording is configured
+	recordingStorage recording.Storage
+
 	enabledPlugins []base.EnabledPlugin
 }
 
@@ -89,6 +131,7 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 		tickerWg:                new(sync.WaitGroup),
 		schedulerWg:             new(sync.WaitGroup),
 		workerAuthCache:         new(sync.Map),
+		reverseTunnels:          newReverseTunnels(),
 		workerStatusUpdateTimes: new(sync.Map),
 		enabledPlugins:          conf.Server.EnabledPlugins,
 	}
@@ -108,6 +151,12 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 		return nil, fmt.Errorf("error auto-generating controller name: %w", err)
 	}
 
+	if rec := conf.RawConfig.Controller.SessionRecording; rec != nil {
+		if c.recordingStorage, err = recording.NewStorage(ctx, recording.StorageType(rec.StorageType), rec.StoragePath); err != nil {
+			return nil, fmt.Errorf("error creating session recording storage: %w", err)
+		}
+	}
+
 	if !conf.RawConfig.DisableMlock {
 		// Ensure our memory usage is locked into physical RAM
 		if err := mlock.LockMemory(); err != nil {
@@ -124,6 +173,9 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 		}
 	}
 
+	if conf.HostPluginInfo == nil {
+		conf.HostPluginInfo = make(map[string]*hostplugin.Info)
+	}
 	for _, enabledPlugin := range c.enabledPlugins {
 		switch enabledPlugin {
 		case base.EnabledPluginHostLoopback:
@@ -132,9 +184,29 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 				hostplugin.WithDescription("Provides an initial loopback host plugin in Boundary"),
 				hostplugin.WithPublicId(conf.DevLoopbackHostPluginId),
 			}
-			if _, err = conf.RegisterHostPlugin(ctx, "loopback", plg, opts...); err != nil {
+			p, err := conf.RegisterHostPlugin(ctx, "loopback", plg, opts...)
+			if err != nil {
 				return nil, err
 			}
+			conf.HostPluginInfo[p.GetPublicId()] = &hostplugin.Info{Version: version.Get().VersionNumber()}
+		case base.EnabledPluginHostFile, base.EnabledPluginHostDns:
+			// These plugins run in-process
+			var srv plugin.HostPluginServiceServer
+			switch enabledPlugin {
+			case base.EnabledPluginHostFile:
+				srv = pluginhost.NewFilePlugin(conf.RawConfig.Controller.HostInventoryDir)
+			case base.EnabledPluginHostDns:
+				srv = pluginhost.NewDnsPlugin()
+			}
+			pluginType := strings.ToLower(enabledPlugin.String())
+			p, err := conf.RegisterHostPlugin(ctx, pluginType, pluginhost.NewWrappingPluginClient(srv), hostplugin.WithDescription(fmt.Sprintf("Built-in %s host plugin", enabledPlugin.String())))
+			if err != nil {
+				return nil, fmt.Errorf("error registering %s host plugin: %w", pluginType, err)
+			}
+			conf.HostPluginInfo[p.GetPublicId()] = &hostplugin.Info{
+				Version:           version.Get().VersionNumber(),
+				CatalogAttributes: builtinHostPluginCatalogAttributes[enabledPlugin],
+			}
 		case base.EnabledPluginHostAzure, base.EnabledPluginHostAws:
 			pluginType := strings.ToLower(enabledPlugin.String())
 			client, cleanup, err := external_host_plugins.CreateHostPlugin(
@@ -147,9 +219,41 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 				return nil, fmt.Errorf("error creating %s host plugin: %w", pluginType, err)
 			}
 			conf.ShutdownFuncs = append(conf.ShutdownFuncs, cleanup)
-			if _, err := conf.RegisterHostPlugin(ctx, pluginType, client, hostplugin.WithDescription(fmt.Sprintf("Built-in %s host plugin", enabledPlugin.String()))); err != nil {
+			p, err := conf.RegisterHostPlugin(ctx, pluginType, client, hostplugin.WithDescription(fmt.Sprintf("Built-in %s host plugin", enabledPlugin.String())))
+			if err != nil {
 				return nil, fmt.Errorf("error registering %s host plugin: %w", pluginType, err)
 			}
+			conf.HostPluginInfo[p.GetPublicId()] = &hostplugin.Info{
+				Version:           version.Get().VersionNumber(),
+				CatalogAttributes: builtinHostPluginCatalogAttributes[enabledPlugin],
+			}
+		}
+	}
+
+	// Load the external host plugins from the plugin execution directory,
+	// verifying their checksum before executing them.
+	for _, hp := range conf.RawConfig.Plugins.Host {
+		if isBuiltinHostPlugin(hp.Name) {
+			return nil, fmt.Errorf("external host plugin %q conflicts with a built-in host plugin", hp.Name)
+		}
+		client, cleanup, err := external_host_plugins.CreateHostPlugin(
+			ctx,
+			hp.Name,
+			external_host_plugins.WithHostPluginsFilesystem("boundary-plugin-host-", os.DirFS(conf.RawConfig.Plugins.ExecutionDir)),
+			external_host_plugins.WithHostPluginExecutionDir(conf.RawConfig.Plugins.ExecutionDir),
+			external_host_plugins.WithHostPluginChecksum(hp.Sha256Sum),
+			external_host_plugins.WithLogger(hclog.NewNullLogger()))
+		if err != nil {
+			return nil, fmt.Errorf("error creating external %s host plugin: %w", hp.Name, err)
+		}
+		conf.ShutdownFuncs = append(conf.ShutdownFuncs, cleanup)
+		p, err := conf.RegisterHostPlugin(ctx, hp.Name, client, hostplugin.WithDescription(fmt.Sprintf("External %s host plugin", hp.Name)))
+		if err != nil {
+			return nil, fmt.Errorf("error registering external %s host plugin: %w", hp.Name, err)
+		}
+		conf.HostPluginInfo[p.GetPublicId()] = &hostplugin.Info{
+			Version:           hp.Version,
+			CatalogAttributes: hp.CatalogAttributes,
 		}
 	}
 
@@ -159,6 +263,13 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 
 	// Set up repo stuff
 	dbase := db.New(c.conf.Database)
+	underlyingDB, err := c.conf.Database.SqlDB(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting underlying database: %w", err)
+	}
+	if err := metric.RegisterDbStats(underlyingDB); err != nil {
+		return nil, fmt.Errorf("error registering database metrics: %w", err)
+	}
 	kmsRepo, err := kms.NewRepository(dbase, dbase)
 	if err != nil {
 		return nil, fmt.Errorf("error creating kms repository: %w", err)
@@ -221,9 +332,15 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 	c.VaultCredentialRepoFn = func() (*vault.Repository, error) {
 		return vault.NewRepository(dbase, dbase, c.kms, c.scheduler)
 	}
+	c.StaticCredentialRepoFn = func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(dbase, dbase, c.kms)
+	}
 	c.ServersRepoFn = func() (*servers.Repository, error) {
 		return servers.NewRepository(dbase, dbase, c.kms)
 	}
+	c.LdapRepoFn = func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, dbase, dbase, c.kms)
+	}
 	c.OidcRepoFn = func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, dbase, dbase, c.kms)
 	}
@@ -257,7 +374,7 @@ func (c *Controller) Start() error {
 		return fmt.Errorf("error starting controller listeners: %w", err)
 	}
 
-	c.tickerWg.Add(5)
+	c.tickerWg.Add(6)
 	go func() {
 		defer c.tickerWg.Done()
 		c.startStatusTicking(c.baseContext)
@@ -274,6 +391,10 @@ func (c *Controller) Start() error {
 		defer c.tickerWg.Done()
 		c.startCloseExpiredPendingTokens(c.baseContext)
 	}()
+	go func() {
+		defer c.tickerWg.Done()
+		c.startKmsCacheRefreshTicking(c.baseContext)
+	}()
 	go func() {
 		defer c.tickerWg.Done()
 		c.started.Store(true)
@@ -294,6 +415,15 @@ func (c *Controller) registerJobs() error {
 	if err := c.registerSessionCleanupJob(); err != nil {
 		return err
 	}
+	if err := c.registerPrincipalRoleExpirationJob(); err != nil {
+		return err
+	}
+	if err := c.registerKmsRewrapJob(); err != nil {
+		return err
+	}
+	if err := c.registerOidcRefreshTokenCheckJob(); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -312,6 +442,48 @@ func (c *Controller) registerSessionCleanupJob() error {
 	return nil
 }
 
+// registerPrincipalRoleExpirationJob is a helper method to abstract
+// registering the principal role expiration job specifically.
+func (c *Controller) registerPrincipalRoleExpirationJob() error {
+	principalRoleExpirationJob, err := newPrincipalRoleExpirationJob(c.IamRepoFn)
+	if err != nil {
+		return fmt.Errorf("error creating principal role expiration job: %w", err)
+	}
+	if err = c.scheduler.RegisterJob(c.baseContext, principalRoleExpirationJob); err != nil {
+		return fmt.Errorf("error registering principal role expiration job: %w", err)
+	}
+
+	return nil
+}
+
+// registerKmsRewrapJob is a helper method to abstract registering the kms
+// rewrap job specifically.
+func (c *Controller) registerKmsRewrapJob() error {
+	kmsRewrapJob, err := newKmsRewrapJob(c.kms, c.SessionRepoFn, c.VaultCredentialRepoFn, c.OidcRepoFn)
+	if err != nil {
+		return fmt.Errorf("error creating kms rewrap job: %w", err)
+	}
+	if err = c.scheduler.RegisterJob(c.baseContext, kmsRewrapJob); err != nil {
+		return fmt.Errorf("error registering kms rewrap job: %w", err)
+	}
+
+	return nil
+}
+
+// registerOidcRefreshTokenCheckJob is a helper method to abstract registering
+// the oidc refresh token check job specifically.
+func (c *Controller) registerOidcRefreshTokenCheckJob() error {
+	checkJob, err := newOidcRefreshTokenCheckJob(c.OidcRepoFn, c.AuthTokenRepoFn, c.conf.RawConfig.Controller.AuthTokenTimeToStaleDuration)
+	if err != nil {
+		return fmt.Errorf("error creating oidc refresh token check job: %w", err)
+	}
+	if err = c.scheduler.RegisterJob(c.baseContext, checkJob); err != nil {
+		return fmt.Errorf("error registering oidc refresh token check job: %w", err)
+	}
+
+	return nil
+}
+
 func (c *Controller) Shutdown(serversOnly bool) error {
 	const op = "controller.(Controller).Shutdown"
 	if !c.started.Load() {
@@ -72,6 +72,7 @@ func newGatewayServer(
 		grpc.MaxSendMsgSize(math.MaxInt32),
 		grpc.UnaryInterceptor(
 			grpc_middleware.ChainUnaryServer(
+				metricsInterceptor(ctx),       // record the latency and final code of the request
 				requestCtxInterceptor,         // populated requestInfo from headers into the request ctx
 				auditRequestInterceptor(ctx),  // before we get started, audit the request
 				errorInterceptor(ctx),         // convert domain and api errors into headers for the http proxy
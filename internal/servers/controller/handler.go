@@ -30,11 +30,14 @@ import (
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/host_sets"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/hosts"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/managed_groups"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/plugins"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/roles"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/scim"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/scopes"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/sessions"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/targets"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/users"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/workers"
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/go-secure-stdlib/listenerutil"
 	"github.com/hashicorp/go-secure-stdlib/strutil"
@@ -63,6 +66,14 @@ func (c *Controller) handler(props HandlerProperties) (http.Handler, error) {
 	mux.Handle("/v1/", h)
 	mux.Handle("/", handleUi(c))
 
+	if c.conf.RawConfig.Controller != nil && len(c.conf.RawConfig.Controller.Scim) > 0 {
+		sh, err := handleScim(c, props)
+		if err != nil {
+			return nil, err
+		}
+		mux.Handle(scim.BasePath, sh)
+	}
+
 	corsWrappedHandler := wrapHandlerWithCors(mux, props)
 	commonWrappedHandler := wrapHandlerWithCommonFuncs(corsWrappedHandler, c, props)
 	callbackInterceptingHandler := wrapHandlerWithCallbackInterceptor(commonWrappedHandler, c)
@@ -113,7 +124,7 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 		}
 	}
 	if _, ok := currentServices[services.AccountService_ServiceDesc.ServiceName]; !ok {
-		accts, err := accounts.NewService(c.PasswordAuthRepoFn, c.OidcRepoFn)
+		accts, err := accounts.NewService(c.PasswordAuthRepoFn, c.OidcRepoFn, c.LdapRepoFn)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create account handler service: %w", err)
 		}
@@ -123,7 +134,7 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 		}
 	}
 	if _, ok := currentServices[services.AuthMethodService_ServiceDesc.ServiceName]; !ok {
-		authMethods, err := authmethods.NewService(c.kms, c.PasswordAuthRepoFn, c.OidcRepoFn, c.IamRepoFn, c.AuthTokenRepoFn)
+		authMethods, err := authmethods.NewService(c.kms, c.PasswordAuthRepoFn, c.OidcRepoFn, c.LdapRepoFn, c.IamRepoFn, c.AuthTokenRepoFn)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create auth method handler service: %w", err)
 		}
@@ -133,7 +144,7 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 		}
 	}
 	if _, ok := currentServices[services.AuthTokenService_ServiceDesc.ServiceName]; !ok {
-		authtoks, err := authtokens.NewService(c.AuthTokenRepoFn, c.IamRepoFn)
+		authtoks, err := authtokens.NewService(c.kms, c.AuthTokenRepoFn, c.IamRepoFn)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create auth token handler service: %w", err)
 		}
@@ -143,7 +154,7 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 		}
 	}
 	if _, ok := currentServices[services.ScopeService_ServiceDesc.ServiceName]; !ok {
-		os, err := scopes.NewService(c.IamRepoFn)
+		os, err := scopes.NewService(c.IamRepoFn, c.kms)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create scope handler service: %w", err)
 		}
@@ -172,7 +183,8 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 			c.SessionRepoFn,
 			c.PluginHostRepoFn,
 			c.StaticHostRepoFn,
-			c.VaultCredentialRepoFn)
+			c.VaultCredentialRepoFn,
+			c.StaticCredentialRepoFn)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create target handler service: %w", err)
 		}
@@ -202,7 +214,7 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 		}
 	}
 	if _, ok := currentServices[services.SessionService_ServiceDesc.ServiceName]; !ok {
-		ss, err := sessions.NewService(c.SessionRepoFn, c.IamRepoFn)
+		ss, err := sessions.NewService(c.SessionRepoFn, c.IamRepoFn, handlers.WithRecordingStorage(c.recordingStorage))
 		if err != nil {
 			return nil, fmt.Errorf("failed to create session handler service: %w", err)
 		}
@@ -212,7 +224,7 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 		}
 	}
 	if _, ok := currentServices[services.ManagedGroupService_ServiceDesc.ServiceName]; !ok {
-		mgs, err := managed_groups.NewService(c.OidcRepoFn)
+		mgs, err := managed_groups.NewService(c.OidcRepoFn, c.LdapRepoFn)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create managed groups handler service: %w", err)
 		}
@@ -222,7 +234,7 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 		}
 	}
 	if _, ok := currentServices[services.CredentialStoreService_ServiceDesc.ServiceName]; !ok {
-		cs, err := credentialstores.NewService(c.VaultCredentialRepoFn, c.IamRepoFn)
+		cs, err := credentialstores.NewService(c.VaultCredentialRepoFn, c.StaticCredentialRepoFn, c.IamRepoFn)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create credential store handler service: %w", err)
 		}
@@ -232,7 +244,7 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 		}
 	}
 	if _, ok := currentServices[services.CredentialLibraryService_ServiceDesc.ServiceName]; !ok {
-		cl, err := credentiallibraries.NewService(c.VaultCredentialRepoFn, c.IamRepoFn)
+		cl, err := credentiallibraries.NewService(c.VaultCredentialRepoFn, c.StaticCredentialRepoFn, c.IamRepoFn)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create credential library handler service: %w", err)
 		}
@@ -241,10 +253,41 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 			return nil, fmt.Errorf("failed to register credential library service handler: %w", err)
 		}
 	}
+	if _, ok := currentServices[services.WorkerService_ServiceDesc.ServiceName]; !ok {
+		ws, err := workers.NewService(c.ServersRepoFn)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create worker handler service: %w", err)
+		}
+		services.RegisterWorkerServiceServer(c.gatewayServer, ws)
+		if err := services.RegisterWorkerServiceHandlerFromEndpoint(ctx, c.gatewayMux, gatewayTarget, dialOptions); err != nil {
+			return nil, fmt.Errorf("failed to register worker service handler: %w", err)
+		}
+	}
+	if _, ok := currentServices[services.PluginService_ServiceDesc.ServiceName]; !ok {
+		ps, err := plugins.NewService(c.HostPluginRepoFn, c.conf.HostPluginInfo)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create plugin handler service: %w", err)
+		}
+		services.RegisterPluginServiceServer(c.gatewayServer, ps)
+		if err := services.RegisterPluginServiceHandlerFromEndpoint(ctx, c.gatewayMux, gatewayTarget, dialOptions); err != nil {
+			return nil, fmt.Errorf("failed to register plugin service handler: %w", err)
+		}
+	}
 
 	return c.gatewayMux, nil
 }
 
+// handleScim returns the handler of the SCIM provisioning endpoint, which
+// authenticates requests with the tokens from the controller configuration
+// rather than auth tokens.
+func handleScim(c *Controller, props HandlerProperties) (http.Handler, error) {
+	var tokens []scim.Token
+	for _, sc := range c.conf.RawConfig.Controller.Scim {
+		tokens = append(tokens, scim.Token{ScopeId: sc.ScopeId, Token: sc.Token})
+	}
+	return scim.NewHandler(props.CancelCtx, c.IamRepoFn, tokens...)
+}
+
 func wrapHandlerWithCommonFuncs(h http.Handler, c *Controller, props HandlerProperties) http.Handler {
 	const op = "controller.wrapHandlerWithCommonFuncs"
 	var maxRequestDuration time.Duration
@@ -286,7 +329,11 @@ func wrapHandlerWithCommonFuncs(h http.Handler, c *Controller, props HandlerProp
 			DisableAuthzFailures: disableAuthzFailures,
 		}
 
-		requestInfo.PublicId, requestInfo.EncryptedToken, requestInfo.TokenFormat = auth.GetTokenFromRequest(ctx, c.kms, r)
+		// SCIM requests carry their own bearer tokens, which are not auth
+		// tokens
+		if !strings.HasPrefix(r.URL.Path, scim.BasePath) {
+			requestInfo.PublicId, requestInfo.EncryptedToken, requestInfo.TokenFormat = auth.GetTokenFromRequest(ctx, c.kms, r)
+		}
 
 		if info, ok := event.RequestInfoFromContext(ctx); ok {
 			// piggyback some eventing fields with the auth info proto message
@@ -8,11 +8,15 @@ import (
 	"io/ioutil"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/scim"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -91,6 +95,45 @@ func TestAuthenticationHandler(t *testing.T) {
 	assert.Truef(t, strings.HasPrefix(tok, pubId), "Token: %q, Id: %q", tok, pubId)
 }
 
+func TestHandleScim(t *testing.T) {
+	t.Setenv("BOUNDARY_TEST_SCIM_TOKEN", "org-token")
+	rawConfig, err := config.Parse(`
+	controller {
+		name = "controller"
+		scim {
+			scope_id = "o_1234567890"
+			token = "env://BOUNDARY_TEST_SCIM_TOKEN"
+		}
+		scim {
+			scope_id = "global"
+			token = "global-token"
+		}
+	}
+	`)
+	require.NoError(t, err)
+	c := &Controller{
+		conf: &Config{RawConfig: rawConfig},
+		IamRepoFn: func() (*iam.Repository, error) {
+			return nil, fmt.Errorf("not used")
+		},
+	}
+	h, err := handleScim(c, HandlerProperties{CancelCtx: context.Background()})
+	require.NoError(t, err)
+
+	for token, wantStatus := range map[string]int{
+		"org-token":                      http.StatusOK,
+		"global-token":                   http.StatusOK,
+		"env://BOUNDARY_TEST_SCIM_TOKEN": http.StatusUnauthorized,
+		"":                               http.StatusUnauthorized,
+	} {
+		req := httptest.NewRequest(http.MethodGet, scim.BasePath+"ServiceProviderConfig", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		assert.Equal(t, wantStatus, w.Code, "token %q", token)
+	}
+}
+
 func TestHandleImplementedPaths(t *testing.T) {
 	c := NewTestController(t, &TestControllerOpts{
 		DisableAuthorizationFailures: true,
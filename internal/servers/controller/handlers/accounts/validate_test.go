@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
@@ -130,6 +131,36 @@ func TestValidateCreateRequest(t *testing.T) {
 				}},
 			},
 		},
+		{
+			name: "missing ldap login name",
+			item: &pb.Account{
+				Type:         ldap.Subtype.String(),
+				AuthMethodId: ldap.AuthMethodPrefix + "_1234567890",
+			},
+			errContains: fieldError(ldapLoginNameField, "This is a required field for this type."),
+		},
+		{
+			name: "read only ldap dn field",
+			item: &pb.Account{
+				Type:         ldap.Subtype.String(),
+				AuthMethodId: ldap.AuthMethodPrefix + "_1234567890",
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"login_name": structpb.NewStringValue("alice"),
+					"dn":         structpb.NewStringValue("uid=alice,ou=people,dc=example,dc=com"),
+				}},
+			},
+			errContains: fieldError(ldapDnField, "This is a read only field."),
+		},
+		{
+			name: "no ldap errors",
+			item: &pb.Account{
+				Type:         ldap.Subtype.String(),
+				AuthMethodId: ldap.AuthMethodPrefix + "_1234567890",
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"login_name": structpb.NewStringValue("alice"),
+				}},
+			},
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
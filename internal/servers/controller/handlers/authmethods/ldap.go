@@ -0,0 +1,210 @@
+package authmethods
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	ldapstore "github.com/hashicorp/boundary/internal/auth/ldap/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/servers/controller/auth"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/types/action"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authmethods"
+	pba "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authtokens"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// ldap field names
+	urlsField             = "attributes.urls"
+	userDnField           = "attributes.user_dn"
+	certificatesField     = "attributes.certificates"
+	bindDnField           = "attributes.bind_dn"
+	bindPasswordField     = "attributes.bind_password"
+	bindPasswordHmacField = "attributes.bind_password_hmac"
+)
+
+var ldapMaskManager handlers.MaskManager
+
+func init() {
+	var err error
+	if ldapMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&ldapstore.AuthMethod{}}, handlers.MaskSource{&pb.AuthMethod{}, &pb.LdapAuthMethodAttributes{}}); err != nil {
+		panic(err)
+	}
+
+	IdActions[ldap.Subtype] = action.ActionSet{
+		action.NoOp,
+		action.Read,
+		action.Update,
+		action.Delete,
+		action.Authenticate,
+	}
+}
+
+// createLdapInRepo creates an ldap auth method in a repo and returns the result.
+// This method should never return a nil AuthMethod without returning an error.
+func (s Service) createLdapInRepo(ctx context.Context, scopeId string, item *pb.AuthMethod) (*ldap.AuthMethod, error) {
+	u, err := toStorageLdapAuthMethod(ctx, scopeId, item)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.CreateAuthMethod(ctx, u)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create auth method: %w", err)
+	}
+	return out, nil
+}
+
+func (s Service) updateLdapInRepo(ctx context.Context, scopeId, id string, mask []string, item *pb.AuthMethod) (*ldap.AuthMethod, error) {
+	u, err := toStorageLdapAuthMethod(ctx, scopeId, item)
+	if err != nil {
+		return nil, err
+	}
+
+	version := item.GetVersion()
+	u.PublicId = id
+
+	dbMask := ldapMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+
+	repo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, rowsUpdated, err := repo.UpdateAuthMethod(ctx, u, version, dbMask)
+	if err != nil {
+		return nil, fmt.Errorf("unable to update auth method: %w", err)
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("AuthMethod %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
+func (s Service) authenticateLdap(ctx context.Context, req *pbs.AuthenticateRequest, authResults *auth.VerifyResults) (*pbs.AuthenticateResponse, error) {
+	reqAttrs := req.GetAttributes().GetFields()
+	tok, err := s.authenticateWithLdapRepo(ctx, req.GetAuthMethodId(), reqAttrs[loginNameField].GetStringValue(), reqAttrs[passwordField].GetStringValue())
+	if err != nil {
+		return nil, err
+	}
+	return s.convertToAuthenticateResponse(ctx, req, authResults, tok)
+}
+
+func (s Service) authenticateWithLdapRepo(ctx context.Context, authMethodId, loginName, pw string) (*pba.AuthToken, error) {
+	iamRepo, err := s.iamRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	atRepo, err := s.atRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	ldapRepo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, err
+	}
+
+	acct, err := ldapRepo.Authenticate(ctx, authMethodId, loginName, pw)
+	if err != nil {
+		return nil, err
+	}
+	if acct == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Unable to authenticate.")
+	}
+
+	u, err := iamRepo.LookupUserWithLogin(ctx, acct.GetPublicId())
+	if err != nil {
+		return nil, err
+	}
+	tok, err := atRepo.CreateAuthToken(ctx, u, acct.GetPublicId())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ConvertInternalAuthTokenToApiAuthToken(
+		ctx,
+		tok,
+	)
+}
+
+// validateLdapAttributes adds to badFields any problems with the ldap
+// attributes which are common to both create and update requests.
+func validateLdapAttributes(ctx context.Context, attrs *pb.LdapAuthMethodAttributes, badFields map[string]string) {
+	for _, u := range attrs.GetUrls() {
+		lu, err := url.Parse(strings.TrimSpace(u))
+		if err != nil || lu.Host == "" {
+			badFields[urlsField] = fmt.Sprintf("%q cannot be parsed as a url.", u)
+			break
+		}
+		if !strutil.StrListContains([]string{"ldap", "ldaps"}, lu.Scheme) {
+			badFields[urlsField] = fmt.Sprintf("%q must have schema %q or %q specified", u, "ldap", "ldaps")
+			break
+		}
+	}
+	if len(attrs.GetCertificates()) > 0 {
+		if _, err := ldap.ParseCertificates(ctx, attrs.GetCertificates()...); err != nil {
+			badFields[certificatesField] = fmt.Sprintf("Cannot parse CA certificates. %v", err.Error())
+		}
+	}
+	if attrs.GetBindPasswordHmac() != "" {
+		badFields[bindPasswordHmacField] = "Field is read only."
+	}
+}
+
+// toStorageLdapAuthMethod converts the api auth method into an in memory
+// ldap auth method.  The result is intentionally not validated since an
+// update only needs to carry the fields in its mask; the repository validates
+// the auth method it is about to create or the result of applying an update.
+func toStorageLdapAuthMethod(ctx context.Context, scopeId string, in *pb.AuthMethod) (*ldap.AuthMethod, error) {
+	const op = "authmethod_service.toStorageLdapAuthMethod"
+	if in == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil auth method.")
+	}
+	attrs := &pb.LdapAuthMethodAttributes{}
+	if err := handlers.StructToProto(in.GetAttributes(), attrs); err != nil {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{attributesField: "Attribute fields do not match the expected format."})
+	}
+
+	am := ldap.AllocAuthMethod()
+	am.ScopeId = scopeId
+	am.Name = in.GetName().GetValue()
+	am.Description = in.GetDescription().GetValue()
+	am.StartTls = attrs.GetStartTls()
+	am.InsecureTls = attrs.GetInsecureTls()
+	am.DiscoverDn = attrs.GetDiscoverDn()
+	am.AnonGroupSearch = attrs.GetAnonGroupSearch()
+	am.EnableGroups = attrs.GetEnableGroups()
+	am.UserDn = strings.TrimSpace(attrs.GetUserDn().GetValue())
+	am.UserAttr = strings.TrimSpace(attrs.GetUserAttr().GetValue())
+	am.UserFilter = strings.TrimSpace(attrs.GetUserFilter().GetValue())
+	am.GroupDn = strings.TrimSpace(attrs.GetGroupDn().GetValue())
+	am.GroupAttr = strings.TrimSpace(attrs.GetGroupAttr().GetValue())
+	am.GroupFilter = strings.TrimSpace(attrs.GetGroupFilter().GetValue())
+	am.BindDn = strings.TrimSpace(attrs.GetBindDn().GetValue())
+	am.BindPassword = attrs.GetBindPassword().GetValue()
+	for _, u := range attrs.GetUrls() {
+		am.Urls = append(am.Urls, strings.TrimSpace(u))
+	}
+	if len(attrs.GetCertificates()) > 0 {
+		certs, err := ldap.ParseCertificates(ctx, attrs.GetCertificates()...)
+		if err != nil {
+			return nil, err
+		}
+		if am.Certificates, err = ldap.EncodeCertificates(ctx, certs...); err != nil {
+			return nil, err
+		}
+	}
+	return &am, nil
+}
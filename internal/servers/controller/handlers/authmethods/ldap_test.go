@@ -0,0 +1,124 @@
+package authmethods_test
+
+import (
+	"context"
+	"testing"
+
+	goldap "github.com/go-ldap/ldap/v3"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	"github.com/hashicorp/boundary/internal/auth/oidc"
+	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	ldaplib "github.com/hashicorp/boundary/internal/libs/ldap"
+	"github.com/hashicorp/boundary/internal/servers/controller/auth"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/authmethods"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authmethods"
+	authtokenpb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authtokens"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestCreateUpdateAuthenticate_Ldap(t *testing.T) {
+	ctx := context.TODO()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrapper), nil
+	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(ctx, rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
+	pwRepoFn := func() (*password.Repository, error) {
+		return password.NewRepository(rw, rw, kms)
+	}
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(rw, rw, kms)
+	}
+	s, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn, atRepoFn)
+	require.NoError(t, err)
+
+	srv := ldaplib.NewTestServer(t, ldaplib.WithTestEntries(
+		goldap.NewEntry("dc=example,dc=com", nil),
+		goldap.NewEntry("ou=people,dc=example,dc=com", nil),
+		goldap.NewEntry("uid=alice,ou=people,dc=example,dc=com", map[string][]string{
+			"uid":          {"alice"},
+			"cn":           {"Alice"},
+			"mail":         {"alice@example.com"},
+			"userPassword": {"alice-password"},
+		}),
+	))
+
+	created, err := s.CreateAuthMethod(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.CreateAuthMethodRequest{Item: &pb.AuthMethod{
+		ScopeId: o.GetPublicId(),
+		Name:    wrapperspb.String("ldap"),
+		Type:    ldap.Subtype.String(),
+		Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+			"urls":      structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("ldap://127.0.0.1:1")}}),
+			"user_dn":   structpb.NewStringValue("ou=people,dc=example,dc=com"),
+			"user_attr": structpb.NewStringValue("uid"),
+		}},
+	}})
+	require.NoError(t, err)
+	am := created.GetItem()
+	assert.Equal(t, ldap.Subtype.String(), am.GetType())
+	assert.Equal(t, "ou=people,dc=example,dc=com", am.GetAttributes().GetFields()["user_dn"].GetStringValue())
+	iam.TestSetPrimaryAuthMethod(t, iam.TestRepo(t, conn, wrapper), o, am.GetId())
+
+	// point the auth method at the test server
+	updated, err := s.UpdateAuthMethod(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.UpdateAuthMethodRequest{
+		Id:         am.GetId(),
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"attributes.urls"}},
+		Item: &pb.AuthMethod{
+			Version: am.GetVersion(),
+			Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+				"urls": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue(srv.URL())}}),
+			}},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, am.GetVersion()+1, updated.GetItem().GetVersion())
+
+	credentials := func(loginName, pw string) *structpb.Struct {
+		return &structpb.Struct{Fields: map[string]*structpb.Value{
+			"login_name": structpb.NewStringValue(loginName),
+			"password":   structpb.NewStringValue(pw),
+		}}
+	}
+
+	_, err = s.Authenticate(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.AuthenticateRequest{
+		AuthMethodId: am.GetId(),
+		TokenType:    "token",
+		Attributes:   credentials("alice", "wrong"),
+	})
+	require.Error(t, err)
+	assert.Truef(t, errors.Is(err, handlers.ApiErrorWithCode(codes.Unauthenticated)), "Got %#v", err)
+
+	resp, err := s.Authenticate(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.AuthenticateRequest{
+		AuthMethodId: am.GetId(),
+		TokenType:    "token",
+		Attributes:   credentials("alice", "alice-password"),
+	})
+	require.NoError(t, err)
+	aToken := &authtokenpb.AuthToken{}
+	require.NoError(t, handlers.StructToProto(resp.GetAttributes(), aToken, handlers.WithDiscardUnknownFields(true)))
+	assert.NotEmpty(t, aToken.GetToken())
+	assert.NotEmpty(t, aToken.GetAccountId())
+	assert.Equal(t, am.GetId(), aToken.GetAuthMethodId())
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	oidcstore "github.com/hashicorp/boundary/internal/auth/oidc/store"
@@ -22,9 +23,10 @@ import (
 
 const (
 	// commands
-	startCommand    = "start"
-	callbackCommand = "callback"
-	tokenCommand    = "token"
+	startCommand       = "start"
+	startDeviceCommand = "start-device"
+	callbackCommand    = "callback"
+	tokenCommand       = "token"
 
 	// token request/response fields
 	statusField = "status"
@@ -153,6 +155,8 @@ func (s Service) authenticateOidc(ctx context.Context, req *pbs.AuthenticateRequ
 	switch req.GetCommand() {
 	case startCommand:
 		return s.authenticateOidcStart(ctx, req)
+	case startDeviceCommand:
+		return s.authenticateOidcStartDevice(ctx, req)
 	case callbackCommand:
 		return s.authenticateOidcCallback(ctx, req)
 	case tokenCommand:
@@ -196,6 +200,35 @@ func (s Service) authenticateOidcStart(ctx context.Context, req *pbs.Authenticat
 	return resp, nil
 }
 
+func (s Service) authenticateOidcStartDevice(ctx context.Context, req *pbs.AuthenticateRequest) (*pbs.AuthenticateResponse, error) {
+	const op = "authmethod_service.(Service).authenticateOidcStartDevice"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Nil request.")
+	}
+
+	da, err := oidc.StartDeviceAuth(ctx, s.oidcRepoFn, req.GetAuthMethodId())
+	if err != nil {
+		// this event.WriteError(...) may cause a dup error to be emitted...
+		// it should be removed if that's the case.
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error starting the oidc device authorization flow"))
+		return nil, errors.New(ctx, errors.Internal, op, "Error starting the OIDC device authorization flow. See the controller's log for more information.")
+	}
+
+	respAttrs := &pb.OidcAuthMethodAuthenticateStartDeviceResponse{
+		UserCode:                da.UserCode,
+		VerificationUri:         da.VerificationUri,
+		VerificationUriComplete: da.VerificationUriComplete,
+		Interval:                uint32(da.Interval / time.Second),
+		ExpiresIn:               uint32(da.ExpiresIn / time.Second),
+		TokenId:                 da.TokenId,
+	}
+	resp := &pbs.AuthenticateResponse{Command: req.GetCommand()}
+	if resp.Attributes, err = handlers.ProtoToStruct(respAttrs); err != nil {
+		return nil, errors.New(ctx, errors.Internal, op, "Error marshaling parameters.", errors.WithWrap(err))
+	}
+	return resp, nil
+}
+
 // authenticateOidcCallback behaves differently than other service methods.
 // Because of the way it this is called by the end user, it should only return
 // an error if we are unable to lookup the auth method or the request
@@ -299,6 +332,11 @@ func (s Service) authenticateOidcToken(ctx context.Context, req *pbs.Authenticat
 	}
 
 	token, err := oidc.TokenRequest(ctx, s.kms, s.atRepoFn, req.GetAuthMethodId(), attrs.TokenId)
+	if err == nil && token == nil {
+		// The token may be for a device authorization request, in which case
+		// the IdP is polled for the user's tokens.
+		token, err = oidc.DeviceTokenRequest(ctx, s.kms, s.oidcRepoFn, oidc.IamRepoFactory(s.iamRepoFn), s.atRepoFn, req.GetAuthMethodId(), attrs.TokenId)
+	}
 	if err != nil {
 		switch {
 		case errors.Match(errors.T(errors.Forbidden), err):
@@ -368,6 +406,7 @@ func validateAuthenticateOidcRequest(req *pbs.AuthenticateRequest) error {
 				}
 			}
 		}
+	case startDeviceCommand:
 	case callbackCommand:
 		if req.GetAttributes() == nil {
 			badFields[attributesField] = "No callback attributes provided."
@@ -496,6 +535,10 @@ func toStorageOidcAuthMethod(ctx context.Context, scopeId string, in *pb.AuthMet
 		opts = append(opts, oidc.WithAccountClaimMap(claimsMap))
 	}
 
+	if attrs.GetStoreRefreshTokens() {
+		opts = append(opts, oidc.WithStoreRefreshTokens(true))
+	}
+
 	u, err := oidc.NewAuthMethod(ctx, scopeId, clientId, clientSecret, opts...)
 	if err != nil {
 		return nil, false, false, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build auth method: %v.", err)
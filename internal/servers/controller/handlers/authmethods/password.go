@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/auth/password"
 	pwstore "github.com/hashicorp/boundary/internal/auth/password/store"
+	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/servers/controller/auth"
@@ -15,15 +17,26 @@ import (
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authmethods"
 	pba "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authtokens"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 const (
 	// password field names
-	loginNameField = "login_name"
-	passwordField  = "password"
-	loginCommand   = "login"
+	loginNameField           = "login_name"
+	passwordField            = "password"
+	newPasswordField         = "new_password"
+	pendingTokenIdField      = "pending_token_id"
+	totpCodeField            = "code"
+	loginCommand             = "login"
+	totpCommand              = "totp"
+	minCharacterClassesField = "attributes.min_character_classes"
+	recoveryCodesField       = "recovery_codes"
 )
 
+// mfaPendingTokenTimeout is how long after the login command the totp
+// command can complete the authentication.
+const mfaPendingTokenTimeout = 5 * time.Minute
+
 var pwMaskManager handlers.MaskManager
 
 func init() {
@@ -88,49 +101,197 @@ func (s Service) updatePwInRepo(ctx context.Context, scopeId, id string, mask []
 }
 
 func (s Service) authenticatePassword(ctx context.Context, req *pbs.AuthenticateRequest, authResults *auth.VerifyResults) (*pbs.AuthenticateResponse, error) {
+	if req.GetCommand() == totpCommand {
+		return s.authenticatePasswordTotp(ctx, req, authResults)
+	}
 	reqAttrs := req.GetAttributes().GetFields()
-	tok, err := s.authenticateWithPwRepo(ctx, authResults.Scope.GetId(), req.GetAuthMethodId(), reqAttrs[loginNameField].GetStringValue(), reqAttrs[passwordField].GetStringValue())
+	tok, mfa, err := s.authenticateWithPwRepo(ctx, authResults.Scope.GetId(), req.GetAuthMethodId(), reqAttrs[loginNameField].GetStringValue(), reqAttrs[passwordField].GetStringValue(), reqAttrs[newPasswordField].GetStringValue())
 	if err != nil {
 		return nil, err
 	}
+	if mfa != nil {
+		retAttrs, err := handlers.ProtoToStruct(mfa)
+		if err != nil {
+			return nil, err
+		}
+		return &pbs.AuthenticateResponse{Command: req.GetCommand(), Attributes: retAttrs}, nil
+	}
 	return s.convertToAuthenticateResponse(ctx, req, authResults, tok)
 }
 
-func (s Service) authenticateWithPwRepo(ctx context.Context, scopeId, authMethodId, loginName, pw string) (*pba.AuthToken, error) {
+// authenticateWithPwRepo authenticates the account and issues it a token. If
+// the account's password has expired, newPw must be set and the password is
+// changed to it before the token is issued. If the account must also
+// authenticate with a TOTP code, a pending token is created instead and
+// returned in the MFA response, with a new TOTP secret if the account must
+// enroll.
+func (s Service) authenticateWithPwRepo(ctx context.Context, scopeId, authMethodId, loginName, pw, newPw string) (*pba.AuthToken, *pb.PasswordAuthMethodAuthenticateMfaResponse, error) {
 	iamRepo, err := s.iamRepoFn()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	atRepo, err := s.atRepoFn()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	pwRepo, err := s.pwRepoFn()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	acct, err := pwRepo.Authenticate(ctx, scopeId, authMethodId, loginName, pw)
-	if err != nil {
-		return nil, err
+	switch {
+	case errors.Match(errors.T(errors.AccountLocked), err):
+		return nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Account is locked.")
+	case errors.Match(errors.T(errors.PasswordExpired), err):
+		if newPw == "" {
+			return nil, nil, handlers.InvalidArgumentErrorf("Password has expired.",
+				map[string]string{"attributes.new_password": "The password has expired and a new password must be provided."})
+		}
+		acct, err = pwRepo.ChangePassword(ctx, scopeId, acct.GetPublicId(), pw, newPw, acct.GetVersion())
+		switch {
+		case errors.Match(errors.T(errors.PasswordTooShort), err):
+			return nil, nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"attributes.new_password": "Password is too short."})
+		case errors.Match(errors.T(errors.PasswordTooWeak), err):
+			return nil, nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"attributes.new_password": "Password does not contain enough character classes."})
+		case errors.Match(errors.T(errors.PasswordReused), err):
+			return nil, nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"attributes.new_password": "Password was used previously."})
+		case errors.Match(errors.T(errors.PasswordsEqual), err):
+			return nil, nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"attributes.new_password": "New password equal to current password."})
+		case err != nil:
+			return nil, nil, err
+		}
+	case err != nil:
+		return nil, nil, err
 	}
 	if acct == nil {
-		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Unable to authenticate.")
+		return nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Unable to authenticate.")
 	}
 
 	u, err := iamRepo.LookupUserWithLogin(ctx, acct.GetPublicId())
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	mfaStatus, err := pwRepo.LookupMfaStatus(ctx, acct.GetPublicId())
+	if err != nil {
+		return nil, nil, err
+	}
+	if mfaStatus != password.MfaNotRequired {
+		pending, err := atRepo.CreateAuthToken(ctx, u, acct.GetPublicId(), authtoken.WithStatus(authtoken.PendingStatus))
+		if err != nil {
+			return nil, nil, err
+		}
+		mfa := &pb.PasswordAuthMethodAuthenticateMfaResponse{PendingTokenId: pending.GetPublicId()}
+		if mfaStatus == password.MfaEnrollmentRequired {
+			enrollment, err := pwRepo.EnrollTotp(ctx, scopeId, acct.GetPublicId())
+			if err != nil {
+				return nil, nil, err
+			}
+			mfa.TotpSecret = enrollment.Secret
+			mfa.TotpUrl = enrollment.Url
+		}
+		return nil, mfa, nil
 	}
+
 	tok, err := atRepo.CreateAuthToken(ctx, u, acct.GetPublicId())
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return s.ConvertInternalAuthTokenToApiAuthToken(
+	apiTok, err := s.ConvertInternalAuthTokenToApiAuthToken(
 		ctx,
 		tok,
 	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return apiTok, nil, nil
+}
+
+// authenticatePasswordTotp completes the authentication of an account which
+// must authenticate with a TOTP code by verifying the code and issuing the
+// pending token created by the login command. Recovery codes generated when
+// the code confirms a TOTP enrollment are returned with the token.
+func (s Service) authenticatePasswordTotp(ctx context.Context, req *pbs.AuthenticateRequest, authResults *auth.VerifyResults) (*pbs.AuthenticateResponse, error) {
+	const op = "authmethod_service.(Service).authenticatePasswordTotp"
+	attrs := new(pbs.PasswordTotpAttributes)
+	if err := handlers.StructToProto(req.GetAttributes(), attrs); err != nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Error parsing request attributes.", errors.WithWrap(err))
+	}
+	atRepo, err := s.atRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	pwRepo, err := s.pwRepoFn()
+	if err != nil {
+		return nil, err
+	}
+
+	unauthenticated := handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Unable to authenticate.")
+	pending, err := atRepo.LookupAuthToken(ctx, attrs.GetPendingTokenId())
+	if err != nil {
+		return nil, err
+	}
+	if pending == nil ||
+		pending.GetStatus() != string(authtoken.PendingStatus) ||
+		time.Since(pending.GetCreateTime().GetTimestamp().AsTime()) > mfaPendingTokenTimeout {
+		return nil, unauthenticated
+	}
+	acct, err := pwRepo.LookupAccount(ctx, pending.GetAuthAccountId())
+	if err != nil && !errors.IsNotFoundError(err) {
+		return nil, err
+	}
+	if acct == nil || acct.GetAuthMethodId() != req.GetAuthMethodId() {
+		return nil, unauthenticated
+	}
+
+	verified, recoveryCodes, err := pwRepo.VerifyTotp(ctx, authResults.Scope.GetId(), acct.GetPublicId(), attrs.GetCode())
+	switch {
+	case errors.Match(errors.T(errors.AccountLocked), err):
+		if _, err := atRepo.DeleteAuthToken(ctx, pending.GetPublicId()); err != nil {
+			return nil, err
+		}
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Account is locked.")
+	case err != nil:
+		return nil, err
+	}
+	if !verified {
+		// Each pending token allows a single attempt, so codes cannot be
+		// guessed without authenticating with the password again.
+		if _, err := atRepo.DeleteAuthToken(ctx, pending.GetPublicId()); err != nil {
+			return nil, err
+		}
+		return nil, unauthenticated
+	}
+
+	tok, err := atRepo.IssueAuthToken(ctx, pending.GetPublicId())
+	if err != nil {
+		return nil, err
+	}
+	apiTok, err := s.ConvertInternalAuthTokenToApiAuthToken(ctx, tok)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.convertToAuthenticateResponse(ctx, req, authResults, apiTok)
+	if err != nil {
+		return nil, err
+	}
+	if len(recoveryCodes) > 0 {
+		rcs := make([]interface{}, 0, len(recoveryCodes))
+		for _, rc := range recoveryCodes {
+			rcs = append(rcs, rc)
+		}
+		lv, err := structpb.NewList(rcs)
+		if err != nil {
+			return nil, errors.New(ctx, errors.Internal, op, "Error generating response attributes.", errors.WithWrap(err))
+		}
+		resp.GetAttributes().GetFields()[recoveryCodesField] = structpb.NewListValue(lv)
+	}
+	return resp, nil
 }
 
 func validateAuthenticatePasswordRequest(req *pbs.AuthenticateRequest) error {
@@ -143,17 +304,26 @@ func validateAuthenticatePasswordRequest(req *pbs.AuthenticateRequest) error {
 	}
 
 	attrs := req.GetAttributes().GetFields()
-	if _, ok := attrs[loginNameField]; !ok {
-		badFields["attributes.login_name"] = "This is a required field."
-	}
-	if _, ok := attrs[passwordField]; !ok {
-		badFields["attributes.password"] = "This is a required field."
-	}
 	if req.GetCommand() == "" {
 		// TODO: Eventually, require a command. For now, fall back to "login" for backwards compat.
 		req.Command = loginCommand
 	}
-	if req.Command != loginCommand {
+	switch {
+	case req.Command == loginCommand:
+		if _, ok := attrs[loginNameField]; !ok {
+			badFields["attributes.login_name"] = "This is a required field."
+		}
+		if _, ok := attrs[passwordField]; !ok {
+			badFields["attributes.password"] = "This is a required field."
+		}
+	case req.Command == totpCommand && strings.HasPrefix(req.GetAuthMethodId(), password.AuthMethodPrefix+"_"):
+		if attrs[pendingTokenIdField].GetStringValue() == "" {
+			badFields["attributes.pending_token_id"] = "This is a required field."
+		}
+		if attrs[totpCodeField].GetStringValue() == "" {
+			badFields["attributes.code"] = "This is a required field."
+		}
+	default:
 		badFields[commandField] = "Invalid command for this auth method type."
 	}
 	tType := strings.ToLower(strings.TrimSpace(req.GetTokenType()))
@@ -195,5 +365,11 @@ func toStoragePwAuthMethod(scopeId string, item *pb.AuthMethod) (*password.AuthM
 	if pwAttrs.GetMinPasswordLength() != 0 {
 		u.MinPasswordLength = pwAttrs.GetMinPasswordLength()
 	}
+	u.MinCharacterClasses = pwAttrs.GetMinCharacterClasses()
+	u.PasswordHistoryCount = pwAttrs.GetPasswordHistoryCount()
+	u.MaxPasswordAgeSeconds = pwAttrs.GetMaxPasswordAgeSeconds()
+	u.LockoutThreshold = pwAttrs.GetLockoutThreshold()
+	u.LockoutDurationSeconds = pwAttrs.GetLockoutDurationSeconds()
+	u.RequireMfa = pwAttrs.GetRequireMfa()
 	return u, nil
 }
@@ -3,11 +3,14 @@ package authtokens
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/requests"
 	"github.com/hashicorp/boundary/internal/servers/controller/auth"
@@ -36,6 +39,8 @@ var (
 	// this collection
 	CollectionActions = action.ActionSet{
 		action.List,
+		action.RevokeAllForUser,
+		action.Introspect,
 	}
 )
 
@@ -43,20 +48,24 @@ var (
 type Service struct {
 	pbs.UnimplementedAuthTokenServiceServer
 
+	kms       *kms.Kms
 	repoFn    common.AuthTokenRepoFactory
 	iamRepoFn common.IamRepoFactory
 }
 
 // NewService returns a user service which handles user related requests to boundary.
-func NewService(repo common.AuthTokenRepoFactory, iamRepoFn common.IamRepoFactory) (Service, error) {
+func NewService(kms *kms.Kms, repo common.AuthTokenRepoFactory, iamRepoFn common.IamRepoFactory) (Service, error) {
 	const op = "authtoken.NewService"
+	if kms == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing kms")
+	}
 	if repo == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing auth token repository")
 	}
 	if iamRepoFn == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing iam repository")
 	}
-	return Service{repoFn: repo, iamRepoFn: iamRepoFn}, nil
+	return Service{kms: kms, repoFn: repo, iamRepoFn: iamRepoFn}, nil
 }
 
 var _ pbs.AuthTokenServiceServer = Service{}
@@ -226,6 +235,116 @@ func (s Service) DeleteAuthToken(ctx context.Context, req *pbs.DeleteAuthTokenRe
 	return nil, nil
 }
 
+// RevokeAllAuthTokensForUser implements the interface pbs.AuthTokenServiceServer.
+func (s Service) RevokeAllAuthTokensForUser(ctx context.Context, req *pbs.RevokeAllAuthTokensForUserRequest) (*pbs.RevokeAllAuthTokensForUserResponse, error) {
+	const op = "authtokens.(Service).RevokeAllAuthTokensForUser"
+	if err := validateRevokeAllForUserRequest(req); err != nil {
+		return nil, err
+	}
+	iamRepo, err := s.iamRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	u, _, err := iamRepo.LookupUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if u == nil {
+		return nil, handlers.NotFoundErrorf("User %q not found.", req.GetUserId())
+	}
+	authResults := auth.Verify(ctx, auth.WithType(resource.AuthToken), auth.WithAction(action.RevokeAllForUser), auth.WithScopeId(u.GetScopeId()))
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	rows, err := repo.DeleteUserAuthTokens(ctx, u.GetPublicId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to revoke auth tokens"))
+	}
+	return &pbs.RevokeAllAuthTokensForUserResponse{RevokedCount: uint32(rows)}, nil
+}
+
+// IntrospectAuthToken implements the interface pbs.AuthTokenServiceServer.
+// Tokens which don't exist, have expired or become stale, or can't be
+// decrypted are reported as inactive rather than returning an error. The
+// request is authorized against the scope of the token, or the global scope
+// if it isn't found. Introspecting a token doesn't update its approximate last
+// access time, so polling doesn't keep an idle token from becoming stale.
+func (s Service) IntrospectAuthToken(ctx context.Context, req *pbs.IntrospectAuthTokenRequest) (*pbs.IntrospectAuthTokenResponse, error) {
+	const op = "authtokens.(Service).IntrospectAuthToken"
+	if err := validateIntrospectRequest(req); err != nil {
+		return nil, err
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var at *authtoken.AuthToken
+	var publicId, encryptedToken string
+	if split := strings.Split(req.GetToken(), "_"); len(split) == 3 {
+		publicId = strings.Join(split[0:2], "_")
+		encryptedToken = split[2]
+		at, err = repo.LookupAuthToken(ctx, publicId)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+
+	// Tokens which aren't found are checked against the global scope.
+	scopeId := scope.Global.String()
+	if at != nil {
+		scopeId = at.GetScopeId()
+	}
+	authResults := auth.Verify(ctx, auth.WithType(resource.AuthToken), auth.WithAction(action.Introspect), auth.WithScopeId(scopeId))
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	if at == nil {
+		return &pbs.IntrospectAuthTokenResponse{}, nil
+	}
+
+	token, err := auth.DecryptToken(ctx, s.kms, at.GetScopeId(), publicId, encryptedToken)
+	if err != nil {
+		return &pbs.IntrospectAuthTokenResponse{}, nil
+	}
+	at, err = repo.CheckToken(ctx, publicId, token)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if at == nil || at.GetIamUserId() == "" {
+		return &pbs.IntrospectAuthTokenResponse{}, nil
+	}
+
+	iamRepo, err := s.iamRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	principals, err := iamRepo.PrincipalsForUser(ctx, at.GetIamUserId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	outputFields := authResults.FetchOutputFields(perms.Resource{
+		Id:      at.GetPublicId(),
+		ScopeId: at.GetScopeId(),
+		Type:    resource.AuthToken,
+	}, action.Introspect).SelfOrDefaults(authResults.UserId)
+	item, err := toProto(ctx, at, handlers.WithOutputFields(&outputFields))
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.IntrospectAuthTokenResponse{
+		Active:     true,
+		Item:       item,
+		Principals: principals,
+	}, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*authtoken.AuthToken, error) {
 	const op = "authtokens.(Service).getFromRepo"
 	repo, err := s.repoFn()
@@ -373,6 +492,28 @@ func validateDeleteRequest(req *pbs.DeleteAuthTokenRequest) error {
 	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, authtoken.AuthTokenPrefix)
 }
 
+func validateRevokeAllForUserRequest(req *pbs.RevokeAllAuthTokensForUserRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetUserId()), iam.UserPrefix) {
+		badFields["user_id"] = "Incorrectly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Invalid fields provided in request.", badFields)
+	}
+	return nil
+}
+
+func validateIntrospectRequest(req *pbs.IntrospectAuthTokenRequest) error {
+	badFields := map[string]string{}
+	if req.GetToken() == "" {
+		badFields["token"] = "This is a required field."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Invalid fields provided in request.", badFields)
+	}
+	return nil
+}
+
 func validateListRequest(req *pbs.ListAuthTokensRequest) error {
 	badFields := map[string]string{}
 	if !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Org.Prefix()) &&
@@ -47,7 +47,7 @@ func TestGetSelf(t *testing.T) {
 		return servers.NewRepository(rw, rw, kms)
 	}
 
-	a, err := authtokens.NewService(tokenRepoFn, iamRepoFn)
+	a, err := authtokens.NewService(kms, tokenRepoFn, iamRepoFn)
 	require.NoError(t, err, "Couldn't create new auth token service.")
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
@@ -125,7 +125,7 @@ func TestGet(t *testing.T) {
 		return authtoken.NewRepository(rw, rw, kms)
 	}
 
-	s, err := authtokens.NewService(repoFn, iamRepoFn)
+	s, err := authtokens.NewService(kms, repoFn, iamRepoFn)
 	require.NoError(t, err, "Couldn't create new auth token service.")
 
 	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
@@ -229,7 +229,7 @@ func TestList_Self(t *testing.T) {
 		},
 	}
 
-	a, err := authtokens.NewService(tokenRepoFn, iamRepoFn)
+	a, err := authtokens.NewService(kms, tokenRepoFn, iamRepoFn)
 	require.NoError(t, err)
 
 	for _, tc := range cases {
@@ -383,7 +383,7 @@ func TestList(t *testing.T) {
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			s, err := authtokens.NewService(repoFn, iamRepoFn)
+			s, err := authtokens.NewService(kms, repoFn, iamRepoFn)
 			assert, require := assert.New(t), require.New(t)
 			require.NoError(err, "Couldn't create new user service.")
 
@@ -430,7 +430,7 @@ func TestDeleteSelf(t *testing.T) {
 		return servers.NewRepository(rw, rw, kms)
 	}
 
-	a, err := authtokens.NewService(tokenRepoFn, iamRepoFn)
+	a, err := authtokens.NewService(kms, tokenRepoFn, iamRepoFn)
 	require.NoError(t, err, "Couldn't create new auth token service.")
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
@@ -519,7 +519,7 @@ func TestDelete(t *testing.T) {
 	org, _ := iam.TestScopes(t, iamRepo)
 	at := authtoken.TestAuthToken(t, conn, kms, org.GetPublicId())
 
-	s, err := authtokens.NewService(repoFn, iamRepoFn)
+	s, err := authtokens.NewService(kms, repoFn, iamRepoFn)
 	require.NoError(t, err, "Error when getting new user service.")
 
 	cases := []struct {
@@ -583,7 +583,7 @@ func TestDelete_twice(t *testing.T) {
 	org, _ := iam.TestScopes(t, iamRepo)
 	at := authtoken.TestAuthToken(t, conn, kms, org.GetPublicId())
 
-	s, err := authtokens.NewService(repoFn, iamRepoFn)
+	s, err := authtokens.NewService(kms, repoFn, iamRepoFn)
 	require.NoError(err, "Error when getting new user service")
 	req := &pbs.DeleteAuthTokenRequest{
 		Id: at.GetPublicId(),
@@ -594,3 +594,150 @@ func TestDelete_twice(t *testing.T) {
 	assert.Error(gErr, "Second attempt")
 	assert.True(errors.Is(gErr, handlers.ApiErrorWithCode(codes.NotFound)), "Expected permission denied for the second delete.")
 }
+
+func TestRevokeAllForUser(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrap), nil
+	}
+	repoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(rw, rw, kms)
+	}
+	iamRepo := iam.TestRepo(t, conn, wrap)
+
+	org, _ := iam.TestScopes(t, iamRepo)
+	at := authtoken.TestAuthToken(t, conn, kms, org.GetPublicId())
+	other := authtoken.TestAuthToken(t, conn, kms, org.GetPublicId())
+
+	s, err := authtokens.NewService(kms, repoFn, iamRepoFn)
+	require.NoError(t, err, "Error when getting new auth token service.")
+
+	cases := []struct {
+		name string
+		req  *pbs.RevokeAllAuthTokensForUserRequest
+		res  *pbs.RevokeAllAuthTokensForUserResponse
+		err  error
+	}{
+		{
+			name: "Revoke tokens of an existing user",
+			req:  &pbs.RevokeAllAuthTokensForUserRequest{UserId: at.GetIamUserId()},
+			res:  &pbs.RevokeAllAuthTokensForUserResponse{RevokedCount: 1},
+		},
+		{
+			name: "Revoke again",
+			req:  &pbs.RevokeAllAuthTokensForUserRequest{UserId: at.GetIamUserId()},
+			res:  &pbs.RevokeAllAuthTokensForUserResponse{},
+		},
+		{
+			name: "Unknown user",
+			req:  &pbs.RevokeAllAuthTokensForUserRequest{UserId: iam.UserPrefix + "_doesntexis"},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "Bad user id formatting",
+			req:  &pbs.RevokeAllAuthTokensForUserRequest{UserId: "bad_format"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, gErr := s.RevokeAllAuthTokensForUser(auth.DisabledAuthTestContext(iamRepoFn, org.GetPublicId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "RevokeAllAuthTokensForUser(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Empty(cmp.Diff(tc.res, got, protocmp.Transform()))
+		})
+	}
+
+	repo, err := repoFn()
+	require.NoError(t, err)
+	got, err := repo.LookupAuthToken(context.Background(), other.GetPublicId())
+	require.NoError(t, err)
+	assert.NotNil(t, got, "another user's token was revoked")
+}
+
+func TestIntrospect(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrap), nil
+	}
+	repoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(rw, rw, kms)
+	}
+	iamRepo := iam.TestRepo(t, conn, wrap)
+
+	org, _ := iam.TestScopes(t, iamRepo)
+	at := authtoken.TestAuthToken(t, conn, kms, org.GetPublicId())
+	encToken, err := authtoken.EncryptToken(context.Background(), kms, org.GetPublicId(), at.GetPublicId(), at.GetToken())
+	require.NoError(t, err)
+	token := at.GetPublicId() + "_" + encToken
+
+	grp := iam.TestGroup(t, conn, org.GetPublicId())
+	iam.TestGroupMember(t, conn, grp.GetPublicId(), at.GetIamUserId())
+
+	s, err := authtokens.NewService(kms, repoFn, iamRepoFn)
+	require.NoError(t, err, "Error when getting new auth token service.")
+
+	cases := []struct {
+		name       string
+		token      string
+		active     bool
+		principals []string
+		err        error
+	}{
+		{
+			name:       "Valid token",
+			token:      token,
+			active:     true,
+			principals: []string{grp.GetPublicId(), at.GetIamUserId()},
+		},
+		{
+			name:  "Unknown token",
+			token: authtoken.AuthTokenPrefix + "_doesntexis_" + encToken,
+		},
+		{
+			name:  "Tampered token",
+			token: at.GetPublicId() + "_" + encToken[:len(encToken)-1],
+		},
+		{
+			name:  "Malformed token",
+			token: "malformed",
+		},
+		{
+			name: "Missing token",
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, gErr := s.IntrospectAuthToken(auth.DisabledAuthTestContext(iamRepoFn, org.GetPublicId()), &pbs.IntrospectAuthTokenRequest{Token: tc.token})
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "IntrospectAuthToken(%q) got error %v, wanted %v", tc.token, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Equal(tc.active, got.GetActive())
+			if !tc.active {
+				assert.Nil(got.GetItem())
+				assert.Empty(got.GetPrincipals())
+				return
+			}
+			assert.Equal(at.GetPublicId(), got.GetItem().GetId())
+			assert.Equal(at.GetIamUserId(), got.GetItem().GetUserId())
+			assert.NotNil(got.GetItem().GetExpirationTime())
+			assert.ElementsMatch(tc.principals, got.GetPrincipals())
+		})
+	}
+}
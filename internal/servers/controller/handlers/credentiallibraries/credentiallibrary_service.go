@@ -2,11 +2,14 @@ package credentiallibraries
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"strings"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/credential"
+	credstatic "github.com/hashicorp/boundary/internal/credential/static"
+	staticstore "github.com/hashicorp/boundary/internal/credential/static/store"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/credential/vault/store"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -28,10 +31,18 @@ const (
 	vaultPathField       = "attributes.path"
 	httpMethodField      = "attributes.http_method"
 	httpRequestBodyField = "attributes.http_request_body"
+
+	credentialTypeField = "attributes.credential_type"
+	usernameField       = "attributes.username"
+	passwordField       = "attributes.password"
+	privateKeyField     = "attributes.private_key"
+	objectField         = "attributes.object"
+	secretHmacField     = "attributes.secret_hmac"
 )
 
 var (
-	maskManager handlers.MaskManager
+	vaultMaskManager  handlers.MaskManager
+	staticMaskManager handlers.MaskManager
 
 	// IdActions contains the set of actions that can be performed on
 	// individual resources
@@ -52,22 +63,27 @@ var (
 
 func init() {
 	var err error
-	if maskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&store.CredentialLibrary{}},
+	if vaultMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&store.CredentialLibrary{}},
 		handlers.MaskSource{&pb.CredentialLibrary{}, &pb.VaultCredentialLibraryAttributes{}}); err != nil {
 		panic(err)
 	}
+	if staticMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&staticstore.CredentialLibrary{}},
+		handlers.MaskSource{&pb.CredentialLibrary{}, &pb.StaticCredentialLibraryAttributes{}}); err != nil {
+		panic(err)
+	}
 }
 
 // Service handles request as described by the pbs.CredentialLibraryServiceServer interface.
 type Service struct {
 	pbs.UnimplementedCredentialLibraryServiceServer
 
-	iamRepoFn common.IamRepoFactory
-	repoFn    common.VaultCredentialRepoFactory
+	iamRepoFn    common.IamRepoFactory
+	repoFn       common.VaultCredentialRepoFactory
+	staticRepoFn common.StaticCredentialRepoFactory
 }
 
 // NewService returns a credential library service which handles credential library related requests to boundary.
-func NewService(repo common.VaultCredentialRepoFactory, iamRepo common.IamRepoFactory) (Service, error) {
+func NewService(repo common.VaultCredentialRepoFactory, staticRepo common.StaticCredentialRepoFactory, iamRepo common.IamRepoFactory) (Service, error) {
 	const op = "credentiallibraries.NewService"
 	if iamRepo == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing iam repository")
@@ -75,7 +91,10 @@ func NewService(repo common.VaultCredentialRepoFactory, iamRepo common.IamRepoFa
 	if repo == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing vault credential repository")
 	}
-	return Service{iamRepoFn: iamRepo, repoFn: repo}, nil
+	if staticRepo == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing static credential repository")
+	}
+	return Service{iamRepoFn: iamRepo, repoFn: repo, staticRepoFn: staticRepo}, nil
 }
 
 var _ pbs.CredentialLibraryServiceServer = Service{}
@@ -270,71 +289,200 @@ func (s Service) DeleteCredentialLibrary(ctx context.Context, req *pbs.DeleteCre
 	return nil, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, storeId string) ([]*vault.CredentialLibrary, error) {
+func (s Service) listFromRepo(ctx context.Context, storeId string) ([]credential.Library, error) {
 	const op = "credentiallibraries.(Service).listFromRepo"
-	repo, err := s.repoFn()
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
-	}
-	csl, err := repo.ListCredentialLibraries(ctx, storeId)
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
+	var ll []credential.Library
+	switch credential.SubtypeFromId(storeId) {
+	case credstatic.Subtype:
+		repo, err := s.staticRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		sll, err := repo.ListCredentialLibraries(ctx, storeId)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		for _, l := range sll {
+			ll = append(ll, l)
+		}
+	default:
+		repo, err := s.repoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		vll, err := repo.ListCredentialLibraries(ctx, storeId)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		for _, l := range vll {
+			ll = append(ll, l)
+		}
 	}
-	return csl, nil
+	return ll, nil
 }
 
 func (s Service) getFromRepo(ctx context.Context, id string) (credential.Library, error) {
 	const op = "credentiallibraries.(Service).getFromRepo"
-	repo, err := s.repoFn()
+	l, err := s.lookupLibrary(ctx, id)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
-	cs, err := repo.LookupCredentialLibrary(ctx, id)
-	if err != nil && !errors.IsNotFoundError(err) {
-		return nil, errors.Wrap(ctx, err, op)
-	}
-	if cs == nil {
+	if l == nil {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("credential library %q not found", id))
 	}
-	return cs, err
+	return l, nil
+}
+
+// lookupLibrary returns the credential library for id from the repository
+// of the library's subtype. It returns nil, nil if the library is not found.
+func (s Service) lookupLibrary(ctx context.Context, id string) (credential.Library, error) {
+	const op = "credentiallibraries.(Service).lookupLibrary"
+	switch credential.SubtypeFromId(id) {
+	case vault.Subtype:
+		repo, err := s.repoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		l, err := repo.LookupCredentialLibrary(ctx, id)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if l != nil {
+			return l, nil
+		}
+	case credstatic.Subtype:
+		repo, err := s.staticRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		l, err := repo.LookupCredentialLibrary(ctx, id)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if l != nil {
+			return l, nil
+		}
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "unrecognized credential library subtype from id")
+	}
+	return nil, nil
 }
 
 func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.CredentialLibrary) (credential.Library, error) {
 	const op = "credentiallibraries.(Service).createInRepo"
+	var out credential.Library
+	switch credential.SubtypeFromId(item.GetCredentialStoreId()) {
+	case credstatic.Subtype:
+		cl, err := toStorageStaticLibrary(item.GetCredentialStoreId(), item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.staticRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		sl, err := repo.CreateCredentialLibrary(ctx, scopeId, cl)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential library"))
+		}
+		if sl != nil {
+			out = sl
+		}
+	default:
+		cl, err := toStorageVaultLibrary(item.GetCredentialStoreId(), item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.repoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		vl, err := repo.CreateCredentialLibrary(ctx, scopeId, cl)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential library"))
+		}
+		if vl != nil {
+			out = vl
+		}
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create credential library but no error returned from repository.")
+	}
+	return out, nil
+}
+
+func (s Service) updateInRepo(ctx context.Context, projId, id string, mask []string, item *pb.CredentialLibrary) (credential.Library, error) {
+	const op = "credentiallibraries.(Service).updateInRepo"
+	switch credential.SubtypeFromId(id) {
+	case credstatic.Subtype:
+		return s.updateStaticInRepo(ctx, projId, id, mask, item)
+	}
 	cl, err := toStorageVaultLibrary(item.GetCredentialStoreId(), item)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
+	cl.PublicId = id
+
+	dbMask := vaultMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
-	out, err := repo.CreateCredentialLibrary(ctx, scopeId, cl)
+	out, rowsUpdated, err := repo.UpdateCredentialLibrary(ctx, projId, cl, item.GetVersion(), dbMask)
 	if err != nil {
-		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential library"))
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential library"))
 	}
-	if out == nil {
-		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create credential library but no error returned from repository.")
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Credential Library %q doesn't exist or incorrect version provided.", id)
 	}
 	return out, nil
 }
 
-func (s Service) updateInRepo(ctx context.Context, projId, id string, mask []string, item *pb.CredentialLibrary) (credential.Library, error) {
-	const op = "credentiallibraries.(Service).updateInRepo"
-	cl, err := toStorageVaultLibrary(item.GetCredentialStoreId(), item)
+func (s Service) updateStaticInRepo(ctx context.Context, projId, id string, mask []string, item *pb.CredentialLibrary) (credential.Library, error) {
+	const op = "credentiallibraries.(Service).updateStaticInRepo"
+	repo, err := s.staticRepoFn()
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
-	cl.PublicId = id
 
-	dbMask := maskManager.Translate(mask)
+	dbMask := staticMaskManager.Translate(mask)
+	secretPath := ""
+	for _, f := range []string{passwordField, privateKeyField, objectField} {
+		if handlers.MaskContains(mask, f) {
+			secretPath = f
+		}
+	}
+	credType := credstatic.CredentialType("")
+	if secretPath != "" {
+		// The secret field being updated must match the credential type
+		// of the library, which is only known to the repository.
+		current, err := repo.LookupCredentialLibrary(ctx, id)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if current == nil {
+			return nil, handlers.NotFoundErrorf("Credential Library %q doesn't exist.", id)
+		}
+		credType = credstatic.CredentialType(current.GetCredentialType())
+		if secretFieldFor(credType) != secretPath {
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{secretPath: fmt.Sprintf("This field cannot be updated on a %q credential library.", credType)})
+		}
+		dbMask = append(dbMask, "Secret")
+	}
 	if len(dbMask) == 0 {
 		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
 	}
-	repo, err := s.repoFn()
+
+	cl, err := toStorageStaticLibrary(item.GetCredentialStoreId(), item, credType)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
+	cl.PublicId = id
+
 	out, rowsUpdated, err := repo.UpdateCredentialLibrary(ctx, projId, cl, item.GetVersion(), dbMask)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential library"))
@@ -347,11 +495,22 @@ func (s Service) updateInRepo(ctx context.Context, projId, id string, mask []str
 
 func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool, error) {
 	const op = "credentiallibraries.(Service).deleteFromRepo"
-	repo, err := s.repoFn()
-	if err != nil {
-		return false, err
+	var rows int
+	var err error
+	switch credential.SubtypeFromId(id) {
+	case credstatic.Subtype:
+		repo, rErr := s.staticRepoFn()
+		if rErr != nil {
+			return false, rErr
+		}
+		rows, err = repo.DeleteCredentialLibrary(ctx, scopeId, id)
+	default:
+		repo, rErr := s.repoFn()
+		if rErr != nil {
+			return false, rErr
+		}
+		rows, err = repo.DeleteCredentialLibrary(ctx, scopeId, id)
 	}
-	rows, err := repo.DeleteCredentialLibrary(ctx, scopeId, id)
 	if err != nil {
 		if errors.IsNotFoundError(err) {
 			return false, nil
@@ -364,11 +523,6 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
 	const op = "credentiallibraries.(Service).authResult"
 	res := auth.VerifyResults{}
-	repo, err := s.repoFn()
-	if err != nil {
-		res.Error = err
-		return res
-	}
 
 	var parentId string
 	opts := []auth.Option{auth.WithType(resource.CredentialLibrary), auth.WithAction(a)}
@@ -378,22 +532,16 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 	default:
 		opts = append(opts, auth.WithId(id))
 
-		switch credential.SubtypeFromId(id) {
-		case vault.Subtype:
-			cl, err := repo.LookupCredentialLibrary(ctx, id)
-			if err != nil {
-				res.Error = err
-				return res
-			}
-			if cl == nil {
-				res.Error = handlers.NotFoundError()
-				return res
-			}
-			parentId = cl.GetStoreId()
-		default:
-			res.Error = errors.New(ctx, errors.InvalidParameter, op, "unrecognized credential library subtype from id")
+		cl, err := s.lookupLibrary(ctx, id)
+		if err != nil {
+			res.Error = err
 			return res
 		}
+		if cl == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+		parentId = cl.GetStoreId()
 	}
 
 	if parentId == "" {
@@ -403,22 +551,45 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 
 	opts = append(opts, auth.WithPin(parentId))
 
+	var cs credential.Store
 	switch credential.SubtypeFromId(parentId) {
 	case vault.Subtype:
-		cs, err := repo.LookupCredentialStore(ctx, parentId)
+		repo, err := s.repoFn()
 		if err != nil {
 			res.Error = err
 			return res
 		}
-		if cs == nil {
-			res.Error = handlers.NotFoundError()
+		vcs, err := repo.LookupCredentialStore(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if vcs != nil {
+			cs = vcs
+		}
+	case credstatic.Subtype:
+		repo, err := s.staticRepoFn()
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		scs, err := repo.LookupCredentialStore(ctx, parentId)
+		if err != nil {
+			res.Error = err
 			return res
 		}
-		opts = append(opts, auth.WithScopeId(cs.GetScopeId()))
+		if scs != nil {
+			cs = scs
+		}
 	default:
 		res.Error = errors.New(ctx, errors.InvalidParameter, op, "unrecognized credential store subtype from id")
 		return res
 	}
+	if cs == nil {
+		res.Error = handlers.NotFoundError()
+		return res
+	}
+	opts = append(opts, auth.WithScopeId(cs.GetScopeId()))
 
 	return auth.Verify(ctx, opts...)
 }
@@ -484,6 +655,23 @@ func toProto(in credential.Library, opt ...handlers.Option) (*pb.CredentialLibra
 			if err != nil {
 				return nil, errors.WrapDeprecated(err, op, errors.WithMsg("failed to convert resource from storage to api"))
 			}
+		case credstatic.Subtype:
+			staticIn, ok := in.(*credstatic.CredentialLibrary)
+			if !ok {
+				return nil, errors.NewDeprecated(errors.Internal, op, "unable to cast to static credential library")
+			}
+			attrs := &pb.StaticCredentialLibraryAttributes{
+				CredentialType: wrapperspb.String(staticIn.GetCredentialType()),
+				SecretHmac:     base64.RawURLEncoding.EncodeToString(staticIn.GetSecretHmac()),
+			}
+			if staticIn.GetUsername() != "" {
+				attrs.Username = wrapperspb.String(staticIn.GetUsername())
+			}
+			var err error
+			out.Attributes, err = handlers.ProtoToStruct(attrs)
+			if err != nil {
+				return nil, errors.WrapDeprecated(err, op, errors.WithMsg("failed to convert resource from storage to api"))
+			}
 		}
 	}
 	return &out, nil
@@ -518,13 +706,91 @@ func toStorageVaultLibrary(storeId string, in *pb.CredentialLibrary) (out *vault
 	return cs, err
 }
 
+// toStorageStaticLibrary converts in to a static credential library. If in
+// does not include a credential type, credType is used.
+func toStorageStaticLibrary(storeId string, in *pb.CredentialLibrary, credType ...credstatic.CredentialType) (out *credstatic.CredentialLibrary, err error) {
+	const op = "credentiallibraries.toStorageStaticLibrary"
+	var opts []credstatic.Option
+	if in.GetName() != nil {
+		opts = append(opts, credstatic.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, credstatic.WithDescription(in.GetDescription().GetValue()))
+	}
+
+	attrs := &pb.StaticCredentialLibraryAttributes{}
+	if err := handlers.StructToProto(in.GetAttributes(), attrs); err != nil {
+		return nil, errors.WrapDeprecated(err, op, errors.WithMsg("unable to parse the attributes"))
+	}
+
+	if attrs.GetUsername() != nil {
+		opts = append(opts, credstatic.WithUsername(attrs.GetUsername().GetValue()))
+	}
+	if attrs.GetPassword() != nil {
+		opts = append(opts, credstatic.WithPassword(credential.Password(attrs.GetPassword().GetValue())))
+	}
+	if attrs.GetPrivateKey() != nil {
+		opts = append(opts, credstatic.WithPrivateKey(credential.PrivateKey(attrs.GetPrivateKey().GetValue())))
+	}
+	if attrs.GetObject() != nil {
+		opts = append(opts, credstatic.WithObject(attrs.GetObject().AsMap()))
+	}
+
+	t := credstatic.CredentialType(attrs.GetCredentialType().GetValue())
+	if t == "" && len(credType) > 0 {
+		t = credType[0]
+	}
+	cl, err := credstatic.NewCredentialLibrary(storeId, t, opts...)
+	if err != nil {
+		return nil, errors.WrapDeprecated(err, op, errors.WithMsg("unable to build credential library"))
+	}
+	return cl, nil
+}
+
+// secretFieldFor returns the attribute field holding the secret of a static
+// credential library of type t.
+func secretFieldFor(t credstatic.CredentialType) string {
+	switch t {
+	case credstatic.UsernamePasswordType:
+		return passwordField
+	case credstatic.SshPrivateKeyType:
+		return privateKeyField
+	case credstatic.JsonType:
+		return objectField
+	}
+	return ""
+}
+
+// validateStaticAttributes validates the attributes of a static credential
+// library. The secret fields are only checked against credType when it is
+// known.
+func validateStaticAttributes(attrs *pb.StaticCredentialLibraryAttributes, credType credstatic.CredentialType, badFields map[string]string) {
+	secrets := map[string]bool{
+		passwordField:   attrs.GetPassword() != nil,
+		privateKeyField: attrs.GetPrivateKey() != nil,
+		objectField:     attrs.GetObject() != nil,
+	}
+	want := secretFieldFor(credType)
+	for f, set := range secrets {
+		if set && want != "" && f != want {
+			badFields[f] = fmt.Sprintf("This field cannot be set on a %q credential library.", credType)
+		}
+	}
+	if credType == credstatic.JsonType && attrs.GetUsername() != nil {
+		badFields[usernameField] = fmt.Sprintf("This field cannot be set on a %q credential library.", credType)
+	}
+	if attrs.GetSecretHmac() != "" {
+		badFields[secretHmacField] = "This is a read only field."
+	}
+}
+
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
 //  * The path passed in is correctly formatted
 //  * All required parameters are set
 //  * There are no conflicting parameters provided
 func validateGetRequest(req *pbs.GetCredentialLibraryRequest) error {
-	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, vault.CredentialLibraryPrefix)
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, vault.CredentialLibraryPrefix, credstatic.CredentialLibraryPrefix)
 }
 
 func validateCreateRequest(req *pbs.CreateCredentialLibraryRequest) error {
@@ -549,6 +815,38 @@ func validateCreateRequest(req *pbs.CreateCredentialLibraryRequest) error {
 			if b := attrs.GetHttpRequestBody(); b != nil && strings.ToUpper(attrs.GetHttpMethod().GetValue()) != "POST" {
 				badFields[httpRequestBodyField] = fmt.Sprintf("Field can only be set if %q is set to the value 'POST'.", httpMethodField)
 			}
+		case credstatic.Subtype:
+			if t := req.GetItem().GetType(); t != "" && credential.SubtypeFromType(t) != credstatic.Subtype {
+				badFields[globals.CredentialStoreIdField] = "If included, type must match that of the credential store."
+			}
+			attrs := &pb.StaticCredentialLibraryAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), attrs); err != nil {
+				badFields[globals.AttributesField] = "Attribute fields do not match the expected format."
+				break
+			}
+			credType := credstatic.CredentialType(attrs.GetCredentialType().GetValue())
+			if secretFieldFor(credType) == "" {
+				badFields[credentialTypeField] = fmt.Sprintf("This is a required field and must be one of %q.", credstatic.ValidCredentialTypes)
+				break
+			}
+			if credType != credstatic.JsonType && attrs.GetUsername().GetValue() == "" {
+				badFields[usernameField] = fmt.Sprintf("This is a required field for a %q credential library.", credType)
+			}
+			switch credType {
+			case credstatic.UsernamePasswordType:
+				if attrs.GetPassword().GetValue() == "" {
+					badFields[passwordField] = fmt.Sprintf("This is a required field for a %q credential library.", credType)
+				}
+			case credstatic.SshPrivateKeyType:
+				if attrs.GetPrivateKey().GetValue() == "" {
+					badFields[privateKeyField] = fmt.Sprintf("This is a required field for a %q credential library.", credType)
+				}
+			case credstatic.JsonType:
+				if len(attrs.GetObject().GetFields()) == 0 {
+					badFields[objectField] = fmt.Sprintf("This is a required field for a %q credential library.", credType)
+				}
+			}
+			validateStaticAttributes(attrs, credType, badFields)
 		default:
 			badFields[globals.CredentialStoreIdField] = "This field must be a valid credential store id."
 		}
@@ -578,18 +876,40 @@ func validateUpdateRequest(req *pbs.UpdateCredentialLibraryRequest) error {
 			if b := attrs.GetHttpRequestBody(); b != nil && strings.ToUpper(attrs.GetHttpMethod().GetValue()) == "GET" {
 				badFields[httpRequestBodyField] = fmt.Sprintf("Field can only be set if %q is set to the value 'POST'.", httpMethodField)
 			}
+		case credstatic.Subtype:
+			if req.GetItem().GetType() != "" && credential.SubtypeFromType(req.GetItem().GetType()) != credstatic.Subtype {
+				badFields[globals.TypeField] = "Cannot modify resource type."
+			}
+			attrs := &pb.StaticCredentialLibraryAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), attrs); err != nil {
+				badFields[globals.AttributesField] = "Attribute fields do not match the expected format."
+				break
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), credentialTypeField) {
+				badFields[credentialTypeField] = "This field cannot be updated."
+			}
+			n := 0
+			for _, f := range []string{passwordField, privateKeyField, objectField} {
+				if handlers.MaskContains(req.GetUpdateMask().GetPaths(), f) {
+					n++
+				}
+			}
+			if n > 1 {
+				badFields["update_mask"] = "Only one secret field can be updated at a time."
+			}
+			validateStaticAttributes(attrs, "", badFields)
 		}
 		return badFields
-	}, vault.CredentialLibraryPrefix)
+	}, vault.CredentialLibraryPrefix, credstatic.CredentialLibraryPrefix)
 }
 
 func validateDeleteRequest(req *pbs.DeleteCredentialLibraryRequest) error {
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, vault.CredentialLibraryPrefix)
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, vault.CredentialLibraryPrefix, credstatic.CredentialLibraryPrefix)
 }
 
 func validateListRequest(req *pbs.ListCredentialLibrariesRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(handlers.Id(req.GetCredentialStoreId()), vault.CredentialStorePrefix) {
+	if !handlers.ValidId(handlers.Id(req.GetCredentialStoreId()), vault.CredentialStorePrefix, credstatic.CredentialStorePrefix) {
 		badFields[globals.CredentialStoreIdField] = "This field must be a valid credential store id."
 	}
 	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
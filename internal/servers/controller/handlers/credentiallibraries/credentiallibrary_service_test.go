@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -47,6 +48,9 @@ func TestList(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
+	staticRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
 
 	_, prjNoLibs := iam.TestScopes(t, iamRepo)
 	storeNoLibs := vault.TestCredentialStores(t, conn, wrapper, prjNoLibs.GetPublicId(), 1)[0]
@@ -109,7 +113,7 @@ func TestList(t *testing.T) {
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			s, err := NewService(repoFn, iamRepoFn)
+			s, err := NewService(repoFn, staticRepoFn, iamRepoFn)
 			require.NoError(t, err, "Couldn't create new host set service.")
 
 			// Test non-anonymous listing
@@ -156,6 +160,9 @@ func TestCreate(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
+	staticRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	store := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
@@ -408,7 +415,7 @@ func TestCreate(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := NewService(repoFn, iamRepoFn)
+			s, err := NewService(repoFn, staticRepoFn, iamRepoFn)
 			require.NoError(err, "Error when getting new credential store service.")
 
 			got, gErr := s.CreateCredentialLibrary(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), tc.req)
@@ -457,12 +464,15 @@ func TestGet(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
+	staticRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 
 	store := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
 	vl := vault.TestCredentialLibraries(t, conn, wrapper, store.GetPublicId(), 1)[0]
-	s, err := NewService(repoFn, iamRepoFn)
+	s, err := NewService(repoFn, staticRepoFn, iamRepoFn)
 	require.NoError(t, err)
 
 	cases := []struct {
@@ -543,12 +553,15 @@ func TestDelete(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
+	staticRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 
 	store := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
 	vl := vault.TestCredentialLibraries(t, conn, wrapper, store.GetPublicId(), 1)[0]
-	s, err := NewService(repoFn, iamRepoFn)
+	s, err := NewService(repoFn, staticRepoFn, iamRepoFn)
 	require.NoError(t, err)
 
 	cases := []struct {
@@ -603,11 +616,14 @@ func TestUpdate(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
+	staticRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
 
-	s, err := NewService(repoFn, iamRepoFn)
+	s, err := NewService(repoFn, staticRepoFn, iamRepoFn)
 	require.NoError(t, err)
 	cs := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 2)
 	store, diffStore := cs[0], cs[1]
@@ -879,3 +895,276 @@ func TestUpdate(t *testing.T) {
 		assert.Nil(t, cl.GetItem().GetAttributes().GetFields()["http_request_body"])
 	})
 }
+
+func TestCreate_Static(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	repoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(rw, rw, kms, sche)
+	}
+	staticRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	store := credstatic.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
+
+	attrs := func(a *pb.StaticCredentialLibraryAttributes) *structpb.Struct {
+		s, err := handlers.ProtoToStruct(a)
+		require.NoError(t, err)
+		return s
+	}
+	object, err := structpb.NewStruct(map[string]interface{}{"token": "secret"})
+	require.NoError(t, err)
+
+	cases := []struct {
+		name      string
+		req       *pbs.CreateCredentialLibraryRequest
+		wantAttrs *pb.StaticCredentialLibraryAttributes
+		err       error
+	}{
+		{
+			name: "missing credential type",
+			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+				CredentialStoreId: store.GetPublicId(),
+				Attributes: attrs(&pb.StaticCredentialLibraryAttributes{
+					Username: wrapperspb.String("user"),
+					Password: wrapperspb.String("pass"),
+				}),
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "missing username",
+			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+				CredentialStoreId: store.GetPublicId(),
+				Attributes: attrs(&pb.StaticCredentialLibraryAttributes{
+					CredentialType: wrapperspb.String(string(credstatic.UsernamePasswordType)),
+					Password:       wrapperspb.String("pass"),
+				}),
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "mismatched secret",
+			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+				CredentialStoreId: store.GetPublicId(),
+				Attributes: attrs(&pb.StaticCredentialLibraryAttributes{
+					CredentialType: wrapperspb.String(string(credstatic.UsernamePasswordType)),
+					Username:       wrapperspb.String("user"),
+					PrivateKey:     wrapperspb.String(string(credstatic.TestSshPrivateKey(t))),
+				}),
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "json with username",
+			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+				CredentialStoreId: store.GetPublicId(),
+				Attributes: attrs(&pb.StaticCredentialLibraryAttributes{
+					CredentialType: wrapperspb.String(string(credstatic.JsonType)),
+					Username:       wrapperspb.String("user"),
+					Object:         object,
+				}),
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "read only secret hmac",
+			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+				CredentialStoreId: store.GetPublicId(),
+				Attributes: attrs(&pb.StaticCredentialLibraryAttributes{
+					CredentialType: wrapperspb.String(string(credstatic.UsernamePasswordType)),
+					Username:       wrapperspb.String("user"),
+					Password:       wrapperspb.String("pass"),
+					SecretHmac:     "hmac",
+				}),
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "username password",
+			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+				CredentialStoreId: store.GetPublicId(),
+				Name:              wrapperspb.String("up"),
+				Attributes: attrs(&pb.StaticCredentialLibraryAttributes{
+					CredentialType: wrapperspb.String(string(credstatic.UsernamePasswordType)),
+					Username:       wrapperspb.String("user"),
+					Password:       wrapperspb.String("pass"),
+				}),
+			}},
+			wantAttrs: &pb.StaticCredentialLibraryAttributes{
+				CredentialType: wrapperspb.String(string(credstatic.UsernamePasswordType)),
+				Username:       wrapperspb.String("user"),
+			},
+		},
+		{
+			name: "ssh private key",
+			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+				CredentialStoreId: store.GetPublicId(),
+				Name:              wrapperspb.String("key"),
+				Attributes: attrs(&pb.StaticCredentialLibraryAttributes{
+					CredentialType: wrapperspb.String(string(credstatic.SshPrivateKeyType)),
+					Username:       wrapperspb.String("user"),
+					PrivateKey:     wrapperspb.String(string(credstatic.TestSshPrivateKey(t))),
+				}),
+			}},
+			wantAttrs: &pb.StaticCredentialLibraryAttributes{
+				CredentialType: wrapperspb.String(string(credstatic.SshPrivateKeyType)),
+				Username:       wrapperspb.String("user"),
+			},
+		},
+		{
+			name: "json",
+			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+				CredentialStoreId: store.GetPublicId(),
+				Name:              wrapperspb.String("json"),
+				Attributes: attrs(&pb.StaticCredentialLibraryAttributes{
+					CredentialType: wrapperspb.String(string(credstatic.JsonType)),
+					Object:         object,
+				}),
+			}},
+			wantAttrs: &pb.StaticCredentialLibraryAttributes{
+				CredentialType: wrapperspb.String(string(credstatic.JsonType)),
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			s, err := NewService(repoFn, staticRepoFn, iamRepoFn)
+			require.NoError(err, "Error when getting new credential library service.")
+
+			got, gErr := s.CreateCredentialLibrary(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "CreateCredentialLibrary(...) got error %v, wanted %v", gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.True(strings.HasPrefix(got.GetItem().GetId(), credstatic.CredentialLibraryPrefix+"_"))
+			assert.Equal(credstatic.Subtype.String(), got.GetItem().GetType())
+
+			gotAttrs := &pb.StaticCredentialLibraryAttributes{}
+			require.NoError(handlers.StructToProto(got.GetItem().GetAttributes(), gotAttrs))
+			assert.NotEmpty(gotAttrs.GetSecretHmac())
+			gotAttrs.SecretHmac = ""
+			assert.Empty(cmp.Diff(gotAttrs, tc.wantAttrs, protocmp.Transform()))
+		})
+	}
+}
+
+func TestUpdate_Static(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	repoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(rw, rw, kms, sche)
+	}
+	staticRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	store := credstatic.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
+
+	s, err := NewService(repoFn, staticRepoFn, iamRepoFn)
+	require.NoError(t, err)
+	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
+
+	attrs := func(a *pb.StaticCredentialLibraryAttributes) *structpb.Struct {
+		s, err := handlers.ProtoToStruct(a)
+		require.NoError(t, err)
+		return s
+	}
+
+	cases := []struct {
+		name     string
+		paths    []string
+		item     *pb.CredentialLibrary
+		username string
+		err      error
+	}{
+		{
+			name:  "username",
+			paths: []string{usernameField},
+			item: &pb.CredentialLibrary{
+				Attributes: attrs(&pb.StaticCredentialLibraryAttributes{Username: wrapperspb.String("new-user")}),
+			},
+			username: "new-user",
+		},
+		{
+			name:  "password",
+			paths: []string{passwordField},
+			item: &pb.CredentialLibrary{
+				Attributes: attrs(&pb.StaticCredentialLibraryAttributes{Password: wrapperspb.String("new-pass")}),
+			},
+			username: "user0",
+		},
+		{
+			name:  "mismatched secret",
+			paths: []string{privateKeyField},
+			item: &pb.CredentialLibrary{
+				Attributes: attrs(&pb.StaticCredentialLibraryAttributes{PrivateKey: wrapperspb.String(string(credstatic.TestSshPrivateKey(t)))}),
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name:  "credential type",
+			paths: []string{credentialTypeField},
+			item: &pb.CredentialLibrary{
+				Attributes: attrs(&pb.StaticCredentialLibraryAttributes{CredentialType: wrapperspb.String(string(credstatic.JsonType))}),
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			cl := credstatic.TestCredentialLibraries(t, conn, wrapper, store.GetPublicId(), 1)[0]
+			before, err := s.GetCredentialLibrary(ctx, &pbs.GetCredentialLibraryRequest{Id: cl.GetPublicId()})
+			require.NoError(err)
+
+			item := proto.Clone(tc.item).(*pb.CredentialLibrary)
+			item.Version = cl.GetVersion()
+			got, gErr := s.UpdateCredentialLibrary(ctx, &pbs.UpdateCredentialLibraryRequest{
+				Id:         cl.GetPublicId(),
+				Item:       item,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: tc.paths},
+			})
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "UpdateCredentialLibrary(...) got error %v, wanted %v", gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+
+			gotAttrs := &pb.StaticCredentialLibraryAttributes{}
+			require.NoError(handlers.StructToProto(got.GetItem().GetAttributes(), gotAttrs))
+			beforeAttrs := &pb.StaticCredentialLibraryAttributes{}
+			require.NoError(handlers.StructToProto(before.GetItem().GetAttributes(), beforeAttrs))
+			assert.Equal(tc.username, gotAttrs.GetUsername().GetValue())
+			if handlers.MaskContains(tc.paths, passwordField) {
+				assert.NotEqual(beforeAttrs.GetSecretHmac(), gotAttrs.GetSecretHmac())
+			} else {
+				assert.Equal(beforeAttrs.GetSecretHmac(), gotAttrs.GetSecretHmac())
+			}
+		})
+	}
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/credential"
+	credstatic "github.com/hashicorp/boundary/internal/credential/static"
+	staticstore "github.com/hashicorp/boundary/internal/credential/static/store"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/credential/vault/store"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -37,7 +39,8 @@ const (
 )
 
 var (
-	maskManager handlers.MaskManager
+	vaultMaskManager  handlers.MaskManager
+	staticMaskManager handlers.MaskManager
 
 	// IdActions contains the set of actions that can be performed on
 	// individual resources
@@ -62,22 +65,27 @@ var (
 
 func init() {
 	var err error
-	if maskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&store.CredentialStore{}, &store.Token{}, &store.ClientCertificate{}},
+	if vaultMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&store.CredentialStore{}, &store.Token{}, &store.ClientCertificate{}},
 		handlers.MaskSource{&pb.CredentialStore{}, &pb.VaultCredentialStoreAttributes{}}); err != nil {
 		panic(err)
 	}
+	if staticMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&staticstore.CredentialStore{}},
+		handlers.MaskSource{&pb.CredentialStore{}}); err != nil {
+		panic(err)
+	}
 }
 
 // Service handles request as described by the pbs.CredentialStoreServiceServer interface.
 type Service struct {
 	pbs.UnimplementedCredentialStoreServiceServer
 
-	iamRepoFn common.IamRepoFactory
-	repoFn    common.VaultCredentialRepoFactory
+	iamRepoFn    common.IamRepoFactory
+	repoFn       common.VaultCredentialRepoFactory
+	staticRepoFn common.StaticCredentialRepoFactory
 }
 
 // NewService returns a credential store service which handles credential store related requests to boundary.
-func NewService(repo common.VaultCredentialRepoFactory, iamRepo common.IamRepoFactory) (Service, error) {
+func NewService(repo common.VaultCredentialRepoFactory, staticRepo common.StaticCredentialRepoFactory, iamRepo common.IamRepoFactory) (Service, error) {
 	const op = "credentialstores.NewService"
 	if iamRepo == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing iam repository")
@@ -85,7 +93,10 @@ func NewService(repo common.VaultCredentialRepoFactory, iamRepo common.IamRepoFa
 	if repo == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing vault credential repository")
 	}
-	return Service{iamRepoFn: iamRepo, repoFn: repo}, nil
+	if staticRepo == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing static credential repository")
+	}
+	return Service{iamRepoFn: iamRepo, repoFn: repo, staticRepoFn: staticRepo}, nil
 }
 
 var _ pbs.CredentialStoreServiceServer = Service{}
@@ -326,68 +337,156 @@ func (s Service) DeleteCredentialStore(ctx context.Context, req *pbs.DeleteCrede
 	return nil, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]*vault.CredentialStore, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]credential.Store, error) {
 	const op = "credentialstores.(Service).listFromRepo"
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
-	csl, err := repo.ListCredentialStores(ctx, scopeIds)
+	vaultStores, err := repo.ListCredentialStores(ctx, scopeIds)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
-	return csl, nil
-}
+	var csl []credential.Store
+	for _, cs := range vaultStores {
+		csl = append(csl, cs)
+	}
 
-func (s Service) getFromRepo(ctx context.Context, id string) (credential.Store, error) {
-	const op = "credentialstores.(Service).getFromRepo"
-	repo, err := s.repoFn()
+	staticRepo, err := s.staticRepoFn()
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
-	cs, err := repo.LookupCredentialStore(ctx, id)
-	if err != nil && !errors.IsNotFoundError(err) {
+	staticStores, err := staticRepo.ListCredentialStores(ctx, scopeIds)
+	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
+	for _, cs := range staticStores {
+		csl = append(csl, cs)
+	}
+	return csl, nil
+}
+
+func (s Service) getFromRepo(ctx context.Context, id string) (credential.Store, error) {
+	const op = "credentialstores.(Service).getFromRepo"
+	var cs credential.Store
+	switch credential.SubtypeFromId(id) {
+	case vault.Subtype:
+		repo, err := s.repoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		vcs, err := repo.LookupCredentialStore(ctx, id)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if vcs != nil {
+			cs = vcs
+		}
+	case credstatic.Subtype:
+		repo, err := s.staticRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		scs, err := repo.LookupCredentialStore(ctx, id)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if scs != nil {
+			cs = scs
+		}
+	}
 	if cs == nil {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("credential store %q not found", id))
 	}
-	return cs, err
+	return cs, nil
 }
 
 func (s Service) createInRepo(ctx context.Context, projId string, item *pb.CredentialStore) (credential.Store, error) {
 	const op = "credentialstores.(Service).createInRepo"
+	var out credential.Store
+	switch credential.SubtypeFromType(item.GetType()) {
+	case credstatic.Subtype:
+		cs, err := toStorageStaticStore(projId, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.staticRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		scs, err := repo.CreateCredentialStore(ctx, cs)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential store"))
+		}
+		if scs != nil {
+			out = scs
+		}
+	default:
+		cs, err := toStorageVaultStore(projId, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.repoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		vcs, err := repo.CreateCredentialStore(ctx, cs)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential store"))
+		}
+		if vcs != nil {
+			out = vcs
+		}
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create credential store but no error returned from repository.")
+	}
+	return out, nil
+}
+
+func (s Service) updateInRepo(ctx context.Context, projId, id string, mask []string, item *pb.CredentialStore) (credential.Store, error) {
+	const op = "credentialstores.(Service).updateInRepo"
+	switch credential.SubtypeFromId(id) {
+	case credstatic.Subtype:
+		return s.updateStaticInRepo(ctx, projId, id, mask, item)
+	}
 	cs, err := toStorageVaultStore(projId, item)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
+	cs.PublicId = id
+
+	dbMask := vaultMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
-	out, err := repo.CreateCredentialStore(ctx, cs)
+	out, rowsUpdated, err := repo.UpdateCredentialStore(ctx, cs, item.GetVersion(), dbMask)
 	if err != nil {
-		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential store"))
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential store"))
 	}
-	if out == nil {
-		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create credential store but no error returned from repository.")
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Credential Store %q doesn't exist or incorrect version provided.", id)
 	}
 	return out, nil
 }
 
-func (s Service) updateInRepo(ctx context.Context, projId, id string, mask []string, item *pb.CredentialStore) (credential.Store, error) {
-	const op = "credentialstores.(Service).updateInRepo"
-	cs, err := toStorageVaultStore(projId, item)
+func (s Service) updateStaticInRepo(ctx context.Context, projId, id string, mask []string, item *pb.CredentialStore) (credential.Store, error) {
+	const op = "credentialstores.(Service).updateStaticInRepo"
+	cs, err := toStorageStaticStore(projId, item)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
 	cs.PublicId = id
 
-	dbMask := maskManager.Translate(mask)
+	dbMask := staticMaskManager.Translate(mask)
 	if len(dbMask) == 0 {
 		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
 	}
-	repo, err := s.repoFn()
+	repo, err := s.staticRepoFn()
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
@@ -403,11 +502,22 @@ func (s Service) updateInRepo(ctx context.Context, projId, id string, mask []str
 
 func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	const op = "credentialstores.(Service).deleteFromRepo"
-	repo, err := s.repoFn()
-	if err != nil {
-		return false, err
+	var rows int
+	var err error
+	switch credential.SubtypeFromId(id) {
+	case credstatic.Subtype:
+		repo, rErr := s.staticRepoFn()
+		if rErr != nil {
+			return false, rErr
+		}
+		rows, err = repo.DeleteCredentialStore(ctx, id)
+	default:
+		repo, rErr := s.repoFn()
+		if rErr != nil {
+			return false, rErr
+		}
+		rows, err = repo.DeleteCredentialStore(ctx, id)
 	}
-	rows, err := repo.DeleteCredentialStore(ctx, id)
 	if err != nil {
 		if errors.IsNotFoundError(err) {
 			return false, nil
@@ -424,11 +534,6 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 		res.Error = err
 		return res
 	}
-	repo, err := s.repoFn()
-	if err != nil {
-		res.Error = err
-		return res
-	}
 
 	var parentId string
 	opts := []auth.Option{auth.WithType(resource.CredentialStore), auth.WithAction(a)}
@@ -445,10 +550,36 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 			return res
 		}
 	default:
-		cs, err := repo.LookupCredentialStore(ctx, id)
-		if err != nil {
-			res.Error = err
-			return res
+		var cs credential.Store
+		switch credential.SubtypeFromId(id) {
+		case vault.Subtype:
+			repo, err := s.repoFn()
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			vcs, err := repo.LookupCredentialStore(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if vcs != nil {
+				cs = vcs
+			}
+		case credstatic.Subtype:
+			repo, err := s.staticRepoFn()
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			scs, err := repo.LookupCredentialStore(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if scs != nil {
+				cs = scs
+			}
 		}
 		if cs == nil {
 			res.Error = handlers.NotFoundError()
@@ -601,13 +732,29 @@ func toStorageVaultStore(scopeId string, in *pb.CredentialStore) (out *vault.Cre
 	return cs, err
 }
 
+func toStorageStaticStore(scopeId string, in *pb.CredentialStore) (out *credstatic.CredentialStore, err error) {
+	const op = "credentialstores.toStorageStaticStore"
+	var opts []credstatic.Option
+	if in.GetName() != nil {
+		opts = append(opts, credstatic.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, credstatic.WithDescription(in.GetDescription().GetValue()))
+	}
+	cs, err := credstatic.NewCredentialStore(scopeId, opts...)
+	if err != nil {
+		return nil, errors.WrapDeprecated(err, op, errors.WithMsg("unable to build credential store for creation"))
+	}
+	return cs, err
+}
+
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
 //  * The path passed in is correctly formatted
 //  * All required parameters are set
 //  * There are no conflicting parameters provided
 func validateGetRequest(req *pbs.GetCredentialStoreRequest) error {
-	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, vault.CredentialStorePrefix)
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, vault.CredentialStorePrefix, credstatic.CredentialStorePrefix)
 }
 
 func validateCreateRequest(req *pbs.CreateCredentialStoreRequest) error {
@@ -649,6 +796,10 @@ func validateCreateRequest(req *pbs.CreateCredentialStoreRequest) error {
 			if len(cs) > 0 && pk == nil {
 				badFields[clientCertField] = "Cannot set a client certificate without a private key."
 			}
+		case credstatic.Subtype:
+			if len(req.GetItem().GetAttributes().GetFields()) > 0 {
+				badFields[globals.AttributesField] = "Attributes are not supported for static credential stores."
+			}
 		default:
 			badFields[globals.TypeField] = "This is a required field and must be a known credential store type."
 		}
@@ -691,13 +842,20 @@ func validateUpdateRequest(req *pbs.UpdateCredentialStoreRequest) error {
 			if err != nil {
 				badFields[clientCertField] = fmt.Sprintf("Invalid values: %q", err.Error())
 			}
+		case credstatic.Subtype:
+			if req.GetItem().GetType() != "" && credential.SubtypeFromType(req.GetItem().GetType()) != credstatic.Subtype {
+				badFields["type"] = "Cannot modify resource type."
+			}
+			if len(req.GetItem().GetAttributes().GetFields()) > 0 {
+				badFields[globals.AttributesField] = "Attributes are not supported for static credential stores."
+			}
 		}
 		return badFields
-	}, vault.CredentialStorePrefix)
+	}, vault.CredentialStorePrefix, credstatic.CredentialStorePrefix)
 }
 
 func validateDeleteRequest(req *pbs.DeleteCredentialStoreRequest) error {
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, vault.CredentialStorePrefix)
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, vault.CredentialStorePrefix, credstatic.CredentialStorePrefix)
 }
 
 func validateListRequest(req *pbs.ListCredentialStoresRequest) error {
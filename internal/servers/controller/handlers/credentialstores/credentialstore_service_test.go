@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -63,6 +64,9 @@ func TestList(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
+	staticRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
 
 	_, prjNoStores := iam.TestScopes(t, iamRepo)
 	_, prj := iam.TestScopes(t, iamRepo)
@@ -127,7 +131,7 @@ func TestList(t *testing.T) {
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			s, err := NewService(repoFn, iamRepoFn)
+			s, err := NewService(repoFn, staticRepoFn, iamRepoFn)
 			require.NoError(t, err, "Couldn't create new host set service.")
 
 			// Test non-anonymous listing
@@ -169,6 +173,9 @@ func TestCreate(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
+	staticRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	defaultCreated := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0].GetCreateTime().GetTimestamp()
@@ -522,7 +529,7 @@ func TestCreate(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := NewService(repoFn, iamRepoFn)
+			s, err := NewService(repoFn, staticRepoFn, iamRepoFn)
 			require.NoError(err, "Error when getting new credential store service.")
 			defer cleanup(s)
 
@@ -580,11 +587,14 @@ func TestGet(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
+	staticRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 
 	store := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
-	s, err := NewService(repoFn, iamRepoFn)
+	s, err := NewService(repoFn, staticRepoFn, iamRepoFn)
 	require.NoError(t, err)
 
 	cases := []struct {
@@ -670,11 +680,14 @@ func TestDelete(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
+	staticRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 
 	store := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 2)[0]
-	s, err := NewService(repoFn, iamRepoFn)
+	s, err := NewService(repoFn, staticRepoFn, iamRepoFn)
 	require.NoError(t, err)
 
 	cases := []struct {
@@ -731,11 +744,14 @@ func TestUpdate(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
+	staticRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
 
-	s, err := NewService(repoFn, iamRepoFn)
+	s, err := NewService(repoFn, staticRepoFn, iamRepoFn)
 	require.NoError(t, err)
 
 	fieldmask := func(paths ...string) *fieldmaskpb.FieldMask {
@@ -1061,3 +1077,72 @@ func TestUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestCrud_Static(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	repoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(rw, rw, kms, sche)
+	}
+	staticRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)
+
+	assert, require := assert.New(t), require.New(t)
+	s, err := NewService(repoFn, staticRepoFn, iamRepoFn)
+	require.NoError(err)
+	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
+
+	attrs, err := structpb.NewStruct(map[string]interface{}{"address": "http://vault"})
+	require.NoError(err)
+	_, err = s.CreateCredentialStore(ctx, &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+		ScopeId:    prj.GetPublicId(),
+		Type:       credstatic.Subtype.String(),
+		Attributes: attrs,
+	}})
+	require.Error(err)
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)))
+
+	created, err := s.CreateCredentialStore(ctx, &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+		ScopeId: prj.GetPublicId(),
+		Type:    credstatic.Subtype.String(),
+		Name:    wrapperspb.String("static"),
+	}})
+	require.NoError(err)
+	id := created.GetItem().GetId()
+	assert.True(strings.HasPrefix(id, credstatic.CredentialStorePrefix+"_"))
+	assert.Equal(credstatic.Subtype.String(), created.GetItem().GetType())
+	assert.Nil(created.GetItem().GetAttributes())
+
+	got, err := s.GetCredentialStore(ctx, &pbs.GetCredentialStoreRequest{Id: id})
+	require.NoError(err)
+	assert.Equal("static", got.GetItem().GetName().GetValue())
+
+	updated, err := s.UpdateCredentialStore(ctx, &pbs.UpdateCredentialStoreRequest{
+		Id:         id,
+		Item:       &pb.CredentialStore{Description: wrapperspb.String("desc"), Version: got.GetItem().GetVersion()},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+	})
+	require.NoError(err)
+	assert.Equal("desc", updated.GetItem().GetDescription().GetValue())
+
+	list, err := s.ListCredentialStores(ctx, &pbs.ListCredentialStoresRequest{ScopeId: prj.GetPublicId()})
+	require.NoError(err)
+	assert.Len(list.GetItems(), 2)
+
+	_, err = s.DeleteCredentialStore(ctx, &pbs.DeleteCredentialStoreRequest{Id: id})
+	require.NoError(err)
+	_, err = s.GetCredentialStore(ctx, &pbs.GetCredentialStoreRequest{Id: id})
+	require.Error(err)
+}
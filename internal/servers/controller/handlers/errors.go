@@ -150,6 +150,19 @@ func InvalidArgumentErrorf(msg string, fields map[string]string) *ApiError {
 	return apiErr
 }
 
+// SessionLimitErrorf returns an ApiError indicating a session limit has been
+// reached. The details describe the limit and the sessions holding it.
+func SessionLimitErrorf(details *pb.SessionLimitDetails, msg string, a ...interface{}) *ApiError {
+	return &ApiError{
+		Status: int32(runtime.HTTPStatusFromCode(codes.ResourceExhausted)),
+		Inner: &pb.Error{
+			Kind:    codes.ResourceExhausted.String(),
+			Message: fmt.Sprintf(msg, a...),
+			Details: &pb.ErrorDetails{SessionLimit: details},
+		},
+	}
+}
+
 var statusRegEx = regexp.MustCompile("Status: ([0-9]+), Kind: \"(.*)\", Error: \"(.*)\"")
 
 // Converts a known errors into an error that can presented to an end user over the API.
@@ -180,6 +193,14 @@ func backendErrorToApiError(inErr error) *ApiError {
 		return NotFoundErrorf(genericNotFoundMsg)
 	case errors.Match(errors.T(errors.AccountAlreadyAssociated), inErr):
 		return InvalidArgumentErrorf(inErr.Error(), nil)
+	case errors.Match(errors.T(errors.UserDisabled), inErr):
+		return &ApiError{
+			Status: http.StatusUnauthorized,
+			Inner: &pb.Error{
+				Kind:    codes.Unauthenticated.String(),
+				Message: "User is disabled.",
+			},
+		}
 	case errors.Match(errors.T(errors.InvalidFieldMask), inErr), errors.Match(errors.T(errors.EmptyFieldMask), inErr):
 		return InvalidArgumentErrorf("Error in provided request", map[string]string{"update_mask": "Invalid update mask provided."})
 	case errors.IsUniqueError(inErr):
@@ -71,6 +71,30 @@ func TestApiErrorHandler(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Session Limit",
+			err: SessionLimitErrorf(&pb.SessionLimitDetails{
+				Kind:       "target",
+				ResourceId: "ttcp_1234567890",
+				Limit:      1,
+				Holders:    []*pb.SessionHolder{{SessionId: "s_1234567890", UserId: "u_1234567890", TargetId: "ttcp_1234567890", Status: "active"}},
+			}, "Test"),
+			expected: ApiError{
+				Status: http.StatusTooManyRequests,
+				Inner: &pb.Error{
+					Kind:    "ResourceExhausted",
+					Message: "Test",
+					Details: &pb.ErrorDetails{
+						SessionLimit: &pb.SessionLimitDetails{
+							Kind:       "target",
+							ResourceId: "ttcp_1234567890",
+							Limit:      1,
+							Holders:    []*pb.SessionHolder{{SessionId: "s_1234567890", UserId: "u_1234567890", TargetId: "ttcp_1234567890", Status: "active"}},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "GrpcGateway Routing Error",
 			err:  runtime.ErrNotMatch,
@@ -194,6 +218,17 @@ func TestApiErrorHandler(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Domain error user disabled",
+			err:  errors.E(ctx, errors.WithCode(errors.UserDisabled)),
+			expected: ApiError{
+				Status: http.StatusUnauthorized,
+				Inner: &pb.Error{
+					Kind:    "Unauthenticated",
+					Message: "User is disabled.",
+				},
+			},
+		},
 		{
 			name: "Wrapped domain error",
 			err:  errors.E(ctx, errors.WithCode(errors.InvalidAddress), errors.WithMsg("test msg"), errors.WithWrap(errors.E(ctx, errors.WithCode(errors.NotNull), errors.WithMsg("inner msg")))),
@@ -1,9 +1,11 @@
 package handlers
 
 import (
+	"context"
 	"testing"
 
 	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/session/recording"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -86,4 +88,17 @@ func Test_GetOpts(t *testing.T) {
 		opts = GetOpts(WithHostSetIds(out))
 		require.Equal(out, opts.WithHostSetIds)
 	})
+	t.Run("WithRecordingStorage", func(t *testing.T) {
+		assert := assert.New(t)
+		require := require.New(t)
+
+		opts := GetOpts()
+		assert.Nil(opts.WithRecordingStorage)
+
+		storage, err := recording.NewLocalStorage(context.Background(), t.TempDir())
+		require.NoError(err)
+
+		opts = GetOpts(WithRecordingStorage(storage))
+		require.Equal(storage, opts.WithRecordingStorage)
+	})
 }
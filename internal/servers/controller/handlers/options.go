@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/session/recording"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/plugins"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -33,6 +34,7 @@ type options struct {
 	WithManagedGroupIds             []string
 	WithMemberIds                   []string
 	WithHostSetIds                  []string
+	WithRecordingStorage            recording.Storage
 }
 
 func getDefaultOptions() options {
@@ -119,3 +121,11 @@ func WithHostSetIds(ids []string) Option {
 		o.WithHostSetIds = ids
 	}
 }
+
+// WithRecordingStorage provides an option to specify the storage session
+// recordings are read from
+func WithRecordingStorage(s recording.Storage) Option {
+	return func(o *options) {
+		o.WithRecordingStorage = s
+	}
+}
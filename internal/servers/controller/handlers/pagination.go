@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// MaxPageSize is the largest number of items a single page of a list response
+// will contain. Requests for larger pages are capped to this size.
+const MaxPageSize = 1000
+
+// listToken is the decoded form of the opaque list token handed to clients
+// so they can request the page following the one they received.
+type listToken struct {
+	// LastId is the public id of the last item examined while building the
+	// previous page. The next page starts with the item sorted after it.
+	LastId string `json:"last_id"`
+	// Fingerprint identifies the parameters of the list request the token was
+	// issued for, so it can't be reused with a different listing.
+	Fingerprint string `json:"fingerprint"`
+}
+
+// Pagination holds the paging state of a list request.
+type Pagination struct {
+	// PageSize is the maximum number of items to return. Zero means the
+	// request is not paginated.
+	PageSize int
+	// StartAfterId is the public id after which the page starts. It is empty
+	// for the first page.
+	StartAfterId string
+
+	fingerprint string
+}
+
+// NewPagination returns the Pagination for a list request with the provided
+// page size and list token. The request parameters, such as the scope id,
+// recursive flag and filter, must be provided in the same order every time
+// as they are used to make sure a list token is only used with the listing
+// it was issued for. A zero page size with no list token leaves the request
+// unpaginated.
+func NewPagination(pageSize uint32, token string, requestParams ...string) (*Pagination, error) {
+	const op = "handlers.NewPagination"
+	p := &Pagination{
+		PageSize:    int(pageSize),
+		fingerprint: fingerprint(requestParams...),
+	}
+	if p.PageSize > MaxPageSize {
+		p.PageSize = MaxPageSize
+	}
+	if token == "" {
+		return p, nil
+	}
+	if p.PageSize == 0 {
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "a list token requires a page size")
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.WrapDeprecated(err, op, errors.WithMsg("couldn't decode list token"), errors.WithCode(errors.InvalidParameter))
+	}
+	var lt listToken
+	if err := json.Unmarshal(raw, &lt); err != nil {
+		return nil, errors.WrapDeprecated(err, op, errors.WithMsg("couldn't decode list token"), errors.WithCode(errors.InvalidParameter))
+	}
+	if lt.LastId == "" {
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "list token is missing its position")
+	}
+	if lt.Fingerprint != p.fingerprint {
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "list token was issued for a different list request")
+	}
+	p.StartAfterId = lt.LastId
+	return p, nil
+}
+
+// Paginated returns true if the list request asked for a page of results.
+func (p *Pagination) Paginated() bool {
+	return p.PageSize > 0
+}
+
+// ListToken returns the opaque list token used to request the page that
+// starts after the item with the provided public id.
+func (p *Pagination) ListToken(lastId string) string {
+	// Marshaling a struct of strings can't fail.
+	raw, _ := json.Marshal(listToken{LastId: lastId, Fingerprint: p.fingerprint})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func fingerprint(params ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(params, "\x00")))
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPagination(t *testing.T) {
+	issuer, err := NewPagination(10, "", "o_1234567890", "true", "")
+	require.NoError(t, err)
+	token := issuer.ListToken("ttcp_1234567890")
+
+	cases := []struct {
+		name         string
+		pageSize     uint32
+		token        string
+		params       []string
+		wantPageSize int
+		wantAfterId  string
+		wantErr      bool
+	}{
+		{
+			name:   "unpaginated",
+			params: []string{"o_1234567890", "true", ""},
+		},
+		{
+			name:         "first page",
+			pageSize:     10,
+			params:       []string{"o_1234567890", "true", ""},
+			wantPageSize: 10,
+		},
+		{
+			name:         "capped page size",
+			pageSize:     MaxPageSize + 1,
+			params:       []string{"o_1234567890", "true", ""},
+			wantPageSize: MaxPageSize,
+		},
+		{
+			name:         "next page",
+			pageSize:     5,
+			token:        token,
+			params:       []string{"o_1234567890", "true", ""},
+			wantPageSize: 5,
+			wantAfterId:  "ttcp_1234567890",
+		},
+		{
+			name:    "token without page size",
+			token:   token,
+			params:  []string{"o_1234567890", "true", ""},
+			wantErr: true,
+		},
+		{
+			name:     "different filter",
+			pageSize: 10,
+			token:    token,
+			params:   []string{"o_1234567890", "true", `"/item/name"=="foo"`},
+			wantErr:  true,
+		},
+		{
+			name:     "different scope",
+			pageSize: 10,
+			token:    token,
+			params:   []string{"p_1234567890", "true", ""},
+			wantErr:  true,
+		},
+		{
+			name:     "not base64",
+			pageSize: 10,
+			token:    "not a token!",
+			params:   []string{"o_1234567890", "true", ""},
+			wantErr:  true,
+		},
+		{
+			name:     "not json",
+			pageSize: 10,
+			token:    "bm90IGpzb24",
+			params:   []string{"o_1234567890", "true", ""},
+			wantErr:  true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			p, err := NewPagination(tc.pageSize, tc.token, tc.params...)
+			if tc.wantErr {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tc.wantPageSize, p.PageSize)
+			assert.Equal(tc.wantPageSize > 0, p.Paginated())
+			assert.Equal(tc.wantAfterId, p.StartAfterId)
+		})
+	}
+}
@@ -0,0 +1,274 @@
+package plugins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/plugin/host"
+	"github.com/hashicorp/boundary/internal/requests"
+	"github.com/hashicorp/boundary/internal/servers/controller/auth"
+	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/plugins"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	// IdActions contains the set of actions that can be performed on
+	// individual resources
+	IdActions = action.ActionSet{
+		action.NoOp,
+		action.Read,
+	}
+
+	// CollectionActions contains the set of actions that can be performed on
+	// this collection
+	CollectionActions = action.ActionSet{
+		action.List,
+	}
+)
+
+// Service handles request as described by the pbs.PluginServiceServer interface.
+type Service struct {
+	pbs.UnimplementedPluginServiceServer
+
+	repoFn common.HostPluginRepoFactory
+
+	// pluginInfo contains the information about the host plugins loaded by
+	// this controller, keyed by plugin id.
+	pluginInfo map[string]*host.Info
+}
+
+// NewService returns a plugin service which handles plugin related requests
+// to boundary. pluginInfo contains the information about the host plugins
+// loaded by the controller, keyed by plugin id, and must not be modified
+// afterwards.
+func NewService(repo common.HostPluginRepoFactory, pluginInfo map[string]*host.Info) (Service, error) {
+	const op = "plugins.NewService"
+	if repo == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing host plugin repository")
+	}
+	return Service{repoFn: repo, pluginInfo: pluginInfo}, nil
+}
+
+var _ pbs.PluginServiceServer = Service{}
+
+// ListPlugins implements the interface pbs.PluginServiceServer.
+func (s Service) ListPlugins(ctx context.Context, req *pbs.ListPluginsRequest) (*pbs.ListPluginsResponse, error) {
+	if err := validateListRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+
+	pl, err := s.listFromRepo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(pl) == 0 {
+		return &pbs.ListPluginsResponse{}, nil
+	}
+
+	filter, err := handlers.NewFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	finalItems := make([]*pb.Plugin, 0, len(pl))
+	res := perms.Resource{
+		Type:    resource.Plugin,
+		ScopeId: scope.Global.String(),
+	}
+	for _, item := range pl {
+		res.Id = item.GetPublicId()
+		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
+		if len(authorizedActions) == 0 {
+			continue
+		}
+
+		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+		outputOpts := make([]handlers.Option, 0, 3)
+		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+		if outputFields.Has(globals.ScopeField) {
+			outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+		}
+		if outputFields.Has(globals.AuthorizedActionsField) {
+			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+		}
+
+		item, err := toProto(ctx, item, s.pluginInfo[item.GetPublicId()], outputOpts...)
+		if err != nil {
+			return nil, err
+		}
+
+		if filter.Match(item) {
+			finalItems = append(finalItems, item)
+		}
+	}
+	return &pbs.ListPluginsResponse{Items: finalItems}, nil
+}
+
+// GetPlugin implements the interface pbs.PluginServiceServer.
+func (s Service) GetPlugin(ctx context.Context, req *pbs.GetPluginRequest) (*pbs.GetPluginResponse, error) {
+	const op = "plugins.(Service).GetPlugin"
+
+	if err := validateGetRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Read)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	p, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, p.GetPublicId(), IdActions).Strings()))
+	}
+
+	item, err := toProto(ctx, p, s.pluginInfo[p.GetPublicId()], outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.GetPluginResponse{Item: item}, nil
+}
+
+func (s Service) getFromRepo(ctx context.Context, id string) (*host.Plugin, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	p, err := repo.LookupPlugin(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return nil, handlers.NotFoundErrorf("Plugin %q doesn't exist.", id)
+	}
+	return p, nil
+}
+
+func (s Service) listFromRepo(ctx context.Context) ([]*host.Plugin, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	pl, err := repo.ListPlugins(ctx, []string{scope.Global.String()})
+	if err != nil {
+		return nil, err
+	}
+	return pl, nil
+}
+
+func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
+	res := auth.VerifyResults{}
+	opts := []auth.Option{auth.WithType(resource.Plugin), auth.WithAction(a), auth.WithScopeId(scope.Global.String())}
+	switch a {
+	case action.List:
+	default:
+		repo, err := s.repoFn()
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		p, err := repo.LookupPlugin(ctx, id)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if p == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+		opts = append(opts, auth.WithId(id))
+	}
+	return auth.Verify(ctx, opts...)
+}
+
+func toProto(ctx context.Context, in *host.Plugin, info *host.Info, opt ...handlers.Option) (*pb.Plugin, error) {
+	opts := handlers.GetOpts(opt...)
+	if opts.WithOutputFields == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "output fields not found when building plugin proto")
+	}
+	outputFields := *opts.WithOutputFields
+	out := pb.Plugin{}
+	if outputFields.Has(globals.IdField) {
+		out.Id = in.GetPublicId()
+	}
+	if outputFields.Has(globals.ScopeIdField) {
+		out.ScopeId = in.GetScopeId()
+	}
+	if outputFields.Has(globals.DescriptionField) && in.GetDescription() != "" {
+		out.Description = wrapperspb.String(in.GetDescription())
+	}
+	if outputFields.Has(globals.NameField) && in.GetName() != "" {
+		out.Name = wrapperspb.String(in.GetName())
+	}
+	if outputFields.Has(globals.CreatedTimeField) {
+		out.CreatedTime = in.GetCreateTime().GetTimestamp()
+	}
+	if outputFields.Has(globals.UpdatedTimeField) {
+		out.UpdatedTime = in.GetUpdateTime().GetTimestamp()
+	}
+	if outputFields.Has(globals.VersionField) {
+		out.Version = in.GetVersion()
+	}
+	if outputFields.Has(globals.PluginVersionField) {
+		out.PluginVersion = info.GetVersion()
+	}
+	if outputFields.Has(globals.CatalogAttributesField) {
+		out.CatalogAttributes = info.GetCatalogAttributes()
+	}
+	if outputFields.Has(globals.ScopeField) {
+		out.Scope = opts.WithScope
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		out.AuthorizedActions = opts.WithAuthorizedActions
+	}
+	return &out, nil
+}
+
+// A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
+// requirements on the structure of the request.  They verify that:
+//  * The path passed in is correctly formatted
+//  * All required parameters are set
+//  * There are no conflicting parameters provided
+func validateGetRequest(req *pbs.GetPluginRequest) error {
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, host.PluginPrefix)
+}
+
+func validateListRequest(req *pbs.ListPluginsRequest) error {
+	badFields := map[string]string{}
+	if req.GetScopeId() != scope.Global.String() {
+		badFields["scope_id"] = "Must be 'global' when listing."
+	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
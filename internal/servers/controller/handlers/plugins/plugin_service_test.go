@@ -0,0 +1,120 @@
+package plugins_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/boundary/internal/db"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/plugin/host"
+	"github.com/hashicorp/boundary/internal/servers/controller/auth"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/plugins"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/plugins"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var testAuthorizedActions = []string{"no-op", "read"}
+
+func TestPluginService(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	pluginRepo, err := host.NewRepository(rw, rw, kms.TestKms(t, conn, wrap))
+	require.NoError(t, err)
+	pluginRepoFn := func() (*host.Repository, error) {
+		return pluginRepo, nil
+	}
+
+	ctx := context.Background()
+	loaded, err := pluginRepo.LookupPlugin(ctx, host.TestPlugin(t, conn, "loaded").GetPublicId())
+	require.NoError(t, err)
+	unloaded, err := pluginRepo.LookupPlugin(ctx, host.TestPlugin(t, conn, "unloaded").GetPublicId())
+	require.NoError(t, err)
+	info := map[string]*host.Info{
+		loaded.GetPublicId(): {
+			Version:           "0.1.0",
+			CatalogAttributes: []string{"region"},
+		},
+	}
+
+	_, err = plugins.NewService(nil, info)
+	require.Error(t, err)
+	s, err := plugins.NewService(pluginRepoFn, info)
+	require.NoError(t, err)
+	authCtx := auth.DisabledAuthTestContext(iamRepoFn, scope.Global.String())
+
+	globalScope := &scopes.ScopeInfo{Id: scope.Global.String(), Type: scope.Global.String(), Name: scope.Global.String(), Description: "Global Scope"}
+	wantLoaded := &pb.Plugin{
+		Id:                loaded.GetPublicId(),
+		ScopeId:           scope.Global.String(),
+		Scope:             globalScope,
+		Name:              wrapperspb.String("loaded"),
+		CreatedTime:       loaded.GetCreateTime().GetTimestamp(),
+		UpdatedTime:       loaded.GetUpdateTime().GetTimestamp(),
+		Version:           loaded.GetVersion(),
+		PluginVersion:     "0.1.0",
+		CatalogAttributes: []string{"region"},
+		AuthorizedActions: testAuthorizedActions,
+	}
+	wantUnloaded := &pb.Plugin{
+		Id:                unloaded.GetPublicId(),
+		ScopeId:           scope.Global.String(),
+		Scope:             globalScope,
+		Name:              wrapperspb.String("unloaded"),
+		CreatedTime:       unloaded.GetCreateTime().GetTimestamp(),
+		UpdatedTime:       unloaded.GetUpdateTime().GetTimestamp(),
+		Version:           unloaded.GetVersion(),
+		AuthorizedActions: testAuthorizedActions,
+	}
+
+	t.Run("list", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := s.ListPlugins(authCtx, &pbs.ListPluginsRequest{ScopeId: scope.Global.String()})
+		require.NoError(err)
+		assert.Empty(cmp.Diff(&pbs.ListPluginsResponse{Items: []*pb.Plugin{wantLoaded, wantUnloaded}}, got, protocmp.Transform(), protocmp.SortRepeated(func(x, y *pb.Plugin) bool {
+			return x.GetId() < y.GetId()
+		})))
+
+		got, err = s.ListPlugins(authCtx, &pbs.ListPluginsRequest{ScopeId: scope.Global.String(), Filter: `"/item/plugin_version"=="0.1.0"`})
+		require.NoError(err)
+		assert.Empty(cmp.Diff(&pbs.ListPluginsResponse{Items: []*pb.Plugin{wantLoaded}}, got, protocmp.Transform()))
+
+		_, err = s.ListPlugins(authCtx, &pbs.ListPluginsRequest{ScopeId: "o_1234567890"})
+		require.Error(err)
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)))
+	})
+
+	t.Run("get", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := s.GetPlugin(authCtx, &pbs.GetPluginRequest{Id: loaded.GetPublicId()})
+		require.NoError(err)
+		assert.Empty(cmp.Diff(&pbs.GetPluginResponse{Item: wantLoaded}, got, protocmp.Transform()))
+
+		got, err = s.GetPlugin(authCtx, &pbs.GetPluginRequest{Id: unloaded.GetPublicId()})
+		require.NoError(err)
+		assert.Empty(cmp.Diff(&pbs.GetPluginResponse{Item: wantUnloaded}, got, protocmp.Transform()))
+
+		_, err = s.GetPlugin(authCtx, &pbs.GetPluginRequest{Id: host.PluginPrefix + "_DoesntExis"})
+		require.Error(err)
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.NotFound)))
+
+		_, err = s.GetPlugin(authCtx, &pbs.GetPluginRequest{Id: "j_1234567890"})
+		require.Error(err)
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)))
+	})
+}
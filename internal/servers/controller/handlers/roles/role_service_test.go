@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
@@ -1156,6 +1157,21 @@ func TestAddPrincipal(t *testing.T) {
 		}
 	}
 
+	t.Run("Add user with expiration time", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		role := iam.TestRole(t, conn, p.GetPublicId())
+		expiration := time.Now().Add(time.Hour).Truncate(time.Microsecond)
+		got, err := s.AddRolePrincipals(auth.DisabledAuthTestContext(repoFn, o.GetPublicId()), &pbs.AddRolePrincipalsRequest{
+			Id:             role.GetPublicId(),
+			Version:        role.GetVersion(),
+			PrincipalIds:   []string{users[1].GetPublicId()},
+			ExpirationTime: timestamppb.New(expiration),
+		})
+		require.NoError(err)
+		require.Len(got.GetItem().GetPrincipals(), 1)
+		assert.True(expiration.Equal(got.GetItem().GetPrincipals()[0].GetExpirationTime().AsTime()))
+	})
+
 	role := iam.TestRole(t, conn, p.GetPublicId())
 
 	failCases := []struct {
@@ -1189,6 +1205,16 @@ func TestAddPrincipal(t *testing.T) {
 			},
 			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
 		},
+		{
+			name: "Expiration Time In Past",
+			req: &pbs.AddRolePrincipalsRequest{
+				Id:             role.GetPublicId(),
+				Version:        role.GetVersion(),
+				PrincipalIds:   []string{users[0].GetPublicId()},
+				ExpirationTime: timestamppb.New(time.Now().Add(-time.Hour)),
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 	}
 	for _, tc := range failCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -1346,6 +1372,21 @@ func TestSetPrincipal(t *testing.T) {
 		}
 	}
 
+	t.Run("Set user with expiration time", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		role := iam.TestRole(t, conn, p.GetPublicId())
+		expiration := time.Now().Add(time.Hour).Truncate(time.Microsecond)
+		got, err := s.SetRolePrincipals(auth.DisabledAuthTestContext(repoFn, o.GetPublicId()), &pbs.SetRolePrincipalsRequest{
+			Id:             role.GetPublicId(),
+			Version:        role.GetVersion(),
+			PrincipalIds:   []string{users[1].GetPublicId()},
+			ExpirationTime: timestamppb.New(expiration),
+		})
+		require.NoError(err)
+		require.Len(got.GetItem().GetPrincipals(), 1)
+		assert.True(expiration.Equal(got.GetItem().GetPrincipals()[0].GetExpirationTime().AsTime()))
+	})
+
 	role := iam.TestRole(t, conn, p.GetPublicId())
 
 	failCases := []struct {
@@ -1379,6 +1420,16 @@ func TestSetPrincipal(t *testing.T) {
 			},
 			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
 		},
+		{
+			name: "Expiration Time In Past",
+			req: &pbs.SetRolePrincipalsRequest{
+				Id:             role.GetPublicId(),
+				Version:        role.GetVersion(),
+				PrincipalIds:   []string{users[0].GetPublicId()},
+				ExpirationTime: timestamppb.New(time.Now().Add(-time.Hour)),
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 	}
 	for _, tc := range failCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -0,0 +1,396 @@
+package scim
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// filter is a parsed SCIM filter expression. See RFC 7644 section 3.4.2.2.
+// Resources are matched in their JSON representation with all attribute
+// names lower cased, since attribute names are case insensitive.
+type filter interface {
+	match(resource map[string]interface{}) bool
+}
+
+type logicalFilter struct {
+	and         bool
+	left, right filter
+}
+
+func (f *logicalFilter) match(r map[string]interface{}) bool {
+	if f.and {
+		return f.left.match(r) && f.right.match(r)
+	}
+	return f.left.match(r) || f.right.match(r)
+}
+
+type notFilter struct {
+	f filter
+}
+
+func (f *notFilter) match(r map[string]interface{}) bool {
+	return !f.f.match(r)
+}
+
+// valuePathFilter matches if any value of a multi-valued complex attribute
+// matches the nested filter, e.g. members[value eq "u_1234567890"].
+type valuePathFilter struct {
+	path []string
+	f    filter
+}
+
+func (f *valuePathFilter) match(r map[string]interface{}) bool {
+	for _, v := range resolve(r, f.path) {
+		if m, ok := v.(map[string]interface{}); ok && f.f.match(m) {
+			return true
+		}
+	}
+	return false
+}
+
+type attrFilter struct {
+	path  []string
+	op    string
+	value interface{}
+}
+
+func (f *attrFilter) match(r map[string]interface{}) bool {
+	values := resolve(r, f.path)
+	switch f.op {
+	case "pr":
+		for _, v := range values {
+			if v != nil && v != "" {
+				return true
+			}
+		}
+		return false
+	case "ne":
+		for _, v := range values {
+			if compare(v, "eq", f.value) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, v := range values {
+		if compare(v, f.op, f.value) {
+			return true
+		}
+	}
+	return false
+}
+
+// compare applies the comparison operator op to the attribute value v and
+// the filter value. Strings are compared case insensitively.
+func compare(v interface{}, op string, value interface{}) bool {
+	switch a := v.(type) {
+	case string:
+		b, ok := value.(string)
+		if !ok {
+			return false
+		}
+		a, b = strings.ToLower(a), strings.ToLower(b)
+		switch op {
+		case "eq":
+			return a == b
+		case "co":
+			return strings.Contains(a, b)
+		case "sw":
+			return strings.HasPrefix(a, b)
+		case "ew":
+			return strings.HasSuffix(a, b)
+		case "gt":
+			return a > b
+		case "ge":
+			return a >= b
+		case "lt":
+			return a < b
+		case "le":
+			return a <= b
+		}
+	case float64:
+		b, ok := value.(float64)
+		if !ok {
+			return false
+		}
+		switch op {
+		case "eq":
+			return a == b
+		case "gt":
+			return a > b
+		case "ge":
+			return a >= b
+		case "lt":
+			return a < b
+		case "le":
+			return a <= b
+		}
+	case bool:
+		b, ok := value.(bool)
+		return ok && op == "eq" && a == b
+	case nil:
+		return op == "eq" && value == nil
+	}
+	return false
+}
+
+// resolve returns the values of the attribute at path in r. Values of
+// multi-valued attributes are flattened.
+func resolve(r map[string]interface{}, path []string) []interface{} {
+	values := []interface{}{r}
+	for _, name := range path {
+		var next []interface{}
+		for _, v := range values {
+			m, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			switch a := m[name].(type) {
+			case nil:
+			case []interface{}:
+				next = append(next, a...)
+			default:
+				next = append(next, a)
+			}
+		}
+		values = next
+	}
+	return values
+}
+
+// attrPath splits an attribute path into its lower cased components,
+// removing the schema URN prefix if present.
+func attrPath(s string) []string {
+	if i := strings.LastIndex(s, ":"); i >= 0 {
+		s = s[i+1:]
+	}
+	return strings.Split(strings.ToLower(s), ".")
+}
+
+const (
+	tokenWord = iota
+	tokenString
+	tokenOpen
+	tokenClose
+	tokenOpenBracket
+	tokenCloseBracket
+)
+
+type token struct {
+	kind  int
+	value string
+}
+
+func tokenize(s string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case unicode.IsSpace(rune(c)):
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenOpen})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenClose})
+			i++
+		case c == '[':
+			tokens = append(tokens, token{kind: tokenOpenBracket})
+			i++
+		case c == ']':
+			tokens = append(tokens, token{kind: tokenCloseBracket})
+			i++
+		case c == '"':
+			j := i + 1
+			for ; j < len(s) && s[j] != '"'; j++ {
+				if s[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(s) {
+				return nil, fmt.Errorf("unterminated string at position %d", i)
+			}
+			value, err := strconv.Unquote(s[i : j+1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid string at position %d: %w", i, err)
+			}
+			tokens = append(tokens, token{kind: tokenString, value: value})
+			i = j + 1
+		default:
+			j := i
+			for ; j < len(s) && !unicode.IsSpace(rune(s[j])) && !strings.ContainsRune("()[]\"", rune(s[j])); j++ {
+			}
+			tokens = append(tokens, token{kind: tokenWord, value: s[i:j]})
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// parseFilter parses a SCIM filter expression.
+func parseFilter(s string) (filter, error) {
+	tokens, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %q", p.tokens[p.pos].value)
+	}
+	return f, nil
+}
+
+func (p *parser) peek() (token, bool) {
+	if p.pos >= len(p.tokens) {
+		return token{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+func (p *parser) peekWord(w string) bool {
+	t, ok := p.peek()
+	return ok && t.kind == tokenWord && strings.EqualFold(t.value, w)
+}
+
+func (p *parser) expect(kind int, desc string) error {
+	t, ok := p.peek()
+	if !ok || t.kind != kind {
+		return fmt.Errorf("expected %s", desc)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *parser) parseOr() (filter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekWord("or") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalFilter{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (filter, error) {
+	left, err := p.parseFactor()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekWord("and") {
+		p.pos++
+		right, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalFilter{and: true, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseFactor() (filter, error) {
+	not := false
+	if p.peekWord("not") {
+		p.pos++
+		not = true
+	}
+	t, ok := p.peek()
+	if !ok {
+		return nil, fmt.Errorf("unexpected end of filter")
+	}
+	var f filter
+	switch t.kind {
+	case tokenOpen:
+		p.pos++
+		var err error
+		if f, err = p.parseOr(); err != nil {
+			return nil, err
+		}
+		if err := p.expect(tokenClose, `")"`); err != nil {
+			return nil, err
+		}
+	case tokenWord:
+		if not {
+			return nil, fmt.Errorf(`expected "(" after "not"`)
+		}
+		return p.parseAttrExp()
+	default:
+		return nil, fmt.Errorf("expected attribute path")
+	}
+	if not {
+		f = &notFilter{f: f}
+	}
+	return f, nil
+}
+
+func (p *parser) parseAttrExp() (filter, error) {
+	path := attrPath(p.tokens[p.pos].value)
+	p.pos++
+	if t, ok := p.peek(); ok && t.kind == tokenOpenBracket {
+		p.pos++
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(tokenCloseBracket, `"]"`); err != nil {
+			return nil, err
+		}
+		return &valuePathFilter{path: path, f: f}, nil
+	}
+
+	t, ok := p.peek()
+	if !ok || t.kind != tokenWord {
+		return nil, fmt.Errorf("expected operator after %q", strings.Join(path, "."))
+	}
+	op := strings.ToLower(t.value)
+	p.pos++
+	switch op {
+	case "pr":
+		return &attrFilter{path: path, op: op}, nil
+	case "eq", "ne", "co", "sw", "ew", "gt", "ge", "lt", "le":
+	default:
+		return nil, fmt.Errorf("unsupported operator %q", t.value)
+	}
+
+	t, ok = p.peek()
+	if !ok {
+		return nil, fmt.Errorf("expected value after %q", op)
+	}
+	p.pos++
+	var value interface{}
+	switch {
+	case t.kind == tokenString:
+		value = t.value
+	case t.kind != tokenWord:
+		return nil, fmt.Errorf("expected value after %q", op)
+	case t.value == "true":
+		value = true
+	case t.value == "false":
+		value = false
+	case t.value == "null":
+		value = nil
+	default:
+		n, err := strconv.ParseFloat(t.value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q", t.value)
+		}
+		value = n
+	}
+	return &attrFilter{path: path, op: op, value: value}, nil
+}
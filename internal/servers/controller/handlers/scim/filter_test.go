@@ -0,0 +1,74 @@
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+	resource := map[string]interface{}{
+		"username":    "alice",
+		"displayname": "Alice Liddell",
+		"active":      true,
+		"externalid":  nil,
+		"meta": map[string]interface{}{
+			"resourcetype": "User",
+		},
+		"members": []interface{}{
+			map[string]interface{}{"value": "u_1234567890", "type": "User"},
+			map[string]interface{}{"value": "u_0987654321", "type": "User"},
+		},
+	}
+	tests := []struct {
+		filter  string
+		want    bool
+		wantErr bool
+	}{
+		{filter: `userName eq "alice"`, want: true},
+		{filter: `userName eq "ALICE"`, want: true},
+		{filter: `userName eq "bob"`, want: false},
+		{filter: `UserName ne "bob"`, want: true},
+		{filter: `urn:ietf:params:scim:schemas:core:2.0:User:userName eq "alice"`, want: true},
+		{filter: `displayName co "lid"`, want: true},
+		{filter: `displayName sw "alice"`, want: true},
+		{filter: `displayName ew "liddell"`, want: true},
+		{filter: `displayName gt "B"`, want: false},
+		{filter: `active eq true`, want: true},
+		{filter: `active eq false`, want: false},
+		{filter: `externalId pr`, want: false},
+		{filter: `displayName pr`, want: true},
+		{filter: `meta.resourceType eq "User"`, want: true},
+		{filter: `members.value eq "u_0987654321"`, want: true},
+		{filter: `members[value eq "u_1234567890" and type eq "User"]`, want: true},
+		{filter: `members[value eq "u_1111111111"]`, want: false},
+		{filter: `userName eq "bob" or active eq true`, want: true},
+		{filter: `userName eq "bob" or userName eq "carol" and active eq true`, want: false},
+		{filter: `(userName eq "bob" or userName eq "alice") and active eq true`, want: true},
+		{filter: `not (userName eq "alice")`, want: false},
+		{filter: `userName eq "a \"quoted\" name"`, want: false},
+		{filter: `userName`, wantErr: true},
+		{filter: `userName xx "alice"`, wantErr: true},
+		{filter: `userName eq`, wantErr: true},
+		{filter: `userName eq "alice`, wantErr: true},
+		{filter: `(userName eq "alice"`, wantErr: true},
+		{filter: `userName eq "alice")`, wantErr: true},
+		{filter: `not userName eq "alice"`, wantErr: true},
+		{filter: `userName eq alice`, wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.filter, func(t *testing.T) {
+			t.Parallel()
+			f, err := parseFilter(tt.filter)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, f.match(resource))
+		})
+	}
+}
@@ -0,0 +1,344 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/iam"
+)
+
+type member struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// group is the SCIM representation of an iam group. The display name maps to
+// the group's name. Members must be users in the same scope as the group.
+type group struct {
+	Schemas     []string `json:"schemas"`
+	Id          string   `json:"id,omitempty"`
+	ExternalId  string   `json:"externalId,omitempty"`
+	DisplayName string   `json:"displayName"`
+	Members     []member `json:"members,omitempty"`
+	Meta        *meta    `json:"meta,omitempty"`
+}
+
+func (g *group) id() string {
+	return g.Id
+}
+
+// toGroup returns the SCIM representation of g. users are the users of the
+// scope by ID, which are used for the display names of members.
+func toGroup(baseUrl string, g *iam.Group, members []*iam.GroupMember, users map[string]*iam.User) *group {
+	location := baseUrl + "Groups/" + g.PublicId
+	resp := &group{
+		Schemas:     []string{schemaGroup},
+		Id:          g.PublicId,
+		ExternalId:  g.ExternalId,
+		DisplayName: g.Name,
+		Meta:        newMeta("Group", location, g.GetCreateTime().GetTimestamp().AsTime(), g.GetUpdateTime().GetTimestamp().AsTime(), g.Version),
+	}
+	for _, m := range members {
+		var display string
+		if u, ok := users[m.MemberId]; ok {
+			display = u.Name
+		}
+		resp.Members = append(resp.Members, member{
+			Value:   m.MemberId,
+			Display: display,
+			Type:    "User",
+			Ref:     baseUrl + "Users/" + m.MemberId,
+		})
+	}
+	return resp
+}
+
+func (g *group) validate() error {
+	if strings.TrimSpace(g.DisplayName) == "" {
+		return newError(http.StatusBadRequest, "invalidValue", "displayName is required.")
+	}
+	return nil
+}
+
+// memberIds returns the IDs of the members of g, which must be users in
+// users.
+func (g *group) memberIds(users map[string]*iam.User) ([]string, error) {
+	ids := make([]string, 0, len(g.Members))
+	for _, m := range g.Members {
+		if m.Type != "" && !strings.EqualFold(m.Type, "User") {
+			return nil, newError(http.StatusBadRequest, "invalidValue", fmt.Sprintf("Unsupported member type %q.", m.Type))
+		}
+		if users[m.Value] == nil {
+			return nil, newError(http.StatusBadRequest, "invalidValue", fmt.Sprintf("Unknown user %q.", m.Value))
+		}
+		ids = append(ids, m.Value)
+	}
+	return ids, nil
+}
+
+func (g *group) patch(op, path string, value json.RawMessage) error {
+	if strings.Contains(path, "[") {
+		return g.patchMembers(op, path, value)
+	}
+	switch strings.Join(attrPath(path), ".") {
+	case "displayname":
+		if op == "remove" {
+			value = json.RawMessage("null")
+		}
+		return unmarshalValue(path, value, &g.DisplayName)
+	case "externalid":
+		if op == "remove" {
+			value = json.RawMessage("null")
+		}
+		return unmarshalValue(path, value, &g.ExternalId)
+	case "members":
+		return g.patchMembers(op, path, value)
+	}
+	return nil
+}
+
+// patchMembers applies an operation on the members of g. The path may
+// contain a filter selecting the members to remove, e.g.
+// members[value eq "u_1234567890"].
+func (g *group) patchMembers(op, path string, value json.RawMessage) error {
+	var selected filter
+	if strings.Contains(path, "[") {
+		f, err := parseFilter(path)
+		if err != nil {
+			return newError(http.StatusBadRequest, "invalidPath", fmt.Sprintf("Invalid path: %s.", err))
+		}
+		vf, ok := f.(*valuePathFilter)
+		if !ok || strings.Join(vf.path, ".") != "members" || op != "remove" {
+			return newError(http.StatusBadRequest, "invalidPath", fmt.Sprintf("Unsupported path %q.", path))
+		}
+		selected = vf.f
+	}
+
+	var members []member
+	if err := unmarshalValue(path, value, &members); err != nil {
+		return err
+	}
+	switch op {
+	case "add":
+		for _, m := range members {
+			if !g.hasMember(m.Value) {
+				g.Members = append(g.Members, m)
+			}
+		}
+	case "replace":
+		g.Members = members
+	case "remove":
+		remove := make(map[string]bool, len(members))
+		for _, m := range members {
+			remove[m.Value] = true
+		}
+		var kept []member
+		for _, m := range g.Members {
+			switch {
+			case selected != nil:
+				mm, err := toMap(m)
+				if err != nil {
+					return err
+				}
+				if selected.match(mm) {
+					continue
+				}
+			case len(members) == 0 || remove[m.Value]:
+				continue
+			}
+			kept = append(kept, m)
+		}
+		g.Members = kept
+	}
+	return nil
+}
+
+func (g *group) hasMember(id string) bool {
+	for _, m := range g.Members {
+		if m.Value == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *Handler) serveGroups(r *request, path []string) error {
+	repo, err := h.iamRepoFn()
+	if err != nil {
+		return err
+	}
+	switch {
+	case len(path) == 0 && r.Method == http.MethodGet:
+		return r.listGroups(repo)
+	case len(path) == 0 && r.Method == http.MethodPost:
+		return r.createGroup(repo)
+	case len(path) == 0:
+		return errMethodNotAllowed
+	case len(path) > 1:
+		return errNotFound
+	}
+
+	g, members, err := r.lookupGroup(repo, path[0])
+	if err != nil {
+		return err
+	}
+	if r.Method == http.MethodDelete {
+		if _, err := repo.DeleteGroup(r.Context(), g.PublicId); err != nil {
+			return err
+		}
+		r.w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+	users, err := r.users(repo)
+	if err != nil {
+		return err
+	}
+	switch r.Method {
+	case http.MethodGet:
+		return r.write(http.StatusOK, toGroup(r.baseUrl, g, members, users))
+	case http.MethodPut:
+		var req group
+		if err := r.decode(&req); err != nil {
+			return err
+		}
+		return r.updateGroup(repo, g, &req, users)
+	case http.MethodPatch:
+		var req patchRequest
+		if err := r.decode(&req); err != nil {
+			return err
+		}
+		patched := toGroup(r.baseUrl, g, members, users)
+		if err := req.apply(patched); err != nil {
+			return err
+		}
+		return r.updateGroup(repo, g, patched, users)
+	}
+	return errMethodNotAllowed
+}
+
+// users returns the users of the request's scope by ID.
+func (r *request) users(repo *iam.Repository) (map[string]*iam.User, error) {
+	users, err := repo.ListUsers(r.Context(), []string{r.scopeId}, iam.WithLimit(-1))
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[string]*iam.User, len(users))
+	for _, u := range users {
+		m[u.PublicId] = u
+	}
+	return m, nil
+}
+
+func (r *request) listGroups(repo *iam.Repository) error {
+	params, err := r.listParams()
+	if err != nil {
+		return err
+	}
+	groups, err := repo.ListGroups(r.Context(), []string{r.scopeId}, iam.WithLimit(-1))
+	if err != nil {
+		return err
+	}
+	var users map[string]*iam.User
+	if !params.excludeMembers {
+		if users, err = r.users(repo); err != nil {
+			return err
+		}
+	}
+	resources := make([]resource, 0, len(groups))
+	for _, g := range groups {
+		var members []*iam.GroupMember
+		if !params.excludeMembers {
+			if members, err = repo.ListGroupMembers(r.Context(), g.PublicId, iam.WithLimit(-1)); err != nil {
+				return err
+			}
+		}
+		resources = append(resources, toGroup(r.baseUrl, g, members, users))
+	}
+	resp, err := params.list(resources)
+	if err != nil {
+		return err
+	}
+	return r.write(http.StatusOK, resp)
+}
+
+// lookupGroup returns the group with the given ID and its members if it is in
+// the request's scope.
+func (r *request) lookupGroup(repo *iam.Repository, id string) (*iam.Group, []*iam.GroupMember, error) {
+	g, members, err := repo.LookupGroup(r.Context(), id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if g == nil || g.ScopeId != r.scopeId {
+		return nil, nil, errNotFound
+	}
+	return g, members, nil
+}
+
+func (r *request) createGroup(repo *iam.Repository) error {
+	var req group
+	if err := r.decode(&req); err != nil {
+		return err
+	}
+	if err := req.validate(); err != nil {
+		return err
+	}
+	users, err := r.users(repo)
+	if err != nil {
+		return err
+	}
+	memberIds, err := req.memberIds(users)
+	if err != nil {
+		return err
+	}
+	g, err := iam.NewGroup(r.scopeId, iam.WithName(req.DisplayName))
+	if err != nil {
+		return err
+	}
+	g.ExternalId = req.ExternalId
+	if g, err = repo.CreateGroup(r.Context(), g); err != nil {
+		return err
+	}
+	var members []*iam.GroupMember
+	if len(memberIds) > 0 {
+		if _, err = repo.AddGroupMembers(r.Context(), g.PublicId, g.Version, memberIds); err != nil {
+			return err
+		}
+		if g, members, err = repo.LookupGroup(r.Context(), g.PublicId); err != nil {
+			return err
+		}
+	}
+	resp := toGroup(r.baseUrl, g, members, users)
+	r.w.Header().Set("Location", resp.Meta.Location)
+	return r.write(http.StatusCreated, resp)
+}
+
+// updateGroup replaces the attributes and members of the group g with those
+// of req.
+func (r *request) updateGroup(repo *iam.Repository, g *iam.Group, req *group, users map[string]*iam.User) error {
+	if err := req.validate(); err != nil {
+		return err
+	}
+	memberIds, err := req.memberIds(users)
+	if err != nil {
+		return err
+	}
+	updated := g.Clone().(*iam.Group)
+	updated.Name = req.DisplayName
+	updated.ExternalId = req.ExternalId
+	updated, _, _, err = repo.UpdateGroup(r.Context(), updated, g.Version, []string{"Name", "ExternalId"})
+	if err != nil {
+		return err
+	}
+	if _, _, err = repo.SetGroupMembers(r.Context(), updated.PublicId, updated.Version, memberIds); err != nil {
+		return err
+	}
+	updated, members, err := repo.LookupGroup(r.Context(), updated.PublicId)
+	if err != nil {
+		return err
+	}
+	return r.write(http.StatusOK, toGroup(r.baseUrl, updated, members, users))
+}
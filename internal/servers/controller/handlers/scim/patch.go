@@ -0,0 +1,105 @@
+package scim
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// patchRequest is a PATCH request. See RFC 7644 section 3.5.2.
+type patchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []patchOperation `json:"Operations"`
+}
+
+type patchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// patchTarget is a resource which operations are applied to. op is one of
+// add, replace or remove and value is null for remove operations without a
+// value.
+type patchTarget interface {
+	patch(op, path string, value json.RawMessage) error
+}
+
+// apply applies the operations of the request to t. Operations without a
+// path apply each attribute of their value.
+func (p *patchRequest) apply(t patchTarget) error {
+	if len(p.Operations) == 0 {
+		return newError(http.StatusBadRequest, "invalidSyntax", "No operations.")
+	}
+	for _, o := range p.Operations {
+		op := strings.ToLower(o.Op)
+		switch op {
+		case "add", "replace", "remove":
+		default:
+			return newError(http.StatusBadRequest, "invalidSyntax", fmt.Sprintf("Unsupported operation %q.", o.Op))
+		}
+		value := o.Value
+		if len(value) == 0 {
+			value = json.RawMessage("null")
+		}
+		if o.Path != "" {
+			if err := t.patch(op, o.Path, value); err != nil {
+				return err
+			}
+			continue
+		}
+		if op == "remove" {
+			return newError(http.StatusBadRequest, "noTarget", "Remove operations require a path.")
+		}
+		var attrs map[string]json.RawMessage
+		if err := json.Unmarshal(value, &attrs); err != nil || attrs == nil {
+			return newError(http.StatusBadRequest, "invalidValue", "Operations without a path require an object value.")
+		}
+		for path, v := range attrs {
+			if err := t.patch(op, path, v); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func isNull(value json.RawMessage) bool {
+	return len(value) == 0 || bytes.Equal(bytes.TrimSpace(value), []byte("null"))
+}
+
+// unmarshalValue unmarshals the value of the attribute at path into v, which
+// is set to its zero value if value is null.
+func unmarshalValue(path string, value json.RawMessage, v interface{}) error {
+	if isNull(value) {
+		rv := reflect.ValueOf(v).Elem()
+		rv.Set(reflect.Zero(rv.Type()))
+		return nil
+	}
+	if err := json.Unmarshal(value, v); err != nil {
+		return newError(http.StatusBadRequest, "invalidValue", fmt.Sprintf("Invalid value for %s.", path))
+	}
+	return nil
+}
+
+// unmarshalBool unmarshals a boolean value. Some identity providers send
+// booleans as strings so those are accepted as well.
+func unmarshalBool(path string, value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		switch strings.ToLower(s) {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+	}
+	return false, newError(http.StatusBadRequest, "invalidValue", fmt.Sprintf("Invalid value for %s.", path))
+}
@@ -0,0 +1,156 @@
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserPatch(t *testing.T) {
+	t.Parallel()
+	active := true
+	inactive := false
+	tests := []struct {
+		name    string
+		patch   string
+		want    *user
+		wantErr bool
+	}{
+		{
+			name:  "replace-active",
+			patch: `{"Operations": [{"op": "replace", "path": "active", "value": false}]}`,
+			want:  &user{UserName: "alice", DisplayName: "Alice", Active: &inactive},
+		},
+		{
+			name:  "replace-active-string",
+			patch: `{"Operations": [{"op": "Replace", "path": "active", "value": "False"}]}`,
+			want:  &user{UserName: "alice", DisplayName: "Alice", Active: &inactive},
+		},
+		{
+			name:  "replace-without-path",
+			patch: `{"Operations": [{"op": "replace", "value": {"userName": "bob", "displayName": "Bob", "emails": []}}]}`,
+			want:  &user{UserName: "bob", DisplayName: "Bob", Active: &active},
+		},
+		{
+			name:  "add-external-id",
+			patch: `{"Operations": [{"op": "add", "path": "externalId", "value": "00u1"}]}`,
+			want:  &user{UserName: "alice", ExternalId: "00u1", DisplayName: "Alice", Active: &active},
+		},
+		{
+			name:  "remove-display-name",
+			patch: `{"Operations": [{"op": "remove", "path": "displayName"}, {"op": "add", "path": "name.givenName", "value": "Al"}]}`,
+			want:  &user{UserName: "alice", Name: &userName{GivenName: "Al"}, Active: &active},
+		},
+		{
+			name:  "ignored-attribute",
+			patch: `{"Operations": [{"op": "replace", "path": "emails[type eq \"work\"].value", "value": "alice@example.com"}]}`,
+			want:  &user{UserName: "alice", DisplayName: "Alice", Active: &active},
+		},
+		{
+			name:    "invalid-op",
+			patch:   `{"Operations": [{"op": "move", "path": "active", "value": false}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid-active",
+			patch:   `{"Operations": [{"op": "replace", "path": "active", "value": "no"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "remove-without-path",
+			patch:   `{"Operations": [{"op": "remove"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "no-operations",
+			patch:   `{"Operations": []}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var req patchRequest
+			require.NoError(t, json.Unmarshal([]byte(tt.patch), &req))
+			u := &user{UserName: "alice", DisplayName: "Alice", Active: &active}
+			err := req.apply(u)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, u)
+		})
+	}
+}
+
+func TestGroupPatch(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		patch   string
+		want    []member
+		wantErr bool
+	}{
+		{
+			name:  "add",
+			patch: `{"Operations": [{"op": "add", "path": "members", "value": [{"value": "u_2"}, {"value": "u_3"}]}]}`,
+			want:  []member{{Value: "u_1"}, {Value: "u_2"}, {Value: "u_3"}},
+		},
+		{
+			name:  "replace",
+			patch: `{"Operations": [{"op": "replace", "path": "members", "value": [{"value": "u_3"}]}]}`,
+			want:  []member{{Value: "u_3"}},
+		},
+		{
+			name:  "remove-filter",
+			patch: `{"Operations": [{"op": "remove", "path": "members[value eq \"u_1\"]"}]}`,
+			want:  []member{{Value: "u_2"}},
+		},
+		{
+			name:  "remove-value",
+			patch: `{"Operations": [{"op": "remove", "path": "members", "value": [{"value": "u_2"}]}]}`,
+			want:  []member{{Value: "u_1"}},
+		},
+		{
+			name:  "remove-all",
+			patch: `{"Operations": [{"op": "remove", "path": "members"}]}`,
+			want:  nil,
+		},
+		{
+			name:  "add-without-path",
+			patch: `{"Operations": [{"op": "add", "value": {"members": [{"value": "u_3"}]}}]}`,
+			want:  []member{{Value: "u_1"}, {Value: "u_2"}, {Value: "u_3"}},
+		},
+		{
+			name:    "replace-filter",
+			patch:   `{"Operations": [{"op": "replace", "path": "members[value eq \"u_1\"]", "value": [{"value": "u_3"}]}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid-filter",
+			patch:   `{"Operations": [{"op": "remove", "path": "members[value eq]"}]}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var req patchRequest
+			require.NoError(t, json.Unmarshal([]byte(tt.patch), &req))
+			g := &group{DisplayName: "engineering", Members: []member{{Value: "u_1"}, {Value: "u_2"}}}
+			err := req.apply(g)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, g.Members)
+			assert.Equal(t, "engineering", g.DisplayName)
+		})
+	}
+}
@@ -0,0 +1,446 @@
+// Package scim implements a SCIM 2.0 service provider (RFC 7643 and RFC 7644)
+// through which identity providers provision the users and groups of a
+// scope. SCIM users map to iam users and SCIM groups to iam groups. A user
+// which is deactivated in the identity provider is disabled, which revokes
+// its auth tokens.
+package scim
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/types/scope"
+)
+
+// BasePath is the path the SCIM endpoint is served under.
+const BasePath = "/scim/v2/"
+
+const (
+	contentType = "application/scim+json"
+
+	schemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	schemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	schemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	schemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	schemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	schemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	schemaResourceType          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+
+	defaultCount = 100
+	maxCount     = 1000
+)
+
+// Token grants a bearer token access to the users and groups of a scope.
+type Token struct {
+	ScopeId string
+	Token   string
+}
+
+type tokenHash struct {
+	scopeId string
+	sum     [sha256.Size]byte
+}
+
+// Handler serves the SCIM endpoint.
+type Handler struct {
+	iamRepoFn common.IamRepoFactory
+	tokens    []tokenHash
+}
+
+// NewHandler returns a Handler which serves the users and groups of the
+// scopes of tokens.
+func NewHandler(ctx context.Context, iamRepoFn common.IamRepoFactory, tokens ...Token) (*Handler, error) {
+	const op = "scim.NewHandler"
+	if iamRepoFn == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing iam repository")
+	}
+	if len(tokens) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing tokens")
+	}
+	h := &Handler{iamRepoFn: iamRepoFn}
+	for _, t := range tokens {
+		switch {
+		case t.Token == "":
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "missing token")
+		case t.ScopeId != scope.Global.String() && !strings.HasPrefix(t.ScopeId, scope.Org.Prefix()+"_"):
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("scope id %q is not the global scope or an org", t.ScopeId))
+		}
+		h.tokens = append(h.tokens, tokenHash{scopeId: t.ScopeId, sum: sha256.Sum256([]byte(t.Token))})
+	}
+	return h, nil
+}
+
+// scopeId returns the scope of the request's bearer token. All tokens are
+// compared so the time taken doesn't depend on which one matches.
+func (h *Handler) scopeId(r *http.Request) (string, bool) {
+	authHeader := strings.SplitN(strings.TrimSpace(r.Header.Get("Authorization")), " ", 2)
+	if len(authHeader) != 2 || !strings.EqualFold(authHeader[0], "bearer") {
+		return "", false
+	}
+	sum := sha256.Sum256([]byte(strings.TrimSpace(authHeader[1])))
+	var scopeId string
+	for _, t := range h.tokens {
+		if subtle.ConstantTimeCompare(sum[:], t.sum[:]) == 1 {
+			scopeId = t.scopeId
+		}
+	}
+	return scopeId, scopeId != ""
+}
+
+// request holds the state of a SCIM request.
+type request struct {
+	*http.Request
+	w       http.ResponseWriter
+	scopeId string
+	// baseUrl is the absolute URL of the SCIM endpoint, used for the
+	// locations of resources.
+	baseUrl string
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	scopeId, ok := h.scopeId(r)
+	if !ok {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="Boundary SCIM"`)
+		writeError(w, newError(http.StatusUnauthorized, "", "Missing or invalid bearer token."))
+		return
+	}
+
+	maxRequestSize := globals.DefaultMaxRequestSize
+	if size, ok := r.Context().Value(globals.ContextMaxRequestSizeTypeKey).(int64); ok && size > 0 {
+		maxRequestSize = size
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxRequestSize)
+
+	scheme := "https"
+	if r.TLS == nil {
+		scheme = "http"
+	}
+	req := &request{
+		Request: r,
+		w:       w,
+		scopeId: scopeId,
+		baseUrl: fmt.Sprintf("%s://%s%s", scheme, r.Host, BasePath),
+	}
+
+	path := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, BasePath), "/"), "/")
+	var err error
+	switch {
+	case len(path) == 1 && path[0] == "ServiceProviderConfig":
+		err = req.get(serviceProviderConfig)
+	case len(path) == 1 && path[0] == "ResourceTypes":
+		err = req.get(req.resourceTypes)
+	case path[0] == "Users":
+		err = h.serveUsers(req, path[1:])
+	case path[0] == "Groups":
+		err = h.serveGroups(req, path[1:])
+	default:
+		err = newError(http.StatusNotFound, "", "Unknown endpoint.")
+	}
+	if err != nil {
+		writeError(w, toScimError(r.Context(), err))
+	}
+}
+
+// get serves a request for a resource which can only be read.
+func (r *request) get(fn func() interface{}) error {
+	if r.Method != http.MethodGet {
+		return errMethodNotAllowed
+	}
+	return r.write(http.StatusOK, fn())
+}
+
+func (r *request) write(status int, resource interface{}) error {
+	r.w.Header().Set("Content-Type", contentType)
+	r.w.WriteHeader(status)
+	return json.NewEncoder(r.w).Encode(resource)
+}
+
+func (r *request) decode(v interface{}) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return newError(http.StatusBadRequest, "invalidSyntax", fmt.Sprintf("Unable to parse request body: %s.", err))
+	}
+	return nil
+}
+
+// listParams are the parameters of a list request.
+type listParams struct {
+	filter     filter
+	startIndex int
+	count      int
+	// excludeMembers is set if excludedAttributes contains members, which
+	// avoids looking up the members of groups.
+	excludeMembers bool
+}
+
+func (r *request) listParams() (*listParams, error) {
+	q := r.URL.Query()
+	p := &listParams{startIndex: 1, count: defaultCount}
+	if f := q.Get("filter"); f != "" {
+		var err error
+		if p.filter, err = parseFilter(f); err != nil {
+			return nil, newError(http.StatusBadRequest, "invalidFilter", fmt.Sprintf("Invalid filter: %s.", err))
+		}
+	}
+	if s := q.Get("startIndex"); s != "" {
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, "invalidValue", "Invalid startIndex.")
+		}
+		if i > 1 {
+			p.startIndex = i
+		}
+	}
+	if s := q.Get("count"); s != "" {
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, "invalidValue", "Invalid count.")
+		}
+		switch {
+		case i < 0:
+			p.count = 0
+		case i > maxCount:
+			p.count = maxCount
+		default:
+			p.count = i
+		}
+	}
+	for _, a := range strings.Split(q.Get("excludedAttributes"), ",") {
+		if strings.EqualFold(strings.TrimSpace(a), "members") {
+			p.excludeMembers = true
+		}
+	}
+	return p, nil
+}
+
+type listResponse struct {
+	Schemas      []string      `json:"schemas"`
+	TotalResults int           `json:"totalResults"`
+	StartIndex   int           `json:"startIndex"`
+	ItemsPerPage int           `json:"itemsPerPage"`
+	Resources    []interface{} `json:"Resources"`
+}
+
+// resource is a user or group in its SCIM representation.
+type resource interface {
+	id() string
+}
+
+// list filters resources, sorted by ID, and returns the requested page.
+func (p *listParams) list(resources []resource) (*listResponse, error) {
+	sort.Slice(resources, func(i, j int) bool { return resources[i].id() < resources[j].id() })
+	matched := resources
+	if p.filter != nil {
+		matched = nil
+		for _, r := range resources {
+			m, err := toMap(r)
+			if err != nil {
+				return nil, err
+			}
+			if p.filter.match(m) {
+				matched = append(matched, r)
+			}
+		}
+	}
+	resp := &listResponse{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: len(matched),
+		StartIndex:   p.startIndex,
+		Resources:    []interface{}{},
+	}
+	for i := p.startIndex - 1; i < len(matched) && len(resp.Resources) < p.count; i++ {
+		resp.Resources = append(resp.Resources, matched[i])
+	}
+	resp.ItemsPerPage = len(resp.Resources)
+	return resp, nil
+}
+
+// toMap returns the JSON representation of v with lower cased attribute
+// names, which filters are matched against.
+func toMap(v interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return lowerKeys(m).(map[string]interface{}), nil
+}
+
+func lowerKeys(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, v := range t {
+			m[strings.ToLower(k)] = lowerKeys(v)
+		}
+		return m
+	case []interface{}:
+		for i := range t {
+			t[i] = lowerKeys(t[i])
+		}
+	}
+	return v
+}
+
+type meta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	Location     string `json:"location,omitempty"`
+	Version      string `json:"version,omitempty"`
+}
+
+func newMeta(resourceType, location string, created, updated time.Time, version uint32) *meta {
+	return &meta{
+		ResourceType: resourceType,
+		Created:      created.UTC().Format(time.RFC3339),
+		LastModified: updated.UTC().Format(time.RFC3339),
+		Location:     location,
+		Version:      fmt.Sprintf(`W/"%d"`, version),
+	}
+}
+
+func serviceProviderConfig() interface{} {
+	type supported struct {
+		Supported bool `json:"supported"`
+	}
+	type filterSupported struct {
+		Supported  bool `json:"supported"`
+		MaxResults int  `json:"maxResults"`
+	}
+	type bulkSupported struct {
+		Supported      bool `json:"supported"`
+		MaxOperations  int  `json:"maxOperations"`
+		MaxPayloadSize int  `json:"maxPayloadSize"`
+	}
+	type authenticationScheme struct {
+		Type        string `json:"type"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+	return struct {
+		Schemas               []string               `json:"schemas"`
+		Patch                 supported              `json:"patch"`
+		Bulk                  bulkSupported          `json:"bulk"`
+		Filter                filterSupported        `json:"filter"`
+		ChangePassword        supported              `json:"changePassword"`
+		Sort                  supported              `json:"sort"`
+		Etag                  supported              `json:"etag"`
+		AuthenticationSchemes []authenticationScheme `json:"authenticationSchemes"`
+	}{
+		Schemas: []string{schemaServiceProviderConfig},
+		Patch:   supported{Supported: true},
+		Filter:  filterSupported{Supported: true, MaxResults: maxCount},
+		AuthenticationSchemes: []authenticationScheme{
+			{
+				Type:        "oauthbearertoken",
+				Name:        "Bearer Token",
+				Description: "Authentication with a bearer token configured on the controller",
+			},
+		},
+	}
+}
+
+func (r *request) resourceTypes() interface{} {
+	type resourceType struct {
+		Schemas  []string `json:"schemas"`
+		Id       string   `json:"id"`
+		Name     string   `json:"name"`
+		Endpoint string   `json:"endpoint"`
+		Schema   string   `json:"schema"`
+		Meta     *meta    `json:"meta"`
+	}
+	resources := []interface{}{
+		resourceType{
+			Schemas:  []string{schemaResourceType},
+			Id:       "User",
+			Name:     "User",
+			Endpoint: "/Users",
+			Schema:   schemaUser,
+			Meta:     &meta{ResourceType: "ResourceType", Location: r.baseUrl + "ResourceTypes/User"},
+		},
+		resourceType{
+			Schemas:  []string{schemaResourceType},
+			Id:       "Group",
+			Name:     "Group",
+			Endpoint: "/Groups",
+			Schema:   schemaGroup,
+			Meta:     &meta{ResourceType: "ResourceType", Location: r.baseUrl + "ResourceTypes/Group"},
+		},
+	}
+	return &listResponse{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: len(resources),
+		StartIndex:   1,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}
+}
+
+// scimError is an error response. See RFC 7644 section 3.12.
+type scimError struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+	status   int
+}
+
+func newError(status int, scimType, detail string) *scimError {
+	return &scimError{
+		Schemas:  []string{schemaError},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+		status:   status,
+	}
+}
+
+func (e *scimError) Error() string {
+	return e.Detail
+}
+
+var (
+	errMethodNotAllowed = newError(http.StatusMethodNotAllowed, "", "Method not allowed.")
+	errNotFound         = newError(http.StatusNotFound, "", "Resource not found.")
+)
+
+// toScimError converts err into a SCIM error response. Unexpected errors
+// are written as events and not returned to the client.
+func toScimError(ctx context.Context, err error) *scimError {
+	const op = "scim.toScimError"
+	var se *scimError
+	switch {
+	case stderrors.As(err, &se):
+		return se
+	case errors.Match(errors.T(errors.NotUnique), err):
+		return newError(http.StatusConflict, "uniqueness", "A resource with the same name or external ID already exists.")
+	case errors.Match(errors.T(errors.RecordNotFound), err):
+		return errNotFound
+	case errors.Match(errors.T(errors.VersionMismatch), err):
+		return newError(http.StatusPreconditionFailed, "", "The resource was modified concurrently.")
+	}
+	event.WriteError(ctx, op, err, event.WithInfoMsg("error handling scim request"))
+	return newError(http.StatusInternalServerError, "", "Internal error.")
+}
+
+func writeError(w http.ResponseWriter, e *scimError) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(e.status)
+	_ = json.NewEncoder(w).Encode(e)
+}
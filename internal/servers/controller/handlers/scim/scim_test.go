@@ -0,0 +1,120 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHandler(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repoFn := func() (*iam.Repository, error) { return nil, nil }
+
+	_, err := NewHandler(ctx, nil, Token{ScopeId: "global", Token: "token"})
+	assert.Error(t, err)
+	_, err = NewHandler(ctx, repoFn)
+	assert.Error(t, err)
+	_, err = NewHandler(ctx, repoFn, Token{ScopeId: "global"})
+	assert.Error(t, err)
+	_, err = NewHandler(ctx, repoFn, Token{ScopeId: "p_1234567890", Token: "token"})
+	assert.Error(t, err)
+	_, err = NewHandler(ctx, repoFn, Token{ScopeId: "global", Token: "token"}, Token{ScopeId: "o_1234567890", Token: "other"})
+	assert.NoError(t, err)
+}
+
+func TestHandler_Authentication(t *testing.T) {
+	t.Parallel()
+	h, err := NewHandler(context.Background(), func() (*iam.Repository, error) { return nil, nil },
+		Token{ScopeId: "o_1234567890", Token: "org-token"},
+		Token{ScopeId: "global", Token: "global-token"},
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		header     string
+		wantStatus int
+	}{
+		{name: "missing", wantStatus: http.StatusUnauthorized},
+		{name: "wrong-scheme", header: "Basic org-token", wantStatus: http.StatusUnauthorized},
+		{name: "wrong-token", header: "Bearer wrong-token", wantStatus: http.StatusUnauthorized},
+		{name: "valid", header: "Bearer org-token", wantStatus: http.StatusOK},
+		{name: "valid-lowercase-scheme", header: "bearer global-token", wantStatus: http.StatusOK},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			req := httptest.NewRequest(http.MethodGet, BasePath+"ServiceProviderConfig", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, contentType, rec.Header().Get("Content-Type"))
+			if tt.wantStatus == http.StatusUnauthorized {
+				assert.NotEmpty(t, rec.Header().Get("WWW-Authenticate"))
+				var e map[string]interface{}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &e))
+				assert.Equal(t, []interface{}{schemaError}, e["schemas"])
+				assert.Equal(t, "401", e["status"])
+			}
+		})
+	}
+}
+
+func TestHandler_Discovery(t *testing.T) {
+	t.Parallel()
+	h, err := NewHandler(context.Background(), func() (*iam.Repository, error) { return nil, nil }, Token{ScopeId: "global", Token: "token"})
+	require.NoError(t, err)
+
+	get := func(method, path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "https://boundary.example.com"+BasePath+path, nil)
+		req.Header.Set("Authorization", "Bearer token")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := get(http.MethodGet, "ServiceProviderConfig")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var config map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &config))
+	assert.Equal(t, map[string]interface{}{"supported": true}, config["patch"])
+	assert.Equal(t, map[string]interface{}{"supported": false}, config["sort"])
+
+	rec = get(http.MethodGet, "ResourceTypes")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var types listResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &types))
+	assert.Equal(t, 2, types.TotalResults)
+
+	assert.Equal(t, http.StatusMethodNotAllowed, get(http.MethodPost, "ResourceTypes").Code)
+	assert.Equal(t, http.StatusNotFound, get(http.MethodGet, "Schemas").Code)
+}
+
+func TestListParams_List(t *testing.T) {
+	t.Parallel()
+	var resources []resource
+	for _, id := range []string{"u_3", "u_1", "u_2", "u_4"} {
+		resources = append(resources, &user{Id: id, UserName: "user-" + id})
+	}
+	f, err := parseFilter(`userName ne "user-u_2"`)
+	require.NoError(t, err)
+	p := &listParams{filter: f, startIndex: 2, count: 1}
+	resp, err := p.list(resources)
+	require.NoError(t, err)
+	assert.Equal(t, 3, resp.TotalResults)
+	assert.Equal(t, 2, resp.StartIndex)
+	assert.Equal(t, 1, resp.ItemsPerPage)
+	require.Len(t, resp.Resources, 1)
+	assert.Equal(t, "u_3", resp.Resources[0].(*user).Id)
+}
@@ -0,0 +1,231 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/iam"
+)
+
+type userName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+// user is the SCIM representation of an iam user. The user name maps to the
+// user's name and the display name to its description. Inactive users are
+// disabled. Attributes which have no counterpart are ignored.
+type user struct {
+	Schemas     []string  `json:"schemas"`
+	Id          string    `json:"id,omitempty"`
+	ExternalId  string    `json:"externalId,omitempty"`
+	UserName    string    `json:"userName"`
+	Name        *userName `json:"name,omitempty"`
+	DisplayName string    `json:"displayName,omitempty"`
+	Active      *bool     `json:"active,omitempty"`
+	Meta        *meta     `json:"meta,omitempty"`
+}
+
+func (u *user) id() string {
+	return u.Id
+}
+
+func toUser(baseUrl string, u *iam.User) *user {
+	active := !u.Disabled
+	location := baseUrl + "Users/" + u.PublicId
+	return &user{
+		Schemas:     []string{schemaUser},
+		Id:          u.PublicId,
+		ExternalId:  u.ExternalId,
+		UserName:    u.Name,
+		DisplayName: u.Description,
+		Active:      &active,
+		Meta:        newMeta("User", location, u.GetCreateTime().GetTimestamp().AsTime(), u.GetUpdateTime().GetTimestamp().AsTime(), u.Version),
+	}
+}
+
+// description returns the display name of the user, falling back to its
+// name.
+func (u *user) description() string {
+	switch {
+	case u.DisplayName != "":
+		return u.DisplayName
+	case u.Name == nil:
+		return ""
+	case u.Name.Formatted != "":
+		return u.Name.Formatted
+	}
+	return strings.TrimSpace(u.Name.GivenName + " " + u.Name.FamilyName)
+}
+
+func (u *user) validate() error {
+	if strings.TrimSpace(u.UserName) == "" {
+		return newError(http.StatusBadRequest, "invalidValue", "userName is required.")
+	}
+	return nil
+}
+
+func (u *user) patch(op, path string, value json.RawMessage) error {
+	if op == "remove" {
+		value = json.RawMessage("null")
+	}
+	switch strings.Join(attrPath(path), ".") {
+	case "username":
+		return unmarshalValue(path, value, &u.UserName)
+	case "displayname":
+		return unmarshalValue(path, value, &u.DisplayName)
+	case "externalid":
+		return unmarshalValue(path, value, &u.ExternalId)
+	case "active":
+		if isNull(value) {
+			u.Active = nil
+			return nil
+		}
+		active, err := unmarshalBool(path, value)
+		if err != nil {
+			return err
+		}
+		u.Active = &active
+	case "name":
+		u.Name = nil
+		return unmarshalValue(path, value, &u.Name)
+	case "name.formatted":
+		return unmarshalValue(path, value, &u.name().Formatted)
+	case "name.givenname":
+		return unmarshalValue(path, value, &u.name().GivenName)
+	case "name.familyname":
+		return unmarshalValue(path, value, &u.name().FamilyName)
+	}
+	return nil
+}
+
+func (u *user) name() *userName {
+	if u.Name == nil {
+		u.Name = &userName{}
+	}
+	return u.Name
+}
+
+func (h *Handler) serveUsers(r *request, path []string) error {
+	repo, err := h.iamRepoFn()
+	if err != nil {
+		return err
+	}
+	switch {
+	case len(path) == 0 && r.Method == http.MethodGet:
+		return r.listUsers(repo)
+	case len(path) == 0 && r.Method == http.MethodPost:
+		return r.createUser(repo)
+	case len(path) == 0:
+		return errMethodNotAllowed
+	case len(path) > 1:
+		return errNotFound
+	}
+
+	u, err := r.lookupUser(repo, path[0])
+	if err != nil {
+		return err
+	}
+	switch r.Method {
+	case http.MethodGet:
+		return r.write(http.StatusOK, toUser(r.baseUrl, u))
+	case http.MethodPut:
+		var req user
+		if err := r.decode(&req); err != nil {
+			return err
+		}
+		return r.updateUser(repo, u, &req)
+	case http.MethodPatch:
+		var req patchRequest
+		if err := r.decode(&req); err != nil {
+			return err
+		}
+		patched := toUser(r.baseUrl, u)
+		if err := req.apply(patched); err != nil {
+			return err
+		}
+		return r.updateUser(repo, u, patched)
+	case http.MethodDelete:
+		if _, err := repo.DeleteUser(r.Context(), u.PublicId); err != nil {
+			return err
+		}
+		r.w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+	return errMethodNotAllowed
+}
+
+func (r *request) listUsers(repo *iam.Repository) error {
+	params, err := r.listParams()
+	if err != nil {
+		return err
+	}
+	users, err := repo.ListUsers(r.Context(), []string{r.scopeId}, iam.WithLimit(-1))
+	if err != nil {
+		return err
+	}
+	resources := make([]resource, 0, len(users))
+	for _, u := range users {
+		resources = append(resources, toUser(r.baseUrl, u))
+	}
+	resp, err := params.list(resources)
+	if err != nil {
+		return err
+	}
+	return r.write(http.StatusOK, resp)
+}
+
+// lookupUser returns the user with the given ID if it is in the request's
+// scope.
+func (r *request) lookupUser(repo *iam.Repository, id string) (*iam.User, error) {
+	u, _, err := repo.LookupUser(r.Context(), id)
+	if err != nil {
+		return nil, err
+	}
+	if u == nil || u.ScopeId != r.scopeId {
+		return nil, errNotFound
+	}
+	return u, nil
+}
+
+func (r *request) createUser(repo *iam.Repository) error {
+	var req user
+	if err := r.decode(&req); err != nil {
+		return err
+	}
+	if err := req.validate(); err != nil {
+		return err
+	}
+	u, err := iam.NewUser(r.scopeId, iam.WithName(req.UserName), iam.WithDescription(req.description()))
+	if err != nil {
+		return err
+	}
+	u.Disabled = req.Active != nil && !*req.Active
+	u.ExternalId = req.ExternalId
+	if u, err = repo.CreateUser(r.Context(), u); err != nil {
+		return err
+	}
+	resp := toUser(r.baseUrl, u)
+	r.w.Header().Set("Location", resp.Meta.Location)
+	return r.write(http.StatusCreated, resp)
+}
+
+// updateUser replaces the attributes of the user u with those of req. Users
+// which are disabled have their auth tokens deleted by the database.
+func (r *request) updateUser(repo *iam.Repository, u *iam.User, req *user) error {
+	if err := req.validate(); err != nil {
+		return err
+	}
+	updated := u.Clone().(*iam.User)
+	updated.Name = req.UserName
+	updated.Description = req.description()
+	updated.Disabled = req.Active != nil && !*req.Active
+	updated.ExternalId = req.ExternalId
+	updated, _, _, err := repo.UpdateUser(r.Context(), updated, u.Version, []string{"Name", "Description", "Disabled", "ExternalId"})
+	if err != nil {
+		return err
+	}
+	return r.write(http.StatusOK, toUser(r.baseUrl, updated))
+}
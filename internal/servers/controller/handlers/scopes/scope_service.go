@@ -2,17 +2,20 @@ package scopes
 
 import (
 	"context"
+	"crypto/rand"
 	"fmt"
 	"sort"
 	"strings"
 
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/iam/store"
+	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/requests"
 	"github.com/hashicorp/boundary/internal/servers/controller/auth"
@@ -24,10 +27,12 @@ import (
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/credentialstores"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/groups"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/host_catalogs"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/plugins"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/roles"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/sessions"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/targets"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/users"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/workers"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/scope"
@@ -46,6 +51,9 @@ var (
 		action.Read,
 		action.Update,
 		action.Delete,
+		action.ListKeys,
+		action.RotateKeys,
+		action.DestroyKeyVersion,
 	}
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -60,9 +68,11 @@ var (
 			resource.AuthMethod: authmethods.CollectionActions,
 			resource.AuthToken:  authtokens.CollectionActions,
 			resource.Group:      groups.CollectionActions,
+			resource.Plugin:     plugins.CollectionActions,
 			resource.Role:       roles.CollectionActions,
 			resource.Scope:      CollectionActions,
 			resource.User:       users.CollectionActions,
+			resource.Worker:     workers.CollectionActions,
 		},
 
 		scope.Org.String(): {
@@ -96,16 +106,20 @@ func init() {
 type Service struct {
 	pbs.UnimplementedScopeServiceServer
 
-	repoFn common.IamRepoFactory
+	repoFn   common.IamRepoFactory
+	kmsCache *kms.Kms
 }
 
 // NewService returns a project service which handles project related requests to boundary.
-func NewService(repo common.IamRepoFactory) (Service, error) {
+func NewService(repo common.IamRepoFactory, kmsCache *kms.Kms) (Service, error) {
 	const op = "scopes.(Service).NewService"
 	if repo == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing iam repository")
 	}
-	return Service{repoFn: repo}, nil
+	if kmsCache == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing kms")
+	}
+	return Service{repoFn: repo, kmsCache: kmsCache}, nil
 }
 
 var _ pbs.ScopeServiceServer = Service{}
@@ -226,7 +240,12 @@ func (s Service) GetScope(ctx context.Context, req *pbs.GetScopeRequest) (*pbs.G
 	act := IdActions
 	// Can't delete global so elide it
 	if p.GetPublicId() == scope.Global.String() {
-		act = act[0:3]
+		act = make(action.ActionSet, 0, len(IdActions)-1)
+		for _, a := range IdActions {
+			if a != action.Delete {
+				act = append(act, a)
+			}
+		}
 	}
 	if outputFields.Has(globals.AuthorizedActionsField) {
 		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, p.GetPublicId(), act).Strings()))
@@ -353,6 +372,64 @@ func (s Service) DeleteScope(ctx context.Context, req *pbs.DeleteScopeRequest) (
 	return nil, nil
 }
 
+// ListKeys implements the interface pbs.ScopeServiceServer.
+func (s Service) ListKeys(ctx context.Context, req *pbs.ListKeysRequest) (*pbs.ListKeysResponse, error) {
+	const op = "scopes.(Service).ListKeys"
+
+	if err := validateKeysRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ListKeys)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	kvs, err := s.kmsCache.ListScopeKeyVersions(ctx, req.GetId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to list keys"))
+	}
+	return &pbs.ListKeysResponse{Items: toKeysProto(kvs)}, nil
+}
+
+// RotateKeys implements the interface pbs.ScopeServiceServer.
+func (s Service) RotateKeys(ctx context.Context, req *pbs.RotateKeysRequest) (*pbs.RotateKeysResponse, error) {
+	const op = "scopes.(Service).RotateKeys"
+
+	if err := validateKeysRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.RotateKeys)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	if err := s.kmsCache.RotateKeys(ctx, req.GetId(), rand.Reader); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to rotate keys"))
+	}
+	return &pbs.RotateKeysResponse{}, nil
+}
+
+// DestroyKeyVersion implements the interface pbs.ScopeServiceServer.
+func (s Service) DestroyKeyVersion(ctx context.Context, req *pbs.DestroyKeyVersionRequest) (*pbs.DestroyKeyVersionResponse, error) {
+	const op = "scopes.(Service).DestroyKeyVersion"
+
+	if err := validateDestroyKeyVersionRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.DestroyKeyVersion)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	if err := s.kmsCache.DestroyKeyVersion(ctx, req.GetId(), req.GetKeyVersionId()); err != nil {
+		switch {
+		case errors.Match(errors.T(errors.RecordNotFound), err):
+			return nil, handlers.NotFoundErrorf("Key version %q doesn't exist in scope %q.", req.GetKeyVersionId(), req.GetId())
+		case errors.Match(errors.T(errors.KeyInUse), err):
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition, fmt.Sprintf("Key version %q is still in use and cannot be destroyed.", req.GetKeyVersionId()))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to destroy key version"))
+	}
+	return &pbs.DestroyKeyVersionResponse{}, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*iam.Scope, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -378,6 +455,9 @@ func (s Service) createInRepo(ctx context.Context, authResults auth.VerifyResult
 	if item.GetDescription() != nil {
 		opts = append(opts, iam.WithDescription(item.GetDescription().GetValue()))
 	}
+	if item.GetMaxConcurrentSessionsPerUser() != nil {
+		opts = append(opts, iam.WithMaxConcurrentSessionsPerUser(item.GetMaxConcurrentSessionsPerUser().GetValue()))
+	}
 	opts = append(opts, iam.WithSkipAdminRoleCreation(req.GetSkipAdminRoleCreation()))
 	opts = append(opts, iam.WithSkipDefaultRoleCreation(req.GetSkipDefaultRoleCreation()))
 
@@ -420,12 +500,18 @@ func (s Service) updateInRepo(ctx context.Context, parentScope *pb.ScopeInfo, sc
 		opts = append(opts, iam.WithName(scopeName))
 	}
 	if primaryAuthMethodId := item.GetPrimaryAuthMethodId(); primaryAuthMethodId != nil {
-		if !handlers.ValidId(handlers.Id(primaryAuthMethodId.GetValue()), password.AuthMethodPrefix, oidc.AuthMethodPrefix) {
+		if !handlers.ValidId(handlers.Id(primaryAuthMethodId.GetValue()), password.AuthMethodPrefix, oidc.AuthMethodPrefix, ldap.AuthMethodPrefix) {
 			return nil, handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{"primary_auth_method_id": "Improperly formatted identifier"})
 		}
 		scopePrimaryAuthMethodId = primaryAuthMethodId.GetValue()
 		opts = append(opts, iam.WithPrimaryAuthMethodId(scopePrimaryAuthMethodId))
 	}
+	// Clearing the limit removes it.
+	scopeMaxConcurrentSessionsPerUser := int32(-1)
+	if limit := item.GetMaxConcurrentSessionsPerUser(); limit != nil {
+		scopeMaxConcurrentSessionsPerUser = limit.GetValue()
+	}
+	opts = append(opts, iam.WithMaxConcurrentSessionsPerUser(scopeMaxConcurrentSessionsPerUser))
 	version := item.GetVersion()
 
 	var iamScope *iam.Scope
@@ -440,6 +526,7 @@ func (s Service) updateInRepo(ctx context.Context, parentScope *pb.ScopeInfo, sc
 		iamScope.Description = scopeDesc
 		iamScope.Name = scopeName
 		iamScope.PrimaryAuthMethodId = scopePrimaryAuthMethodId
+		iamScope.MaxConcurrentSessionsPerUser = scopeMaxConcurrentSessionsPerUser
 	case parentScope.GetType() == scope.Global.String():
 		iamScope, err = iam.NewOrg(opts...)
 	case parentScope.GetType() == scope.Org.String():
@@ -539,6 +626,28 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 	return auth.Verify(ctx, opts...)
 }
 
+// toKeysProto groups the key versions, which are ordered by purpose and
+// descending version, by their key.
+func toKeysProto(kvs []*kms.ScopeKeyVersion) []*pb.Key {
+	var keys []*pb.Key
+	for _, kv := range kvs {
+		if len(keys) == 0 || keys[len(keys)-1].GetId() != kv.KeyId {
+			keys = append(keys, &pb.Key{
+				Id:      kv.KeyId,
+				ScopeId: kv.ScopeId,
+				Purpose: kv.Purpose,
+			})
+		}
+		k := keys[len(keys)-1]
+		k.Versions = append(k.Versions, &pb.KeyVersion{
+			Id:          kv.PrivateId,
+			Version:     kv.Version,
+			CreatedTime: kv.CreateTime.GetTimestamp(),
+		})
+	}
+	return keys
+}
+
 func ToProto(ctx context.Context, in *iam.Scope, opt ...handlers.Option) (*pb.Scope, error) {
 	opts := handlers.GetOpts(opt...)
 	if opts.WithOutputFields == nil {
@@ -583,6 +692,9 @@ func ToProto(ctx context.Context, in *iam.Scope, opt ...handlers.Option) (*pb.Sc
 	if outputFields.Has(globals.PrimaryAuthMethodIdField) && in.GetPrimaryAuthMethodId() != "" {
 		out.PrimaryAuthMethodId = &wrapperspb.StringValue{Value: in.GetPrimaryAuthMethodId()}
 	}
+	if outputFields.Has(globals.MaxConcurrentSessionsPerUserField) && in.GetMaxConcurrentSessionsPerUser() > 0 {
+		out.MaxConcurrentSessionsPerUser = wrapperspb.Int32(in.GetMaxConcurrentSessionsPerUser())
+	}
 
 	return &out, nil
 }
@@ -594,20 +706,7 @@ func ToProto(ctx context.Context, in *iam.Scope, opt ...handlers.Option) (*pb.Sc
 //  * There are no conflicting parameters provided
 func validateGetRequest(req *pbs.GetScopeRequest) error {
 	badFields := map[string]string{}
-	id := req.GetId()
-	switch {
-	case id == scope.Global.String():
-	case strings.HasPrefix(id, scope.Org.Prefix()):
-		if !handlers.ValidId(handlers.Id(id), scope.Org.Prefix()) {
-			badFields["id"] = "Invalidly formatted scope id."
-		}
-	case strings.HasPrefix(id, scope.Project.Prefix()):
-		if !handlers.ValidId(handlers.Id(id), scope.Project.Prefix()) {
-			badFields["id"] = "Invalidly formatted scope id."
-		}
-	default:
-		badFields["id"] = "Invalidly formatted scope id."
-	}
+	validateScopeId(req.GetId(), badFields)
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
 	}
@@ -644,6 +743,7 @@ func validateCreateRequest(req *pbs.CreateScopeRequest) error {
 	if item.GetVersion() != 0 {
 		badFields["version"] = "This cannot be specified at create time."
 	}
+	validateMaxConcurrentSessionsPerUser(item, badFields)
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
 	}
@@ -697,9 +797,15 @@ func validateUpdateRequest(req *pbs.UpdateScopeRequest) error {
 	if item.GetUpdatedTime() != nil {
 		badFields["updated_time"] = "This is a read only field and cannot be specified in an update request."
 	}
-	if item.GetPrimaryAuthMethodId().GetValue() != "" && !handlers.ValidId(handlers.Id(item.GetPrimaryAuthMethodId().GetValue()), password.AuthMethodPrefix, oidc.AuthMethodPrefix) {
+	if item.GetPrimaryAuthMethodId().GetValue() != "" && !handlers.ValidId(handlers.Id(item.GetPrimaryAuthMethodId().GetValue()), password.AuthMethodPrefix, oidc.AuthMethodPrefix, ldap.AuthMethodPrefix) {
 		badFields["primary_auth_method_id"] = "Improperly formatted identifier."
 	}
+	switch {
+	case id != scope.Global.String():
+		validateMaxConcurrentSessionsPerUser(item, badFields)
+	case item.GetMaxConcurrentSessionsPerUser() != nil || handlers.MaskContains(req.GetUpdateMask().GetPaths(), globals.MaxConcurrentSessionsPerUserField):
+		badFields[globals.MaxConcurrentSessionsPerUserField] = "This cannot be set on the global scope."
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
 	}
@@ -707,6 +813,18 @@ func validateUpdateRequest(req *pbs.UpdateScopeRequest) error {
 	return nil
 }
 
+func validateMaxConcurrentSessionsPerUser(item *pb.Scope, badFields map[string]string) {
+	if item.GetMaxConcurrentSessionsPerUser() == nil {
+		return
+	}
+	switch val := item.GetMaxConcurrentSessionsPerUser().GetValue(); {
+	case val == -1:
+	case val > 0:
+	default:
+		badFields[globals.MaxConcurrentSessionsPerUserField] = "This must be -1 (unlimited) or greater than zero."
+	}
+}
+
 func validateDeleteRequest(req *pbs.DeleteScopeRequest) error {
 	badFields := map[string]string{}
 	id := req.GetId()
@@ -730,6 +848,49 @@ func validateDeleteRequest(req *pbs.DeleteScopeRequest) error {
 	return nil
 }
 
+type keysRequest interface {
+	GetId() string
+}
+
+func validateKeysRequest(req keysRequest) error {
+	badFields := map[string]string{}
+	validateScopeId(req.GetId(), badFields)
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
+func validateDestroyKeyVersionRequest(req *pbs.DestroyKeyVersionRequest) error {
+	badFields := map[string]string{}
+	validateScopeId(req.GetId(), badFields)
+	if req.GetKeyVersionId() == "" {
+		badFields["key_version_id"] = "This field is required."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
+// validateScopeId adds an entry for the id to badFields if it is not the
+// global scope or a validly formatted org or project scope id.
+func validateScopeId(id string, badFields map[string]string) {
+	switch {
+	case id == scope.Global.String():
+	case strings.HasPrefix(id, scope.Org.Prefix()):
+		if !handlers.ValidId(handlers.Id(id), scope.Org.Prefix()) {
+			badFields["id"] = "Invalidly formatted scope id."
+		}
+	case strings.HasPrefix(id, scope.Project.Prefix()):
+		if !handlers.ValidId(handlers.Id(id), scope.Project.Prefix()) {
+			badFields["id"] = "Invalidly formatted scope id."
+		}
+	default:
+		badFields["id"] = "Invalidly formatted scope id."
+	}
+}
+
 func validateListRequest(req *pbs.ListScopesRequest) error {
 	badFields := map[string]string{}
 	if req.GetScopeId() != scope.Global.String() && !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Org.Prefix()) {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/boundary/internal/db"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/servers/controller/auth"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
@@ -30,9 +31,9 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-var testAuthorizedActions = []string{"no-op", "read", "update", "delete"}
+var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "list-keys", "rotate-keys", "destroy-key-version"}
 
-func createDefaultScopesAndRepo(t *testing.T) (*iam.Scope, *iam.Scope, func() (*iam.Repository, error)) {
+func createDefaultScopesAndRepo(t *testing.T) (*iam.Scope, *iam.Scope, func() (*iam.Repository, error), *kms.Kms) {
 	t.Helper()
 	conn, _ := db.TestSetup(t, "postgres")
 	wrap := db.TestWrapper(t)
@@ -56,7 +57,7 @@ func createDefaultScopesAndRepo(t *testing.T) (*iam.Scope, *iam.Scope, func() (*
 	require.NoError(t, err)
 	pRes, _, err = repo.UpdateScope(context.Background(), pRes, 1, []string{"Name", "Description"})
 	require.NoError(t, err)
-	return oRes, pRes, repoFn
+	return oRes, pRes, repoFn, kms.TestKms(t, conn, wrap)
 }
 
 var globalAuthorizedCollectionActions = map[string]*structpb.ListValue{
@@ -77,6 +78,11 @@ var globalAuthorizedCollectionActions = map[string]*structpb.ListValue{
 			structpb.NewStringValue("list"),
 		},
 	},
+	"plugins": {
+		Values: []*structpb.Value{
+			structpb.NewStringValue("list"),
+		},
+	},
 	"roles": {
 		Values: []*structpb.Value{
 			structpb.NewStringValue("create"),
@@ -95,6 +101,11 @@ var globalAuthorizedCollectionActions = map[string]*structpb.ListValue{
 			structpb.NewStringValue("list"),
 		},
 	},
+	"workers": {
+		Values: []*structpb.Value{
+			structpb.NewStringValue("list"),
+		},
+	},
 }
 
 var orgAuthorizedCollectionActions = map[string]*structpb.ListValue{
@@ -174,7 +185,7 @@ var projectAuthorizedCollectionActions = map[string]*structpb.ListValue{
 }
 
 func TestGet(t *testing.T) {
-	org, proj, repoFn := createDefaultScopesAndRepo(t)
+	org, proj, repoFn, kmsCache := createDefaultScopesAndRepo(t)
 	toMerge := &pbs.GetScopeRequest{
 		Id: proj.GetPublicId(),
 	}
@@ -262,7 +273,7 @@ func TestGet(t *testing.T) {
 			req := proto.Clone(toMerge).(*pbs.GetScopeRequest)
 			proto.Merge(req, tc.req)
 
-			s, err := scopes.NewService(repoFn)
+			s, err := scopes.NewService(repoFn, kmsCache)
 			require.NoError(err, "Couldn't create new project service.")
 
 			got, gErr := s.GetScope(auth.DisabledAuthTestContext(repoFn, tc.scopeId), req)
@@ -279,6 +290,7 @@ func TestList(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	wrap := db.TestWrapper(t)
 	iamRepo := iam.TestRepo(t, conn, wrap)
+	kmsCache := kms.TestKms(t, conn, wrap)
 	repoFn := func() (*iam.Repository, error) {
 		return iamRepo, nil
 	}
@@ -343,7 +355,7 @@ func TestList(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := scopes.NewService(repoFn)
+			s, err := scopes.NewService(repoFn, kmsCache)
 			require.NoError(err, "Couldn't create new role service.")
 
 			// Test with non-anonymous listing first
@@ -457,7 +469,7 @@ func TestList(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := scopes.NewService(repoFn)
+			s, err := scopes.NewService(repoFn, kmsCache)
 			require.NoError(err, "Couldn't create new role service.")
 
 			// Test with non-anonymous listing first
@@ -484,9 +496,9 @@ func TestList(t *testing.T) {
 }
 
 func TestDelete(t *testing.T) {
-	org, proj, repoFn := createDefaultScopesAndRepo(t)
+	org, proj, repoFn, kmsCache := createDefaultScopesAndRepo(t)
 
-	s, err := scopes.NewService(repoFn)
+	s, err := scopes.NewService(repoFn, kmsCache)
 	require.NoError(t, err, "Error when getting new project service.")
 
 	cases := []struct {
@@ -558,9 +570,9 @@ func TestDelete(t *testing.T) {
 
 func TestDelete_twice(t *testing.T) {
 	assert, require := assert.New(t), require.New(t)
-	org, proj, repoFn := createDefaultScopesAndRepo(t)
+	org, proj, repoFn, kmsCache := createDefaultScopesAndRepo(t)
 
-	s, err := scopes.NewService(repoFn)
+	s, err := scopes.NewService(repoFn, kmsCache)
 	require.NoError(err, "Error when getting new scopes service")
 	ctx := auth.DisabledAuthTestContext(repoFn, org.GetPublicId())
 	req := &pbs.DeleteScopeRequest{
@@ -585,7 +597,7 @@ func TestDelete_twice(t *testing.T) {
 
 func TestCreate(t *testing.T) {
 	ctx := context.Background()
-	defaultOrg, defaultProj, repoFn := createDefaultScopesAndRepo(t)
+	defaultOrg, defaultProj, repoFn, kmsCache := createDefaultScopesAndRepo(t)
 	defaultProjCreated := defaultProj.GetCreateTime().GetTimestamp().AsTime()
 	toMerge := &pbs.CreateScopeRequest{}
 
@@ -612,22 +624,24 @@ func TestCreate(t *testing.T) {
 			scopeId: defaultOrg.GetPublicId(),
 			req: &pbs.CreateScopeRequest{
 				Item: &pb.Scope{
-					ScopeId:     defaultOrg.GetPublicId(),
-					Name:        &wrapperspb.StringValue{Value: "name"},
-					Description: &wrapperspb.StringValue{Value: "desc"},
+					ScopeId:                      defaultOrg.GetPublicId(),
+					Name:                         &wrapperspb.StringValue{Value: "name"},
+					Description:                  &wrapperspb.StringValue{Value: "desc"},
+					MaxConcurrentSessionsPerUser: wrapperspb.Int32(3),
 				},
 			},
 			res: &pbs.CreateScopeResponse{
 				Uri: "scopes/p_",
 				Item: &pb.Scope{
-					ScopeId:                     defaultOrg.GetPublicId(),
-					Scope:                       &pb.ScopeInfo{Id: defaultOrg.GetPublicId(), Type: scope.Org.String(), ParentScopeId: scope.Global.String(), Name: "defaultOrg", Description: "defaultOrg"},
-					Name:                        &wrapperspb.StringValue{Value: "name"},
-					Description:                 &wrapperspb.StringValue{Value: "desc"},
-					Version:                     1,
-					Type:                        scope.Project.String(),
-					AuthorizedActions:           testAuthorizedActions,
-					AuthorizedCollectionActions: projectAuthorizedCollectionActions,
+					ScopeId:                      defaultOrg.GetPublicId(),
+					Scope:                        &pb.ScopeInfo{Id: defaultOrg.GetPublicId(), Type: scope.Org.String(), ParentScopeId: scope.Global.String(), Name: "defaultOrg", Description: "defaultOrg"},
+					Name:                         &wrapperspb.StringValue{Value: "name"},
+					Description:                  &wrapperspb.StringValue{Value: "desc"},
+					Version:                      1,
+					Type:                         scope.Project.String(),
+					MaxConcurrentSessionsPerUser: wrapperspb.Int32(3),
+					AuthorizedActions:            testAuthorizedActions,
+					AuthorizedCollectionActions:  projectAuthorizedCollectionActions,
 				},
 			},
 		},
@@ -768,7 +782,7 @@ func TestCreate(t *testing.T) {
 				req := proto.Clone(toMerge).(*pbs.CreateScopeRequest)
 				proto.Merge(req, tc.req)
 
-				s, err := scopes.NewService(repoFn)
+				s, err := scopes.NewService(repoFn, kmsCache)
 				require.NoError(err, "Error when getting new project service.")
 
 				if name != "" {
@@ -842,8 +856,8 @@ func TestCreate(t *testing.T) {
 }
 
 func TestUpdate(t *testing.T) {
-	org, proj, repoFn := createDefaultScopesAndRepo(t)
-	tested, err := scopes.NewService(repoFn)
+	org, proj, repoFn, kmsCache := createDefaultScopesAndRepo(t)
+	tested, err := scopes.NewService(repoFn, kmsCache)
 	require.NoError(t, err, "Error when getting new project service.")
 
 	iamRepo, err := repoFn()
@@ -1219,6 +1233,35 @@ func TestUpdate(t *testing.T) {
 			res: nil,
 			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
 		},
+		{
+			name:    "Invalid max concurrent sessions per user",
+			scopeId: org.GetPublicId(),
+			req: &pbs.UpdateScopeRequest{
+				UpdateMask: &field_mask.FieldMask{
+					Paths: []string{"max_concurrent_sessions_per_user"},
+				},
+				Item: &pb.Scope{
+					MaxConcurrentSessionsPerUser: wrapperspb.Int32(0),
+				},
+			},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name:    "Cant set max concurrent sessions per user on global",
+			scopeId: scope.Global.String(),
+			req: &pbs.UpdateScopeRequest{
+				UpdateMask: &field_mask.FieldMask{
+					Paths: []string{"max_concurrent_sessions_per_user"},
+				},
+				Item: &pb.Scope{
+					MaxConcurrentSessionsPerUser: wrapperspb.Int32(2),
+					Type:                         scope.Global.String(),
+				},
+			},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -1275,3 +1318,62 @@ func TestUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestKeys(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	org, proj, repoFn, kmsCache := createDefaultScopesAndRepo(t)
+
+	s, err := scopes.NewService(repoFn, kmsCache)
+	require.NoError(err, "Error when getting new scopes service")
+	ctx := auth.DisabledAuthTestContext(repoFn, org.GetPublicId())
+
+	_, err = s.ListKeys(ctx, &pbs.ListKeysRequest{Id: "bad_format"})
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "Got %v, wanted invalid argument", err)
+	_, err = s.RotateKeys(ctx, &pbs.RotateKeysRequest{Id: "p_doesntexis"})
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.NotFound)), "Got %v, wanted not found", err)
+	_, err = s.DestroyKeyVersion(ctx, &pbs.DestroyKeyVersionRequest{Id: proj.GetPublicId()})
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "Got %v, wanted invalid argument", err)
+
+	got, err := s.ListKeys(ctx, &pbs.ListKeysRequest{Id: proj.GetPublicId()})
+	require.NoError(err)
+	purposes := make([]string, 0, len(got.GetItems()))
+	for _, k := range got.GetItems() {
+		purposes = append(purposes, k.GetPurpose())
+		assert.Equal(proj.GetPublicId(), k.GetScopeId())
+		require.Len(k.GetVersions(), 1)
+		assert.Equal(uint32(1), k.GetVersions()[0].GetVersion())
+	}
+	assert.Equal([]string{"database", "oidc", "oplog", "root", "sessions", "tokens"}, purposes)
+
+	_, err = s.RotateKeys(ctx, &pbs.RotateKeysRequest{Id: proj.GetPublicId()})
+	require.NoError(err)
+	got, err = s.ListKeys(ctx, &pbs.ListKeysRequest{Id: proj.GetPublicId()})
+	require.NoError(err)
+	require.Len(got.GetItems(), len(purposes))
+	var oldDatabaseVersion, latestDatabaseVersion string
+	for _, k := range got.GetItems() {
+		require.Len(k.GetVersions(), 2)
+		assert.Equal(uint32(2), k.GetVersions()[0].GetVersion())
+		assert.Equal(uint32(1), k.GetVersions()[1].GetVersion())
+		if k.GetPurpose() == "database" {
+			latestDatabaseVersion = k.GetVersions()[0].GetId()
+			oldDatabaseVersion = k.GetVersions()[1].GetId()
+		}
+	}
+
+	_, err = s.DestroyKeyVersion(ctx, &pbs.DestroyKeyVersionRequest{Id: proj.GetPublicId(), KeyVersionId: latestDatabaseVersion})
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.FailedPrecondition)), "Got %v, wanted failed precondition", err)
+	_, err = s.DestroyKeyVersion(ctx, &pbs.DestroyKeyVersionRequest{Id: org.GetPublicId(), KeyVersionId: oldDatabaseVersion})
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.NotFound)), "Got %v, wanted not found", err)
+	_, err = s.DestroyKeyVersion(ctx, &pbs.DestroyKeyVersionRequest{Id: proj.GetPublicId(), KeyVersionId: oldDatabaseVersion})
+	require.NoError(err)
+
+	got, err = s.ListKeys(ctx, &pbs.ListKeysRequest{Id: proj.GetPublicId()})
+	require.NoError(err)
+	for _, k := range got.GetItems() {
+		if k.GetPurpose() == "database" {
+			require.Len(k.GetVersions(), 1)
+			assert.Equal(latestDatabaseVersion, k.GetVersions()[0].GetId())
+		}
+	}
+}
@@ -4,10 +4,14 @@ import (
 	"context"
 	stderrors "errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/observability/event"
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/requests"
 	"github.com/hashicorp/boundary/internal/servers/controller/auth"
@@ -15,12 +19,15 @@ import (
 	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/session/recording"
 	"github.com/hashicorp/boundary/internal/target"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/sessions"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var (
@@ -32,6 +39,9 @@ var (
 		action.ReadSelf,
 		action.Cancel,
 		action.CancelSelf,
+		action.ReadRecording,
+		action.Approve,
+		action.Deny,
 	}
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -41,16 +51,22 @@ var (
 	}
 )
 
+// recordingPageSize is the maximum number of bytes of chunk data returned in
+// a single page of a session recording.
+const recordingPageSize = 1 << 20
+
 // Service handles request as described by the pbs.SessionServiceServer interface.
 type Service struct {
 	pbs.UnimplementedSessionServiceServer
 
-	repoFn    common.SessionRepoFactory
-	iamRepoFn common.IamRepoFactory
+	repoFn           common.SessionRepoFactory
+	iamRepoFn        common.IamRepoFactory
+	recordingStorage recording.Storage
 }
 
 // NewService returns a session service which handles session related requests to boundary.
-func NewService(repoFn common.SessionRepoFactory, iamRepoFn common.IamRepoFactory) (Service, error) {
+// The storage recordings are read from can be provided using handlers.WithRecordingStorage.
+func NewService(repoFn common.SessionRepoFactory, iamRepoFn common.IamRepoFactory, opt ...handlers.Option) (Service, error) {
 	const op = "sessions.NewService"
 	if repoFn == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing session repository")
@@ -58,7 +74,8 @@ func NewService(repoFn common.SessionRepoFactory, iamRepoFn common.IamRepoFactor
 	if iamRepoFn == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing iam repository")
 	}
-	return Service{repoFn: repoFn, iamRepoFn: iamRepoFn}, nil
+	opts := handlers.GetOpts(opt...)
+	return Service{repoFn: repoFn, iamRepoFn: iamRepoFn, recordingStorage: opts.WithRecordingStorage}, nil
 }
 
 var _ pbs.SessionServiceServer = Service{}
@@ -147,55 +164,166 @@ func (s Service) ListSessions(ctx context.Context, req *pbs.ListSessionsRequest)
 		return &pbs.ListSessionsResponse{}, nil
 	}
 
-	sesList, err := s.listFromRepo(ctx, scopeIds)
+	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	if len(sesList) == 0 {
-		return &pbs.ListSessionsResponse{}, nil
-	}
-
-	filter, err := handlers.NewFilter(req.GetFilter())
+	pagination, err := handlers.NewPagination(req.GetPageSize(), req.GetListToken(),
+		req.GetScopeId(), strconv.FormatBool(req.GetRecursive()), req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	finalItems := make([]*pb.Session, 0, len(sesList))
+	var finalItems []*pb.Session
+	var listToken string
+	lastId := pagination.StartAfterId
 	res := perms.Resource{
 		Type: resource.Session,
 	}
-	for _, item := range sesList {
-		res.Id = item.GetPublicId()
-		res.ScopeId = item.ScopeId
-		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res))
-		if len(authorizedActions) == 0 {
-			continue
+	// Sessions the caller can't see or that don't match the filter don't count
+	// towards the page size, so keep reading batches until the page is full.
+	for {
+		sesList, err := s.listFromRepo(ctx, scopeIds, pagination.PageSize, lastId)
+		if err != nil {
+			return nil, err
 		}
+		for _, item := range sesList {
+			if pagination.Paginated() && len(finalItems) == pagination.PageSize {
+				// The page is full and at least one more session remains.
+				listToken = pagination.ListToken(lastId)
+				break
+			}
+			lastId = item.GetPublicId()
+			res.Id = item.GetPublicId()
+			res.ScopeId = item.ScopeId
+			authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res))
+			if len(authorizedActions) == 0 {
+				continue
+			}
 
-		if authorizedActions.OnlySelf() && item.UserId != authResults.UserId {
-			continue
-		}
+			if authorizedActions.OnlySelf() && item.UserId != authResults.UserId {
+				continue
+			}
+
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.ScopeId]))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions.Strings()))
+			}
+
+			item, err := toProto(ctx, item, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
 
-		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
-		outputOpts := make([]handlers.Option, 0, 3)
-		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
-		if outputFields.Has(globals.ScopeField) {
-			outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.ScopeId]))
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+			}
 		}
-		if outputFields.Has(globals.AuthorizedActionsField) {
-			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions.Strings()))
+		if !pagination.Paginated() || listToken != "" || len(sesList) < pagination.PageSize {
+			break
 		}
-
-		item, err := toProto(ctx, item, outputOpts...)
-		if err != nil {
-			return nil, err
+		if len(finalItems) == pagination.PageSize {
+			// The page is full and so was the batch, so more sessions may remain.
+			listToken = pagination.ListToken(lastId)
+			break
 		}
+	}
+
+	return &pbs.ListSessionsResponse{Items: finalItems, ListToken: listToken}, nil
+}
 
-		if filter.Match(item) {
-			finalItems = append(finalItems, item)
+// GetSessionUsage implements the interface pbs.SessionServiceServer.
+func (s Service) GetSessionUsage(ctx context.Context, req *pbs.GetSessionUsageRequest) (*pbs.GetSessionUsageResponse, error) {
+	if err := validateGetUsageRequest(req); err != nil {
+		return nil, err
+	}
+
+	// Usage is a summary of the sessions the caller is allowed to list, so
+	// it is authorized the same way as ListSessions.
+	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
+	if authResults.Error != nil {
+		if (authResults.Error == handlers.ForbiddenError() || authResults.Error == handlers.UnauthenticatedError()) &&
+			req.GetRecursive() &&
+			authResults.AuthenticationFinished {
+		} else {
+			return nil, authResults.Error
 		}
 	}
 
-	return &pbs.ListSessionsResponse{Items: finalItems}, nil
+	scopeIds, _, err := scopeids.GetListingScopeIds(ctx,
+		s.iamRepoFn, authResults, req.GetScopeId(), resource.Session, req.GetRecursive(), false)
+	if err != nil {
+		return nil, err
+	}
+	if len(scopeIds) == 0 {
+		return &pbs.GetSessionUsageResponse{}, nil
+	}
+
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	var startTime, endTime time.Time
+	if req.GetStartTime() != nil {
+		startTime = req.GetStartTime().AsTime()
+	}
+	if req.GetEndTime() != nil {
+		endTime = req.GetEndTime().AsTime()
+	}
+	usage, err := repo.ListSessionUsage(ctx, scopeIds, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	type usageKey struct {
+		userId, targetId, scopeId string
+	}
+	totals := make(map[usageKey]*pb.SessionUsage)
+	res := perms.Resource{
+		Type: resource.Session,
+	}
+	for _, u := range usage {
+		res.Id = u.SessionId
+		res.ScopeId = u.ScopeId
+		authorizedActions := authResults.FetchActionSetForId(ctx, u.SessionId, IdActions, auth.WithResource(&res))
+		if len(authorizedActions) == 0 {
+			continue
+		}
+		if authorizedActions.OnlySelf() && u.UserId != authResults.UserId {
+			continue
+		}
+
+		k := usageKey{userId: u.UserId, targetId: u.TargetId, scopeId: u.ScopeId}
+		t, ok := totals[k]
+		if !ok {
+			t = &pb.SessionUsage{UserId: u.UserId, TargetId: u.TargetId, ScopeId: u.ScopeId}
+			totals[k] = t
+		}
+		t.SessionCount++
+		t.ConnectionCount += u.ConnectionCount
+		t.BytesUp += u.BytesUp
+		t.BytesDown += u.BytesDown
+	}
+
+	items := make([]*pb.SessionUsage, 0, len(totals))
+	for _, t := range totals {
+		items = append(items, t)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		switch {
+		case items[i].UserId != items[j].UserId:
+			return items[i].UserId < items[j].UserId
+		case items[i].TargetId != items[j].TargetId:
+			return items[i].TargetId < items[j].TargetId
+		default:
+			return items[i].ScopeId < items[j].ScopeId
+		}
+	})
+	return &pbs.GetSessionUsageResponse{Items: items}, nil
 }
 
 // CancelSession implements the interface pbs.SessionServiceServer.
@@ -269,6 +397,158 @@ func (s Service) CancelSession(ctx context.Context, req *pbs.CancelSessionReques
 	return &pbs.CancelSessionResponse{Item: item}, nil
 }
 
+// ReadSessionRecording implements the interface pbs.SessionServiceServer.
+func (s Service) ReadSessionRecording(ctx context.Context, req *pbs.ReadSessionRecordingRequest) (*pbs.ReadSessionRecordingResponse, error) {
+	const op = "sessions.(Service).ReadSessionRecording"
+
+	if err := validateReadRecordingRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ReadRecording)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	if s.recordingStorage == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition, "Session recording is not configured on this controller.")
+	}
+	ses, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	var conn *session.Connection
+	for _, c := range ses.Connections {
+		if c.PublicId == req.GetConnectionId() {
+			conn = c
+			break
+		}
+	}
+	if conn == nil {
+		return nil, handlers.NotFoundErrorf("Connection %q doesn't exist in session %q.", req.GetConnectionId(), req.GetId())
+	}
+	if conn.RecordingId == "" {
+		return nil, handlers.NotFoundErrorf("Connection %q was not recorded.", req.GetConnectionId())
+	}
+
+	chunks, next, err := recording.ReadPage(ctx, s.recordingStorage, conn.RecordingId, req.GetOffset(), recordingPageSize)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, handlers.NotFoundErrorf("Recording %q doesn't exist.", conn.RecordingId)
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to read recording"))
+	}
+
+	item := &pb.SessionRecording{
+		Id:           conn.RecordingId,
+		SessionId:    ses.GetPublicId(),
+		ConnectionId: conn.PublicId,
+		Chunks:       make([]*pb.RecordingChunk, 0, len(chunks)),
+		Offset:       req.GetOffset(),
+		NextOffset:   next,
+	}
+	for _, c := range chunks {
+		item.Chunks = append(item.Chunks, &pb.RecordingChunk{
+			Time:      timestamppb.New(c.Time),
+			Direction: c.Direction.String(),
+			Data:      c.Data,
+		})
+	}
+	return &pbs.ReadSessionRecordingResponse{Item: item}, nil
+}
+
+// ApproveSession implements the interface pbs.SessionServiceServer.
+func (s Service) ApproveSession(ctx context.Context, req *pbs.ApproveSessionRequest) (*pbs.ApproveSessionResponse, error) {
+	if err := validateApproveRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Approve)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	ses, err := s.approveInRepo(ctx, req.GetId(), req.GetVersion(), authResults.UserId)
+	if err != nil {
+		return nil, err
+	}
+	auditDecision(ctx, ses, authResults, action.Approve, req)
+	item, err := s.decisionToProto(ctx, ses, authResults, action.Approve)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ApproveSessionResponse{Item: item}, nil
+}
+
+// DenySession implements the interface pbs.SessionServiceServer.
+func (s Service) DenySession(ctx context.Context, req *pbs.DenySessionRequest) (*pbs.DenySessionResponse, error) {
+	if err := validateDenyRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Deny)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	ses, err := s.denyInRepo(ctx, req.GetId(), req.GetVersion(), authResults.UserId)
+	if err != nil {
+		return nil, err
+	}
+	auditDecision(ctx, ses, authResults, action.Deny, req)
+	item, err := s.decisionToProto(ctx, ses, authResults, action.Deny)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.DenySessionResponse{Item: item}, nil
+}
+
+// auditDecision writes an audit event recording that the user in authResults
+// approved or denied the session. The decision has already been committed, so
+// a failure to write the event is logged rather than returned.
+func auditDecision(ctx context.Context, ses *session.Session, authResults auth.VerifyResults, a action.Type, req proto.Message) {
+	const op = "sessions.auditDecision"
+	id, err := event.NewId(event.IdPrefix)
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("unable to generate audit event id", "session_id", ses.GetPublicId()))
+		return
+	}
+	opts := []event.Option{
+		event.WithId(id),
+		event.WithAuth(&event.Auth{
+			AuthTokenId: authResults.AuthTokenId,
+			UserInfo:    &event.UserInfo{UserId: authResults.UserId},
+		}),
+		event.WithRequest(&event.Request{
+			Operation: a.String(),
+			Endpoint:  fmt.Sprintf("/v1/sessions/%s:%s", ses.GetPublicId(), a.String()),
+			Details:   req,
+		}),
+		event.WithFlush(),
+	}
+	if reqInfo, ok := event.RequestInfoFromContext(ctx); ok {
+		opts = append(opts, event.WithRequestInfo(reqInfo))
+	}
+	if err := event.WriteAudit(ctx, op, opts...); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("unable to write session decision audit event", "session_id", ses.GetPublicId(), "action", a.String()))
+	}
+}
+
+// decisionToProto returns the proto of a session which was approved or
+// denied using the output fields of action a.
+func (s Service) decisionToProto(ctx context.Context, ses *session.Session, authResults auth.VerifyResults, a action.Type) (*pb.Session, error) {
+	outputFields := authResults.FetchOutputFields(perms.Resource{
+		Id:      ses.GetPublicId(),
+		ScopeId: ses.ScopeId,
+		Type:    resource.Session,
+	}, a).SelfOrDefaults(authResults.UserId)
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		authorizedActions := authResults.FetchActionSetForId(ctx, ses.GetPublicId(), IdActions)
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions.Strings()))
+	}
+	return toProto(ctx, ses, outputOpts...)
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*session.Session, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -287,12 +567,18 @@ func (s Service) getFromRepo(ctx context.Context, id string) (*session.Session,
 	return sess, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]*session.Session, error) {
+// listFromRepo lists the sessions in the provided scopes. A non-zero page size
+// limits the result to a page of sessions sorted after startAfterId.
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, pageSize int, startAfterId string) ([]*session.Session, error) {
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
 	}
-	sesList, err := repo.ListSessions(ctx, session.WithScopeIds(scopeIds))
+	opts := []session.Option{session.WithScopeIds(scopeIds)}
+	if pageSize > 0 {
+		opts = append(opts, session.WithLimit(pageSize), session.WithStartPageAfterId(startAfterId))
+	}
+	sesList, err := repo.ListSessions(ctx, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -312,6 +598,50 @@ func (s Service) cancelInRepo(ctx context.Context, id string, version uint32) (*
 	return out, nil
 }
 
+func (s Service) approveInRepo(ctx context.Context, id string, version uint32, approverId string) (*session.Session, error) {
+	const op = "sessions.(Service).approveInRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.ApproveSession(ctx, id, version, approverId)
+	if err != nil {
+		if apiErr := approvalErrorToApiError(id, err); apiErr != nil {
+			return nil, apiErr
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to approve session"))
+	}
+	return out, nil
+}
+
+func (s Service) denyInRepo(ctx context.Context, id string, version uint32, approverId string) (*session.Session, error) {
+	const op = "sessions.(Service).denyInRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.DenySession(ctx, id, version, approverId)
+	if err != nil {
+		if apiErr := approvalErrorToApiError(id, err); apiErr != nil {
+			return nil, apiErr
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to deny session"))
+	}
+	return out, nil
+}
+
+// approvalErrorToApiError returns the api error for errors returned when
+// approving or denying a session, or nil if there is none.
+func approvalErrorToApiError(id string, err error) error {
+	switch {
+	case errors.Match(errors.T(errors.Forbidden), err):
+		return handlers.ForbiddenError()
+	case errors.Match(errors.T(errors.InvalidSessionState), err):
+		return handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition, fmt.Sprintf("Session %q is not pending approval.", id))
+	}
+	return nil
+}
+
 func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
 	res := auth.VerifyResults{}
 
@@ -334,7 +664,7 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 			res.Error = handlers.NotFoundError()
 			return res
 		}
-	case action.Read, action.ReadSelf, action.Cancel, action.CancelSelf:
+	case action.Read, action.ReadSelf, action.Cancel, action.CancelSelf, action.ReadRecording, action.Approve, action.Deny:
 		repo, err := s.repoFn()
 		if err != nil {
 			res.Error = err
@@ -429,7 +759,8 @@ func toProto(ctx context.Context, in *session.Session, opt ...handlers.Option) (
 		if outputFields.Has(globals.StatesField) {
 			for _, s := range in.States {
 				sessState := &pb.SessionState{
-					Status: s.Status.String(),
+					Status:     s.Status.String(),
+					ApproverId: s.ApproverId,
 				}
 				if s.StartTime != nil {
 					sessState.StartTime = s.StartTime.GetTimestamp()
@@ -440,31 +771,67 @@ func toProto(ctx context.Context, in *session.Session, opt ...handlers.Option) (
 				out.States = append(out.States, sessState)
 			}
 		}
+		// The duration is only reported once the session has ended so that
+		// it doesn't change between reads.
+		if outputFields.Has(globals.DurationSecondsField) &&
+			in.States[0].Status == session.StatusTerminated &&
+			in.States[0].StartTime != nil && in.CreateTime != nil {
+			out.DurationSeconds = durationSeconds(in.CreateTime.GetTimestamp(), in.States[0].StartTime.GetTimestamp())
+		}
 	}
 
 	if len(in.Connections) > 0 {
-		if outputFields.Has(globals.ConnectionsField) {
-			connections := make([]*pb.Connection, 0, len(in.Connections))
-			for _, c := range in.Connections {
-				connections = append(connections, &pb.Connection{
-					PublicId:           c.PublicId,
-					SessionId:          in.PublicId,
-					ClientTcpAddress:   c.ClientTcpAddress,
-					ClientTcpPort:      c.ClientTcpPort,
-					EndpointTcpAddress: c.EndpointTcpAddress,
-					EndpointTcpPort:    c.EndpointTcpPort,
-					BytesUp:            c.BytesUp,
-					BytesDown:          c.BytesDown,
-					ClosedReason:       c.ClosedReason,
-				})
+		var bytesUp, bytesDown uint64
+		connections := make([]*pb.Connection, 0, len(in.Connections))
+		for _, c := range in.Connections {
+			bytesUp += c.BytesUp
+			bytesDown += c.BytesDown
+			conn := &pb.Connection{
+				PublicId:           c.PublicId,
+				SessionId:          in.PublicId,
+				ClientTcpAddress:   c.ClientTcpAddress,
+				ClientTcpPort:      c.ClientTcpPort,
+				EndpointTcpAddress: c.EndpointTcpAddress,
+				EndpointTcpPort:    c.EndpointTcpPort,
+				BytesUp:            c.BytesUp,
+				BytesDown:          c.BytesDown,
+				ClosedReason:       c.ClosedReason,
+				RecordingId:        c.RecordingId,
+				CreatedTime:        c.CreateTime.GetTimestamp(),
+				WorkerChain:        c.WorkerChain,
+			}
+			if c.ClosedTime != nil {
+				conn.ClosedTime = c.ClosedTime.GetTimestamp()
+				if c.CreateTime != nil {
+					conn.DurationSeconds = durationSeconds(conn.CreatedTime, conn.ClosedTime)
+				}
 			}
+			connections = append(connections, conn)
+		}
+		if outputFields.Has(globals.ConnectionsField) {
 			out.Connections = append(out.Connections, connections...)
 		}
+		if outputFields.Has(globals.BytesUpField) {
+			out.BytesUp = bytesUp
+		}
+		if outputFields.Has(globals.BytesDownField) {
+			out.BytesDown = bytesDown
+		}
 	}
 
 	return &out, nil
 }
 
+// durationSeconds returns the whole number of seconds between start and end,
+// or zero if end is not after start.
+func durationSeconds(start, end *timestamppb.Timestamp) uint32 {
+	d := end.AsTime().Sub(start.AsTime())
+	if d <= 0 {
+		return 0
+	}
+	return uint32(d.Seconds())
+}
+
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
 //  * The path passed in is correctly formatted
@@ -483,12 +850,38 @@ func validateListRequest(req *pbs.ListSessionsRequest) error {
 	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
 		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
 	}
+	if _, err := handlers.NewPagination(req.GetPageSize(), req.GetListToken(),
+		req.GetScopeId(), strconv.FormatBool(req.GetRecursive()), req.GetFilter()); err != nil {
+		badFields[globals.ListTokenField] = fmt.Sprintf("This field could not be used. %v", err)
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
 	}
 	return nil
 }
 
+func validateGetUsageRequest(req *pbs.GetSessionUsageRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Project.Prefix()) &&
+		!req.GetRecursive() {
+		badFields["scope_id"] = "This field must be a valid project scope ID or the request must be recursive."
+	}
+	if req.GetStartTime() != nil && !req.GetStartTime().IsValid() {
+		badFields["start_time"] = "This field is not a valid timestamp."
+	}
+	if req.GetEndTime() != nil && !req.GetEndTime().IsValid() {
+		badFields["end_time"] = "This field is not a valid timestamp."
+	}
+	if req.GetStartTime().IsValid() && req.GetEndTime().IsValid() &&
+		!req.GetEndTime().AsTime().After(req.GetStartTime().AsTime()) {
+		badFields["end_time"] = "This field must be after start_time."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Invalid fields provided in request.", badFields)
+	}
+	return nil
+}
+
 func validateCancelRequest(req *pbs.CancelSessionRequest) error {
 	badFields := map[string]string{}
 	if !handlers.ValidId(handlers.Id(req.GetId()), session.SessionPrefix) {
@@ -502,3 +895,45 @@ func validateCancelRequest(req *pbs.CancelSessionRequest) error {
 	}
 	return nil
 }
+
+func validateReadRecordingRequest(req *pbs.ReadSessionRecordingRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), session.SessionPrefix) {
+		badFields["id"] = "Improperly formatted identifier."
+	}
+	if !handlers.ValidId(handlers.Id(req.GetConnectionId()), session.ConnectionPrefix) {
+		badFields["connection_id"] = "Improperly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
+
+func validateApproveRequest(req *pbs.ApproveSessionRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), session.SessionPrefix) {
+		badFields["id"] = "Improperly formatted identifier."
+	}
+	if req.GetVersion() == 0 {
+		badFields["version"] = "Required field."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
+
+func validateDenyRequest(req *pbs.DenySessionRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), session.SessionPrefix) {
+		badFields["id"] = "Improperly formatted identifier."
+	}
+	if req.GetVersion() == 0 {
+		badFields["version"] = "Required field."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
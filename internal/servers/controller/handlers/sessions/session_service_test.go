@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http/httptest"
+	"sort"
 	"testing"
 	"time"
 
@@ -21,6 +22,7 @@ import (
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/sessions"
 	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/session/recording"
 	"github.com/hashicorp/boundary/internal/target"
 	"github.com/hashicorp/boundary/internal/target/tcp"
 	"github.com/hashicorp/boundary/internal/types/scope"
@@ -30,9 +32,10 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-var testAuthorizedActions = []string{"no-op", "read", "read:self", "cancel", "cancel:self"}
+var testAuthorizedActions = []string{"no-op", "read", "read:self", "cancel", "cancel:self", "read-recording", "approve", "deny"}
 
 func TestGetSession(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
@@ -312,6 +315,7 @@ func TestList(t *testing.T) {
 					ClientTcpPort:      c.ClientTcpPort,
 					EndpointTcpAddress: c.EndpointTcpAddress,
 					EndpointTcpPort:    c.EndpointTcpPort,
+					CreatedTime:        c.CreateTime.GetTimestamp(),
 				},
 			},
 		})
@@ -359,6 +363,7 @@ func TestList(t *testing.T) {
 					ClientTcpPort:      c.ClientTcpPort,
 					EndpointTcpAddress: c.EndpointTcpAddress,
 					EndpointTcpPort:    c.EndpointTcpPort,
+					CreatedTime:        c.CreateTime.GetTimestamp(),
 				},
 			},
 		})
@@ -458,13 +463,175 @@ func TestList(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("List Sessions In Pages", func(t *testing.T) {
+		require, assert := require.New(t), assert.New(t)
+		s, err := sessions.NewService(sessRepoFn, iamRepoFn)
+		require.NoError(err, "Couldn't create new session service.")
+
+		var wantIds []string
+		for _, sess := range wantSession {
+			wantIds = append(wantIds, sess.GetId())
+		}
+		sort.Strings(wantIds)
+
+		req := &pbs.ListSessionsRequest{
+			ScopeId:   scope.Global.String(),
+			Recursive: true,
+			Filter:    fmt.Sprintf(`"/item/scope/id"==%q`, pWithSessions.GetPublicId()),
+			PageSize:  4,
+		}
+		var gotIds []string
+		for {
+			got, err := s.ListSessions(auth.DisabledAuthTestContext(iamRepoFn, req.GetScopeId()), req)
+			require.NoError(err)
+			assert.LessOrEqual(len(got.GetItems()), int(req.GetPageSize()))
+			for _, item := range got.GetItems() {
+				assert.Equal(1, len(item.GetConnections()))
+				gotIds = append(gotIds, item.GetId())
+			}
+			if got.GetListToken() == "" {
+				break
+			}
+			req.ListToken = got.GetListToken()
+		}
+		assert.Equal(wantIds, gotIds)
+	})
+}
+
+func TestGetSessionUsage(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+
+	iamRepo := iam.TestRepo(t, conn, wrap)
+
+	rw := db.New(conn)
+	sessRepo, err := session.NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	sessRepoFn := func() (*session.Repository, error) {
+		return sessRepo, nil
+	}
+
+	_, pNoSessions := iam.TestScopes(t, iamRepo)
+	o, pWithSessions := iam.TestScopes(t, iamRepo)
+	at := authtoken.TestAuthToken(t, conn, kms, o.GetPublicId())
+
+	hc := static.TestCatalogs(t, conn, pWithSessions.GetPublicId(), 1)[0]
+	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	static.TestSetMembers(t, conn, hs.GetPublicId(), []*static.Host{h})
+	tar := tcp.TestTarget(context.Background(), t, conn, pWithSessions.GetPublicId(), "test", target.WithHostSources([]string{hs.GetPublicId()}))
+
+	worker := session.TestWorker(t, conn, wrap)
+	var connBytes []session.ConnectionBytes
+	for i := 0; i < 3; i++ {
+		sess := session.TestSession(t, conn, wrap, session.ComposedOf{
+			UserId:      at.GetIamUserId(),
+			HostId:      h.GetPublicId(),
+			TargetId:    tar.GetPublicId(),
+			HostSetId:   hs.GetPublicId(),
+			AuthTokenId: at.GetPublicId(),
+			ScopeId:     pWithSessions.GetPublicId(),
+			Endpoint:    "tcp://127.0.0.1:22",
+		})
+		c := session.TestConnection(t, conn, sess.PublicId, "127.0.0.1", 22, "127.0.0.2", 23, session.WithServerId(worker.PrivateId))
+		connBytes = append(connBytes, session.ConnectionBytes{ConnectionId: c.PublicId, BytesUp: 10, BytesDown: 100})
+	}
+	_, err = sessRepo.UpdateConnectionBytes(context.Background(), worker.PrivateId, connBytes)
+	require.NoError(t, err)
+
+	wantUsage := &pb.SessionUsage{
+		UserId:          at.GetIamUserId(),
+		TargetId:        tar.GetPublicId(),
+		ScopeId:         pWithSessions.GetPublicId(),
+		SessionCount:    3,
+		ConnectionCount: 3,
+		BytesUp:         30,
+		BytesDown:       300,
+	}
+
+	cases := []struct {
+		name string
+		req  *pbs.GetSessionUsageRequest
+		res  *pbs.GetSessionUsageResponse
+		err  error
+	}{
+		{
+			name: "Usage In Scope",
+			req:  &pbs.GetSessionUsageRequest{ScopeId: pWithSessions.GetPublicId()},
+			res:  &pbs.GetSessionUsageResponse{Items: []*pb.SessionUsage{wantUsage}},
+		},
+		{
+			name: "Usage Recursively",
+			req:  &pbs.GetSessionUsageRequest{ScopeId: scope.Global.String(), Recursive: true},
+			res:  &pbs.GetSessionUsageResponse{Items: []*pb.SessionUsage{wantUsage}},
+		},
+		{
+			name: "Usage In Time Range",
+			req: &pbs.GetSessionUsageRequest{
+				ScopeId:   pWithSessions.GetPublicId(),
+				StartTime: timestamppb.New(time.Now().Add(-time.Hour)),
+				EndTime:   timestamppb.New(time.Now().Add(time.Hour)),
+			},
+			res: &pbs.GetSessionUsageResponse{Items: []*pb.SessionUsage{wantUsage}},
+		},
+		{
+			name: "Usage Outside Time Range",
+			req: &pbs.GetSessionUsageRequest{
+				ScopeId:   pWithSessions.GetPublicId(),
+				StartTime: timestamppb.New(time.Now().Add(time.Hour)),
+			},
+			res: &pbs.GetSessionUsageResponse{Items: []*pb.SessionUsage{}},
+		},
+		{
+			name: "No Sessions",
+			req:  &pbs.GetSessionUsageRequest{ScopeId: pNoSessions.GetPublicId()},
+			res:  &pbs.GetSessionUsageResponse{Items: []*pb.SessionUsage{}},
+		},
+		{
+			name: "End Before Start",
+			req: &pbs.GetSessionUsageRequest{
+				ScopeId:   pWithSessions.GetPublicId(),
+				StartTime: timestamppb.New(time.Now()),
+				EndTime:   timestamppb.New(time.Now().Add(-time.Hour)),
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Non Recursive Org Scope",
+			req:  &pbs.GetSessionUsageRequest{ScopeId: o.GetPublicId()},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require, assert := require.New(t), assert.New(t)
+			s, err := sessions.NewService(sessRepoFn, iamRepoFn)
+			require.NoError(err, "Couldn't create new session service.")
+
+			got, gErr := s.GetSessionUsage(auth.DisabledAuthTestContext(iamRepoFn, tc.req.GetScopeId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "GetSessionUsage(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform()), "GetSessionUsage(%q) got response %q, wanted %q", tc.req, got, tc.res)
+		})
+	}
 }
 
 func convertStates(in []*session.State) (string, []*pb.SessionState) {
 	out := make([]*pb.SessionState, 0, len(in))
 	for _, s := range in {
 		sessState := &pb.SessionState{
-			Status: s.Status.String(),
+			Status:     s.Status.String(),
+			ApproverId: s.ApproverId,
 		}
 		if s.StartTime != nil {
 			sessState.StartTime = s.StartTime.GetTimestamp()
@@ -634,3 +801,141 @@ func TestCancel(t *testing.T) {
 		})
 	}
 }
+
+func TestReadRecording(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+
+	iamRepo := iam.TestRepo(t, conn, wrap)
+
+	rw := db.New(conn)
+	sessRepo, err := session.NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	sessRepoFn := func() (*session.Repository, error) {
+		return sessRepo, nil
+	}
+
+	sess := session.TestDefaultSession(t, conn, wrap, iamRepo)
+	recordedConn := session.TestConnection(t, conn, sess.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222)
+	unrecordedConn := session.TestConnection(t, conn, sess.PublicId, "127.0.0.1", 22, "127.0.0.1", 2223)
+
+	storage, err := recording.NewLocalStorage(ctx, t.TempDir())
+	require.NoError(t, err)
+	recId, err := recording.NewId(ctx)
+	require.NoError(t, err)
+	ts := time.Now().Truncate(time.Microsecond)
+	rec, err := recording.NewRecorder(ctx, storage, recId, recording.WithNow(func() time.Time { return ts }))
+	require.NoError(t, err)
+	require.NoError(t, rec.Record(recording.Up, []byte("ping")))
+	require.NoError(t, rec.Record(recording.Down, []byte("pong")))
+	require.NoError(t, rec.Close())
+
+	_, _, err = sessRepo.ConnectConnection(ctx, session.ConnectWith{
+		ConnectionId:       recordedConn.PublicId,
+		ClientTcpAddress:   recordedConn.ClientTcpAddress,
+		ClientTcpPort:      recordedConn.ClientTcpPort,
+		EndpointTcpAddress: recordedConn.EndpointTcpAddress,
+		EndpointTcpPort:    recordedConn.EndpointTcpPort,
+		RecordingId:        recId,
+	})
+	require.NoError(t, err)
+
+	cases := []struct {
+		name    string
+		storage recording.Storage
+		req     *pbs.ReadSessionRecordingRequest
+		res     *pbs.ReadSessionRecordingResponse
+		err     error
+	}{
+		{
+			name:    "Read a recording",
+			storage: storage,
+			req:     &pbs.ReadSessionRecordingRequest{Id: sess.PublicId, ConnectionId: recordedConn.PublicId},
+			res: &pbs.ReadSessionRecordingResponse{Item: &pb.SessionRecording{
+				Id:           recId,
+				SessionId:    sess.PublicId,
+				ConnectionId: recordedConn.PublicId,
+				Chunks: []*pb.RecordingChunk{
+					{Time: timestamppb.New(ts), Direction: "up", Data: []byte("ping")},
+					{Time: timestamppb.New(ts), Direction: "down", Data: []byte("pong")},
+				},
+			}},
+		},
+		{
+			name:    "Read a recording from an offset",
+			storage: storage,
+			req:     &pbs.ReadSessionRecordingRequest{Id: sess.PublicId, ConnectionId: recordedConn.PublicId, Offset: 1},
+			res: &pbs.ReadSessionRecordingResponse{Item: &pb.SessionRecording{
+				Id:           recId,
+				SessionId:    sess.PublicId,
+				ConnectionId: recordedConn.PublicId,
+				Offset:       1,
+				Chunks: []*pb.RecordingChunk{
+					{Time: timestamppb.New(ts), Direction: "down", Data: []byte("pong")},
+				},
+			}},
+		},
+		{
+			name: "Recording not configured",
+			req:  &pbs.ReadSessionRecordingRequest{Id: sess.PublicId, ConnectionId: recordedConn.PublicId},
+			err:  handlers.ApiErrorWithCode(codes.FailedPrecondition),
+		},
+		{
+			name:    "Connection not recorded",
+			storage: storage,
+			req:     &pbs.ReadSessionRecordingRequest{Id: sess.PublicId, ConnectionId: unrecordedConn.PublicId},
+			err:     handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name:    "Connection not in session",
+			storage: storage,
+			req:     &pbs.ReadSessionRecordingRequest{Id: sess.PublicId, ConnectionId: session.ConnectionPrefix + "_DoesntExis"},
+			err:     handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name:    "Non existing session",
+			storage: storage,
+			req:     &pbs.ReadSessionRecordingRequest{Id: session.SessionPrefix + "_DoesntExis", ConnectionId: recordedConn.PublicId},
+			err:     handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name:    "Wrong connection id prefix",
+			storage: storage,
+			req:     &pbs.ReadSessionRecordingRequest{Id: sess.PublicId, ConnectionId: "j_1234567890"},
+			err:     handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name:    "Missing connection id",
+			storage: storage,
+			req:     &pbs.ReadSessionRecordingRequest{Id: sess.PublicId},
+			err:     handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			var opts []handlers.Option
+			if tc.storage != nil {
+				opts = append(opts, handlers.WithRecordingStorage(tc.storage))
+			}
+			s, err := sessions.NewService(sessRepoFn, iamRepoFn, opts...)
+			require.NoError(err, "Couldn't create new session service.")
+
+			got, gErr := s.ReadSessionRecording(auth.DisabledAuthTestContext(iamRepoFn, sess.ScopeId), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "ReadSessionRecording(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform()), "ReadSessionRecording(%q) got response %q, wanted %q", tc.req, got, tc.res)
+		})
+	}
+}
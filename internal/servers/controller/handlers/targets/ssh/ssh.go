@@ -0,0 +1,77 @@
+package ssh
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/targets"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/ssh"
+	"github.com/hashicorp/boundary/internal/target/ssh/store"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+)
+
+type attribute struct {
+	*pb.SshTargetAttributes
+}
+
+func (a *attribute) Options() []target.Option {
+	var opts []target.Option
+	if a.GetDefaultPort().GetValue() != 0 {
+		opts = append(opts, target.WithDefaultPort(a.GetDefaultPort().GetValue()))
+	}
+	if len(a.GetHostKeys()) > 0 {
+		opts = append(opts, target.WithHostKeys(strings.Join(a.GetHostKeys(), "\n")))
+	}
+	return opts
+}
+
+func (a *attribute) Vet() map[string]string {
+	badFields := map[string]string{}
+	if a.GetDefaultPort() != nil && a.GetDefaultPort().GetValue() == 0 {
+		badFields["attributes.default_port"] = "This optional field cannot be set to 0."
+	}
+	for _, k := range a.GetHostKeys() {
+		keys, err := ssh.ParseHostKeys(k)
+		if err != nil {
+			badFields["attributes.host_keys"] = fmt.Sprintf("Unable to parse host key %q: %v.", k, err)
+			break
+		}
+		if len(keys) != 1 || strings.Contains(k, "\n") {
+			badFields["attributes.host_keys"] = fmt.Sprintf("Host key %q must be a single public key in the OpenSSH authorized_keys format.", k)
+			break
+		}
+	}
+	return badFields
+}
+
+func newAttribute(t target.Target) targets.Attributes {
+	a := &attribute{
+		&pb.SshTargetAttributes{},
+	}
+	if t != nil {
+		if t.GetDefaultPort() > 0 {
+			a.DefaultPort = &wrappers.UInt32Value{Value: t.GetDefaultPort()}
+		}
+		if hk, ok := t.(target.HostKeyTarget); ok && hk.GetHostKeys() != "" {
+			a.HostKeys = strings.Split(hk.GetHostKeys(), "\n")
+		}
+	}
+	return a
+}
+
+func init() {
+	var maskManager handlers.MaskManager
+	var err error
+
+	if maskManager, err = handlers.NewMaskManager(
+		handlers.MaskDestination{&store.Target{}},
+		handlers.MaskSource{&pb.Target{}, &pb.SshTargetAttributes{}},
+	); err != nil {
+		panic(err)
+	}
+
+	targets.Register(ssh.Subtype, maskManager, newAttribute)
+}
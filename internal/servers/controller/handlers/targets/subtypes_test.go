@@ -0,0 +1,286 @@
+package targets_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	credstatic "github.com/hashicorp/boundary/internal/credential/static"
+	"github.com/hashicorp/boundary/internal/credential/vault"
+	"github.com/hashicorp/boundary/internal/db"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/host/plugin"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/servers"
+	"github.com/hashicorp/boundary/internal/servers/controller/auth"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/targets"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/postgres"
+	"github.com/hashicorp/boundary/internal/target/ssh"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gossh "golang.org/x/crypto/ssh"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	_ "github.com/hashicorp/boundary/internal/servers/controller/handlers/targets/postgres"
+	_ "github.com/hashicorp/boundary/internal/servers/controller/handlers/targets/ssh"
+)
+
+var testAuthorizedActions = []string{
+	"no-op",
+	"read",
+	"update",
+	"delete",
+	"add-host-sets",
+	"set-host-sets",
+	"remove-host-sets",
+	"add-credential-libraries",
+	"set-credential-libraries",
+	"remove-credential-libraries",
+	"add-credential-sources",
+	"set-credential-sources",
+	"remove-credential-sources",
+	"authorize-session",
+}
+
+func testService(t *testing.T, conn *db.DB, kms *kms.Kms, wrapper wrapping.Wrapper) (targets.Service, error) {
+	rw := db.New(conn)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	repoFn := func() (*target.Repository, error) {
+		return target.NewRepository(rw, rw, kms)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrapper), nil
+	}
+	serversRepoFn := func() (*servers.Repository, error) {
+		return servers.NewRepository(rw, rw, kms)
+	}
+	sessionRepoFn := func() (*session.Repository, error) {
+		return session.NewRepository(rw, rw, kms)
+	}
+	staticHostRepoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+	pluginHostRepoFn := func() (*plugin.Repository, error) {
+		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
+	}
+	credentialRepoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(rw, rw, kms, sche)
+	}
+	staticCredentialRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
+	return targets.NewService(context.Background(), kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, credentialRepoFn, staticCredentialRepoFn)
+}
+
+// testSubtypes are the target subtypes whose handlers are tested by the
+// tests in this file, along with the attributes used to create their
+// targets.
+var testSubtypes = []struct {
+	subtype    subtypes.Subtype
+	prefix     string
+	attributes func(t *testing.T) map[string]*structpb.Value
+}{
+	{
+		subtype: ssh.Subtype,
+		prefix:  ssh.TargetPrefix,
+		attributes: func(t *testing.T) map[string]*structpb.Value {
+			hostKeys, err := structpb.NewList([]interface{}{testHostKey(t)})
+			require.NoError(t, err)
+			return map[string]*structpb.Value{
+				"default_port": structpb.NewNumberValue(22),
+				"host_keys":    structpb.NewListValue(hostKeys),
+			}
+		},
+	},
+	{
+		subtype: postgres.Subtype,
+		prefix:  postgres.TargetPrefix,
+		attributes: func(t *testing.T) map[string]*structpb.Value {
+			return map[string]*structpb.Value{
+				"default_port": structpb.NewNumberValue(5432),
+			}
+		},
+	},
+}
+
+func testHostKey(t *testing.T) string {
+	t.Helper()
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	pk, err := gossh.NewPublicKey(pub)
+	require.NoError(t, err)
+	return strings.TrimSpace(string(gossh.MarshalAuthorizedKey(pk)))
+}
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+
+	org, proj := iam.TestScopes(t, iamRepo)
+
+	s, err := testService(t, conn, kms, wrapper)
+	require.NoError(t, err, "Error when getting new target service.")
+
+	for _, st := range testSubtypes {
+		st := st
+		t.Run(st.subtype.String(), func(t *testing.T) {
+			attrs := st.attributes(t)
+			req := &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId:     proj.GetPublicId(),
+				Name:        wrapperspb.String(st.subtype.String()),
+				Description: wrapperspb.String("desc"),
+				Type:        st.subtype.String(),
+				Attributes:  &structpb.Struct{Fields: attrs},
+			}}
+			want := &pbs.CreateTargetResponse{
+				Uri: fmt.Sprintf("targets/%s_", st.prefix),
+				Item: &pb.Target{
+					ScopeId:                proj.GetPublicId(),
+					Scope:                  &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: org.GetPublicId()},
+					Name:                   wrapperspb.String(st.subtype.String()),
+					Description:            wrapperspb.String("desc"),
+					Type:                   st.subtype.String(),
+					Attributes:             &structpb.Struct{Fields: attrs},
+					SessionMaxSeconds:      wrapperspb.UInt32(28800),
+					SessionConnectionLimit: wrapperspb.Int32(1),
+					AuthorizedActions:      testAuthorizedActions,
+				},
+			}
+
+			got, err := s.CreateTarget(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), req)
+			require.NoError(t, err)
+			require.NotNil(t, got)
+			assert.True(t, strings.HasPrefix(got.GetUri(), want.GetUri()))
+			assert.True(t, strings.HasPrefix(got.GetItem().GetId(), st.prefix+"_"))
+			assert.Equal(t, got.GetItem().GetCreatedTime(), got.GetItem().GetUpdatedTime())
+			// Clear all values which are hard to compare against.
+			got.Uri, want.Uri = "", ""
+			got.Item.Id, want.Item.Id = "", ""
+			got.Item.CreatedTime, got.Item.UpdatedTime, want.Item.CreatedTime, want.Item.UpdatedTime = nil, nil, nil, nil
+			got.Item.Version = 0
+			assert.Empty(t, cmp.Diff(got, want, protocmp.Transform()), "CreateTarget(%q) got response %q, wanted %q", req, got, want)
+		})
+	}
+}
+
+func TestCreate_InvalidSshHostKey(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+
+	_, proj := iam.TestScopes(t, iamRepo)
+
+	s, err := testService(t, conn, kms, wrapper)
+	require.NoError(t, err, "Error when getting new target service.")
+
+	tests := []struct {
+		name     string
+		hostKeys []interface{}
+	}{
+		{
+			name:     "not-a-key",
+			hostKeys: []interface{}{"ssh-ed25519 not-a-key"},
+		},
+		{
+			name:     "multiple-keys-in-one-value",
+			hostKeys: []interface{}{testHostKey(t) + "\n" + testHostKey(t)},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			hostKeys, err := structpb.NewList(tt.hostKeys)
+			require.NoError(t, err)
+			req := &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId: proj.GetPublicId(),
+				Name:    wrapperspb.String(tt.name),
+				Type:    ssh.Subtype.String(),
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"host_keys": structpb.NewListValue(hostKeys),
+				}},
+			}}
+			_, err = s.CreateTarget(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), req)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+		})
+	}
+}
+
+func TestAddTargetSources(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+
+	_, proj := iam.TestScopes(t, iamRepo)
+
+	s, err := testService(t, conn, kms, wrapper)
+	require.NoError(t, err, "Error when getting new target service.")
+
+	store := vault.TestCredentialStores(t, conn, wrapper, proj.GetPublicId(), 1)[0]
+	cls := vault.TestCredentialLibraries(t, conn, wrapper, store.GetPublicId(), 2)
+
+	ctx := context.Background()
+	for _, st := range testSubtypes {
+		st := st
+		t.Run(st.subtype.String(), func(t *testing.T) {
+			tar := target.TestTarget(ctx, t, conn, st.subtype, proj.GetPublicId(), st.subtype.String())
+
+			req := &pbs.AddTargetCredentialSourcesRequest{
+				Id:                             tar.GetPublicId(),
+				Version:                        tar.GetVersion(),
+				ApplicationCredentialSourceIds: []string{cls[0].GetPublicId()},
+				EgressCredentialSourceIds:      []string{cls[1].GetPublicId()},
+			}
+			got, err := s.AddTargetCredentialSources(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), req)
+			require.NoError(t, err)
+
+			item := got.GetItem()
+			assert.Equal(t, []string{cls[0].GetPublicId()}, item.GetApplicationCredentialSourceIds())
+			require.Len(t, item.GetApplicationCredentialSources(), 1)
+			assert.Equal(t, cls[0].GetPublicId(), item.GetApplicationCredentialSources()[0].GetId())
+			assert.Equal(t, []string{cls[1].GetPublicId()}, item.GetEgressCredentialSourceIds())
+			require.Len(t, item.GetEgressCredentialSources(), 1)
+			assert.Equal(t, cls[1].GetPublicId(), item.GetEgressCredentialSources()[0].GetId())
+			assert.Equal(t, store.GetPublicId(), item.GetEgressCredentialSources()[0].GetCredentialStoreId())
+		})
+	}
+}
@@ -8,17 +8,21 @@ import (
 	"fmt"
 	"math/rand"
 	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/credential"
+	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
+	pb_api "github.com/hashicorp/boundary/internal/gen/controller/api"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/host"
 	"github.com/hashicorp/boundary/internal/host/plugin"
 	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/requests"
@@ -32,10 +36,10 @@ import (
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
 	"github.com/hashicorp/go-bexpr"
 	"github.com/hashicorp/go-secure-stdlib/strutil"
-	"github.com/mitchellh/pointerstructure"
 	"github.com/mr-tron/base58"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/proto"
@@ -83,6 +87,7 @@ type Service struct {
 	pluginHostRepoFn common.PluginHostRepoFactory
 	staticHostRepoFn common.StaticRepoFactory
 	vaultCredRepoFn  common.VaultCredentialRepoFactory
+	staticCredRepoFn common.StaticCredentialRepoFactory
 	kmsCache         *kms.Kms
 }
 
@@ -96,7 +101,8 @@ func NewService(
 	sessionRepoFn common.SessionRepoFactory,
 	pluginHostRepoFn common.PluginHostRepoFactory,
 	staticHostRepoFn common.StaticRepoFactory,
-	vaultCredRepoFn common.VaultCredentialRepoFactory) (Service, error) {
+	vaultCredRepoFn common.VaultCredentialRepoFactory,
+	staticCredRepoFn common.StaticCredentialRepoFactory) (Service, error) {
 	const op = "targets.NewService"
 	if repoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing target repository")
@@ -119,6 +125,9 @@ func NewService(
 	if vaultCredRepoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing vault credential repository")
 	}
+	if staticCredRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing static credential repository")
+	}
 	return Service{
 		repoFn:           repoFn,
 		iamRepoFn:        iamRepoFn,
@@ -127,6 +136,7 @@ func NewService(
 		pluginHostRepoFn: pluginHostRepoFn,
 		staticHostRepoFn: staticHostRepoFn,
 		vaultCredRepoFn:  vaultCredRepoFn,
+		staticCredRepoFn: staticCredRepoFn,
 		kmsCache:         kmsCache,
 	}, nil
 }
@@ -162,50 +172,71 @@ func (s Service) ListTargets(ctx context.Context, req *pbs.ListTargetsRequest) (
 		return &pbs.ListTargetsResponse{}, nil
 	}
 
-	tl, err := s.listFromRepo(ctx, scopeIds)
+	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	if len(tl) == 0 {
-		return &pbs.ListTargetsResponse{}, nil
-	}
-
-	filter, err := handlers.NewFilter(req.GetFilter())
+	pagination, err := handlers.NewPagination(req.GetPageSize(), req.GetListToken(),
+		req.GetScopeId(), strconv.FormatBool(req.GetRecursive()), req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	finalItems := make([]*pb.Target, 0, len(tl))
+	var finalItems []*pb.Target
+	var listToken string
+	lastId := pagination.StartAfterId
 	res := perms.Resource{
 		Type: resource.Target,
 	}
-	for _, item := range tl {
-		res.Id = item.GetPublicId()
-		res.ScopeId = item.GetScopeId()
-		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
-		if len(authorizedActions) == 0 {
-			continue
-		}
-
-		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
-		outputOpts := make([]handlers.Option, 0, 3)
-		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
-		if outputFields.Has(globals.ScopeField) {
-			outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetScopeId()]))
-		}
-		if outputFields.Has(globals.AuthorizedActionsField) {
-			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
-		}
-
-		item, err := toProto(ctx, item, nil, nil, outputOpts...)
+	// Targets the caller can't see or that don't match the filter don't count
+	// towards the page size, so keep reading batches until the page is full.
+	for {
+		tl, err := s.listFromRepo(ctx, scopeIds, pagination.PageSize, lastId)
 		if err != nil {
 			return nil, err
 		}
+		for _, item := range tl {
+			if pagination.Paginated() && len(finalItems) == pagination.PageSize {
+				// The page is full and at least one more target remains.
+				listToken = pagination.ListToken(lastId)
+				break
+			}
+			lastId = item.GetPublicId()
+			res.Id = item.GetPublicId()
+			res.ScopeId = item.GetScopeId()
+			authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
+			if len(authorizedActions) == 0 {
+				continue
+			}
+
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetScopeId()]))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+			}
+
+			item, err := toProto(ctx, item, nil, nil, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
 
-		if filter.Match(item) {
-			finalItems = append(finalItems, item)
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+			}
+		}
+		if !pagination.Paginated() || listToken != "" || len(tl) < pagination.PageSize {
+			break
+		}
+		if len(finalItems) == pagination.PageSize {
+			// The page is full and so was the batch, so more targets may remain.
+			listToken = pagination.ListToken(lastId)
+			break
 		}
 	}
-	return &pbs.ListTargetsResponse{Items: finalItems}, nil
+	return &pbs.ListTargetsResponse{Items: finalItems, ListToken: listToken}, nil
 }
 
 // GetTargets implements the interface pbs.TargetServiceServer.
@@ -852,88 +883,73 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	if t == nil {
 		return nil, handlers.NotFoundErrorf("Target %q not found.", t.GetPublicId())
 	}
+	for _, l := range libs {
+		cl, err := target.NewCredentialLibrary(t.GetPublicId(), l.Id(), l.CredentialPurpose())
+		if err != nil {
+			return nil, err
+		}
+		if err := target.VetCredentialLibraries(ctx, t.GetType(), []*target.CredentialLibrary{cl}); err != nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition,
+				"Credential source %q has purpose %q, which is not supported by %s targets.", l.Id(), l.CredentialPurpose(), t.GetType())
+		}
+	}
 
 	// Instantiate some repos
 	sessionRepo, err := s.sessionRepoFn()
 	if err != nil {
 		return nil, err
 	}
+	sessionLimits, err := s.sessionLimits(ctx, t)
+	if err != nil {
+		return nil, err
+	}
 	serversRepo, err := s.serversRepoFn()
 	if err != nil {
 		return nil, err
 	}
 
 	// First ensure we can actually service a request, that is, we have workers
-	// available (after any filtering). WorkerInfo only contains the address;
-	// worker IDs below is used to contain their IDs in the same order. This is
-	// used to fetch tags for filtering. But we avoid allocation unless we
-	// actually need it.
-	var workers []*pb.WorkerInfo
-	var workerIds []string
-	hasWorkerFilter := len(t.GetWorkerFilter()) > 0
-	servers, err := serversRepo.ListServers(ctx, servers.ServerTypeWorker)
+	// available (after any filtering). When the target has an ingress worker
+	// filter, clients are given the workers matching it, which forward
+	// connections to a worker matching the worker filter; otherwise clients
+	// connect directly to the workers matching the worker filter. Reverse
+	// workers can't be dialed, so they are never given to clients.
+	allWorkers, err := serversRepo.ListServers(ctx, servers.ServerTypeWorker)
 	if err != nil {
 		return nil, err
 	}
-	for _, v := range servers {
-		if hasWorkerFilter {
-			workerIds = append(workerIds, v.GetPrivateId())
-		}
-		workers = append(workers, &pb.WorkerInfo{Address: v.Address})
+	egressWorkers, err := handlers.FilterWorkers(ctx, serversRepo, allWorkers, t.GetWorkerFilter())
+	if err != nil {
+		return nil, err
 	}
-
-	if hasWorkerFilter && len(workerIds) > 0 {
-		finalWorkers := make([]*pb.WorkerInfo, 0, len(workers))
-		// Fetch the tags for the given worker IDs
-		tags, err := serversRepo.ListTagsForServers(ctx, workerIds)
+	if len(egressWorkers) == 0 {
+		return nil, handlers.ApiErrorWithCodeAndMessage(
+			codes.FailedPrecondition,
+			"No workers are available to handle this session, or all have been filtered.")
+	}
+	clientWorkers := egressWorkers
+	if t.GetIngressWorkerFilter() != "" {
+		clientWorkers, err = handlers.FilterWorkers(ctx, serversRepo, allWorkers, t.GetIngressWorkerFilter())
 		if err != nil {
 			return nil, err
 		}
-		// Build the map for filtering. This is similar to the filter map we
-		// built from the worker config, but with one extra level: a map of the
-		// worker's ID to its filter map.
-		tagMap := make(map[string]map[string][]string)
-		for _, tag := range tags {
-			currWorkerMap := tagMap[tag.ServerId]
-			if currWorkerMap == nil {
-				currWorkerMap = make(map[string][]string)
-				tagMap[tag.ServerId] = currWorkerMap
-			}
-			currWorkerMap[tag.Key] = append(currWorkerMap[tag.Key], tag.Value)
-			// We don't need to reinsert after the fact because maps are
-			// reference types, so we don't need to re-insert into tagMap
+		if len(clientWorkers) == 0 {
+			return nil, handlers.ApiErrorWithCodeAndMessage(
+				codes.FailedPrecondition,
+				"No ingress workers are available to handle this session, or all have been filtered.")
 		}
-
-		// Create the evaluator
-		eval, err := bexpr.CreateEvaluator(t.GetWorkerFilter())
-		if err != nil {
-			return nil, err
-		}
-
-		// Iterate through the known worker IDs, and evaluate. If evaluation
-		// returns true, add to the final worker slice, which is assigned back
-		// to workers after this.
-		for i, worker := range workerIds {
-			filterInput := map[string]interface{}{
-				"name": worker,
-				"tags": tagMap[worker],
-			}
-			ok, err := eval.Evaluate(filterInput)
-			if err != nil && !stderrors.Is(err, pointerstructure.ErrNotFound) {
-				return nil, handlers.ApiErrorWithCodeAndMessage(
-					codes.FailedPrecondition,
-					fmt.Sprintf("Worker filter expression evaluation resulted in error: %s", err))
-			}
-			if ok {
-				finalWorkers = append(finalWorkers, workers[i])
-			}
+	}
+	workers := make([]*pb.WorkerInfo, 0, len(clientWorkers))
+	for _, v := range clientWorkers {
+		if v.GetReverse() {
+			continue
 		}
-		workers = finalWorkers
+		workers = append(workers, &pb.WorkerInfo{Address: v.Address})
 	}
 	if len(workers) == 0 {
 		return nil, handlers.ApiErrorWithCodeAndMessage(
 			codes.FailedPrecondition,
-			"No workers are available to handle this session, or all have been filtered.")
+			"No workers clients can connect to are available to handle this session; reverse workers can only be reached through ingress workers.")
 	}
 
 	requestedId := req.GetHostId()
@@ -1021,17 +1037,19 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	expTime := timestamppb.Now()
 	expTime.Seconds += int64(t.GetSessionMaxSeconds())
 	sessionComposition := session.ComposedOf{
-		UserId:             authResults.UserId,
-		HostId:             chosenEndpoint.HostId,
-		TargetId:           t.GetPublicId(),
-		HostSetId:          chosenEndpoint.SetId,
-		AuthTokenId:        authResults.AuthTokenId,
-		ScopeId:            authResults.Scope.Id,
-		Endpoint:           endpointUrl.String(),
-		ExpirationTime:     &timestamp.Timestamp{Timestamp: expTime},
-		ConnectionLimit:    t.GetSessionConnectionLimit(),
-		WorkerFilter:       t.GetWorkerFilter(),
-		DynamicCredentials: dynCreds,
+		UserId:              authResults.UserId,
+		HostId:              chosenEndpoint.HostId,
+		TargetId:            t.GetPublicId(),
+		HostSetId:           chosenEndpoint.SetId,
+		AuthTokenId:         authResults.AuthTokenId,
+		ScopeId:             authResults.Scope.Id,
+		Endpoint:            endpointUrl.String(),
+		ExpirationTime:      &timestamp.Timestamp{Timestamp: expTime},
+		ConnectionLimit:     t.GetSessionConnectionLimit(),
+		WorkerFilter:        t.GetWorkerFilter(),
+		IngressWorkerFilter: t.GetIngressWorkerFilter(),
+		DynamicCredentials:  dynCreds,
+		ApprovalRequired:    t.GetApprovalRequired(),
 	}
 
 	sess, err := session.New(sessionComposition)
@@ -1042,24 +1060,50 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	if err != nil {
 		return nil, err
 	}
-	sess, privKey, err := sessionRepo.CreateSession(ctx, wrapper, sess)
+	sess, privKey, err := sessionRepo.CreateSession(ctx, wrapper, sess, session.WithSessionLimits(sessionLimits...))
 	if err != nil {
+		var limitErr *session.SessionLimitError
+		if errors.As(err, &limitErr) {
+			return nil, sessionLimitError(limitErr, authResults.UserId)
+		}
 		return nil, err
 	}
 
+	var vaultReqs, staticReqs []credential.Request
+	for _, r := range reqs {
+		switch credential.SubtypeFromId(r.SourceId) {
+		case credstatic.Subtype:
+			staticReqs = append(staticReqs, r)
+		default:
+			vaultReqs = append(vaultReqs, r)
+		}
+	}
 	var cs []credential.Dynamic
-	if len(reqs) > 0 {
+	if len(vaultReqs) > 0 {
 		credRepo, err := s.vaultCredRepoFn()
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
-		cs, err = credRepo.Issue(ctx, sess.GetPublicId(), reqs)
+		vcs, err := credRepo.Issue(ctx, sess.GetPublicId(), vaultReqs)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cs = append(cs, vcs...)
+	}
+	if len(staticReqs) > 0 {
+		credRepo, err := s.staticCredRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		scs, err := credRepo.Issue(ctx, sess.GetPublicId(), staticReqs)
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
+		cs = append(cs, scs...)
 	}
 
 	var creds []*pb.SessionCredential
+	var workerCreds []session.Credential
 	for _, c := range cs {
 		l := c.Library()
 		secret := c.Secret()
@@ -1068,6 +1112,16 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("marshalling secret to json"))
 		}
+		if c.Purpose() == credential.EgressPurpose {
+			// Egress credentials are used by the worker to authenticate to
+			// the endpoint and are never returned to the user.
+			if _, ok := credential.FromSecret(c.GetPublicId(), secret); !ok {
+				return nil, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition,
+					"Egress credential from credential library %q does not contain a username and a password or private key.", l.GetPublicId())
+			}
+			workerCreds = append(workerCreds, jSecret)
+			continue
+		}
 		var sSecret *structpb.Struct
 		switch secret.(type) {
 		case map[string]interface{}:
@@ -1108,6 +1162,18 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		})
 	}
 
+	if len(workerCreds) > 0 {
+		if err := sessionRepo.AddSessionCredentials(ctx, sess.ScopeId, sess.GetPublicId(), workerCreds); err != nil {
+			// The session was committed before its credentials could be
+			// issued, so cancel it rather than leave a session the worker
+			// cannot authenticate to the endpoint for.
+			if _, cancelErr := sessionRepo.CancelSession(ctx, sess.GetPublicId(), sess.Version); cancelErr != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to cancel session after failing to add credentials: %v", cancelErr)))
+			}
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+
 	sad := &pb.SessionAuthorizationData{
 		SessionId:       sess.PublicId,
 		TargetId:        t.GetPublicId(),
@@ -1161,6 +1227,62 @@ func (s Service) getFromRepo(ctx context.Context, id string) (target.Target, []t
 	return u, hs, cl, nil
 }
 
+// sessionLimits returns the limits of concurrent sessions a new session for
+// the target must not exceed: the target's limit, and the user's limits in
+// the target's project and its org. They are enforced by the session
+// repository when it creates the session.
+func (s Service) sessionLimits(ctx context.Context, t target.Target) ([]session.SessionLimit, error) {
+	const op = "targets.(Service).sessionLimits"
+	var limits []session.SessionLimit
+	if limit := t.GetMaxConcurrentSessions(); limit > 0 {
+		limits = append(limits, session.SessionLimit{Kind: session.TargetSessionLimit, ResourceId: t.GetPublicId(), Limit: limit})
+	}
+
+	iamRepo, err := s.iamRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	scopeId := t.GetScopeId()
+	for scopeId != scope.Global.String() {
+		sc, err := iamRepo.LookupScope(ctx, scopeId)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if sc == nil {
+			return nil, handlers.NotFoundErrorf("Scope %q not found.", scopeId)
+		}
+		if limit := sc.GetMaxConcurrentSessionsPerUser(); limit > 0 {
+			limits = append(limits, session.SessionLimit{Kind: session.UserSessionLimit, ResourceId: sc.GetPublicId(), Limit: limit})
+		}
+		scopeId = sc.GetParentId()
+	}
+	return limits, nil
+}
+
+// sessionLimitError returns an error listing the sessions holding the limit
+// the user's new session would have exceeded.
+func sessionLimitError(limitErr *session.SessionLimitError, userId string) error {
+	details := &pb_api.SessionLimitDetails{
+		Kind:       limitErr.Kind,
+		ResourceId: limitErr.ResourceId,
+		Limit:      limitErr.Limit,
+	}
+	for _, h := range limitErr.Holders {
+		details.Holders = append(details.Holders, &pb_api.SessionHolder{
+			SessionId: h.SessionId,
+			UserId:    h.UserId,
+			TargetId:  h.TargetId,
+			Status:    h.Status,
+		})
+	}
+	if limitErr.Kind == session.UserSessionLimit {
+		return handlers.SessionLimitErrorf(details,
+			"User %q has reached the limit of %d concurrent sessions in scope %q.", userId, limitErr.Limit, limitErr.ResourceId)
+	}
+	return handlers.SessionLimitErrorf(details,
+		"Target %q has reached its limit of %d concurrent sessions.", limitErr.ResourceId, limitErr.Limit)
+}
+
 func (s Service) createInRepo(ctx context.Context, item *pb.Target) (target.Target, []target.HostSource, []target.CredentialSource, error) {
 	const op = "targets.(Service).createInRepo"
 	opts := []target.Option{target.WithName(item.GetName().GetValue())}
@@ -1176,6 +1298,18 @@ func (s Service) createInRepo(ctx context.Context, item *pb.Target) (target.Targ
 	if item.GetWorkerFilter() != nil {
 		opts = append(opts, target.WithWorkerFilter(item.GetWorkerFilter().GetValue()))
 	}
+	if item.GetIngressWorkerFilter() != nil {
+		opts = append(opts, target.WithIngressWorkerFilter(item.GetIngressWorkerFilter().GetValue()))
+	}
+	if item.GetApprovalRequired() != nil {
+		opts = append(opts, target.WithApprovalRequired(item.GetApprovalRequired().GetValue()))
+	}
+	if item.GetMaxConcurrentSessions() != nil {
+		opts = append(opts, target.WithMaxConcurrentSessions(item.GetMaxConcurrentSessions().GetValue()))
+	}
+	if len(item.GetApproverIds()) > 0 {
+		opts = append(opts, target.WithApproverIds(item.GetApproverIds()))
+	}
 
 	attr, err := subtypeRegistry.newAttribute(target.SubtypeFromType(item.GetType()), withStruct(item.GetAttributes()))
 	if err != nil {
@@ -1219,6 +1353,18 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []st
 	if filter := item.GetWorkerFilter(); filter != nil {
 		opts = append(opts, target.WithWorkerFilter(item.GetWorkerFilter().GetValue()))
 	}
+	if filter := item.GetIngressWorkerFilter(); filter != nil {
+		opts = append(opts, target.WithIngressWorkerFilter(filter.GetValue()))
+	}
+	if item.GetApprovalRequired() != nil {
+		opts = append(opts, target.WithApprovalRequired(item.GetApprovalRequired().GetValue()))
+	}
+	if item.GetMaxConcurrentSessions() != nil {
+		opts = append(opts, target.WithMaxConcurrentSessions(item.GetMaxConcurrentSessions().GetValue()))
+	}
+	if len(item.GetApproverIds()) > 0 {
+		opts = append(opts, target.WithApproverIds(item.GetApproverIds()))
+	}
 	subtype := target.SubtypeFromId(id)
 
 	attr, err := subtypeRegistry.newAttribute(subtype, withStruct(item.GetAttributes()))
@@ -1275,12 +1421,18 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]target.Target, error) {
+// listFromRepo lists the targets in the provided scopes. A non-zero page size
+// limits the result to a page of targets sorted after startAfterId.
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, pageSize int, startAfterId string) ([]target.Target, error) {
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
 	}
-	ul, err := repo.ListTargets(ctx, target.WithScopeIds(scopeIds))
+	opts := []target.Option{target.WithScopeIds(scopeIds)}
+	if pageSize > 0 {
+		opts = append(opts, target.WithLimit(pageSize), target.WithStartPageAfterId(startAfterId))
+	}
+	ul, err := repo.ListTargets(ctx, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1355,6 +1507,9 @@ func (s Service) addCredentialSourcesInRepo(ctx context.Context, targetId string
 	if err != nil {
 		return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to set credential sources in target: %v.", err)
 	}
+	if err := vetCredentialSourcePurposes(ctx, target.SubtypeFromId(targetId), credLibs); err != nil {
+		return nil, nil, nil, err
+	}
 
 	out, hs, credSources, err := repo.AddTargetCredentialSources(ctx, targetId, version, credLibs)
 	if err != nil {
@@ -1378,6 +1533,9 @@ func (s Service) setCredentialSourcesInRepo(ctx context.Context, targetId string
 	if err != nil {
 		return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to set credential sources in target: %v.", err)
 	}
+	if err := vetCredentialSourcePurposes(ctx, target.SubtypeFromId(targetId), credLibs); err != nil {
+		return nil, nil, nil, err
+	}
 
 	_, _, _, err = repo.SetTargetCredentialSources(ctx, targetId, version, credLibs)
 	if err != nil {
@@ -1516,6 +1674,18 @@ func toProto(ctx context.Context, in target.Target, hostSources []target.HostSou
 	if outputFields.Has(globals.WorkerFilterField) && in.GetWorkerFilter() != "" {
 		out.WorkerFilter = wrapperspb.String(in.GetWorkerFilter())
 	}
+	if outputFields.Has(globals.IngressWorkerFilterField) && in.GetIngressWorkerFilter() != "" {
+		out.IngressWorkerFilter = wrapperspb.String(in.GetIngressWorkerFilter())
+	}
+	if outputFields.Has(globals.ApprovalRequiredField) && in.GetApprovalRequired() {
+		out.ApprovalRequired = wrapperspb.Bool(in.GetApprovalRequired())
+	}
+	if outputFields.Has(globals.ApproverIdsField) {
+		out.ApproverIds = in.GetApproverIds()
+	}
+	if outputFields.Has(globals.MaxConcurrentSessionsField) && in.GetMaxConcurrentSessions() > 0 {
+		out.MaxConcurrentSessions = wrapperspb.Int32(in.GetMaxConcurrentSessions())
+	}
 	if outputFields.Has(globals.ScopeField) {
 		out.Scope = opts.WithScope
 	}
@@ -1550,14 +1720,28 @@ func toProto(ctx context.Context, in target.Target, hostSources []target.HostSou
 	}
 	if outputFields.Has(globals.ApplicationCredentialLibraryIdsField) {
 		for _, cs := range credSources {
+			if credential.Purpose(cs.CredentialPurpose()) != credential.ApplicationPurpose {
+				continue
+			}
 			out.ApplicationCredentialLibraryIds = append(out.ApplicationCredentialLibraryIds, cs.Id())
 		}
 	}
 	if outputFields.Has(globals.ApplicationCredentialSourceIdsField) {
 		for _, cs := range credSources {
+			if credential.Purpose(cs.CredentialPurpose()) != credential.ApplicationPurpose {
+				continue
+			}
 			out.ApplicationCredentialSourceIds = append(out.ApplicationCredentialSourceIds, cs.Id())
 		}
 	}
+	if outputFields.Has(globals.EgressCredentialSourceIdsField) {
+		for _, cs := range credSources {
+			if credential.Purpose(cs.CredentialPurpose()) != credential.EgressPurpose {
+				continue
+			}
+			out.EgressCredentialSourceIds = append(out.EgressCredentialSourceIds, cs.Id())
+		}
+	}
 	if outputFields.Has(globals.ApplicationCredentialLibrariesField) {
 		for _, cs := range credSources {
 			switch credential.Purpose(cs.CredentialPurpose()) {
@@ -1588,6 +1772,21 @@ func toProto(ctx context.Context, in target.Target, hostSources []target.HostSou
 			}
 		}
 	}
+	if outputFields.Has(globals.EgressCredentialSourcesField) {
+		for _, cs := range credSources {
+			switch credential.Purpose(cs.CredentialPurpose()) {
+			case credential.EgressPurpose:
+				out.EgressCredentialSources = append(out.EgressCredentialSources, &pb.CredentialSource{
+					Id:                cs.Id(),
+					CredentialStoreId: cs.CredentialStoreId(),
+				})
+			case credential.ApplicationPurpose, credential.IngressPurpose:
+				// Reported in their own fields.
+			default:
+				return nil, errors.New(ctx, errors.Internal, op, fmt.Sprintf("unrecognized purpose %q for credential source on target", cs.CredentialPurpose()))
+			}
+		}
+	}
 	if outputFields.Has(globals.AttributesField) {
 		attr, err := subtypeRegistry.newAttribute(in.GetType(), withTarget(in))
 		if err != nil {
@@ -1630,6 +1829,15 @@ func validateCreateRequest(req *pbs.CreateTargetRequest) error {
 				badFields[globals.SessionConnectionLimitField] = "This must be -1 (unlimited) or greater than zero."
 			}
 		}
+		if req.GetItem().GetMaxConcurrentSessions() != nil {
+			val := req.GetItem().GetMaxConcurrentSessions().GetValue()
+			switch {
+			case val == -1:
+			case val > 0:
+			default:
+				badFields[globals.MaxConcurrentSessionsField] = "This must be -1 (unlimited) or greater than zero."
+			}
+		}
 		if req.GetItem().GetSessionMaxSeconds() != nil && req.GetItem().GetSessionMaxSeconds().GetValue() == 0 {
 			badFields[globals.SessionMaxSecondsField] = "This must be greater than zero."
 		}
@@ -1643,6 +1851,20 @@ func validateCreateRequest(req *pbs.CreateTargetRequest) error {
 				badFields[globals.WorkerFilterField] = "Unable to successfully parse filter expression."
 			}
 		}
+		if filter := req.GetItem().GetIngressWorkerFilter(); filter != nil {
+			if _, err := bexpr.CreateEvaluator(filter.GetValue()); err != nil {
+				badFields[globals.IngressWorkerFilterField] = "Unable to successfully parse filter expression."
+			}
+		}
+		for _, id := range req.GetItem().GetApproverIds() {
+			if !handlers.ValidId(handlers.Id(id), iam.UserPrefix, iam.GroupPrefix) {
+				badFields[globals.ApproverIdsField] = fmt.Sprintf("Incorrectly formatted identifier %q.", id)
+				break
+			}
+		}
+		if req.GetItem().GetApprovalRequired().GetValue() && len(req.GetItem().GetApproverIds()) == 0 {
+			badFields[globals.ApproverIdsField] = "Approvers must be provided when approval is required."
+		}
 
 		subtype := target.SubtypeFromType(req.GetItem().GetType())
 		_, err := subtypeRegistry.get(subtype)
@@ -1677,6 +1899,15 @@ func validateUpdateRequest(req *pbs.UpdateTargetRequest) error {
 				badFields[globals.SessionConnectionLimitField] = "This must be -1 (unlimited) or greater than zero."
 			}
 		}
+		if req.GetItem().GetMaxConcurrentSessions() != nil {
+			val := req.GetItem().GetMaxConcurrentSessions().GetValue()
+			switch {
+			case val == -1:
+			case val > 0:
+			default:
+				badFields[globals.MaxConcurrentSessionsField] = "This must be -1 (unlimited) or greater than zero."
+			}
+		}
 		if req.GetItem().GetSessionMaxSeconds() != nil && req.GetItem().GetSessionMaxSeconds().GetValue() == 0 {
 			badFields[globals.SessionMaxSecondsField] = "This must be greater than zero."
 		}
@@ -1685,6 +1916,17 @@ func validateUpdateRequest(req *pbs.UpdateTargetRequest) error {
 				badFields[globals.WorkerFilterField] = "Unable to successfully parse filter expression."
 			}
 		}
+		if filter := req.GetItem().GetIngressWorkerFilter(); filter != nil {
+			if _, err := bexpr.CreateEvaluator(filter.GetValue()); err != nil {
+				badFields[globals.IngressWorkerFilterField] = "Unable to successfully parse filter expression."
+			}
+		}
+		for _, id := range req.GetItem().GetApproverIds() {
+			if !handlers.ValidId(handlers.Id(id), iam.UserPrefix, iam.GroupPrefix) {
+				badFields[globals.ApproverIdsField] = fmt.Sprintf("Incorrectly formatted identifier %q.", id)
+				break
+			}
+		}
 		subtype := target.SubtypeFromId(req.GetId())
 		_, err := subtypeRegistry.get(subtype)
 		if err != nil {
@@ -1720,6 +1962,10 @@ func validateListRequest(req *pbs.ListTargetsRequest) error {
 	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
 		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
 	}
+	if _, err := handlers.NewPagination(req.GetPageSize(), req.GetListToken(),
+		req.GetScopeId(), strconv.FormatBool(req.GetRecursive()), req.GetFilter()); err != nil {
+		badFields[globals.ListTokenField] = fmt.Sprintf("This field could not be used. %v", err)
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
 	}
@@ -1876,7 +2122,7 @@ func validateAddLibrariesRequest(req *pbs.AddTargetCredentialLibrariesRequest) e
 		badFields[globals.ApplicationCredentialLibraryIdsField] = "Must be non-empty."
 	}
 	for _, cl := range req.GetApplicationCredentialLibraryIds() {
-		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix) {
+		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix, credstatic.CredentialLibraryPrefix) {
 			badFields[globals.ApplicationCredentialLibraryIdsField] = fmt.Sprintf("Incorrectly formatted credential library identifier %q.", cl)
 			break
 		}
@@ -1896,7 +2142,7 @@ func validateSetLibrariesRequest(req *pbs.SetTargetCredentialLibrariesRequest) e
 		badFields[globals.VersionField] = "Required field."
 	}
 	for _, cl := range req.GetApplicationCredentialLibraryIds() {
-		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix) {
+		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix, credstatic.CredentialLibraryPrefix) {
 			badFields[globals.ApplicationCredentialLibraryIdsField] = fmt.Sprintf("Incorrectly formatted credential library identifier %q.", cl)
 			break
 		}
@@ -1919,7 +2165,7 @@ func validateRemoveLibrariesRequest(req *pbs.RemoveTargetCredentialLibrariesRequ
 		badFields[globals.ApplicationCredentialLibraryIdsField] = "Must be non-empty."
 	}
 	for _, cl := range req.GetApplicationCredentialLibraryIds() {
-		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix) {
+		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix, credstatic.CredentialLibraryPrefix) {
 			badFields[globals.ApplicationCredentialLibraryIdsField] = fmt.Sprintf("Incorrectly formatted credential library identifier %q.", cl)
 			break
 		}
@@ -1943,13 +2189,13 @@ func validateAddCredentialSourcesRequest(req *pbs.AddTargetCredentialSourcesRequ
 		badFields[globals.EgressCredentialSourceIdsField] = "Application or Egress Credential Source IDs must be provided."
 	}
 	for _, cl := range req.GetApplicationCredentialSourceIds() {
-		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix) {
+		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix, credstatic.CredentialLibraryPrefix) {
 			badFields[globals.ApplicationCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
 		}
 	}
 	for _, cl := range req.GetEgressCredentialSourceIds() {
-		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix) {
+		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix, credstatic.CredentialLibraryPrefix) {
 			badFields[globals.EgressCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
 		}
@@ -1969,13 +2215,13 @@ func validateSetCredentialSourcesRequest(req *pbs.SetTargetCredentialSourcesRequ
 		badFields[globals.VersionField] = "Required field."
 	}
 	for _, cl := range req.GetApplicationCredentialSourceIds() {
-		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix) {
+		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix, credstatic.CredentialLibraryPrefix) {
 			badFields[globals.ApplicationCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
 		}
 	}
 	for _, cl := range req.GetEgressCredentialSourceIds() {
-		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix) {
+		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix, credstatic.CredentialLibraryPrefix) {
 			badFields[globals.EgressCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
 		}
@@ -1999,13 +2245,13 @@ func validateRemoveCredentialSourcesRequest(req *pbs.RemoveTargetCredentialSourc
 		badFields[globals.EgressCredentialSourceIdsField] = "Application or Egress Credential Source IDs must be provided."
 	}
 	for _, cl := range req.GetApplicationCredentialSourceIds() {
-		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix) {
+		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix, credstatic.CredentialLibraryPrefix) {
 			badFields[globals.ApplicationCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
 		}
 	}
 	for _, cl := range req.GetEgressCredentialSourceIds() {
-		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix) {
+		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix, credstatic.CredentialLibraryPrefix) {
 			badFields[globals.EgressCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
 		}
@@ -2057,6 +2303,26 @@ func validateAuthorizeSessionRequest(req *pbs.AuthorizeSessionRequest) error {
 	return nil
 }
 
+// vetCredentialSourcePurposes returns an InvalidArgument error if a target of
+// the given subtype cannot use the credential libraries for their purposes,
+// for example egress credential libraries on a tcp target.
+func vetCredentialSourcePurposes(ctx context.Context, subtype subtypes.Subtype, credLibs []*target.CredentialLibrary) error {
+	badFields := map[string]string{}
+	for _, cl := range credLibs {
+		if err := target.VetCredentialLibraries(ctx, subtype, []*target.CredentialLibrary{cl}); err != nil {
+			field := globals.ApplicationCredentialSourceIdsField
+			if credential.Purpose(cl.GetCredentialPurpose()) == credential.EgressPurpose {
+				field = globals.EgressCredentialSourceIdsField
+			}
+			badFields[field] = fmt.Sprintf("Credential sources with purpose %q are not supported by %s targets.", cl.GetCredentialPurpose(), subtype)
+		}
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
 func createCredLibs(targetId string, applicationIds, ingressIds, egressIds []string) ([]*target.CredentialLibrary, error) {
 	credLibs := make([]*target.CredentialLibrary, 0, len(applicationIds)+len(ingressIds)+len(egressIds))
 
@@ -15,6 +15,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/credential"
+	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/db"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
@@ -98,7 +99,10 @@ func testService(t *testing.T, conn *db.DB, kms *kms.Kms, wrapper wrapping.Wrapp
 	credentialRepoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
-	return targets.NewService(context.Background(), kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, credentialRepoFn)
+	staticCredentialRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
+	return targets.NewService(context.Background(), kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, credentialRepoFn, staticCredentialRepoFn)
 }
 
 func TestGet(t *testing.T) {
@@ -326,6 +330,66 @@ func TestList(t *testing.T) {
 	}
 }
 
+func TestList_Pages(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+
+	_, proj := iam.TestScopes(t, iamRepo)
+	_, otherProj := iam.TestScopes(t, iamRepo)
+	ctx := context.Background()
+
+	var wantIds []string
+	for i := 0; i < 5; i++ {
+		name := fmt.Sprintf("tar%d", i)
+		wantIds = append(wantIds, tcp.TestTarget(ctx, t, conn, proj.GetPublicId(), name).GetPublicId())
+		tcp.TestTarget(ctx, t, conn, otherProj.GetPublicId(), name)
+	}
+	sort.Strings(wantIds)
+
+	s, err := testService(t, conn, kms, wrapper)
+	require.NoError(t, err)
+	req := &pbs.ListTargetsRequest{
+		ScopeId:   scope.Global.String(),
+		Recursive: true,
+		Filter:    fmt.Sprintf(`"/item/scope/id"==%q`, proj.GetPublicId()),
+		PageSize:  2,
+	}
+	listCtx := auth.DisabledAuthTestContext(iamRepoFn, req.GetScopeId())
+
+	var gotIds []string
+	var pages int
+	for {
+		got, err := s.ListTargets(listCtx, req)
+		require.NoError(t, err)
+		pages++
+		assert.LessOrEqual(t, len(got.GetItems()), int(req.GetPageSize()))
+		for _, item := range got.GetItems() {
+			gotIds = append(gotIds, item.GetId())
+		}
+		if got.GetListToken() == "" {
+			break
+		}
+		req.ListToken = got.GetListToken()
+	}
+	assert.Equal(t, wantIds, gotIds)
+	assert.Equal(t, 3, pages)
+
+	t.Run("token for another listing", func(t *testing.T) {
+		first, err := s.ListTargets(listCtx, &pbs.ListTargetsRequest{ScopeId: scope.Global.String(), Recursive: true, PageSize: 2})
+		require.NoError(t, err)
+		require.NotEmpty(t, first.GetListToken())
+		_, err = s.ListTargets(listCtx, &pbs.ListTargetsRequest{ScopeId: scope.Global.String(), Recursive: true, PageSize: 2, Filter: req.GetFilter(), ListToken: first.GetListToken()})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, handlers.InvalidArgumentErrorf("bad token", nil)))
+	})
+}
+
 func TestDelete(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -447,7 +511,9 @@ func TestCreate(t *testing.T) {
 				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
 					"default_port": structpb.NewNumberValue(2),
 				}},
-				WorkerFilter: wrapperspb.String(`type == "bar"`),
+				WorkerFilter:          wrapperspb.String(`type == "bar"`),
+				IngressWorkerFilter:   wrapperspb.String(`type == "edge"`),
+				MaxConcurrentSessions: wrapperspb.Int32(3),
 			}},
 			res: &pbs.CreateTargetResponse{
 				Uri: fmt.Sprintf("targets/%s_", tcp.TargetPrefix),
@@ -464,6 +530,8 @@ func TestCreate(t *testing.T) {
 					SessionConnectionLimit: wrapperspb.Int32(1),
 					AuthorizedActions:      testAuthorizedActions,
 					WorkerFilter:           wrapperspb.String(`type == "bar"`),
+					IngressWorkerFilter:    wrapperspb.String(`type == "edge"`),
+					MaxConcurrentSessions:  wrapperspb.Int32(3),
 				},
 			},
 		},
@@ -528,6 +596,25 @@ func TestCreate(t *testing.T) {
 			res: nil,
 			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
 		},
+		{
+			name: "Invalid ingress worker filter expression",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				IngressWorkerFilter: wrapperspb.String("bad expression"),
+			}},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Invalid max concurrent sessions",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId:               proj.GetPublicId(),
+				Name:                  wrapperspb.String("max concurrent sessions"),
+				Type:                  tcp.Subtype.String(),
+				MaxConcurrentSessions: wrapperspb.Int32(0),
+			}},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -2317,6 +2404,15 @@ func TestAddTargetSources(t *testing.T) {
 			},
 			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
 		},
+		{
+			name: "Egress source",
+			req: &pbs.AddTargetCredentialSourcesRequest{
+				Id:                        tar.GetPublicId(),
+				Version:                   tar.GetVersion(),
+				EgressCredentialSourceIds: []string{cls[0].GetPublicId()},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 	}
 	for _, tc := range failCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -2451,6 +2547,15 @@ func TestSetTargetCredentialSources(t *testing.T) {
 			},
 			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
 		},
+		{
+			name: "Egress source",
+			req: &pbs.SetTargetCredentialSourcesRequest{
+				Id:                        tar.GetPublicId(),
+				Version:                   tar.GetVersion(),
+				EgressCredentialSourceIds: []string{cls[0].GetPublicId()},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 	}
 	for _, tc := range failCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -2631,6 +2736,9 @@ func TestAuthorizeSession(t *testing.T) {
 	credentialRepoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
+	staticCredentialRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(rw, rw, kms)
 	}
@@ -2679,7 +2787,7 @@ func TestAuthorizeSession(t *testing.T) {
 	_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
 	_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), "id=*;type=*;actions=*")
 
-	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, credentialRepoFn)
+	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, credentialRepoFn, staticCredentialRepoFn)
 	require.NoError(t, err)
 
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
@@ -2698,7 +2806,7 @@ func TestAuthorizeSession(t *testing.T) {
 	sec, tok := v.CreateToken(t, vault.WithPolicies([]string{"default", "boundary-controller", "pki"}))
 
 	store := vault.TestCredentialStore(t, conn, wrapper, proj.GetPublicId(), v.Addr, tok, sec.Auth.Accessor)
-	credService, err := credentiallibraries.NewService(credentialRepoFn, iamRepoFn)
+	credService, err := credentiallibraries.NewService(credentialRepoFn, staticCredentialRepoFn, iamRepoFn)
 	require.NoError(t, err)
 	clsResp, err := credService.CreateCredentialLibrary(ctx, &pbs.CreateCredentialLibraryRequest{Item: &credpb.CredentialLibrary{
 		CredentialStoreId: store.GetPublicId(),
@@ -2753,7 +2861,7 @@ func TestAuthorizeSession(t *testing.T) {
 			require.NoError(t, err)
 
 			// Tell our DB that there is a worker ready to serve the data
-			workerService := workers.NewWorkerServiceServer(serversRepoFn, sessionRepoFn, &sync.Map{}, kms)
+			workerService := workers.NewWorkerServiceServer(serversRepoFn, sessionRepoFn, repoFn, &sync.Map{}, kms)
 			_, err = workerService.Status(ctx, &spbs.StatusRequest{
 				Worker: &spb.Server{
 					PrivateId: "testworker",
@@ -2872,12 +2980,15 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 	credentialRepoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
+	staticCredentialRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(rw, rw, kms)
 	}
 	org, proj := iam.TestScopes(t, iamRepo)
 
-	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, credentialRepoFn)
+	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, credentialRepoFn, staticCredentialRepoFn)
 	require.NoError(t, err)
 
 	// Authorized user gets full permissions
@@ -2902,7 +3013,7 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 	store := vault.TestCredentialStore(t, conn, wrapper, proj.GetPublicId(), v.Addr, tok, sec.Auth.Accessor)
 
 	workerExists := func(tar target.Target) (version uint32) {
-		workerService := workers.NewWorkerServiceServer(serversRepoFn, sessionRepoFn, &sync.Map{}, kms)
+		workerService := workers.NewWorkerServiceServer(serversRepoFn, sessionRepoFn, repoFn, &sync.Map{}, kms)
 		_, err := workerService.Status(context.Background(), &spbs.StatusRequest{
 			Worker: &spb.Server{
 				PrivateId: "testworker",
@@ -2941,7 +3052,7 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 	}
 
 	libraryExists := func(tar target.Target) (version uint32) {
-		credService, err := credentiallibraries.NewService(credentialRepoFn, iamRepoFn)
+		credService, err := credentiallibraries.NewService(credentialRepoFn, staticCredentialRepoFn, iamRepoFn)
 		require.NoError(t, err)
 		clsResp, err := credService.CreateCredentialLibrary(ctx, &pbs.CreateCredentialLibraryRequest{Item: &credpb.CredentialLibrary{
 			CredentialStoreId: store.GetPublicId(),
@@ -2963,7 +3074,7 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 	}
 
 	misConfiguredlibraryExists := func(tar target.Target) (version uint32) {
-		credService, err := credentiallibraries.NewService(credentialRepoFn, iamRepoFn)
+		credService, err := credentiallibraries.NewService(credentialRepoFn, staticCredentialRepoFn, iamRepoFn)
 		require.NoError(t, err)
 		clsResp, err := credService.CreateCredentialLibrary(ctx, &pbs.CreateCredentialLibraryRequest{Item: &credpb.CredentialLibrary{
 			CredentialStoreId: store.GetPublicId(),
@@ -3033,6 +3144,131 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 	}
 }
 
+func TestAuthorizeSession_SessionLimits(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+
+	repoFn := func() (*target.Repository, error) {
+		return target.NewRepository(rw, rw, kms)
+	}
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	serversRepoFn := func() (*servers.Repository, error) {
+		return servers.NewRepository(rw, rw, kms)
+	}
+	sessionRepoFn := func() (*session.Repository, error) {
+		return session.NewRepository(rw, rw, kms)
+	}
+	staticHostRepoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+	pluginHostRepoFn := func() (*plugin.Repository, error) {
+		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
+	}
+	credentialRepoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(rw, rw, kms, sche)
+	}
+	staticCredentialRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(rw, rw, kms)
+	}
+	org, proj := iam.TestScopes(t, iamRepo)
+
+	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, credentialRepoFn, staticCredentialRepoFn)
+	require.NoError(t, err)
+
+	at := authtoken.TestAuthToken(t, conn, kms, org.GetPublicId())
+	ctx = auth.NewVerifierContext(requests.NewRequestContext(context.Background()),
+		iamRepoFn,
+		atRepoFn,
+		serversRepoFn,
+		kms,
+		&authpb.RequestInfo{
+			Token:       at.GetToken(),
+			TokenFormat: uint32(auth.AuthTokenTypeBearer),
+			PublicId:    at.GetPublicId(),
+		})
+	r := iam.TestRole(t, conn, proj.GetPublicId())
+	_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
+	_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), "id=*;type=*;actions=*")
+
+	workerService := workers.NewWorkerServiceServer(serversRepoFn, sessionRepoFn, repoFn, &sync.Map{}, kms)
+	_, err = workerService.Status(context.Background(), &spbs.StatusRequest{
+		Worker: &spb.Server{
+			PrivateId: "testworker",
+			Address:   "localhost:123",
+		},
+	})
+	require.NoError(t, err)
+
+	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	_ = static.TestSetMembers(t, conn, hs.GetPublicId(), []*static.Host{h})
+	newTarget := func(name string, opt ...target.Option) target.Target {
+		return tcp.TestTarget(ctx, t, conn, proj.GetPublicId(), name, append(opt, target.WithHostSources([]string{hs.GetPublicId()}))...)
+	}
+	wantLimitErr := func(t *testing.T, err error, kind, resourceId string, holders ...string) {
+		t.Helper()
+		var apiErr *handlers.ApiError
+		require.True(t, errors.As(err, &apiErr), "unexpected error %v", err)
+		assert.Equal(t, codes.ResourceExhausted.String(), apiErr.Inner.GetKind())
+		details := apiErr.Inner.GetDetails().GetSessionLimit()
+		assert.Equal(t, kind, details.GetKind())
+		assert.Equal(t, resourceId, details.GetResourceId())
+		var got []string
+		for _, h := range details.GetHolders() {
+			assert.Equal(t, at.GetIamUserId(), h.GetUserId())
+			got = append(got, h.GetSessionId())
+		}
+		assert.Equal(t, holders, got)
+	}
+
+	limited := newTarget("limited", target.WithMaxConcurrentSessions(1))
+	first, err := s.AuthorizeSession(ctx, &pbs.AuthorizeSessionRequest{Id: limited.GetPublicId()})
+	require.NoError(t, err)
+	firstId := first.GetItem().GetSessionId()
+
+	_, err = s.AuthorizeSession(ctx, &pbs.AuthorizeSessionRequest{Id: limited.GetPublicId()})
+	wantLimitErr(t, err, "target", limited.GetPublicId(), firstId)
+
+	unlimited := newTarget("unlimited")
+	second, err := s.AuthorizeSession(ctx, &pbs.AuthorizeSessionRequest{Id: unlimited.GetPublicId()})
+	require.NoError(t, err)
+	secondId := second.GetItem().GetSessionId()
+
+	for _, sc := range []*iam.Scope{org, proj} {
+		sc.MaxConcurrentSessionsPerUser = 2
+		_, _, err = iamRepo.UpdateScope(ctx, sc, sc.GetVersion(), []string{"MaxConcurrentSessionsPerUser"})
+		require.NoError(t, err)
+		_, err = s.AuthorizeSession(ctx, &pbs.AuthorizeSessionRequest{Id: unlimited.GetPublicId()})
+		wantLimitErr(t, err, "user", sc.GetPublicId(), firstId, secondId)
+
+		sc, err = iamRepo.LookupScope(ctx, sc.GetPublicId())
+		require.NoError(t, err)
+		sc.MaxConcurrentSessionsPerUser = -1
+		_, _, err = iamRepo.UpdateScope(ctx, sc, sc.GetVersion(), []string{"MaxConcurrentSessionsPerUser"})
+		require.NoError(t, err)
+	}
+
+	sessionRepo, err := sessionRepoFn()
+	require.NoError(t, err)
+	sess, _, err := sessionRepo.LookupSession(ctx, firstId)
+	require.NoError(t, err)
+	_, err = sessionRepo.CancelSession(ctx, firstId, sess.Version)
+	require.NoError(t, err)
+	_, err = s.AuthorizeSession(ctx, &pbs.AuthorizeSessionRequest{Id: limited.GetPublicId()})
+	require.NoError(t, err)
+}
+
 func decodeJsonSecret(t *testing.T, in string) map[string]interface{} {
 	t.Helper()
 	ret := make(map[string]interface{})
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
@@ -423,6 +424,7 @@ func (s Service) createInRepo(ctx context.Context, orgId string, item *pb.User)
 	if err != nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build user for creation: %v.", err)
 	}
+	u.Disabled = item.GetDisabled().GetValue()
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
@@ -452,6 +454,7 @@ func (s Service) updateInRepo(ctx context.Context, orgId, id string, mask []stri
 		return nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build user for update: %v.", err)
 	}
 	u.PublicId = id
+	u.Disabled = item.GetDisabled().GetValue()
 	dbMask := maskManager.Translate(mask)
 	if len(dbMask) == 0 {
 		return nil, nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
@@ -641,6 +644,9 @@ func toProto(ctx context.Context, in *iam.User, accts []string, opt ...handlers.
 	if outputFields.Has(globals.EmailField) {
 		out.Email = in.GetEmail()
 	}
+	if outputFields.Has(globals.DisabledField) && in.GetDisabled() {
+		out.Disabled = wrapperspb.Bool(in.GetDisabled())
+	}
 	if outputFields.Has(globals.ScopeField) {
 		out.Scope = opts.WithScope
 	}
@@ -719,6 +725,7 @@ func validateAddUserAccountsRequest(req *pbs.AddUserAccountsRequest) error {
 			intglobals.OldPasswordAccountPrefix,
 			intglobals.NewPasswordAccountPrefix,
 			oidc.AccountPrefix,
+			ldap.AccountPrefix,
 		) {
 			badFields["account_ids"] = "Values must be valid account ids."
 			break
@@ -744,6 +751,7 @@ func validateSetUserAccountsRequest(req *pbs.SetUserAccountsRequest) error {
 			intglobals.OldPasswordAccountPrefix,
 			intglobals.NewPasswordAccountPrefix,
 			oidc.AccountPrefix,
+			ldap.AccountPrefix,
 		) {
 			badFields["account_ids"] = "Values must be valid account ids."
 			break
@@ -772,6 +780,7 @@ func validateRemoveUserAccountsRequest(req *pbs.RemoveUserAccountsRequest) error
 			intglobals.OldPasswordAccountPrefix,
 			intglobals.NewPasswordAccountPrefix,
 			oidc.AccountPrefix,
+			ldap.AccountPrefix,
 		) {
 			badFields["account_ids"] = "Values must be valid account ids."
 			break
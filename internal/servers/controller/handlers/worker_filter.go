@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/servers"
+	"github.com/hashicorp/go-bexpr"
+	"github.com/mitchellh/pointerstructure"
+	"google.golang.org/grpc/codes"
+)
+
+// FilterWorkers returns the workers which match the given worker filter
+// expression, keeping their order. An empty filter matches every worker.
+func FilterWorkers(ctx context.Context, serversRepo *servers.Repository, workers []*servers.Server, filter string) ([]*servers.Server, error) {
+	if filter == "" || len(workers) == 0 {
+		return workers, nil
+	}
+	workerIds := make([]string, 0, len(workers))
+	for _, w := range workers {
+		workerIds = append(workerIds, w.GetPrivateId())
+	}
+	// Fetch the tags for the given worker IDs
+	tags, err := serversRepo.ListTagsForServers(ctx, workerIds)
+	if err != nil {
+		return nil, err
+	}
+	// Build the map for filtering. This is similar to the filter map we
+	// built from the worker config, but with one extra level: a map of the
+	// worker's ID to its filter map.
+	tagMap := make(map[string]map[string][]string)
+	for _, tag := range tags {
+		currWorkerMap := tagMap[tag.ServerId]
+		if currWorkerMap == nil {
+			currWorkerMap = make(map[string][]string)
+			tagMap[tag.ServerId] = currWorkerMap
+		}
+		currWorkerMap[tag.Key] = append(currWorkerMap[tag.Key], tag.Value)
+		// We don't need to reinsert after the fact because maps are
+		// reference types, so we don't need to re-insert into tagMap
+	}
+
+	// Create the evaluator
+	eval, err := bexpr.CreateEvaluator(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Iterate through the known workers, and evaluate. If evaluation returns
+	// true, add to the final worker slice.
+	finalWorkers := make([]*servers.Server, 0, len(workers))
+	for _, w := range workers {
+		filterInput := map[string]interface{}{
+			"name": w.GetPrivateId(),
+			"tags": tagMap[w.GetPrivateId()],
+		}
+		ok, err := eval.Evaluate(filterInput)
+		if err != nil && !stderrors.Is(err, pointerstructure.ErrNotFound) {
+			return nil, ApiErrorWithCodeAndMessage(
+				codes.FailedPrecondition,
+				fmt.Sprintf("Worker filter expression evaluation resulted in error: %s", err))
+		}
+		if ok {
+			finalWorkers = append(finalWorkers, w)
+		}
+	}
+	return finalWorkers, nil
+}
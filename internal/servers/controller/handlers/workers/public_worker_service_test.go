@@ -0,0 +1,149 @@
+package workers_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/boundary/internal/db"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/servers"
+	"github.com/hashicorp/boundary/internal/servers/controller/auth"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/workers"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/workers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var testAuthorizedActions = []string{"no-op", "read", "update", "delete"}
+
+func TestWorkerService(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	serversRepo, err := servers.NewRepository(rw, rw, kms.TestKms(t, conn, wrap))
+	require.NoError(t, err)
+	serversRepoFn := func() (*servers.Repository, error) {
+		return serversRepo, nil
+	}
+	_, _, err = serversRepo.UpsertServer(ctx, &servers.Server{
+		PrivateId: "test-worker",
+		Type:      servers.ServerTypeWorker.String(),
+		Address:   "127.0.0.1:9202",
+		Tags: map[string]*servers.TagValues{
+			"type": {Values: []string{"dev"}},
+		},
+	}, servers.WithUpdateTags(true))
+	require.NoError(t, err)
+	wl, err := serversRepo.ListWorkers(ctx)
+	require.NoError(t, err)
+	require.Len(t, wl, 1)
+	w := wl[0]
+
+	s, err := workers.NewService(serversRepoFn)
+	require.NoError(t, err)
+	authCtx := auth.DisabledAuthTestContext(iamRepoFn, scope.Global.String())
+
+	want := &pb.Worker{
+		Id:                 w.GetPublicId(),
+		ScopeId:            scope.Global.String(),
+		Scope:              &scopes.ScopeInfo{Id: scope.Global.String(), Type: scope.Global.String(), Name: scope.Global.String(), Description: "Global Scope"},
+		CreatedTime:        w.GetCreateTime().GetTimestamp(),
+		UpdatedTime:        w.GetUpdateTime().GetTimestamp(),
+		Version:            w.GetVersion(),
+		Address:            "127.0.0.1:9202",
+		LastStatusTime:     w.GetLastStatusTime().GetTimestamp(),
+		ConfigTags:         map[string]*structpb.ListValue{"type": {Values: []*structpb.Value{structpb.NewStringValue("dev")}}},
+		AuthorizedActions:  testAuthorizedActions,
+		ActiveSessionCount: 0,
+	}
+
+	t.Run("list", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := s.ListWorkers(authCtx, &pbs.ListWorkersRequest{ScopeId: scope.Global.String()})
+		require.NoError(err)
+		assert.Empty(cmp.Diff(&pbs.ListWorkersResponse{Items: []*pb.Worker{want}}, got, protocmp.Transform()))
+
+		got, err = s.ListWorkers(authCtx, &pbs.ListWorkersRequest{ScopeId: scope.Global.String(), Filter: `"/item/address"=="10.0.0.1"`})
+		require.NoError(err)
+		assert.Empty(got.GetItems())
+
+		_, err = s.ListWorkers(authCtx, &pbs.ListWorkersRequest{ScopeId: "o_1234567890"})
+		require.Error(err)
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)))
+	})
+
+	t.Run("get", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := s.GetWorker(authCtx, &pbs.GetWorkerRequest{Id: w.GetPublicId()})
+		require.NoError(err)
+		assert.Empty(cmp.Diff(&pbs.GetWorkerResponse{Item: want}, got, protocmp.Transform()))
+
+		_, err = s.GetWorker(authCtx, &pbs.GetWorkerRequest{Id: servers.WorkerPrefix + "_DoesntExis"})
+		require.Error(err)
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.NotFound)))
+
+		_, err = s.GetWorker(authCtx, &pbs.GetWorkerRequest{Id: "j_1234567890"})
+		require.Error(err)
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)))
+	})
+
+	t.Run("update", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		_, err := s.UpdateWorker(authCtx, &pbs.UpdateWorkerRequest{
+			Id:         w.GetPublicId(),
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"address"}},
+			Item:       &pb.Worker{Version: w.GetVersion(), Address: "10.0.0.1"},
+		})
+		require.Error(err)
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)))
+
+		got, err := s.UpdateWorker(authCtx, &pbs.UpdateWorkerRequest{
+			Id:         w.GetPublicId(),
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"name", "config_tags"}},
+			Item: &pb.Worker{
+				Version:    w.GetVersion(),
+				Name:       wrapperspb.String("worker-one"),
+				ConfigTags: map[string]*structpb.ListValue{"type": {Values: []*structpb.Value{structpb.NewStringValue("prod")}}},
+			},
+		})
+		require.NoError(err)
+		assert.Equal("worker-one", got.GetItem().GetName().GetValue())
+		assert.Equal(w.GetVersion()+1, got.GetItem().GetVersion())
+		assert.Equal("prod", got.GetItem().GetConfigTags()["type"].GetValues()[0].GetStringValue())
+
+		_, err = s.UpdateWorker(authCtx, &pbs.UpdateWorkerRequest{
+			Id:         w.GetPublicId(),
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"name"}},
+			Item:       &pb.Worker{Version: w.GetVersion(), Name: wrapperspb.String("stale")},
+		})
+		require.Error(err)
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.NotFound)))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		_, err := s.DeleteWorker(authCtx, &pbs.DeleteWorkerRequest{Id: w.GetPublicId()})
+		require.NoError(err)
+
+		_, err = s.DeleteWorker(authCtx, &pbs.DeleteWorkerRequest{Id: w.GetPublicId()})
+		require.Error(err)
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.NotFound)))
+	})
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/hashicorp/boundary/internal/credential"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/observability/event"
@@ -14,6 +16,7 @@ import (
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
 	"github.com/hashicorp/go-bexpr"
@@ -27,6 +30,7 @@ type workerServiceServer struct {
 
 	serversRepoFn common.ServersRepoFactory
 	sessionRepoFn common.SessionRepoFactory
+	targetRepoFn  common.TargetRepoFactory
 	updateTimes   *sync.Map
 	kms           *kms.Kms
 }
@@ -34,11 +38,13 @@ type workerServiceServer struct {
 func NewWorkerServiceServer(
 	serversRepoFn common.ServersRepoFactory,
 	sessionRepoFn common.SessionRepoFactory,
+	targetRepoFn common.TargetRepoFactory,
 	updateTimes *sync.Map,
 	kms *kms.Kms) *workerServiceServer {
 	return &workerServiceServer{
 		serversRepoFn: serversRepoFn,
 		sessionRepoFn: sessionRepoFn,
+		targetRepoFn:  targetRepoFn,
 		updateTimes:   updateTimes,
 		kms:           kms,
 	}
@@ -77,6 +83,8 @@ func (ws *workerServiceServer) Status(ctx context.Context, req *pbs.StatusReques
 	var (
 		// For tracking the reported open connections.
 		reportedOpenConns []string
+		// For tracking the bytes proxied on the reported open connections.
+		reportedConnBytes []session.ConnectionBytes
 		// For tracking the session IDs we've already requested
 		// cancellation for. We won't need to add connection cancel
 		// requests for these because canceling the session terminates the
@@ -112,6 +120,13 @@ func (ws *workerServiceServer) Status(ctx context.Context, req *pbs.StatusReques
 					// Note that unspecified is the default state for the enum
 					// but it's not ever explicitly set by us.
 					reportedOpenConns = append(reportedOpenConns, conn.GetConnectionId())
+					if conn.GetBytesUp() > 0 || conn.GetBytesDown() > 0 {
+						reportedConnBytes = append(reportedConnBytes, session.ConnectionBytes{
+							ConnectionId: conn.GetConnectionId(),
+							BytesUp:      conn.GetBytesUp(),
+							BytesDown:    conn.GetBytesDown(),
+						})
+					}
 				}
 			}
 
@@ -164,6 +179,13 @@ func (ws *workerServiceServer) Status(ctx context.Context, req *pbs.StatusReques
 		}
 	}
 
+	// Record the bytes proxied so far on the open connections. Failing to do
+	// so doesn't fail the status request as the bytes are reported again in
+	// the next one and set when the connections are closed.
+	if _, err := sessRepo.UpdateConnectionBytes(ctx, req.GetWorker().GetPrivateId(), reportedConnBytes); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error updating bytes proxied on connections"))
+	}
+
 	// Normalize the current state of connections on the worker side
 	// with the data from the controller. In other words, if one of our
 	// found connections isn't supposed to be alive still, kill it.
@@ -232,6 +254,7 @@ func (ws *workerServiceServer) LookupSession(ctx context.Context, req *pbs.Looku
 		return nil, status.Error(codes.Internal, "Empty session states during lookup.")
 	}
 
+	var upstreamWorkers []*pbs.UpstreamWorker
 	if sessionInfo.WorkerFilter != "" {
 		if req.ServerId == "" {
 			event.WriteError(ctx, op, errors.New("worker filter enabled for session but got no server ID from worker"))
@@ -271,9 +294,9 @@ func (ws *workerServiceServer) LookupSession(ctx context.Context, req *pbs.Looku
 				fmt.Sprintf("Worker filter expression evaluation resulted in error: %s", err))
 		}
 		if !ok {
-			return nil, handlers.ApiErrorWithCodeAndMessage(
-				codes.FailedPrecondition,
-				"Worker filter expression precludes this worker from serving this session")
+			if upstreamWorkers, err = ws.upstreamWorkers(ctx, serversRepo, req.ServerId, sessionInfo); err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -293,6 +316,7 @@ func (ws *workerServiceServer) LookupSession(ctx context.Context, req *pbs.Looku
 		HostSetId:       sessionInfo.HostSetId,
 		TargetId:        sessionInfo.TargetId,
 		UserId:          sessionInfo.UserId,
+		UpstreamWorkers: upstreamWorkers,
 	}
 	if resp.ConnectionsLeft != -1 {
 		resp.ConnectionsLeft -= int32(authzSummary.CurrentConnectionCount)
@@ -310,9 +334,123 @@ func (ws *workerServiceServer) LookupSession(ctx context.Context, req *pbs.Looku
 		return nil, status.Errorf(codes.Internal, "Error deriving session key: %v", err)
 	}
 
+	// A worker forwarding the connections to an upstream worker only proxies
+	// bytes, so it never receives the endpoint's credentials or host keys.
+	if len(upstreamWorkers) > 0 {
+		return resp, nil
+	}
+
+	creds, err := sessRepo.ListSessionCredentials(ctx, sessionInfo.ScopeId, sessionInfo.GetPublicId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error retrieving session credentials: %v", err)
+	}
+	for _, c := range creds {
+		wc, err := workerCredential(c)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Error converting session credential: %v", err)
+		}
+		resp.Credentials = append(resp.Credentials, wc)
+	}
+
+	if len(resp.Credentials) > 0 {
+		// The worker only uses the credentials to authenticate to a host
+		// presenting one of the target's host keys.
+		targetRepo, err := ws.targetRepoFn()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Error getting target repo: %v", err)
+		}
+		t, _, _, err := targetRepo.LookupTarget(ctx, sessionInfo.TargetId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Error looking up target: %v", err)
+		}
+		if hk, ok := t.(target.HostKeyTarget); ok {
+			for _, k := range strings.Split(hk.GetHostKeys(), "\n") {
+				if k = strings.TrimSpace(k); k != "" {
+					resp.HostKeys = append(resp.HostKeys, k)
+				}
+			}
+		}
+	}
+
 	return resp, nil
 }
 
+// upstreamWorkers returns the workers matching the session's worker filter
+// which the given worker can forward the session's connections to. The
+// worker must match the session's ingress worker filter.
+func (ws *workerServiceServer) upstreamWorkers(ctx context.Context, serversRepo *servers.Repository, workerId string, sessionInfo *session.Session) ([]*pbs.UpstreamWorker, error) {
+	precluded := handlers.ApiErrorWithCodeAndMessage(
+		codes.FailedPrecondition,
+		"Worker filter expression precludes this worker from serving this session")
+	if sessionInfo.IngressWorkerFilter == "" {
+		return nil, precluded
+	}
+	ingress, err := handlers.FilterWorkers(ctx, serversRepo, []*servers.Server{{PrivateId: workerId}}, sessionInfo.IngressWorkerFilter)
+	if err != nil {
+		return nil, err
+	}
+	if len(ingress) == 0 {
+		return nil, precluded
+	}
+
+	workers, err := serversRepo.ListServers(ctx, servers.ServerTypeWorker)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error listing workers: %v", err)
+	}
+	egress, err := handlers.FilterWorkers(ctx, serversRepo, workers, sessionInfo.WorkerFilter)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*pbs.UpstreamWorker
+	for _, w := range egress {
+		if w.GetPrivateId() == workerId {
+			continue
+		}
+		ret = append(ret, &pbs.UpstreamWorker{
+			Id:      w.GetPrivateId(),
+			Address: w.GetAddress(),
+			Reverse: w.GetReverse(),
+		})
+	}
+	if len(ret) == 0 {
+		return nil, handlers.ApiErrorWithCodeAndMessage(
+			codes.FailedPrecondition,
+			"No upstream workers are available to handle this session, or all have been filtered.")
+	}
+	return ret, nil
+}
+
+// workerCredential converts the secret data of a session credential to the
+// typed credential sent to the worker.
+func workerCredential(c session.Credential) (*pbs.Credential, error) {
+	cred, ok := credential.FromSecret("", []byte(c))
+	if !ok {
+		return nil, errors.New("unsupported credential type")
+	}
+	switch cred := cred.(type) {
+	case credential.UserPassword:
+		return &pbs.Credential{
+			Credential: &pbs.Credential_UsernamePassword{
+				UsernamePassword: &pbs.UsernamePassword{
+					Username: cred.Username(),
+					Password: string(cred.Password()),
+				},
+			},
+		}, nil
+	case credential.KeyPair:
+		return &pbs.Credential{
+			Credential: &pbs.Credential_SshPrivateKey{
+				SshPrivateKey: &pbs.SshPrivateKey{
+					Username:   cred.Username(),
+					PrivateKey: cred.Private(),
+				},
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported credential type %T", cred)
+	}
+}
+
 func (ws *workerServiceServer) CancelSession(ctx context.Context, req *pbs.CancelSessionRequest) (*pbs.CancelSessionResponse, error) {
 	const op = "workers.(workerServiceServer).CancelSession"
 
@@ -373,7 +511,27 @@ func (ws *workerServiceServer) AuthorizeConnection(ctx context.Context, req *pbs
 		return nil, status.Errorf(codes.Internal, "error getting session repo: %v", err)
 	}
 
-	connectionInfo, connStates, authzSummary, err := sessRepo.AuthorizeConnection(ctx, req.GetSessionId(), req.GetWorkerId())
+	if len(req.GetWorkerChain()) > 0 {
+		serversRepo, err := ws.serversRepoFn()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error getting servers repo: %v", err)
+		}
+		workers, err := serversRepo.ListServers(ctx, servers.ServerTypeWorker)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error listing workers: %v", err)
+		}
+		known := make(map[string]bool, len(workers))
+		for _, w := range workers {
+			known[w.GetPrivateId()] = true
+		}
+		for _, id := range req.GetWorkerChain() {
+			if !known[id] {
+				return nil, status.Errorf(codes.PermissionDenied, "Unknown worker %q in worker chain.", id)
+			}
+		}
+	}
+
+	connectionInfo, connStates, authzSummary, err := sessRepo.AuthorizeConnection(ctx, req.GetSessionId(), req.GetWorkerId(), session.WithWorkerChain(req.GetWorkerChain()))
 	if err != nil {
 		return nil, err
 	}
@@ -409,6 +567,7 @@ func (ws *workerServiceServer) ConnectConnection(ctx context.Context, req *pbs.C
 		ClientTcpPort:      req.GetClientTcpPort(),
 		EndpointTcpAddress: req.GetEndpointTcpAddress(),
 		EndpointTcpPort:    req.GetEndpointTcpPort(),
+		RecordingId:        req.GetRecordingId(),
 	})
 	if err != nil {
 		return nil, err
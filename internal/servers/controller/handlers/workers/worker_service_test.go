@@ -0,0 +1,160 @@
+package workers_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/servers"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/workers"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupSession_Credentials(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+
+	serversRepo, err := servers.NewRepository(rw, rw, kmsCache)
+	require.NoError(t, err)
+	serversRepoFn := func() (*servers.Repository, error) {
+		return serversRepo, nil
+	}
+	sessionRepo, err := session.NewRepository(rw, rw, kmsCache)
+	require.NoError(t, err)
+	sessionRepoFn := func() (*session.Repository, error) {
+		return sessionRepo, nil
+	}
+	targetRepoFn := func() (*target.Repository, error) {
+		return target.NewRepository(rw, rw, kmsCache)
+	}
+
+	for id, typ := range map[string]string{
+		"ingress-worker": "ingress",
+		"egress-worker":  "egress",
+	} {
+		_, _, err = serversRepo.UpsertServer(ctx, &servers.Server{
+			PrivateId: id,
+			Type:      servers.ServerTypeWorker.String(),
+			Address:   "127.0.0.1:9202",
+			Tags: map[string]*servers.TagValues{
+				"type": {Values: []string{typ}},
+			},
+		}, servers.WithUpdateTags(true))
+		require.NoError(t, err)
+	}
+
+	composedOf := session.TestSessionParams(t, conn, wrap, iamRepo)
+	composedOf.WorkerFilter = `"egress" in "/tags/type"`
+	composedOf.IngressWorkerFilter = `"ingress" in "/tags/type"`
+	sess := session.TestSession(t, conn, wrap, composedOf)
+	require.NoError(t, sessionRepo.AddSessionCredentials(ctx, sess.ScopeId, sess.PublicId,
+		[]session.Credential{session.Credential(`{"username":"user","password":"pass"}`)}))
+
+	s := workers.NewWorkerServiceServer(serversRepoFn, sessionRepoFn, targetRepoFn, new(sync.Map), kmsCache)
+
+	t.Run("forwarding-worker", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		resp, err := s.LookupSession(ctx, &pbs.LookupSessionRequest{
+			SessionId: sess.PublicId,
+			ServerId:  "ingress-worker",
+		})
+		require.NoError(err)
+		require.Len(resp.GetUpstreamWorkers(), 1)
+		assert.Equal("egress-worker", resp.GetUpstreamWorkers()[0].GetId())
+		assert.Empty(resp.GetCredentials())
+		assert.Empty(resp.GetHostKeys())
+	})
+
+	t.Run("egress-worker", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		resp, err := s.LookupSession(ctx, &pbs.LookupSessionRequest{
+			SessionId: sess.PublicId,
+			ServerId:  "egress-worker",
+		})
+		require.NoError(err)
+		assert.Empty(resp.GetUpstreamWorkers())
+		require.Len(resp.GetCredentials(), 1)
+		assert.Equal("user", resp.GetCredentials()[0].GetUsernamePassword().GetUsername())
+		assert.Equal("pass", resp.GetCredentials()[0].GetUsernamePassword().GetPassword())
+	})
+}
+
+func TestStatus_ConnectionBytes(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+
+	serversRepoFn := func() (*servers.Repository, error) {
+		return servers.NewRepository(rw, rw, kmsCache)
+	}
+	sessionRepo, err := session.NewRepository(rw, rw, kmsCache)
+	require.NoError(t, err)
+	sessionRepoFn := func() (*session.Repository, error) {
+		return sessionRepo, nil
+	}
+	targetRepoFn := func() (*target.Repository, error) {
+		return target.NewRepository(rw, rw, kmsCache)
+	}
+
+	owner := session.TestWorker(t, conn, wrap)
+	other := session.TestWorker(t, conn, wrap)
+	sess := session.TestDefaultSession(t, conn, wrap, iamRepo)
+	c := session.TestConnection(t, conn, sess.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222, session.WithServerId(owner.PrivateId))
+
+	s := workers.NewWorkerServiceServer(serversRepoFn, sessionRepoFn, targetRepoFn, new(sync.Map), kmsCache)
+	report := func(t *testing.T, worker *servers.Server, bytesUp, bytesDown uint64) {
+		t.Helper()
+		_, err := s.Status(ctx, &pbs.StatusRequest{
+			Worker: &servers.Server{
+				PrivateId: worker.PrivateId,
+				Address:   worker.Address,
+			},
+			Jobs: []*pbs.JobStatus{{
+				Job: &pbs.Job{
+					Type: pbs.JOBTYPE_JOBTYPE_SESSION,
+					JobInfo: &pbs.Job_SessionInfo{
+						SessionInfo: &pbs.SessionJobInfo{
+							SessionId: sess.PublicId,
+							Status:    pbs.SESSIONSTATUS_SESSIONSTATUS_ACTIVE,
+							Connections: []*pbs.Connection{{
+								ConnectionId: c.PublicId,
+								Status:       pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CONNECTED,
+								BytesUp:      bytesUp,
+								BytesDown:    bytesDown,
+							}},
+						},
+					},
+				},
+			}},
+		})
+		require.NoError(t, err)
+	}
+	wantBytes := func(t *testing.T, bytesUp, bytesDown uint64) {
+		t.Helper()
+		got, _, err := sessionRepo.LookupConnection(ctx, c.PublicId)
+		require.NoError(t, err)
+		assert.Equal(t, bytesUp, got.BytesUp)
+		assert.Equal(t, bytesDown, got.BytesDown)
+	}
+
+	report(t, owner, 10, 100)
+	wantBytes(t, 10, 100)
+
+	// Another worker can't rewrite the bytes of the connection
+	report(t, other, 1, 1)
+	wantBytes(t, 10, 100)
+}
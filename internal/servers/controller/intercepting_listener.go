@@ -44,7 +44,14 @@ func newInterceptingListener(c *Controller, baseLn net.Listener) *interceptingLi
 }
 
 func (m *interceptingListener) Accept() (net.Conn, error) {
-	const op = "controller.(interceptingListener).Accept"
+	conn, _, err := m.acceptWorker()
+	return conn, err
+}
+
+// acceptWorker accepts the next connection and validates its nonce, returning
+// the connection along with the auth information of the worker which made it.
+func (m *interceptingListener) acceptWorker() (net.Conn, *workerAuthEntry, error) {
+	const op = "controller.(interceptingListener).acceptWorker"
 	ctx := context.TODO()
 	conn, err := m.baseLn.Accept()
 	if err != nil {
@@ -53,7 +60,7 @@ func (m *interceptingListener) Accept() (net.Conn, error) {
 				event.WriteError(context.TODO(), op, err, event.WithInfoMsg("error closing worker connection"))
 			}
 		}
-		return nil, err
+		return nil, nil, err
 	}
 
 	nonce := make([]byte, 20)
@@ -62,25 +69,25 @@ func (m *interceptingListener) Accept() (net.Conn, error) {
 		if err := conn.Close(); err != nil {
 			event.WriteError(ctx, op, err, event.WithInfoMsg("error closing worker connection"))
 		}
-		return nil, fmt.Errorf("error reading nonce from connection: %w", err)
+		return nil, nil, fmt.Errorf("error reading nonce from connection: %w", err)
 	}
 	if read != len(nonce) {
 		if err := conn.Close(); err != nil {
 			event.WriteError(ctx, op, err, event.WithInfoMsg("error closing worker connection"))
 		}
-		return nil, fmt.Errorf("error reading nonce from worker, expected %d bytes, got %d", 20, read)
+		return nil, nil, fmt.Errorf("error reading nonce from worker, expected %d bytes, got %d", 20, read)
 	}
 	workerInfoRaw, found := m.c.workerAuthCache.Load(string(nonce))
 	if !found {
 		if err := conn.Close(); err != nil {
 			event.WriteError(ctx, op, err, event.WithInfoMsg("error closing worker connection"))
 		}
-		return nil, errors.New("did not find valid nonce for incoming worker")
+		return nil, nil, errors.New("did not find valid nonce for incoming worker")
 	}
 	workerInfo := workerInfoRaw.(*workerAuthEntry)
 	workerInfo.conn = conn
 	event.WriteSysEvent(ctx, op, "worker successfully authed", "name", workerInfo.Name)
-	return conn, nil
+	return conn, workerInfo, nil
 }
 
 func (m *interceptingListener) Close() error {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/errors"
 	pb "github.com/hashicorp/boundary/internal/gen/controller/api"
@@ -12,6 +13,7 @@ import (
 	pberrors "github.com/hashicorp/boundary/internal/gen/errors"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/observability/metric"
 	"github.com/hashicorp/boundary/internal/requests"
 	commonSrv "github.com/hashicorp/boundary/internal/servers/common"
 	"github.com/hashicorp/boundary/internal/servers/controller/auth"
@@ -270,6 +272,23 @@ func auditResponseInterceptor(
 	}
 }
 
+// metricsInterceptor records the latency of each request along with its
+// gRPC service, method and the code of the final response.
+func metricsInterceptor(
+	_ context.Context,
+) grpc.UnaryServerInterceptor {
+	return func(interceptorCtx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		start := time.Now()
+		resp, err := handler(interceptorCtx, req)
+		metric.ObserveApiRequest(info.FullMethod, status.Code(err), time.Since(start))
+		return resp, err
+	}
+}
+
 func workerRequestInfoInterceptor(ctx context.Context, eventer *event.Eventer) (grpc.UnaryServerInterceptor, error) {
 	const op = "worker.requestInfoInterceptor"
 	if eventer == nil {
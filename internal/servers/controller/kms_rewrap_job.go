@@ -0,0 +1,115 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/servers/controller/common"
+)
+
+// kmsRewrapJob defines a periodic job that re-encrypts values stored in the
+// database with the latest version of the database key of their scope.
+//
+// After the keys of a scope are rotated, the job moves the session tofu
+// tokens, vault tokens, vault client certificate keys and OIDC client
+// secrets off the older key versions so that they can be destroyed.
+type kmsRewrapJob struct {
+	kms                   *kms.Kms
+	sessionRepoFn         common.SessionRepoFactory
+	vaultCredentialRepoFn common.VaultCredentialRepoFactory
+	oidcRepoFn            common.OidcAuthRepoFactory
+
+	// The total number of values rewrapped in the last run.
+	totalRewrapped int
+}
+
+// newKmsRewrapJob instantiates the kms rewrap job.
+func newKmsRewrapJob(kmsCache *kms.Kms, sessionRepoFn common.SessionRepoFactory, vaultCredentialRepoFn common.VaultCredentialRepoFactory, oidcRepoFn common.OidcAuthRepoFactory) (*kmsRewrapJob, error) {
+	const op = "controller.newKmsRewrapJob"
+	switch {
+	case kmsCache == nil:
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing kms")
+	case sessionRepoFn == nil:
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing sessionRepoFn")
+	case vaultCredentialRepoFn == nil:
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing vaultCredentialRepoFn")
+	case oidcRepoFn == nil:
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing oidcRepoFn")
+	}
+	return &kmsRewrapJob{
+		kms:                   kmsCache,
+		sessionRepoFn:         sessionRepoFn,
+		vaultCredentialRepoFn: vaultCredentialRepoFn,
+		oidcRepoFn:            oidcRepoFn,
+	}, nil
+}
+
+// Name returns a short, unique name for the job.
+func (j *kmsRewrapJob) Name() string { return "kms_rewrap" }
+
+// Description returns the description for the job.
+func (j *kmsRewrapJob) Description() string {
+	return "Re-encrypt values with the latest version of the database key of their scope"
+}
+
+// NextRunIn returns the next run time after a job is completed.
+//
+// The next run time is defined for kmsRewrapJob as five minutes.
+func (j *kmsRewrapJob) NextRunIn() (time.Duration, error) { return 5 * time.Minute, nil }
+
+// Status returns the status of the running job.
+func (j *kmsRewrapJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: j.totalRewrapped,
+		Total:     j.totalRewrapped,
+	}
+}
+
+// Run executes the job.
+func (j *kmsRewrapJob) Run(ctx context.Context) error {
+	const op = "controller.(kmsRewrapJob).Run"
+	j.totalRewrapped = 0
+
+	// The keys may have been rotated through another controller, so make sure
+	// the latest key versions are used for encryption.
+	j.kms.ClearCache()
+
+	sessionRepo, err := j.sessionRepoFn()
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("error getting session repo"))
+	}
+	n, err := sessionRepo.RewrapTofuTokens(ctx)
+	j.totalRewrapped += n
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	vaultRepo, err := j.vaultCredentialRepoFn()
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("error getting vault credential repo"))
+	}
+	n, err = vaultRepo.RewrapTokens(ctx)
+	j.totalRewrapped += n
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	n, err = vaultRepo.RewrapClientCertificates(ctx)
+	j.totalRewrapped += n
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	oidcRepo, err := j.oidcRepoFn()
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("error getting oidc repo"))
+	}
+	n, err = oidcRepo.RewrapClientSecrets(ctx)
+	j.totalRewrapped += n
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
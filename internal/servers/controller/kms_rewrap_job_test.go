@@ -0,0 +1,144 @@
+package controller
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/auth/oidc"
+	"github.com/hashicorp/boundary/internal/credential/vault"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// assert the interface
+var _ = scheduler.Job(new(kmsRewrapJob))
+
+func TestKmsRewrapJob(t *testing.T) {
+	t.Parallel()
+	require, assert := require.New(t), assert.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+
+	sessionRepo, err := session.NewRepository(rw, rw, kmsCache)
+	require.NoError(err)
+	vaultRepo, err := vault.NewRepository(rw, rw, kmsCache, scheduler.TestScheduler(t, conn, wrapper))
+	require.NoError(err)
+	oidcRepo, err := oidc.NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(err)
+
+	worker := session.TestWorker(t, conn, wrapper)
+	sess := session.TestDefaultSession(t, conn, wrapper, iamRepo)
+	_, _, err = sessionRepo.ActivateSession(ctx, sess.PublicId, sess.Version, worker.PrivateId, worker.Type, []byte("foo"))
+	require.NoError(err)
+
+	vault.TestCredentialStores(t, conn, wrapper, sess.ScopeId, 1)
+
+	proj, err := iamRepo.LookupScope(ctx, sess.ScopeId)
+	require.NoError(err)
+	orgId := proj.GetParentId()
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, orgId, kms.KeyPurposeDatabase)
+	require.NoError(err)
+	oidc.TestAuthMethod(t, conn, databaseWrapper, orgId, oidc.InactiveState, "alice-rp", "fido")
+
+	job, err := newKmsRewrapJob(kmsCache,
+		func() (*session.Repository, error) { return sessionRepo, nil },
+		func() (*vault.Repository, error) { return vaultRepo, nil },
+		func() (*oidc.Repository, error) { return oidcRepo, nil },
+	)
+	require.NoError(err)
+
+	// Everything is encrypted with the latest key versions.
+	require.NoError(job.Run(ctx))
+	assert.Equal(0, job.Status().Completed)
+
+	require.NoError(kmsCache.RotateKeys(ctx, sess.ScopeId, rand.Reader))
+	require.NoError(kmsCache.RotateKeys(ctx, orgId, rand.Reader))
+
+	// The tofu token, the vault token, the client certificate key and the
+	// client secret are rewrapped.
+	require.NoError(job.Run(ctx))
+	assert.Equal(4, job.Status().Completed)
+
+	require.NoError(job.Run(ctx))
+	assert.Equal(0, job.Status().Completed)
+
+	found, _, err := sessionRepo.LookupSession(ctx, sess.PublicId)
+	require.NoError(err)
+	assert.Equal([]byte("foo"), found.TofuToken)
+	newWrapper, err := kmsCache.GetWrapper(ctx, sess.ScopeId, kms.KeyPurposeDatabase)
+	require.NoError(err)
+	assert.Equal(newWrapper.KeyID(), found.TofuTokenKeyId)
+}
+
+func TestKmsRewrapJobNewJobErr(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+	const op = "controller.newKmsRewrapJob"
+	conn, _ := db.TestSetup(t, "postgres")
+	kmsCache := kms.TestKms(t, conn, db.TestWrapper(t))
+	sessionRepoFn := func() (*session.Repository, error) { return nil, nil }
+	vaultRepoFn := func() (*vault.Repository, error) { return nil, nil }
+	oidcRepoFn := func() (*oidc.Repository, error) { return nil, nil }
+
+	tests := []struct {
+		name          string
+		kms           *kms.Kms
+		sessionRepoFn func() (*session.Repository, error)
+		vaultRepoFn   func() (*vault.Repository, error)
+		oidcRepoFn    func() (*oidc.Repository, error)
+		wantMsg       string
+	}{
+		{
+			name:          "missing-kms",
+			sessionRepoFn: sessionRepoFn,
+			vaultRepoFn:   vaultRepoFn,
+			oidcRepoFn:    oidcRepoFn,
+			wantMsg:       "missing kms",
+		},
+		{
+			name:        "missing-session-repo",
+			kms:         kmsCache,
+			vaultRepoFn: vaultRepoFn,
+			oidcRepoFn:  oidcRepoFn,
+			wantMsg:     "missing sessionRepoFn",
+		},
+		{
+			name:          "missing-vault-repo",
+			kms:           kmsCache,
+			sessionRepoFn: sessionRepoFn,
+			oidcRepoFn:    oidcRepoFn,
+			wantMsg:       "missing vaultCredentialRepoFn",
+		},
+		{
+			name:          "missing-oidc-repo",
+			kms:           kmsCache,
+			sessionRepoFn: sessionRepoFn,
+			vaultRepoFn:   vaultRepoFn,
+			wantMsg:       "missing oidcRepoFn",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			job, err := newKmsRewrapJob(tt.kms, tt.sessionRepoFn, tt.vaultRepoFn, tt.oidcRepoFn)
+			require.Equal(err, errors.E(
+				ctx,
+				errors.WithCode(errors.InvalidParameter),
+				errors.WithOp(op),
+				errors.WithMsg(tt.wantMsg),
+			))
+			require.Nil(job)
+		})
+	}
+}
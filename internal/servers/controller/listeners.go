@@ -139,7 +139,7 @@ func (c *Controller) startListeners(ctx context.Context) error {
 				),
 			),
 		)
-		workerService := workers.NewWorkerServiceServer(c.ServersRepoFn, c.SessionRepoFn, c.workerStatusUpdateTimes, c.kms)
+		workerService := workers.NewWorkerServiceServer(c.ServersRepoFn, c.SessionRepoFn, c.TargetRepoFn, c.workerStatusUpdateTimes, c.kms)
 		pbs.RegisterServerCoordinationServiceServer(workerServer, workerService)
 		pbs.RegisterSessionServiceServer(workerServer, workerService)
 
@@ -150,6 +150,28 @@ func (c *Controller) startListeners(ctx context.Context) error {
 		servers = append(servers, func() {
 			go workerServer.Serve(interceptor)
 		})
+
+		// Reverse workers open tunnels to the cluster listener, and other
+		// workers ask for connections to be relayed to them over those
+		// tunnels. Both authenticate the same way as for the gRPC server.
+		ln.Mux.UnregisterProto(globals.ReverseTunnelV1)
+		tunnelLn, err := ln.Mux.RegisterProto(globals.ReverseTunnelV1, &tls.Config{
+			GetConfigForClient: c.validateWorkerTls,
+		})
+		if err != nil {
+			return fmt.Errorf("error getting sub-listener for reverse worker tunnels: %w", err)
+		}
+		ln.Mux.UnregisterProto(globals.ReverseRelayV1)
+		relayLn, err := ln.Mux.RegisterProto(globals.ReverseRelayV1, &tls.Config{
+			GetConfigForClient: c.validateWorkerTls,
+		})
+		if err != nil {
+			return fmt.Errorf("error getting sub-listener for reverse worker relays: %w", err)
+		}
+		servers = append(servers, func() {
+			go c.serveReverseTunnels(newInterceptingListener(c, tunnelLn))
+			go c.serveReverseRelays(newInterceptingListener(c, relayLn))
+		})
 		return nil
 	}
 
@@ -168,6 +190,8 @@ func (c *Controller) startListeners(ctx context.Context) error {
 				err = configureForCluster(ln)
 			case "proxy":
 				// Do nothing, in a dev mode we might see it here
+			case "ops":
+				// Do nothing, ops listeners are served by the ops server
 			default:
 				err = fmt.Errorf("unknown listener purpose %q", purpose)
 			}
@@ -224,6 +248,7 @@ func (c *Controller) stopListeners(serversOnly bool) error {
 	}
 
 	serverWg.Wait()
+	c.reverseTunnels.closeAll()
 	if serversOnly {
 		return nil
 	}
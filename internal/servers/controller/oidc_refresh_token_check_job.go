@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth/oidc"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/servers/controller/common"
+)
+
+// defaultRefreshTokenCheckInterval is used when auth_token_time_to_stale is
+// not configured and matches the default staleness of auth tokens.
+const defaultRefreshTokenCheckInterval = 24 * time.Hour
+
+// oidcRefreshTokenCheckJob defines a periodic job that uses the refresh tokens
+// stored for OIDC auth methods to re-check the standing of users with their
+// IdP.
+//
+// Each refresh token is checked once per interval, which is the configured
+// auth_token_time_to_stale. Auth tokens whose refresh token is refused by the
+// IdP are revoked.
+type oidcRefreshTokenCheckJob struct {
+	oidcRepoFn common.OidcAuthRepoFactory
+	atRepoFn   common.AuthTokenRepoFactory
+	interval   time.Duration
+
+	// The number of refresh tokens checked and auth tokens revoked in the last
+	// run.
+	totalChecked, totalRevoked int
+}
+
+// newOidcRefreshTokenCheckJob instantiates the oidc refresh token check job.
+// If interval is zero, defaultRefreshTokenCheckInterval is used.
+func newOidcRefreshTokenCheckJob(oidcRepoFn common.OidcAuthRepoFactory, atRepoFn common.AuthTokenRepoFactory, interval time.Duration) (*oidcRefreshTokenCheckJob, error) {
+	const op = "controller.newOidcRefreshTokenCheckJob"
+	switch {
+	case oidcRepoFn == nil:
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing oidcRepoFn")
+	case atRepoFn == nil:
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing atRepoFn")
+	case interval < 0:
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "negative interval")
+	case interval == 0:
+		interval = defaultRefreshTokenCheckInterval
+	}
+	return &oidcRefreshTokenCheckJob{
+		oidcRepoFn: oidcRepoFn,
+		atRepoFn:   atRepoFn,
+		interval:   interval,
+	}, nil
+}
+
+// Name returns a short, unique name for the job.
+func (j *oidcRefreshTokenCheckJob) Name() string { return "oidc_refresh_token_check" }
+
+// Description returns the description for the job.
+func (j *oidcRefreshTokenCheckJob) Description() string {
+	return "Revoke auth tokens of users whose OIDC refresh token is refused by their IdP"
+}
+
+// NextRunIn returns the next run time after a job is completed.
+//
+// The next run time is defined for oidcRefreshTokenCheckJob as five minutes.
+func (j *oidcRefreshTokenCheckJob) NextRunIn() (time.Duration, error) {
+	return 5 * time.Minute, nil
+}
+
+// Status returns the status of the running job.
+func (j *oidcRefreshTokenCheckJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: j.totalChecked,
+		Total:     j.totalChecked,
+	}
+}
+
+// Run executes the job.
+func (j *oidcRefreshTokenCheckJob) Run(ctx context.Context) error {
+	const op = "controller.(oidcRefreshTokenCheckJob).Run"
+	j.totalChecked, j.totalRevoked = 0, 0
+
+	var err error
+	j.totalChecked, j.totalRevoked, err = oidc.CheckRefreshTokens(ctx, j.oidcRepoFn, j.atRepoFn, j.interval)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
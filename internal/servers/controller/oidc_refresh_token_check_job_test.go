@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth/oidc"
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// assert the interface
+var _ = scheduler.Job(new(oidcRefreshTokenCheckJob))
+
+func TestOidcRefreshTokenCheckJob(t *testing.T) {
+	t.Parallel()
+	require, assert := require.New(t), assert.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+
+	oidcRepoFn := func() (*oidc.Repository, error) { return oidc.NewRepository(ctx, rw, rw, kmsCache) }
+	atRepoFn := func() (*authtoken.Repository, error) { return authtoken.NewRepository(rw, rw, kmsCache) }
+
+	job, err := newOidcRefreshTokenCheckJob(oidcRepoFn, atRepoFn, 0)
+	require.NoError(err)
+	assert.Equal(defaultRefreshTokenCheckInterval, job.interval)
+
+	require.NoError(job.Run(ctx))
+	assert.Equal(0, job.Status().Completed)
+}
+
+func TestOidcRefreshTokenCheckJobNewJobErr(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+	const op = "controller.newOidcRefreshTokenCheckJob"
+	require := require.New(t)
+
+	oidcRepoFn := func() (*oidc.Repository, error) { return nil, nil }
+	atRepoFn := func() (*authtoken.Repository, error) { return nil, nil }
+
+	job, err := newOidcRefreshTokenCheckJob(nil, atRepoFn, time.Hour)
+	require.Equal(err, errors.E(
+		ctx,
+		errors.WithCode(errors.InvalidParameter),
+		errors.WithOp(op),
+		errors.WithMsg("missing oidcRepoFn"),
+	))
+	require.Nil(job)
+
+	job, err = newOidcRefreshTokenCheckJob(oidcRepoFn, nil, time.Hour)
+	require.Equal(err, errors.E(
+		ctx,
+		errors.WithCode(errors.InvalidParameter),
+		errors.WithOp(op),
+		errors.WithMsg("missing atRepoFn"),
+	))
+	require.Nil(job)
+
+	job, err = newOidcRefreshTokenCheckJob(oidcRepoFn, atRepoFn, -time.Hour)
+	require.Equal(err, errors.E(
+		ctx,
+		errors.WithCode(errors.InvalidParameter),
+		errors.WithOp(op),
+		errors.WithMsg("negative interval"),
+	))
+	require.Nil(job)
+}
@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/servers/controller/common"
+)
+
+// principalRoleExpirationJob defines a periodic job that deletes the
+// assignments of principals to roles which have expired.
+//
+// Expired assignments are already ignored when computing a user's grants, so
+// the job only removes them from the roles they were assigned to.
+type principalRoleExpirationJob struct {
+	iamRepoFn common.IamRepoFactory
+
+	// The total number of principal roles deleted in the last run.
+	totalDeleted int
+}
+
+// newPrincipalRoleExpirationJob instantiates the principal role expiration
+// job.
+func newPrincipalRoleExpirationJob(iamRepoFn common.IamRepoFactory) (*principalRoleExpirationJob, error) {
+	const op = "controller.newPrincipalRoleExpirationJob"
+	if iamRepoFn == nil {
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing iamRepoFn")
+	}
+	return &principalRoleExpirationJob{
+		iamRepoFn: iamRepoFn,
+	}, nil
+}
+
+// Name returns a short, unique name for the job.
+func (j *principalRoleExpirationJob) Name() string { return "principal_role_expiration" }
+
+// Description returns the description for the job.
+func (j *principalRoleExpirationJob) Description() string {
+	return "Remove principals from roles once their assignment has expired"
+}
+
+// NextRunIn returns the next run time after a job is completed.
+//
+// The next run time is defined for principalRoleExpirationJob as one minute.
+func (j *principalRoleExpirationJob) NextRunIn() (time.Duration, error) { return time.Minute, nil }
+
+// Status returns the status of the running job.
+func (j *principalRoleExpirationJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: j.totalDeleted,
+		Total:     j.totalDeleted,
+	}
+}
+
+// Run executes the job.
+func (j *principalRoleExpirationJob) Run(ctx context.Context) error {
+	const op = "controller.(principalRoleExpirationJob).Run"
+	j.totalDeleted = 0
+
+	iamRepo, err := j.iamRepoFn()
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("error getting iam repo"))
+	}
+
+	j.totalDeleted, err = iamRepo.DeleteExpiredPrincipalRoles(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
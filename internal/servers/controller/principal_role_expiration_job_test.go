@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// assert the interface
+var _ = scheduler.Job(new(principalRoleExpirationJob))
+
+func TestPrincipalRoleExpirationJob(t *testing.T) {
+	t.Parallel()
+	require, assert := require.New(t), assert.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	ctx := context.Background()
+
+	org, _ := iam.TestScopes(t, iamRepo)
+	user := iam.TestUser(t, iamRepo, org.PublicId)
+	role := iam.TestRole(t, conn, org.PublicId)
+	expiration := time.Now().Add(time.Second)
+	iam.TestUserRole(t, conn, role.PublicId, user.PublicId, iam.WithExpirationTime(expiration))
+
+	job, err := newPrincipalRoleExpirationJob(func() (*iam.Repository, error) { return iamRepo, nil })
+	require.NoError(err)
+
+	require.NoError(job.Run(ctx))
+	assert.Equal(0, job.Status().Completed)
+
+	time.Sleep(time.Until(expiration))
+	require.NoError(job.Run(ctx))
+	assert.Equal(1, job.Status().Completed)
+
+	principals, err := iamRepo.ListPrincipalRoles(ctx, role.PublicId)
+	require.NoError(err)
+	assert.Empty(principals)
+}
+
+func TestPrincipalRoleExpirationJobNewJobErr(t *testing.T) {
+	t.Parallel()
+	ctx := context.TODO()
+	const op = "controller.newPrincipalRoleExpirationJob"
+	require := require.New(t)
+
+	job, err := newPrincipalRoleExpirationJob(nil)
+	require.Equal(err, errors.E(
+		ctx,
+		errors.WithCode(errors.InvalidParameter),
+		errors.WithOp(op),
+		errors.WithMsg("missing iamRepoFn"),
+	))
+	require.Nil(job)
+}
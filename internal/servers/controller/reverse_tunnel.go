@@ -0,0 +1,165 @@
+package controller
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/yamux"
+)
+
+// reverseTunnels holds the tunnels reverse workers keep open to this
+// controller, keyed by worker id. A worker has at most one tunnel to a
+// controller; a new tunnel replaces the previous one.
+type reverseTunnels struct {
+	sync.Mutex
+	m map[string]*yamux.Session
+}
+
+func newReverseTunnels() *reverseTunnels {
+	return &reverseTunnels{m: make(map[string]*yamux.Session)}
+}
+
+// add registers the tunnel of the worker, closing any previous tunnel, and
+// removes it again once it is closed.
+func (t *reverseTunnels) add(workerId string, sess *yamux.Session) {
+	t.Lock()
+	prev := t.m[workerId]
+	t.m[workerId] = sess
+	t.Unlock()
+	if prev != nil {
+		_ = prev.Close()
+	}
+	go func() {
+		<-sess.CloseChan()
+		t.Lock()
+		defer t.Unlock()
+		if t.m[workerId] == sess {
+			delete(t.m, workerId)
+		}
+	}()
+}
+
+func (t *reverseTunnels) get(workerId string) *yamux.Session {
+	t.Lock()
+	defer t.Unlock()
+	return t.m[workerId]
+}
+
+func (t *reverseTunnels) closeAll() {
+	t.Lock()
+	defer t.Unlock()
+	for id, sess := range t.m {
+		_ = sess.Close()
+		delete(t.m, id)
+	}
+}
+
+// serveReverseTunnels accepts the tunnels reverse workers open to the cluster
+// listener. The controller is the yamux client side of a tunnel: it opens a
+// stream for each connection relayed to the worker.
+func (c *Controller) serveReverseTunnels(ln *interceptingListener) {
+	const op = "controller.(Controller).serveReverseTunnels"
+	for {
+		conn, workerInfo, err := ln.acceptWorker()
+		if err != nil {
+			if strings.Contains(err.Error(), "use of closed network connection") {
+				return
+			}
+			event.WriteError(c.baseContext, op, err, event.WithInfoMsg("error accepting reverse worker tunnel"))
+			continue
+		}
+		cfg := yamux.DefaultConfig()
+		// Errors are surfaced to us through the session, don't log them twice
+		cfg.LogOutput = io.Discard
+		sess, err := yamux.Client(conn, cfg)
+		if err != nil {
+			event.WriteError(c.baseContext, op, err, event.WithInfoMsg("error creating reverse worker tunnel", "name", workerInfo.Name))
+			_ = conn.Close()
+			continue
+		}
+		c.reverseTunnels.add(workerInfo.Name, sess)
+		event.WriteSysEvent(c.baseContext, op, "reverse worker tunnel opened", "name", workerInfo.Name)
+	}
+}
+
+// serveReverseRelays accepts connections from workers asking for a
+// connection to be relayed to a reverse worker and relays them.
+func (c *Controller) serveReverseRelays(ln *interceptingListener) {
+	const op = "controller.(Controller).serveReverseRelays"
+	for {
+		conn, _, err := ln.acceptWorker()
+		if err != nil {
+			if strings.Contains(err.Error(), "use of closed network connection") {
+				return
+			}
+			event.WriteError(c.baseContext, op, err, event.WithInfoMsg("error accepting reverse worker relay"))
+			continue
+		}
+		go c.relay(conn)
+	}
+}
+
+// relay reads the id of the reverse worker the connection is for and copies
+// data between the connection and a new stream on the worker's tunnel until
+// either side closes. The relayed data is opaque to the controller; it is a
+// TLS connection authenticated with the session certificate.
+func (c *Controller) relay(conn net.Conn) {
+	const op = "controller.(Controller).relay"
+	defer conn.Close()
+	workerId, err := readRelayHeader(conn)
+	if err != nil {
+		event.WriteError(c.baseContext, op, err, event.WithInfoMsg("error reading relay header"))
+		return
+	}
+	sess := c.reverseTunnels.get(workerId)
+	if sess == nil {
+		event.WriteError(c.baseContext, op, errors.New("no tunnel to reverse worker"), event.WithInfo("worker_id", workerId))
+		return
+	}
+	stream, err := sess.Open()
+	if err != nil {
+		event.WriteError(c.baseContext, op, err, event.WithInfoMsg("error opening stream to reverse worker", "worker_id", workerId))
+		return
+	}
+	defer stream.Close()
+
+	connWg := new(sync.WaitGroup)
+	connWg.Add(2)
+	go func() {
+		defer connWg.Done()
+		_, _ = io.Copy(conn, stream)
+		_ = conn.Close()
+		_ = stream.Close()
+	}()
+	go func() {
+		defer connWg.Done()
+		_, _ = io.Copy(stream, conn)
+		_ = stream.Close()
+		_ = conn.Close()
+	}()
+	connWg.Wait()
+}
+
+// readRelayHeader reads the id of the reverse worker a relayed connection is
+// for. It is sent by the worker as a big endian uint16 length followed by the
+// id.
+func readRelayHeader(r io.Reader) (string, error) {
+	var l uint16
+	if err := binary.Read(r, binary.BigEndian, &l); err != nil {
+		return "", fmt.Errorf("error reading worker id length: %w", err)
+	}
+	if l == 0 {
+		return "", errors.New("empty worker id")
+	}
+	id := make([]byte, l)
+	if _, err := io.ReadFull(r, id); err != nil {
+		return "", fmt.Errorf("error reading worker id: %w", err)
+	}
+	return string(id), nil
+}
@@ -0,0 +1,57 @@
+package controller
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadRelayHeader(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      []byte
+		want    string
+		wantErr string
+	}{
+		{
+			name: "valid",
+			in:   append([]byte{0, 14}, "w_1234567890xy"...),
+			want: "w_1234567890xy",
+		},
+		{
+			name: "trailing-data",
+			in:   append([]byte{0, 3}, "w_1rest"...),
+			want: "w_1",
+		},
+		{
+			name:    "empty",
+			in:      []byte{},
+			wantErr: "error reading worker id length",
+		},
+		{
+			name:    "zero-length",
+			in:      []byte{0, 0},
+			wantErr: "empty worker id",
+		},
+		{
+			name:    "short-id",
+			in:      append([]byte{0, 10}, "w_1"...),
+			wantErr: "error reading worker id",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := readRelayHeader(bytes.NewReader(tt.in))
+			if tt.wantErr != "" {
+				require.Error(err)
+				assert.Contains(err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+		})
+	}
+}
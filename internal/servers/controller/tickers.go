@@ -12,8 +12,9 @@ import (
 
 // In the future we could make this configurable
 const (
-	statusInterval      = 10 * time.Second
-	terminationInterval = 1 * time.Minute
+	statusInterval          = 10 * time.Second
+	terminationInterval     = 1 * time.Minute
+	kmsCacheRefreshInterval = 5 * time.Minute
 )
 
 // This is exported so it can be tweaked in tests
@@ -149,3 +150,22 @@ func (c *Controller) startCloseExpiredPendingTokens(cancelCtx context.Context) {
 		}
 	}
 }
+
+// startKmsCacheRefreshTicking periodically clears the cached wrappers of the
+// kms so that key versions created by rotating keys through another
+// controller are picked up.
+func (c *Controller) startKmsCacheRefreshTicking(cancelCtx context.Context) {
+	const op = "controller.(Controller).startKmsCacheRefreshTicking"
+	timer := time.NewTimer(kmsCacheRefreshInterval)
+	for {
+		select {
+		case <-cancelCtx.Done():
+			event.WriteSysEvent(cancelCtx, op, "kms cache refresh ticking shutting down")
+			return
+
+		case <-timer.C:
+			c.kms.ClearCache()
+			timer.Reset(kmsCacheRefreshInterval)
+		}
+	}
+}
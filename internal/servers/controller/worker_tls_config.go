@@ -11,6 +11,7 @@ import (
 	"net"
 	"strings"
 
+	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/boundary/internal/servers"
 	wrapping "github.com/hashicorp/go-kms-wrapping"
@@ -60,6 +61,7 @@ func (c Controller) validateWorkerTls(hello *tls.ClientHelloInfo) (*tls.Config,
 // * Validates it by decrypting against the configured KMS
 // * Ensures that the nonce is unique to prevent replay attacks
 // * Returns the shared TLS configuration that is used to establish the connection
+// * Negotiates the reverse worker proto if one was sent
 func (c Controller) v1WorkerAuthConfig(protos []string) (*tls.Config, *base.WorkerAuthInfo, error) {
 	var firstMatchProto string
 	var encString string
@@ -75,6 +77,16 @@ func (c Controller) v1WorkerAuthConfig(protos []string) (*tls.Config, *base.Work
 	if firstMatchProto == "" {
 		return nil, nil, errors.New("no matching proto found")
 	}
+	// Workers opening a reverse tunnel or asking for a relay also send the
+	// proto for it, which is negotiated so the connection is routed to the
+	// right listener.
+	nextProto := firstMatchProto
+	for _, p := range protos {
+		switch p {
+		case globals.ReverseTunnelV1, globals.ReverseRelayV1:
+			nextProto = p
+		}
+	}
 	marshaledEncInfo, err := base64.RawStdEncoding.DecodeString(encString)
 	if err != nil {
 		return nil, nil, err
@@ -113,7 +125,7 @@ func (c Controller) v1WorkerAuthConfig(protos []string) (*tls.Config, *base.Work
 		Certificates: []tls.Certificate{tlsCert},
 		ClientCAs:    rootCAs,
 		ClientAuth:   tls.RequireAndVerifyClientCert,
-		NextProtos:   []string{firstMatchProto},
+		NextProtos:   []string{nextProto},
 		MinVersion:   tls.VersionTLS13,
 	}
 
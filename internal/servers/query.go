@@ -3,4 +3,12 @@ package servers
 const (
 	deleteWhereCreateTimeSql = `create_time < ?`
 	deleteTagsSql            = `server_id = ?`
+	deleteWorkerSql          = `
+delete from server
+ where private_id = (
+         select server_id
+           from server_worker
+          where public_id = ?
+       );
+`
 )
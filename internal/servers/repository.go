@@ -147,15 +147,32 @@ func (r *Repository) UpsertServer(ctx context.Context, server *Server, opt ...Op
 			var err error
 			onConflict := &db.OnConflict{
 				Target: db.Constraint("server_pkey"),
-				Action: append(db.SetColumns([]string{"type", "description", "address"}), db.SetColumnValues(map[string]interface{}{"update_time": "now()"})...),
+				Action: append(db.SetColumns([]string{"type", "description", "address", "reverse"}), db.SetColumnValues(map[string]interface{}{"update_time": "now()"})...),
 			}
 			err = w.Create(ctx, server, db.WithOnConflict(onConflict), db.WithReturnRowsAffected(&rowsUpdated))
 			if err != nil {
 				return errors.Wrap(ctx, err, op+":Upsert")
 			}
 
-			// If it's a worker, fetch the current controllers to feed to them
+			// If it's a worker, make sure it has a public id and fetch the
+			// current controllers to feed to them
 			if server.Type == resource.Worker.String() {
+				id, err := db.NewPublicId(WorkerPrefix)
+				if err != nil {
+					return errors.Wrap(ctx, err, op+":NewPublicId")
+				}
+				worker := &Worker{
+					PublicId: id,
+					ServerId: server.PrivateId,
+				}
+				workerOnConflict := &db.OnConflict{
+					Target: db.Constraint("server_worker_server_id_uq"),
+					Action: db.DoNothing(true),
+				}
+				if err := w.Create(ctx, worker, db.WithOnConflict(workerOnConflict)); err != nil {
+					return errors.Wrap(ctx, err, op+":UpsertWorker")
+				}
+
 				// Fetch current controllers to feed to the workers
 				controllers, err = r.listServersWithReader(ctx, read, ServerTypeController)
 				if err != nil {
@@ -0,0 +1,268 @@
+package servers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	dbcommon "github.com/hashicorp/boundary/internal/db/common"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// WorkerPrefix is the prefix of the public ids of workers.
+const WorkerPrefix = "w"
+
+// TableName returns the table name of the operator managed fields of a worker.
+func (w *Worker) TableName() string {
+	return "server_worker"
+}
+
+// clone returns a deep copy of the worker.
+func (w *Worker) clone() *Worker {
+	return proto.Clone(w).(*Worker)
+}
+
+// workerAggregate is a worker as read from the server_worker_aggregate view,
+// which adds the fields reported by the worker in its status updates.
+type workerAggregate struct {
+	PublicId           string `gorm:"primary_key"`
+	ServerId           string
+	Name               string
+	Description        string
+	CreateTime         *timestamp.Timestamp
+	UpdateTime         *timestamp.Timestamp
+	Version            uint32
+	Address            string
+	LastStatusTime     *timestamp.Timestamp
+	ActiveSessionCount uint32
+}
+
+// TableName returns the view name of the workerAggregate.
+func (workerAggregate) TableName() string {
+	return "server_worker_aggregate"
+}
+
+func (a *workerAggregate) toWorker() *Worker {
+	return &Worker{
+		PublicId:           a.PublicId,
+		ServerId:           a.ServerId,
+		Name:               a.Name,
+		Description:        a.Description,
+		CreateTime:         a.CreateTime,
+		UpdateTime:         a.UpdateTime,
+		Version:            a.Version,
+		Address:            a.Address,
+		LastStatusTime:     a.LastStatusTime,
+		ActiveSessionCount: a.ActiveSessionCount,
+	}
+}
+
+// LookupWorker returns the worker with the provided public id. It returns
+// nil and no error if the worker is not found.
+func (r *Repository) LookupWorker(ctx context.Context, publicId string, _ ...Option) (*Worker, error) {
+	const op = "servers.(Repository).LookupWorker"
+	if publicId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	w, err := r.lookupWorkerWithReader(ctx, r.reader, publicId)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", publicId)))
+	}
+	return w, nil
+}
+
+func (r *Repository) lookupWorkerWithReader(ctx context.Context, reader db.Reader, publicId string) (*Worker, error) {
+	const op = "servers.(Repository).lookupWorkerWithReader"
+	var agg workerAggregate
+	if err := reader.LookupWhere(ctx, &agg, "public_id = ?", publicId); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	workers := []*Worker{agg.toWorker()}
+	if err := r.setWorkerTags(ctx, reader, workers); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return workers[0], nil
+}
+
+// ListWorkers returns the workers which have reported their status to a
+// controller, ordered by public id, along with their tags. It supports the
+// WithLimit option.
+func (r *Repository) ListWorkers(ctx context.Context, opt ...Option) ([]*Worker, error) {
+	const op = "servers.(Repository).ListWorkers"
+	opts := getOpts(opt...)
+	var aggs []*workerAggregate
+	if err := r.reader.SearchWhere(ctx, &aggs, "", nil, db.WithLimit(opts.withLimit), db.WithOrder("public_id asc")); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	workers := make([]*Worker, 0, len(aggs))
+	for _, agg := range aggs {
+		workers = append(workers, agg.toWorker())
+	}
+	if err := r.setWorkerTags(ctx, r.reader, workers); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return workers, nil
+}
+
+// setWorkerTags sets the tags of the provided workers from the server_tag
+// entries of their servers.
+func (r *Repository) setWorkerTags(ctx context.Context, reader db.Reader, workers []*Worker) error {
+	const op = "servers.(Repository).setWorkerTags"
+	if len(workers) == 0 {
+		return nil
+	}
+	byServerId := make(map[string]*Worker, len(workers))
+	serverIds := make([]string, 0, len(workers))
+	for _, w := range workers {
+		byServerId[w.ServerId] = w
+		serverIds = append(serverIds, w.ServerId)
+	}
+	var serverTags []*ServerTag
+	if err := reader.SearchWhere(ctx, &serverTags, "server_id in (?)", []interface{}{serverIds}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	for _, t := range serverTags {
+		w := byServerId[t.ServerId]
+		if w.Tags == nil {
+			w.Tags = make(map[string]*TagValues)
+		}
+		if w.Tags[t.Key] == nil {
+			w.Tags[t.Key] = &TagValues{}
+		}
+		w.Tags[t.Key].Values = append(w.Tags[t.Key].Values, t.Value)
+	}
+	return nil
+}
+
+// UpdateWorker updates the name, description and tags of a worker. The
+// fieldMaskPaths provides field_mask.proto paths for fields that should be
+// updated. Fields will be set to NULL if the field is a zero value and
+// included in fieldMask. Name, Description and Tags are the only updatable
+// fields. Tags set this way are replaced by the tags in the configuration of
+// the worker the next time it starts. If no rows were updated, the returned
+// worker is nil.
+func (r *Repository) UpdateWorker(ctx context.Context, worker *Worker, version uint32, fieldMaskPaths []string, _ ...Option) (*Worker, int, error) {
+	const op = "servers.(Repository).UpdateWorker"
+	if worker == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing worker")
+	}
+	if worker.PublicId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	if version == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	}
+
+	var updateTags bool
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold("name", f):
+		case strings.EqualFold("description", f):
+		case strings.EqualFold("tags", f):
+			updateTags = true
+		default:
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
+		}
+	}
+	var dbMask, nullFields []string
+	dbMask, nullFields = dbcommon.BuildUpdatePaths(
+		map[string]interface{}{
+			"Name":        worker.Name,
+			"Description": worker.Description,
+		},
+		fieldMaskPaths,
+		nil,
+	)
+	if len(dbMask) == 0 && len(nullFields) == 0 && !updateTags {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
+	}
+	w := worker.clone()
+	if len(dbMask) == 0 && len(nullFields) == 0 {
+		// Only the tags are being changed, so update the version of the
+		// worker to record the change.
+		w.Version = version + 1
+		dbMask = append(dbMask, "Version")
+	}
+
+	var returnedWorker *Worker
+	var rowsUpdated int
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(read db.Reader, txw db.Writer) error {
+			var err error
+			rowsUpdated, err = txw.Update(ctx, w, dbMask, nullFields, db.WithVersion(&version))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated == 0 {
+				return nil
+			}
+			if rowsUpdated > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+			}
+			current, err := r.lookupWorkerWithReader(ctx, read, w.PublicId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if updateTags {
+				if _, err := txw.Delete(ctx, &ServerTag{}, db.WithWhere(deleteTagsSql, current.ServerId)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete tags"))
+				}
+				tags := make([]interface{}, 0, len(worker.Tags))
+				for k, v := range worker.Tags {
+					if v == nil {
+						return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("found nil tag value for worker %s and key %s", w.PublicId, k))
+					}
+					for _, val := range v.Values {
+						tags = append(tags, ServerTag{
+							ServerId: current.ServerId,
+							Key:      k,
+							Value:    val,
+						})
+					}
+				}
+				if len(tags) > 0 {
+					if err := txw.CreateItems(ctx, tags); err != nil {
+						return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create tags"))
+					}
+				}
+				current.Tags = worker.Tags
+			}
+			returnedWorker = current
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("worker %s already exists", worker.Name))
+		}
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", worker.PublicId)))
+	}
+	return returnedWorker, rowsUpdated, nil
+}
+
+// DeleteWorker deletes the worker with the provided public id along with its
+// server entry and tags. A worker that is still running registers again with
+// a new public id when it next reports its status.
+func (r *Repository) DeleteWorker(ctx context.Context, publicId string, _ ...Option) (int, error) {
+	const op = "servers.(Repository).DeleteWorker"
+	if publicId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	rowsDeleted, err := r.writer.Exec(ctx, deleteWorkerSql, []interface{}{publicId})
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", publicId)))
+	}
+	if rowsDeleted > 1 {
+		return db.NoRowsAffected, errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
+	}
+	return rowsDeleted, nil
+}
@@ -0,0 +1,124 @@
+package servers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/servers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkers(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := servers.NewRepository(rw, rw, kms)
+	require.NoError(err)
+	ctx := context.Background()
+
+	for _, id := range []string{"test1", "test2"} {
+		_, _, err := repo.UpsertServer(ctx, &servers.Server{
+			PrivateId: id,
+			Type:      "worker",
+			Address:   "127.0.0.1",
+			Tags: map[string]*servers.TagValues{
+				"type": {Values: []string{"dev"}},
+			},
+		}, servers.WithUpdateTags(true))
+		require.NoError(err)
+	}
+	// Reporting the status again doesn't register the worker again.
+	_, _, err = repo.UpsertServer(ctx, &servers.Server{PrivateId: "test1", Type: "worker", Address: "127.0.0.1"})
+	require.NoError(err)
+	// Controllers aren't workers.
+	_, _, err = repo.UpsertServer(ctx, &servers.Server{PrivateId: "ctrl", Type: "controller", Address: "127.0.0.1"})
+	require.NoError(err)
+
+	workers, err := repo.ListWorkers(ctx)
+	require.NoError(err)
+	require.Len(workers, 2)
+	w := workers[0]
+	assert.Contains([]string{"test1", "test2"}, w.ServerId)
+	assert.Equal("127.0.0.1", w.Address)
+	assert.NotNil(w.LastStatusTime)
+	assert.Equal(uint32(1), w.Version)
+	assert.Equal([]string{"dev"}, w.Tags["type"].GetValues())
+
+	got, err := repo.LookupWorker(ctx, w.PublicId)
+	require.NoError(err)
+	assert.Equal(w.ServerId, got.ServerId)
+
+	got, err = repo.LookupWorker(ctx, "w_1234567890")
+	require.NoError(err)
+	assert.Nil(got)
+
+	// Update the name and description
+	updated, rowsUpdated, err := repo.UpdateWorker(ctx, &servers.Worker{
+		PublicId:    w.PublicId,
+		Name:        "worker-one",
+		Description: "the first worker",
+	}, w.Version, []string{"Name", "Description"})
+	require.NoError(err)
+	assert.Equal(1, rowsUpdated)
+	assert.Equal("worker-one", updated.Name)
+	assert.Equal("the first worker", updated.Description)
+	assert.Equal(uint32(2), updated.Version)
+	assert.Equal([]string{"dev"}, updated.Tags["type"].GetValues())
+
+	// Only updating the tags still bumps the version.
+	updated, rowsUpdated, err = repo.UpdateWorker(ctx, &servers.Worker{
+		PublicId: w.PublicId,
+		Tags: map[string]*servers.TagValues{
+			"type": {Values: []string{"prod", "east"}},
+		},
+	}, updated.Version, []string{"Tags"})
+	require.NoError(err)
+	assert.Equal(1, rowsUpdated)
+	assert.Equal(uint32(3), updated.Version)
+	assert.Equal("worker-one", updated.Name)
+	got, err = repo.LookupWorker(ctx, w.PublicId)
+	require.NoError(err)
+	assert.ElementsMatch([]string{"prod", "east"}, got.Tags["type"].GetValues())
+
+	// A stale version doesn't update anything.
+	updated, rowsUpdated, err = repo.UpdateWorker(ctx, &servers.Worker{PublicId: w.PublicId, Name: "stale"}, 1, []string{"Name"})
+	require.NoError(err)
+	assert.Equal(0, rowsUpdated)
+	assert.Nil(updated)
+
+	_, _, err = repo.UpdateWorker(ctx, &servers.Worker{PublicId: w.PublicId, Address: "10.0.0.1"}, 3, []string{"Address"})
+	require.Error(err)
+	assert.True(errors.Match(errors.T(errors.InvalidFieldMask), err))
+
+	_, _, err = repo.UpdateWorker(ctx, &servers.Worker{PublicId: workers[1].PublicId, Name: "worker-one"}, 1, []string{"Name"})
+	require.Error(err)
+	assert.True(errors.Match(errors.T(errors.NotUnique), err))
+
+	// Deleting removes the server entry, so the worker registers again with
+	// a new public id.
+	rowsDeleted, err := repo.DeleteWorker(ctx, w.PublicId)
+	require.NoError(err)
+	assert.Equal(1, rowsDeleted)
+	rowsDeleted, err = repo.DeleteWorker(ctx, w.PublicId)
+	require.NoError(err)
+	assert.Equal(0, rowsDeleted)
+	servs, err := repo.ListServers(ctx, servers.ServerTypeWorker, servers.WithLiveness(-1))
+	require.NoError(err)
+	assert.Len(servs, 1)
+
+	_, _, err = repo.UpsertServer(ctx, &servers.Server{PrivateId: w.ServerId, Type: "worker", Address: "127.0.0.1"})
+	require.NoError(err)
+	workers, err = repo.ListWorkers(ctx)
+	require.NoError(err)
+	require.Len(workers, 2)
+	for _, nw := range workers {
+		assert.NotEqual(w.PublicId, nw.PublicId)
+	}
+}
@@ -27,24 +27,38 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
-func (w *Worker) startControllerConnections() error {
-	const op = "worker.(Worker).startControllerConnections"
-	initialAddrs := make([]resolver.Address, 0, len(w.conf.RawConfig.Worker.Controllers))
+// controllerAddrs returns the configured controller addresses, adding the
+// default cluster port to those without one.
+func (w *Worker) controllerAddrs() ([]string, error) {
+	addrs := make([]string, 0, len(w.conf.RawConfig.Worker.Controllers))
 	for _, addr := range w.conf.RawConfig.Worker.Controllers {
 		switch {
 		case strings.HasPrefix(addr, "/"):
-			initialAddrs = append(initialAddrs, resolver.Address{Addr: addr})
+			addrs = append(addrs, addr)
 		default:
 			host, port, err := net.SplitHostPort(addr)
 			if err != nil && strings.Contains(err.Error(), "missing port in address") {
 				host, port, err = net.SplitHostPort(net.JoinHostPort(addr, "9201"))
 			}
 			if err != nil {
-				return fmt.Errorf("error parsing controller address: %w", err)
+				return nil, fmt.Errorf("error parsing controller address: %w", err)
 			}
-			initialAddrs = append(initialAddrs, resolver.Address{Addr: net.JoinHostPort(host, port)})
+			addrs = append(addrs, net.JoinHostPort(host, port))
 		}
 	}
+	return addrs, nil
+}
+
+func (w *Worker) startControllerConnections() error {
+	const op = "worker.(Worker).startControllerConnections"
+	addrs, err := w.controllerAddrs()
+	if err != nil {
+		return err
+	}
+	initialAddrs := make([]resolver.Address, 0, len(addrs))
+	for _, addr := range addrs {
+		initialAddrs = append(initialAddrs, resolver.Address{Addr: addr})
+	}
 
 	if len(initialAddrs) == 0 {
 		return errors.New("no initial controller addresses found")
@@ -61,39 +75,46 @@ func (w *Worker) startControllerConnections() error {
 }
 
 func (w *Worker) controllerDialerFunc() func(context.Context, string) (net.Conn, error) {
-	const op = "worker.(Worker).controllerDialerFunc"
 	return func(ctx context.Context, addr string) (net.Conn, error) {
-		tlsConf, authInfo, err := w.workerAuthTLSConfig()
-		if err != nil {
-			return nil, fmt.Errorf("error creating tls config for worker auth: %w", err)
-		}
-		dialer := &net.Dialer{}
-		var nonTlsConn net.Conn
-		switch {
-		case strings.HasPrefix(addr, "/"):
-			nonTlsConn, err = dialer.DialContext(ctx, "unix", addr)
-		default:
-			nonTlsConn, err = dialer.DialContext(ctx, "tcp", addr)
-		}
-		if err != nil {
-			return nil, fmt.Errorf("unable to dial to controller: %w", err)
-		}
-		tlsConn := tls.Client(nonTlsConn, tlsConf)
-		written, err := tlsConn.Write([]byte(authInfo.ConnectionNonce))
-		if err != nil {
-			if err := nonTlsConn.Close(); err != nil {
-				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing connection after writing failure"))
-			}
-			return nil, fmt.Errorf("unable to write connection nonce: %w", err)
+		return w.dialController(ctx, addr)
+	}
+}
+
+// dialController opens a worker authenticated connection to the controller
+// at addr. extraProtos are sent along with the worker auth protos, selecting
+// the controller listener the connection is for.
+func (w *Worker) dialController(ctx context.Context, addr string, extraProtos ...string) (net.Conn, error) {
+	const op = "worker.(Worker).dialController"
+	tlsConf, authInfo, err := w.workerAuthTLSConfig(extraProtos...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating tls config for worker auth: %w", err)
+	}
+	dialer := &net.Dialer{}
+	var nonTlsConn net.Conn
+	switch {
+	case strings.HasPrefix(addr, "/"):
+		nonTlsConn, err = dialer.DialContext(ctx, "unix", addr)
+	default:
+		nonTlsConn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to dial to controller: %w", err)
+	}
+	tlsConn := tls.Client(nonTlsConn, tlsConf)
+	written, err := tlsConn.Write([]byte(authInfo.ConnectionNonce))
+	if err != nil {
+		if err := nonTlsConn.Close(); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error closing connection after writing failure"))
 		}
-		if written != len(authInfo.ConnectionNonce) {
-			if err := nonTlsConn.Close(); err != nil {
-				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing connection after writing failure"))
-			}
-			return nil, fmt.Errorf("expected to write %d bytes of connection nonce, wrote %d", len(authInfo.ConnectionNonce), written)
+		return nil, fmt.Errorf("unable to write connection nonce: %w", err)
+	}
+	if written != len(authInfo.ConnectionNonce) {
+		if err := nonTlsConn.Close(); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error closing connection after writing failure"))
 		}
-		return tlsConn, nil
+		return nil, fmt.Errorf("expected to write %d bytes of connection nonce, wrote %d", len(authInfo.ConnectionNonce), written)
 	}
+	return tlsConn, nil
 }
 
 func (w *Worker) createClientConn(addr string) error {
@@ -135,7 +156,7 @@ func (w *Worker) createClientConn(addr string) error {
 	return nil
 }
 
-func (w *Worker) workerAuthTLSConfig() (*tls.Config, *base.WorkerAuthInfo, error) {
+func (w *Worker) workerAuthTLSConfig(extraProtos ...string) (*tls.Config, *base.WorkerAuthInfo, error) {
 	var err error
 	info := &base.WorkerAuthInfo{
 		Name:            w.conf.RawConfig.Worker.Name,
@@ -218,6 +239,7 @@ func (w *Worker) workerAuthTLSConfig() (*tls.Config, *base.WorkerAuthInfo, error
 		nextProtos = append(nextProtos, fmt.Sprintf("v1workerauth-%02d-%s", count, b64alpn[i:end]))
 		count++
 	}
+	nextProtos = append(nextProtos, extraProtos...)
 
 	cert, err := x509.ParseCertificate(certBytes)
 	if err != nil {
@@ -0,0 +1,176 @@
+package worker
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+
+	"github.com/hashicorp/boundary/globals"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/proxy"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	"github.com/hashicorp/go-cleanhttp"
+	"nhooyr.io/websocket"
+	"nhooyr.io/websocket/wspb"
+)
+
+// forwardConnection forwards a client connection for a session this worker
+// can't serve itself to the first of the upstream workers which accepts it.
+// The upstream worker is dialed with the session's certificate, exactly as a
+// client would, and sent the client's handshake, which must already include
+// this worker in its worker chain. The upstream worker's handshake result is
+// relayed to the client and then the connection is proxied in both
+// directions until either side closes it. Reverse upstream workers are dialed
+// through the tunnel they keep open to the controllers.
+func (w *Worker) forwardConnection(ctx context.Context, conn *websocket.Conn, authz *targets.SessionAuthorizationData, upstreams []*pbs.UpstreamWorker, handshake *proxy.ClientHandshake) {
+	const op = "worker.(Worker).forwardConnection"
+
+	transport, err := upstreamTransport(authz)
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error building upstream transport"))
+		if err = conn.Close(websocket.StatusInternalError, "unable to forward connection"); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
+		}
+		return
+	}
+
+	var upstreamConn *websocket.Conn
+	for _, u := range upstreams {
+		dialTransport, addr := transport, u.GetAddress()
+		if u.GetReverse() {
+			workerId := u.GetId()
+			dialTransport = transport.Clone()
+			dialTransport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return w.dialReverseRelay(ctx, workerId)
+			}
+			// The address is only used for the request URL; the connection
+			// always goes through the relay.
+			addr = workerId
+		}
+		upstreamConn, err = dialUpstream(ctx, dialTransport, addr)
+		if err == nil {
+			break
+		}
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error dialing upstream worker", "upstream_worker_id", u.GetId(), "address", u.GetAddress()))
+	}
+	if upstreamConn == nil {
+		if err = conn.Close(websocket.StatusInternalError, "unable to reach upstream worker"); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
+		}
+		return
+	}
+	defer upstreamConn.Close(websocket.StatusNormalClosure, "done")
+
+	if err := wspb.Write(ctx, upstreamConn, handshake); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error sending handshake to upstream worker"))
+		if err = conn.Close(websocket.StatusInternalError, "unable to forward handshake"); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
+		}
+		return
+	}
+	var handshakeResult proxy.HandshakeResult
+	if err := wspb.Read(ctx, upstreamConn, &handshakeResult); err != nil {
+		// Relay the upstream worker's close reason so the client sees the same
+		// failure it would have seen connecting to that worker directly.
+		var closeErr websocket.CloseError
+		if errors.As(err, &closeErr) {
+			if err = conn.Close(closeErr.Code, closeErr.Reason); err != nil && !errors.Is(err, io.EOF) {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
+			}
+			return
+		}
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error reading handshake result from upstream worker"))
+		if err = conn.Close(websocket.StatusInternalError, "unable to read upstream handshake result"); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
+		}
+		return
+	}
+	if err := wspb.Write(ctx, conn, &handshakeResult); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error sending handshake result to client"))
+		if err = conn.Close(websocket.StatusProtocolError, "unable to send handshake result"); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
+		}
+		return
+	}
+
+	clientNetConn := websocket.NetConn(ctx, conn, websocket.MessageBinary)
+	upstreamNetConn := websocket.NetConn(ctx, upstreamConn, websocket.MessageBinary)
+	connWg := new(sync.WaitGroup)
+	connWg.Add(2)
+	go func() {
+		defer connWg.Done()
+		_, _ = io.Copy(clientNetConn, upstreamNetConn)
+		_ = clientNetConn.Close()
+		_ = upstreamNetConn.Close()
+	}()
+	go func() {
+		defer connWg.Done()
+		_, _ = io.Copy(upstreamNetConn, clientNetConn)
+		_ = upstreamNetConn.Close()
+		_ = clientNetConn.Close()
+	}()
+	connWg.Wait()
+}
+
+// upstreamTransport returns a transport which authenticates to upstream
+// workers with the session's certificate and only trusts that certificate.
+func upstreamTransport(authz *targets.SessionAuthorizationData) (*http.Transport, error) {
+	parsedCert, err := x509.ParseCertificate(authz.GetCertificate())
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode session certificate: %w", err)
+	}
+	if len(parsedCert.DNSNames) != 1 {
+		return nil, errors.New("session certificate has invalid parameters")
+	}
+	certPool := x509.NewCertPool()
+	certPool.AddCert(parsedCert)
+
+	transport := cleanhttp.DefaultTransport()
+	transport.DisableKeepAlives = false
+	transport.TLSClientConfig = &tls.Config{
+		Certificates: []tls.Certificate{
+			{
+				Certificate: [][]byte{authz.GetCertificate()},
+				PrivateKey:  ed25519.PrivateKey(authz.GetPrivateKey()),
+				Leaf:        parsedCert,
+			},
+		},
+		RootCAs:    certPool,
+		ServerName: parsedCert.DNSNames[0],
+		MinVersion: tls.VersionTLS13,
+	}
+	// The connection is hijacked, so this isn't used, just setting for
+	// completeness
+	transport.IdleConnTimeout = 0
+	return transport, nil
+}
+
+// dialUpstream opens a proxy websocket to the upstream worker at addr.
+func dialUpstream(ctx context.Context, transport *http.Transport, addr string) (*websocket.Conn, error) {
+	conn, resp, err := websocket.Dial(
+		ctx,
+		fmt.Sprintf("wss://%s/v1/proxy", addr),
+		&websocket.DialOptions{
+			HTTPClient: &http.Client{
+				Transport: transport,
+			},
+			Subprotocols: []string{globals.TcpProxyV1},
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error dialing upstream worker: %w", err)
+	}
+	if resp == nil || resp.Header.Get("Sec-WebSocket-Protocol") != globals.TcpProxyV1 {
+		_ = conn.Close(websocket.StatusProtocolError, "unexpected negotiated protocol")
+		return nil, errors.New("unexpected negotiated protocol")
+	}
+	return conn, nil
+}
@@ -10,6 +10,7 @@ import (
 	"strconv"
 
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/observability/event"
 	"github.com/hashicorp/boundary/internal/proxy"
@@ -77,6 +78,10 @@ func (w *Worker) handleProxy() http.HandlerFunc {
 		tofuToken := si.LookupSessionResponse.GetTofuToken()
 		version := si.LookupSessionResponse.GetVersion()
 		endpoint := si.LookupSessionResponse.GetEndpoint()
+		egressCreds := egressCredentials(si.LookupSessionResponse.GetCredentials())
+		hostKeys := si.LookupSessionResponse.GetHostKeys()
+		upstreamWorkers := si.LookupSessionResponse.GetUpstreamWorkers()
+		authz := si.LookupSessionResponse.GetAuthorization()
 		sessStatus := si.Status
 		si.RUnlock()
 
@@ -121,6 +126,15 @@ func (w *Worker) handleProxy() http.HandlerFunc {
 			return
 		}
 
+		// This worker only accepts connections for the session, so hand the
+		// connection to a worker which can reach the endpoint. That worker
+		// validates the tofu token and authorizes the connection.
+		if len(upstreamWorkers) > 0 {
+			handshake.WorkerChain = append(handshake.WorkerChain, workerId)
+			w.forwardConnection(connCtx, conn, authz, upstreamWorkers, &handshake)
+			return
+		}
+
 		if tofuToken != "" {
 			if tofuToken != handshake.GetTofuToken() {
 				event.WriteError(ctx, op, errors.New("WARNING: mismatched tofu token"), event.WithInfo("session_id", sessionId))
@@ -130,6 +144,13 @@ func (w *Worker) handleProxy() http.HandlerFunc {
 				return
 			}
 		} else {
+			if sessStatus == pbs.SESSIONSTATUS_SESSIONSTATUS_PENDING_APPROVAL {
+				event.WriteError(ctx, op, errors.New("session is pending approval"), event.WithInfo("session_id", sessionId))
+				if err = conn.Close(websocket.StatusPolicyViolation, "session is pending approval"); err != nil {
+					event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
+				}
+				return
+			}
 			if sessStatus != pbs.SESSIONSTATUS_SESSIONSTATUS_PENDING {
 				event.WriteError(ctx, op, err, event.WithInfoMsg("no tofu token but not in correct session state"))
 				if err = conn.Close(websocket.StatusInternalError, "refusing to activate session"); err != nil {
@@ -181,10 +202,19 @@ func (w *Worker) handleProxy() http.HandlerFunc {
 			}
 			return
 		}
+		if w.recordingStorage != nil {
+			if handleProxyFn, err = proxyHandlers.NewRecordingHandler(w.recordingStorage, handleProxyFn); err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("error enabling session recording"))
+				if err = conn.Close(websocket.StatusInternalError, "unable to record session"); err != nil {
+					event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
+				}
+				return
+			}
+		}
 
 		var ci *session.ConnInfo
 		var connsLeft int32
-		ci, connsLeft, err = session.AuthorizeConnection(ctx, sessClient, workerId, sessionId)
+		ci, connsLeft, err = session.AuthorizeConnection(ctx, sessClient, workerId, sessionId, handshake.GetWorkerChain())
 		if err != nil {
 			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to authorize connection"))
 			if err = conn.Close(websocket.StatusInternalError, "unable to authorize connection"); err != nil {
@@ -232,7 +262,7 @@ func (w *Worker) handleProxy() http.HandlerFunc {
 			return
 		}
 
-		if err = handleProxyFn(connCtx, conf); err != nil {
+		if err = handleProxyFn(connCtx, conf, proxyHandlers.WithEgressCredentials(egressCreds), proxyHandlers.WithHostKeys(hostKeys)); err != nil {
 			event.WriteError(ctx, op, err, event.WithInfoMsg("error handling proxy", "session_id", sessionId, "endpoint", endpoint))
 			if err = conn.Close(websocket.StatusInternalError, "unable to establish proxy"); err != nil {
 				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
@@ -241,6 +271,23 @@ func (w *Worker) handleProxy() http.HandlerFunc {
 	}
 }
 
+// egressCredentials converts the credentials returned by the controller for a
+// session into the credentials passed to the proxy handlers.
+func egressCredentials(creds []*pbs.Credential) []credential.Credential {
+	var out []credential.Credential
+	for _, c := range creds {
+		switch {
+		case c.GetUsernamePassword() != nil:
+			up := c.GetUsernamePassword()
+			out = append(out, credential.NewUserPassword("", up.GetUsername(), credential.Password(up.GetPassword())))
+		case c.GetSshPrivateKey() != nil:
+			pk := c.GetSshPrivateKey()
+			out = append(out, credential.NewKeyPair("", pk.GetUsername(), credential.PrivateKey(pk.GetPrivateKey())))
+		}
+	}
+	return out
+}
+
 func (w *Worker) wrapGenericHandler(h http.Handler, _ HandlerProperties) http.Handler {
 	return http.HandlerFunc(func(wr http.ResponseWriter, r *http.Request) {
 		// Set the Cache-Control header for all responses returned
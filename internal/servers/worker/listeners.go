@@ -34,6 +34,10 @@ func (w *Worker) startListeners() error {
 				// We may have this in dev mode; ignore
 				continue
 
+			case "ops":
+				// Ops listeners are served by the ops server; ignore
+				continue
+
 			case "proxy":
 				// Do nothing; handle below
 
@@ -2,6 +2,7 @@ package proxy
 
 import (
 	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/session/recording"
 )
 
 // Option - how Options are passed as arguments.
@@ -19,11 +20,15 @@ func GetOpts(opt ...Option) Options {
 // Options = how options are represented
 type Options struct {
 	WithEgressCredentials []credential.Credential
+	WithRecorder          *recording.Recorder
+	WithHostKeys          []string
 }
 
 func getDefaultOptions() Options {
 	return Options{
 		WithEgressCredentials: nil,
+		WithRecorder:          nil,
+		WithHostKeys:          nil,
 	}
 }
 
@@ -33,3 +38,20 @@ func WithEgressCredentials(creds []credential.Credential) Option {
 		o.WithEgressCredentials = creds
 	}
 }
+
+// WithRecorder provides an optional recorder that the proxied bytes should be
+// written to
+func WithRecorder(r *recording.Recorder) Option {
+	return func(o *Options) {
+		o.WithRecorder = r
+	}
+}
+
+// WithHostKeys provides the optional public keys, in the OpenSSH
+// authorized_keys format, which the endpoint must present before egress
+// credentials are used to authenticate to it
+func WithHostKeys(keys []string) Option {
+	return func(o *Options) {
+		o.WithHostKeys = keys
+	}
+}
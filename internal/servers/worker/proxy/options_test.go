@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/session/recording"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -27,4 +28,13 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.WithEgressCredentials = []credential.Credential{c}
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithRecorder", func(t *testing.T) {
+		assert := assert.New(t)
+		r := &recording.Recorder{}
+		opts := GetOpts(WithRecorder(r))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.WithRecorder = r
+		assert.Equal(opts, testOpts)
+	})
 }
@@ -0,0 +1,339 @@
+// Package postgres provides the worker proxy handler for postgres targets.
+// The handler terminates the client's PostgreSQL connection on the worker and
+// opens a second connection to the endpoint, authenticating with the egress
+// credentials brokered for the session, so the password is never given to the
+// user. Each query executed through the proxy is written as an audit event.
+// Importing this package registers the handler for the "postgres" protocol.
+package postgres
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	pbp "github.com/hashicorp/boundary/internal/proxy"
+	"github.com/hashicorp/boundary/internal/servers/worker/proxy"
+	"github.com/hashicorp/boundary/internal/servers/worker/session"
+	"github.com/hashicorp/boundary/internal/session/recording"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgproto3/v2"
+)
+
+const protocol = "postgres"
+
+// endpointRootCAs is the set of root certificate authorities used to verify
+// the endpoint's certificate. If nil, the host's root CA set is used.
+var endpointRootCAs *x509.CertPool
+
+func init() {
+	err := proxy.RegisterHandler(protocol, handleProxy)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// handleProxy creates a postgres proxy between the incoming websocket conn
+// and the endpoint. The startup of the client's connection is handled by the
+// worker: SSL and GSS encryption requests are declined and the client is
+// told it is authenticated without being asked for a password, since it has
+// already been authorized for the session. A TLS connection is then
+// established to the endpoint, whose certificate must be valid for the
+// endpoint's host, using the first UserPassword credential provided using
+// proxy.WithEgressCredentials, with the database and runtime parameters
+// requested by the client. handleProxy sets the connectionId as connected in
+// the repository once both connections are established.
+//
+// Messages are then forwarded between the two connections. An audit event is
+// written for each simple query and for each execution of a prepared
+// statement sent by the client. A failure to write an audit event terminates
+// the connection.
+//
+// handleProxy blocks until either connection is closed.
+//
+// If a recorder is provided using proxy.WithRecorder, all messages proxied
+// after the startup of the connection are written to it and the recording's
+// id is reported when the connection is marked as connected.
+func handleProxy(ctx context.Context, conf proxy.Config, opt ...proxy.Option) error {
+	opts := proxy.GetOpts(opt...)
+	sessionUrl, err := url.Parse(conf.RemoteEndpoint)
+	if err != nil {
+		return fmt.Errorf("error parsing endpoint information: %w", err)
+	}
+	if sessionUrl.Scheme != protocol {
+		return fmt.Errorf("invalid scheme for postgres proxy: %v", sessionUrl.Scheme)
+	}
+	cred, err := egressCredential(opts.WithEgressCredentials)
+	if err != nil {
+		return err
+	}
+
+	netConn := conf.ClientNetConn(ctx)
+	defer netConn.Close()
+	client := pgproto3.NewBackend(pgproto3.NewChunkReader(netConn), netConn)
+
+	startup, err := receiveStartupMessage(client, netConn, sessionUrl.Host)
+	if err != nil {
+		return err
+	}
+	if startup == nil {
+		// The client only cancelled a query running on another connection.
+		return nil
+	}
+
+	// The egress credentials must only ever be sent to the endpoint over a
+	// TLS connection to a server whose certificate is valid for its host.
+	endpointConfig, err := pgconn.ParseConfig(fmt.Sprintf("postgres://%s/?sslmode=verify-full", sessionUrl.Host))
+	if err != nil {
+		return fmt.Errorf("error parsing endpoint connection config: %w", err)
+	}
+	endpointConfig.TLSConfig.RootCAs = endpointRootCAs
+	endpointConfig.User = cred.Username()
+	endpointConfig.Password = string(cred.Password())
+	endpointConfig.Database = cred.Username()
+	endpointConfig.RuntimeParams = make(map[string]string, len(startup.Parameters))
+	for k, v := range startup.Parameters {
+		switch k {
+		case "user":
+		case "database":
+			endpointConfig.Database = v
+		default:
+			endpointConfig.RuntimeParams[k] = v
+		}
+	}
+
+	pgConn, err := pgconn.ConnectConfig(ctx, endpointConfig)
+	if err != nil {
+		_ = client.Send(&pgproto3.ErrorResponse{
+			Severity: "FATAL",
+			Code:     "08001",
+			Message:  "unable to connect to endpoint",
+		})
+		return fmt.Errorf("error establishing postgres connection to endpoint: %w", err)
+	}
+	endpoint, err := pgConn.Hijack()
+	if err != nil {
+		_ = pgConn.Close(ctx)
+		return fmt.Errorf("error taking over postgres connection to endpoint: %w", err)
+	}
+	defer endpoint.Conn.Close()
+
+	endpointAddr := endpoint.Conn.RemoteAddr().(*net.TCPAddr)
+	connectionInfo := &pbs.ConnectConnectionRequest{
+		ConnectionId:       conf.ConnectionId,
+		ClientTcpAddress:   conf.ClientAddress.IP.String(),
+		ClientTcpPort:      uint32(conf.ClientAddress.Port),
+		EndpointTcpAddress: endpointAddr.IP.String(),
+		EndpointTcpPort:    uint32(endpointAddr.Port),
+		Type:               protocol,
+	}
+	if opts.WithRecorder != nil {
+		connectionInfo.RecordingId = opts.WithRecorder.Id()
+	}
+
+	connStatus, err := session.ConnectConnection(ctx, conf.SessionClient, connectionInfo)
+	if err != nil {
+		return fmt.Errorf("error marking connection as connected: %w", err)
+	}
+
+	// Update connection info to set connection status
+	conf.SessionInfo.Lock()
+	conf.SessionInfo.ConnInfoMap[conf.ConnectionId].Status = connStatus
+	userId := conf.SessionInfo.LookupSessionResponse.GetUserId()
+	conf.SessionInfo.Unlock()
+
+	// Complete the client's startup with the parameters reported by the
+	// endpoint. The endpoint's key data is passed along so that the client
+	// can cancel its queries through a new connection for the session.
+	startupMsgs := []pgproto3.BackendMessage{&pgproto3.AuthenticationOk{}}
+	for name, value := range endpoint.ParameterStatuses {
+		startupMsgs = append(startupMsgs, &pgproto3.ParameterStatus{Name: name, Value: value})
+	}
+	startupMsgs = append(startupMsgs,
+		&pgproto3.BackendKeyData{ProcessID: endpoint.PID, SecretKey: endpoint.SecretKey},
+		&pgproto3.ReadyForQuery{TxStatus: endpoint.TxStatus},
+	)
+	for _, msg := range startupMsgs {
+		if err := client.Send(msg); err != nil {
+			return fmt.Errorf("error completing postgres startup with client: %w", err)
+		}
+	}
+
+	var upDst, downDst io.Writer = endpoint.Conn, netConn
+	if rec := opts.WithRecorder; rec != nil {
+		upDst = io.MultiWriter(endpoint.Conn, rec.Writer(recording.Up))
+		downDst = io.MultiWriter(netConn, rec.Writer(recording.Down))
+	}
+
+	a := &auditor{
+		query: &pbp.DatabaseQuery{
+			Protocol:     protocol,
+			SessionId:    conf.SessionInfo.Id,
+			ConnectionId: conf.ConnectionId,
+			UserId:       userId,
+			Endpoint:     conf.RemoteEndpoint,
+		},
+		statements: map[string]string{},
+		portals:    map[string]string{},
+	}
+
+	connWg := new(sync.WaitGroup)
+	connWg.Add(2)
+	go func() {
+		defer connWg.Done()
+		forwardBackend(downDst, endpoint.Frontend)
+		_ = netConn.Close()
+		_ = endpoint.Conn.Close()
+	}()
+	go func() {
+		defer connWg.Done()
+		if err := a.forwardFrontend(ctx, upDst, client); err != nil {
+			event.WriteError(ctx, "postgres.handleProxy", err, event.WithInfoMsg("terminating postgres connection", "session_id", conf.SessionInfo.Id))
+		}
+		_ = endpoint.Conn.Close()
+		_ = netConn.Close()
+	}()
+	connWg.Wait()
+	return nil
+}
+
+// egressCredential returns the first UserPassword credential in creds.
+func egressCredential(creds []credential.Credential) (credential.UserPassword, error) {
+	for _, c := range creds {
+		if c, ok := c.(credential.UserPassword); ok {
+			return c, nil
+		}
+	}
+	return nil, errors.New("no username/password egress credential found for postgres proxy")
+}
+
+// receiveStartupMessage receives the client's startup message, declining any
+// requests to encrypt the connection by writing to clientConn since the
+// connection to the worker is already encrypted. If the client instead sends a cancel request it is
+// forwarded to the endpoint at addr and nil is returned.
+func receiveStartupMessage(client *pgproto3.Backend, clientConn io.Writer, addr string) (*pgproto3.StartupMessage, error) {
+	for {
+		msg, err := client.ReceiveStartupMessage()
+		if err != nil {
+			return nil, fmt.Errorf("error receiving postgres startup message: %w", err)
+		}
+		switch msg := msg.(type) {
+		case *pgproto3.StartupMessage:
+			return msg, nil
+		case *pgproto3.SSLRequest, *pgproto3.GSSEncRequest:
+			if _, err := clientConn.Write([]byte{'N'}); err != nil {
+				return nil, fmt.Errorf("error declining postgres encryption request: %w", err)
+			}
+		case *pgproto3.CancelRequest:
+			remoteConn, err := net.Dial("tcp", addr)
+			if err != nil {
+				return nil, fmt.Errorf("error dialing endpoint: %w", err)
+			}
+			defer remoteConn.Close()
+			if _, err := remoteConn.Write(msg.Encode(nil)); err != nil {
+				return nil, fmt.Errorf("error forwarding postgres cancel request: %w", err)
+			}
+			return nil, nil
+		default:
+			return nil, fmt.Errorf("unexpected postgres startup message: %T", msg)
+		}
+	}
+}
+
+// forwardBackend writes each message received from the endpoint to dst until
+// either connection is closed.
+func forwardBackend(dst io.Writer, src pgconn.Frontend) {
+	for {
+		msg, err := src.Receive()
+		if err != nil {
+			return
+		}
+		if _, err := dst.Write(msg.Encode(nil)); err != nil {
+			return
+		}
+	}
+}
+
+// auditor writes an audit event for each query executed by the client.
+type auditor struct {
+	query *pbp.DatabaseQuery
+
+	// statements maps the names of prepared statements to their queries and
+	// portals the names of portals to the queries of the statements bound
+	// to them. The unnamed statement and portal use the empty name.
+	statements map[string]string
+	portals    map[string]string
+}
+
+// forwardFrontend writes each message received from the client to dst until
+// the client terminates the connection or either connection is closed.
+// Queries are audited before they are written to dst and an error is only
+// returned if a query could not be audited.
+func (a *auditor) forwardFrontend(ctx context.Context, dst io.Writer, src *pgproto3.Backend) error {
+	for {
+		msg, err := src.Receive()
+		if err != nil {
+			return nil
+		}
+		switch msg := msg.(type) {
+		case *pgproto3.Query:
+			if err := a.audit(ctx, msg.String); err != nil {
+				return err
+			}
+		case *pgproto3.Parse:
+			a.statements[msg.Name] = msg.Query
+		case *pgproto3.Bind:
+			a.portals[msg.DestinationPortal] = a.statements[msg.PreparedStatement]
+		case *pgproto3.Execute:
+			if err := a.audit(ctx, a.portals[msg.Portal]); err != nil {
+				return err
+			}
+		case *pgproto3.Close:
+			switch msg.ObjectType {
+			case 'S':
+				delete(a.statements, msg.Name)
+			case 'P':
+				delete(a.portals, msg.Name)
+			}
+		}
+		if _, err := dst.Write(msg.Encode(nil)); err != nil {
+			return nil
+		}
+		if _, ok := msg.(*pgproto3.Terminate); ok {
+			return nil
+		}
+	}
+}
+
+// audit writes an audit event for the execution of query.
+func (a *auditor) audit(ctx context.Context, query string) error {
+	const op = "postgres.(auditor).audit"
+	details := &pbp.DatabaseQuery{
+		Protocol:     a.query.GetProtocol(),
+		SessionId:    a.query.GetSessionId(),
+		ConnectionId: a.query.GetConnectionId(),
+		UserId:       a.query.GetUserId(),
+		Endpoint:     a.query.GetEndpoint(),
+		Query:        query,
+	}
+	err := event.WriteAudit(ctx, op,
+		event.WithAuth(&event.Auth{UserInfo: &event.UserInfo{UserId: details.GetUserId()}}),
+		event.WithRequest(&event.Request{
+			Operation: "query",
+			Endpoint:  details.GetEndpoint(),
+			Details:   details,
+		}),
+		event.WithFlush(),
+	)
+	if err != nil {
+		return fmt.Errorf("error writing audit event for postgres query: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,488 @@
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/servers/worker/proxy"
+	"github.com/hashicorp/boundary/internal/servers/worker/session"
+	"github.com/hashicorp/boundary/internal/session/recording"
+	"github.com/hashicorp/go-hclog"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgproto3/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"nhooyr.io/websocket"
+)
+
+const (
+	testUsername = "boundary"
+	testPassword = "egress-password"
+	testUserId   = "u_1234567890"
+)
+
+// testEndpoint starts a minimal PostgreSQL server which requires the
+// testUsername and testPassword using cleartext password authentication. If
+// tlsConfig is nil the server declines TLS, otherwise it accepts TLS using
+// tlsConfig. Each query, whether sent using the simple or the extended
+// protocol, is answered with a single row containing the query and the
+// database the client connected to. It returns the address of the server.
+func testEndpoint(t *testing.T, ctx context.Context, tlsConfig *tls.Config) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() {
+		<-ctx.Done()
+		_ = l.Close()
+	}()
+	go func() {
+		for {
+			nc, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer nc.Close()
+				serveTestEndpoint(nc, tlsConfig)
+			}()
+		}
+	}()
+	return l.Addr().String()
+}
+
+func serveTestEndpoint(nc net.Conn, tlsConfig *tls.Config) {
+	b := pgproto3.NewBackend(pgproto3.NewChunkReader(nc), nc)
+	var startup *pgproto3.StartupMessage
+	for startup == nil {
+		msg, err := b.ReceiveStartupMessage()
+		if err != nil {
+			return
+		}
+		switch msg := msg.(type) {
+		case *pgproto3.SSLRequest:
+			if tlsConfig == nil {
+				if _, err := nc.Write([]byte{'N'}); err != nil {
+					return
+				}
+				continue
+			}
+			if _, err := nc.Write([]byte{'S'}); err != nil {
+				return
+			}
+			tc := tls.Server(nc, tlsConfig)
+			if err := tc.Handshake(); err != nil {
+				return
+			}
+			nc = tc
+			b = pgproto3.NewBackend(pgproto3.NewChunkReader(nc), nc)
+		case *pgproto3.StartupMessage:
+			startup = msg
+		default:
+			return
+		}
+	}
+	database := startup.Parameters["database"]
+
+	if err := b.Send(&pgproto3.AuthenticationCleartextPassword{}); err != nil {
+		return
+	}
+	msg, err := b.Receive()
+	if err != nil {
+		return
+	}
+	if pw, ok := msg.(*pgproto3.PasswordMessage); !ok || pw.Password != testPassword || startup.Parameters["user"] != testUsername {
+		_ = b.Send(&pgproto3.ErrorResponse{Severity: "FATAL", Code: "28P01", Message: "password authentication failed"})
+		return
+	}
+	for _, m := range []pgproto3.BackendMessage{
+		&pgproto3.AuthenticationOk{},
+		&pgproto3.ParameterStatus{Name: "server_version", Value: "14.0"},
+		&pgproto3.BackendKeyData{ProcessID: 1, SecretKey: 2},
+		&pgproto3.ReadyForQuery{TxStatus: 'I'},
+	} {
+		if err := b.Send(m); err != nil {
+			return
+		}
+	}
+
+	rowDesc := &pgproto3.RowDescription{Fields: []pgproto3.FieldDescription{
+		{Name: []byte("query"), DataTypeOID: 25, DataTypeSize: -1, TypeModifier: -1},
+		{Name: []byte("database"), DataTypeOID: 25, DataTypeSize: -1, TypeModifier: -1},
+	}}
+	statements := map[string]string{}
+	var portal string
+	for {
+		msg, err := b.Receive()
+		if err != nil {
+			return
+		}
+		var reply []pgproto3.BackendMessage
+		switch msg := msg.(type) {
+		case *pgproto3.Query:
+			reply = []pgproto3.BackendMessage{
+				rowDesc,
+				&pgproto3.DataRow{Values: [][]byte{[]byte(msg.String), []byte(database)}},
+				&pgproto3.CommandComplete{CommandTag: []byte("SELECT 1")},
+				&pgproto3.ReadyForQuery{TxStatus: 'I'},
+			}
+		case *pgproto3.Parse:
+			statements[msg.Name] = msg.Query
+			reply = []pgproto3.BackendMessage{&pgproto3.ParseComplete{}}
+		case *pgproto3.Bind:
+			portal = statements[msg.PreparedStatement]
+			reply = []pgproto3.BackendMessage{&pgproto3.BindComplete{}}
+		case *pgproto3.Describe:
+			reply = []pgproto3.BackendMessage{rowDesc}
+		case *pgproto3.Execute:
+			reply = []pgproto3.BackendMessage{
+				&pgproto3.DataRow{Values: [][]byte{[]byte(portal), []byte(database)}},
+				&pgproto3.CommandComplete{CommandTag: []byte("SELECT 1")},
+			}
+		case *pgproto3.Sync:
+			reply = []pgproto3.BackendMessage{&pgproto3.ReadyForQuery{TxStatus: 'I'}}
+		case *pgproto3.Terminate:
+			return
+		}
+		for _, m := range reply {
+			if err := b.Send(m); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// testTLSConfig returns a TLS config for a server at 127.0.0.1 with a self
+// signed certificate, and a pool containing the certificate.
+func testTLSConfig(t *testing.T) (*tls.Config, *x509.CertPool) {
+	t.Helper()
+	require := require.New(t)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "127.0.0.1"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(err)
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	return &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}},
+	}, pool
+}
+
+var (
+	trustedTLSConfigOnce sync.Once
+	trustedTLSConfig     *tls.Config
+)
+
+// testTrustedTLSConfig returns a TLS config for a test endpoint whose
+// certificate is trusted by handleProxy.
+func testTrustedTLSConfig(t *testing.T) *tls.Config {
+	t.Helper()
+	trustedTLSConfigOnce.Do(func() {
+		trustedTLSConfig, endpointRootCAs = testTLSConfig(t)
+	})
+	require.NotNil(t, trustedTLSConfig)
+	return trustedTLSConfig
+}
+
+// testProxy runs handleProxy for a postgres endpoint at addr with the
+// provided options and returns a connection to the database through the
+// proxy. The client does not provide a password.
+func testProxy(t *testing.T, ctx context.Context, addr string, opt ...proxy.Option) (*pgconn.PgConn, <-chan error, error) {
+	t.Helper()
+	require := require.New(t)
+
+	clientConn, proxyConn := proxy.TestWsConn(t, ctx)
+	require.NotNil(clientConn)
+	require.NotNil(proxyConn)
+
+	si := &session.Info{
+		Id: "s_1234567890",
+		LookupSessionResponse: &pbs.LookupSessionResponse{
+			UserId: testUserId,
+		},
+		ConnInfoMap: map[string]*session.ConnInfo{
+			"mock-connection": {},
+		},
+	}
+	conf := proxy.Config{
+		ClientAddress: &net.TCPAddr{
+			IP:   net.ParseIP("127.0.0.1"),
+			Port: 50000,
+		},
+		ClientConn:     proxyConn,
+		RemoteEndpoint: fmt.Sprintf("postgres://%s", addr),
+		SessionClient:  pbs.NewMockSessionServiceClient(),
+		SessionInfo:    si,
+		ConnectionId:   "mock-connection",
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- handleProxy(ctx, conf, opt...)
+	}()
+
+	clientConfig, err := pgconn.ParseConfig("postgres://user-without-credentials@127.0.0.1/appdb?sslmode=disable")
+	require.NoError(err)
+	clientConfig.DialFunc = func(context.Context, string, string) (net.Conn, error) {
+		return websocket.NetConn(ctx, clientConn, websocket.MessageBinary), nil
+	}
+	c, err := pgconn.ConnectConfig(ctx, clientConfig)
+	return c, errCh, err
+}
+
+// testAuditEvents returns the request of each audit event written to the
+// file fileName.
+func testAuditEvents(t *testing.T, fileName string) []map[string]interface{} {
+	t.Helper()
+	b, err := os.ReadFile(fileName)
+	require.NoError(t, err)
+	var reqs []map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	for {
+		var e struct {
+			Data struct {
+				Request map[string]interface{} `json:"request"`
+			} `json:"data"`
+		}
+		if err := dec.Decode(&e); err == io.EOF {
+			break
+		} else {
+			require.NoError(t, err)
+		}
+		reqs = append(reqs, e.Data.Request)
+	}
+	return reqs
+}
+
+func TestHandleProxy(t *testing.T) {
+	// this cannot run in parallel because it relies on envvar
+	// globals.BOUNDARY_DEVELOPER_ENABLE_EVENTS
+	event.TestEnableEventing(t, true)
+	eventConfig := event.TestEventerConfig(t, "TestHandleProxy", event.TestWithAuditSink(t))
+	testLock := &sync.Mutex{}
+	testLogger := hclog.New(&hclog.LoggerOptions{
+		Mutex: testLock,
+		Name:  "test",
+	})
+	require.NoError(t, event.InitSysEventer(testLogger, testLock, "TestHandleProxy", event.WithEventerConfig(&eventConfig.EventerConfig)))
+
+	assert, require := assert.New(t), require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	addr := testEndpoint(t, ctx, testTrustedTLSConfig(t))
+	creds := []credential.Credential{credential.NewUserPassword("", testUsername, testPassword)}
+	c, errCh, err := testProxy(t, ctx, addr, proxy.WithEgressCredentials(creds))
+	require.NoError(err)
+	assert.Equal("14.0", c.ParameterStatus("server_version"))
+
+	results, err := c.Exec(ctx, "select 'simple'").ReadAll()
+	require.NoError(err)
+	require.Len(results, 1)
+	assert.Equal([][][]byte{{[]byte("select 'simple'"), []byte("appdb")}}, results[0].Rows)
+
+	result := c.ExecParams(ctx, "select $1::text", [][]byte{[]byte("extended")}, nil, nil, nil).Read()
+	require.NoError(result.Err)
+	assert.Equal([][][]byte{{[]byte("select $1::text"), []byte("appdb")}}, result.Rows)
+
+	// The proxy closes the client's connection once the client terminates,
+	// which can race with the client closing it.
+	_ = c.Close(ctx)
+	require.NoError(<-errCh)
+
+	var queries []map[string]interface{}
+	for _, r := range testAuditEvents(t, eventConfig.AuditEvents.Name()) {
+		if r["operation"] == "query" {
+			queries = append(queries, r)
+		}
+	}
+	require.Len(queries, 2)
+	for _, q := range queries {
+		assert.Equal(fmt.Sprintf("postgres://%s", addr), q["endpoint"])
+		details, ok := q["details"].(map[string]interface{})
+		require.True(ok)
+		assert.Equal("postgres", details["protocol"])
+		assert.Equal("s_1234567890", details["session_id"])
+		assert.Equal("mock-connection", details["connection_id"])
+		assert.Equal(testUserId, details["user_id"])
+		// Queries may contain sensitive data and are redacted by default.
+		assert.Equal("[REDACTED]", details["query"])
+	}
+}
+
+func TestHandleProxy_Recording(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	storage, err := recording.NewLocalStorage(ctx, t.TempDir())
+	require.NoError(err)
+	id, err := recording.NewId(ctx)
+	require.NoError(err)
+	rec, err := recording.NewRecorder(ctx, storage, id)
+	require.NoError(err)
+
+	addr := testEndpoint(t, ctx, testTrustedTLSConfig(t))
+	creds := []credential.Credential{credential.NewUserPassword("", testUsername, testPassword)}
+	c, errCh, err := testProxy(t, ctx, addr, proxy.WithEgressCredentials(creds), proxy.WithRecorder(rec))
+	require.NoError(err)
+	_, err = c.Exec(ctx, "select 'recorded'").ReadAll()
+	require.NoError(err)
+	// The proxy closes the client's connection once the client terminates,
+	// which can race with the client closing it.
+	_ = c.Close(ctx)
+	require.NoError(<-errCh)
+	require.NoError(rec.Close())
+
+	chunks, err := recording.ReadAll(ctx, storage, id)
+	require.NoError(err)
+	var up, down bytes.Buffer
+	for _, c := range chunks {
+		switch c.Direction {
+		case recording.Up:
+			up.Write(c.Data)
+		case recording.Down:
+			down.Write(c.Data)
+		}
+	}
+	assert.Contains(up.String(), "select 'recorded'")
+	assert.Contains(down.String(), "appdb")
+	assert.NotContains(up.String(), testPassword)
+}
+
+func TestHandleProxy_WrongPassword(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	addr := testEndpoint(t, ctx, testTrustedTLSConfig(t))
+	creds := []credential.Credential{credential.NewUserPassword("", testUsername, "wrong")}
+	_, errCh, err := testProxy(t, ctx, addr, proxy.WithEgressCredentials(creds))
+	require.Error(err)
+	assert.Contains(err.Error(), "unable to connect to endpoint")
+	err = <-errCh
+	require.Error(err)
+	assert.Contains(err.Error(), "error establishing postgres connection to endpoint")
+}
+
+func TestHandleProxy_Errors(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	addr := testEndpoint(t, ctx, testTrustedTLSConfig(t))
+
+	validCreds := []credential.Credential{credential.NewUserPassword("", testUsername, testPassword)}
+
+	tests := []struct {
+		name     string
+		endpoint string
+		creds    []credential.Credential
+		wantErr  string
+	}{
+		{
+			name:     "wrong-scheme",
+			endpoint: fmt.Sprintf("tcp://%s", addr),
+			creds:    validCreds,
+			wantErr:  "invalid scheme",
+		},
+		{
+			name:     "no-credentials",
+			endpoint: fmt.Sprintf("postgres://%s", addr),
+			wantErr:  "no username/password egress credential",
+		},
+		{
+			name:     "key-pair-credential",
+			endpoint: fmt.Sprintf("postgres://%s", addr),
+			creds:    []credential.Credential{credential.NewKeyPair("", testUsername, credential.PrivateKey("key"))},
+			wantErr:  "no username/password egress credential",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			conf := proxy.Config{
+				ClientAddress:  &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 50000},
+				RemoteEndpoint: tt.endpoint,
+				SessionClient:  pbs.NewMockSessionServiceClient(),
+				SessionInfo:    &session.Info{LookupSessionResponse: &pbs.LookupSessionResponse{}},
+				ConnectionId:   "mock-connection",
+			}
+			err := handleProxy(ctx, conf, proxy.WithEgressCredentials(tt.creds))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestHandleProxy_EndpointTLS(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// handleProxy must trust the test certificate authority for the
+	// untrusted case to fail because of the certificate it presents.
+	testTrustedTLSConfig(t)
+	untrusted, _ := testTLSConfig(t)
+
+	tests := []struct {
+		name      string
+		tlsConfig *tls.Config
+		wantErr   string
+	}{
+		{
+			name:    "tls-declined",
+			wantErr: "server refused TLS connection",
+		},
+		{
+			name:      "untrusted-certificate",
+			tlsConfig: untrusted,
+			wantErr:   "certificate",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			addr := testEndpoint(t, ctx, tt.tlsConfig)
+			creds := []credential.Credential{credential.NewUserPassword("", testUsername, testPassword)}
+			_, errCh, err := testProxy(t, ctx, addr, proxy.WithEgressCredentials(creds))
+			require.Error(err)
+			assert.Contains(err.Error(), "unable to connect to endpoint")
+			err = <-errCh
+			require.Error(err)
+			assert.Contains(err.Error(), "error establishing postgres connection to endpoint")
+			assert.Contains(err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -45,6 +45,40 @@ func (c Config) Validate() error {
 	}
 }
 
+// ClientNetConn returns the client connection as a net.Conn. The bytes read
+// from it and written to it are counted as the bytes proxied up and down on
+// the connection in the connection's information, so that they can be
+// reported to the controller while the connection is open.
+func (c Config) ClientNetConn(ctx context.Context) net.Conn {
+	netConn := websocket.NetConn(ctx, c.ClientConn, websocket.MessageBinary)
+	c.SessionInfo.RLock()
+	ci, ok := c.SessionInfo.ConnInfoMap[c.ConnectionId]
+	c.SessionInfo.RUnlock()
+	if !ok {
+		return netConn
+	}
+	return &countingConn{Conn: netConn, ci: ci}
+}
+
+// countingConn is a net.Conn which counts the bytes read from and written to
+// it in a session.ConnInfo.
+type countingConn struct {
+	net.Conn
+	ci *session.ConnInfo
+}
+
+func (c *countingConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	c.ci.AddBytesUp(n)
+	return n, err
+}
+
+func (c *countingConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	c.ci.AddBytesDown(n)
+	return n, err
+}
+
 // Handler is the type that all proxies need to implement to be called by the worker
 // when a new client connection is created.
 type Handler func(ctx context.Context, config Config, opt ...Option) error
@@ -0,0 +1,39 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/session/recording"
+)
+
+// NewRecordingHandler wraps h so that every connection it proxies is recorded
+// to storage. A new recording is created for each connection and passed to h
+// using the WithRecorder option; it is up to h to write the proxied bytes to
+// the recorder and to report the recording's id to the controller. The
+// recording is closed once h returns.
+func NewRecordingHandler(storage recording.Storage, h Handler) (Handler, error) {
+	if storage == nil {
+		return nil, errors.New("missing recording storage")
+	}
+	if h == nil {
+		return nil, errors.New("missing handler")
+	}
+	return func(ctx context.Context, conf Config, opt ...Option) (retErr error) {
+		id, err := recording.NewId(ctx)
+		if err != nil {
+			return fmt.Errorf("error generating recording id: %w", err)
+		}
+		rec, err := recording.NewRecorder(ctx, storage, id)
+		if err != nil {
+			return fmt.Errorf("error creating recording: %w", err)
+		}
+		defer func() {
+			if err := rec.Close(); err != nil && retErr == nil {
+				retErr = fmt.Errorf("error closing recording: %w", err)
+			}
+		}()
+		return h(ctx, conf, append(opt, WithRecorder(rec))...)
+	}, nil
+}
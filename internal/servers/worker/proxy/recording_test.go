@@ -0,0 +1,60 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/session/recording"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRecordingHandler(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	storage, err := recording.NewLocalStorage(ctx, t.TempDir())
+	require.NoError(t, err)
+
+	noop := func(context.Context, Config, ...Option) error { return nil }
+
+	t.Run("missing-storage", func(t *testing.T) {
+		h, err := NewRecordingHandler(nil, noop)
+		assert.Error(t, err)
+		assert.Nil(t, h)
+	})
+	t.Run("missing-handler", func(t *testing.T) {
+		h, err := NewRecordingHandler(storage, nil)
+		assert.Error(t, err)
+		assert.Nil(t, h)
+	})
+	t.Run("records", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		var recId string
+		inner := func(_ context.Context, _ Config, opt ...Option) error {
+			opts := GetOpts(opt...)
+			require.NotNil(opts.WithRecorder)
+			recId = opts.WithRecorder.Id()
+			require.NoError(opts.WithRecorder.Record(recording.Up, []byte("hello")))
+			require.NoError(opts.WithRecorder.Record(recording.Down, []byte("world")))
+			return nil
+		}
+		h, err := NewRecordingHandler(storage, inner)
+		require.NoError(err)
+		require.NoError(h(ctx, Config{}))
+
+		chunks, err := recording.ReadAll(ctx, storage, recId)
+		require.NoError(err)
+		require.Len(chunks, 2)
+		assert.Equal(recording.Up, chunks[0].Direction)
+		assert.Equal("hello", string(chunks[0].Data))
+		assert.Equal(recording.Down, chunks[1].Direction)
+		assert.Equal("world", string(chunks[1].Data))
+	})
+	t.Run("handler-error", func(t *testing.T) {
+		wantErr := errors.New("handler failed")
+		h, err := NewRecordingHandler(storage, func(context.Context, Config, ...Option) error { return wantErr })
+		require.NoError(t, err)
+		assert.ErrorIs(t, h(ctx, Config{}), wantErr)
+	})
+}
@@ -0,0 +1,386 @@
+// Package ssh provides the worker proxy handler for ssh targets. The handler
+// terminates the client's SSH connection on the worker and opens a second SSH
+// connection to the endpoint, authenticating with the egress credentials
+// brokered for the session, so the secret is never given to the user.
+// Importing this package registers the handler for the "ssh" protocol.
+package ssh
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/servers/worker/proxy"
+	"github.com/hashicorp/boundary/internal/servers/worker/session"
+	"github.com/hashicorp/boundary/internal/session/recording"
+	"golang.org/x/crypto/ssh"
+)
+
+func init() {
+	err := proxy.RegisterHandler("ssh", handleProxy)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// handleProxy creates an ssh proxy between the incoming websocket conn and the
+// endpoint. The client's SSH connection is terminated on the worker using the
+// session's private key as the host key, and any client authentication is
+// accepted since the client has already been authorized for the session. A
+// second SSH connection is then established to the endpoint, authenticating
+// with the first UserPassword or KeyPair credential provided using
+// proxy.WithEgressCredentials. The credential is only sent once the endpoint
+// has presented one of the host keys provided using proxy.WithHostKeys; an
+// error is returned without connecting to the endpoint if no host keys are
+// provided. Channels and requests are then forwarded between the two
+// connections. handleProxy sets the connectionId as connected in the
+// repository once both connections are established.
+//
+// handleProxy blocks until either connection is closed.
+//
+// If a recorder is provided using proxy.WithRecorder, the data sent on all
+// channels in either direction is written to it and the recording's id is
+// reported when the connection is marked as connected.
+func handleProxy(ctx context.Context, conf proxy.Config, opt ...proxy.Option) error {
+	opts := proxy.GetOpts(opt...)
+	sessionUrl, err := url.Parse(conf.RemoteEndpoint)
+	if err != nil {
+		return fmt.Errorf("error parsing endpoint information: %w", err)
+	}
+	if sessionUrl.Scheme != "ssh" {
+		return fmt.Errorf("invalid scheme for ssh proxy: %v", sessionUrl.Scheme)
+	}
+
+	clientConfig, err := endpointClientConfig(opts.WithEgressCredentials, opts.WithHostKeys)
+	if err != nil {
+		return err
+	}
+	serverConfig, err := clientServerConfig(conf)
+	if err != nil {
+		return err
+	}
+
+	remoteConn, err := net.Dial("tcp", sessionUrl.Host)
+	if err != nil {
+		return fmt.Errorf("error dialing endpoint: %w", err)
+	}
+	defer remoteConn.Close()
+
+	// Authenticate to the endpoint before accepting the client's connection
+	// so that a client is never left with an open connection that cannot be
+	// proxied.
+	endpointConn, endpointChans, endpointReqs, err := ssh.NewClientConn(remoteConn, sessionUrl.Host, clientConfig)
+	if err != nil {
+		return fmt.Errorf("error establishing ssh connection to endpoint: %w", err)
+	}
+	defer endpointConn.Close()
+
+	netConn := conf.ClientNetConn(ctx)
+	defer netConn.Close()
+	clientConn, clientChans, clientReqs, err := ssh.NewServerConn(netConn, serverConfig)
+	if err != nil {
+		return fmt.Errorf("error establishing ssh connection with client: %w", err)
+	}
+	defer clientConn.Close()
+
+	endpointAddr := remoteConn.RemoteAddr().(*net.TCPAddr)
+	connectionInfo := &pbs.ConnectConnectionRequest{
+		ConnectionId:       conf.ConnectionId,
+		ClientTcpAddress:   conf.ClientAddress.IP.String(),
+		ClientTcpPort:      uint32(conf.ClientAddress.Port),
+		EndpointTcpAddress: endpointAddr.IP.String(),
+		EndpointTcpPort:    uint32(endpointAddr.Port),
+		Type:               "ssh",
+	}
+	if opts.WithRecorder != nil {
+		connectionInfo.RecordingId = opts.WithRecorder.Id()
+	}
+
+	connStatus, err := session.ConnectConnection(ctx, conf.SessionClient, connectionInfo)
+	if err != nil {
+		return fmt.Errorf("error marking connection as connected: %w", err)
+	}
+
+	// Update connection info to set connection status
+	conf.SessionInfo.Lock()
+	conf.SessionInfo.ConnInfoMap[conf.ConnectionId].Status = connStatus
+	conf.SessionInfo.Unlock()
+
+	p := &sshProxy{
+		recorder: opts.WithRecorder,
+	}
+	connWg := new(sync.WaitGroup)
+	connWg.Add(4)
+	go func() {
+		defer connWg.Done()
+		p.forwardChannels(endpointConn, clientChans, recording.Up)
+	}()
+	go func() {
+		defer connWg.Done()
+		p.forwardChannels(clientConn, endpointChans, recording.Down)
+	}()
+	go func() {
+		defer connWg.Done()
+		forwardGlobalRequests(endpointConn, clientReqs)
+	}()
+	go func() {
+		defer connWg.Done()
+		forwardGlobalRequests(clientConn, endpointReqs)
+	}()
+
+	// When either side goes away close the other so all of the forwarding
+	// goroutines above return.
+	go func() {
+		_ = clientConn.Wait()
+		_ = endpointConn.Close()
+	}()
+	_ = endpointConn.Wait()
+	_ = clientConn.Close()
+
+	connWg.Wait()
+	p.wg.Wait()
+	return nil
+}
+
+// endpointClientConfig returns the configuration used to authenticate to the
+// endpoint using the first UserPassword or KeyPair credential in creds. The
+// endpoint must present one of hostKeys, which are in the OpenSSH
+// authorized_keys format, before the credential is sent to it.
+func endpointClientConfig(creds []credential.Credential, hostKeys []string) (*ssh.ClientConfig, error) {
+	hostKeyCallback, hostKeyAlgorithms, err := hostKeyCallback(hostKeys)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range creds {
+		switch c := c.(type) {
+		case credential.UserPassword:
+			return &ssh.ClientConfig{
+				User:              c.Username(),
+				Auth:              []ssh.AuthMethod{ssh.Password(string(c.Password()))},
+				HostKeyCallback:   hostKeyCallback,
+				HostKeyAlgorithms: hostKeyAlgorithms,
+			}, nil
+		case credential.KeyPair:
+			signer, err := ssh.ParsePrivateKey(c.Private())
+			if err != nil {
+				return nil, fmt.Errorf("error parsing private key of egress credential: %w", err)
+			}
+			return &ssh.ClientConfig{
+				User:              c.Username(),
+				Auth:              []ssh.AuthMethod{ssh.PublicKeys(signer)},
+				HostKeyCallback:   hostKeyCallback,
+				HostKeyAlgorithms: hostKeyAlgorithms,
+			}, nil
+		}
+	}
+	return nil, errors.New("no username/password or key pair egress credential found for ssh proxy")
+}
+
+// hostKeyCallback returns a callback accepting only the host keys in keys and
+// the host key algorithms the endpoint should use to present one of them. An
+// error is returned if keys is empty, since the egress credentials must never
+// be sent to an unverified host.
+func hostKeyCallback(keys []string) (ssh.HostKeyCallback, []string, error) {
+	var pks []ssh.PublicKey
+	var algorithms []string
+	seen := make(map[string]bool)
+	for _, k := range keys {
+		pk, _, _, _, err := ssh.ParseAuthorizedKey([]byte(k))
+		if err != nil {
+			return nil, nil, fmt.Errorf("error parsing host key of target: %w", err)
+		}
+		pks = append(pks, pk)
+		if seen[pk.Type()] {
+			continue
+		}
+		seen[pk.Type()] = true
+		if pk.Type() == ssh.KeyAlgoRSA {
+			// RSA host keys are presented using the SHA-2 signature
+			// algorithms by current servers.
+			algorithms = append(algorithms, ssh.SigAlgoRSASHA2512, ssh.SigAlgoRSASHA2256)
+		}
+		algorithms = append(algorithms, pk.Type())
+	}
+	if len(pks) == 0 {
+		return nil, nil, errors.New("no host keys configured for ssh target, refusing to send egress credentials to unverified host")
+	}
+	return func(hostname string, _ net.Addr, key ssh.PublicKey) error {
+		for _, pk := range pks {
+			if pk.Type() == key.Type() && bytes.Equal(pk.Marshal(), key.Marshal()) {
+				return nil
+			}
+		}
+		return fmt.Errorf("host key %s of %s does not match any host key of the target", ssh.FingerprintSHA256(key), hostname)
+	}, algorithms, nil
+}
+
+// clientServerConfig returns the configuration used to terminate the client's
+// SSH connection. The session's private key is used as the host key, which
+// allows the client to verify it is connected to a worker handling its
+// session.
+func clientServerConfig(conf proxy.Config) (*ssh.ServerConfig, error) {
+	conf.SessionInfo.RLock()
+	key := conf.SessionInfo.LookupSessionResponse.GetAuthorization().GetPrivateKey()
+	conf.SessionInfo.RUnlock()
+	if len(key) != ed25519.PrivateKeySize {
+		return nil, errors.New("missing session private key for ssh proxy")
+	}
+	hostKey, err := ssh.NewSignerFromKey(ed25519.PrivateKey(key))
+	if err != nil {
+		return nil, fmt.Errorf("error creating ssh host key: %w", err)
+	}
+	serverConfig := &ssh.ServerConfig{
+		// The client has already been authorized for the session when the
+		// websocket connection was established.
+		NoClientAuth: true,
+	}
+	serverConfig.AddHostKey(hostKey)
+	return serverConfig, nil
+}
+
+// sshProxy forwards channels between the client and the endpoint.
+type sshProxy struct {
+	recorder *recording.Recorder
+	wg       sync.WaitGroup
+}
+
+// forwardChannels opens a channel on dst for each new channel received in
+// chans and copies data and requests between the two channels. Data read from
+// the new channels is recorded in direction d.
+func (p *sshProxy) forwardChannels(dst ssh.Conn, chans <-chan ssh.NewChannel, d recording.Direction) {
+	for newCh := range chans {
+		dstCh, dstReqs, err := dst.OpenChannel(newCh.ChannelType(), newCh.ExtraData())
+		if err != nil {
+			reason, msg := ssh.ConnectionFailed, err.Error()
+			var openErr *ssh.OpenChannelError
+			if errors.As(err, &openErr) {
+				reason, msg = openErr.Reason, openErr.Message
+			}
+			_ = newCh.Reject(reason, msg)
+			continue
+		}
+		srcCh, srcReqs, err := newCh.Accept()
+		if err != nil {
+			_ = dstCh.Close()
+			continue
+		}
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			p.forwardChannel(srcCh, srcReqs, dstCh, dstReqs, d)
+		}()
+	}
+}
+
+// forwardChannel copies data, extended data, and requests between the src
+// and dst channels until both are closed. d is the direction of the data read
+// from src.
+func (p *sshProxy) forwardChannel(src ssh.Channel, srcReqs <-chan *ssh.Request, dst ssh.Channel, dstReqs <-chan *ssh.Request, d recording.Direction) {
+	opposite := recording.Down
+	if d == recording.Down {
+		opposite = recording.Up
+	}
+
+	// srcDone is closed once all data read from src has been written to dst
+	// and dstDone once all data read from dst has been written to src.
+	srcDone, dstDone := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(srcDone)
+		p.copyData(dst, src, d)
+	}()
+	go func() {
+		defer close(dstDone)
+		p.copyData(src, dst, opposite)
+	}()
+
+	// srcMu is held while a request read from src is forwarded and replied
+	// to, and dstMu likewise for dst, so that neither channel is closed
+	// before an in-flight request on it has been replied to.
+	var srcMu, dstMu sync.Mutex
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		forwardChannelRequests(dst, srcReqs, srcDone, &srcMu)
+		<-srcDone
+		dstMu.Lock()
+		_ = dst.Close()
+		dstMu.Unlock()
+	}()
+	go func() {
+		defer wg.Done()
+		forwardChannelRequests(src, dstReqs, dstDone, &dstMu)
+		<-dstDone
+		srcMu.Lock()
+		_ = src.Close()
+		srcMu.Unlock()
+	}()
+	wg.Wait()
+}
+
+// copyData copies the data and the extended data read from src to dst,
+// recording it in direction d, and then signals EOF on dst.
+func (p *sshProxy) copyData(dst, src ssh.Channel, d recording.Direction) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, _ = io.Copy(dst.Stderr(), p.reader(src.Stderr(), d))
+	}()
+	_, _ = io.Copy(dst, p.reader(src, d))
+	wg.Wait()
+	_ = dst.CloseWrite()
+}
+
+// reader returns r, writing everything read from it to the recorder in
+// direction d when recording.
+func (p *sshProxy) reader(r io.Reader, d recording.Direction) io.Reader {
+	if p.recorder == nil {
+		return r
+	}
+	return io.TeeReader(r, p.recorder.Writer(d))
+}
+
+// forwardChannelRequests sends each request in reqs to dst and replies with
+// dst's response. Requests reporting the exit of a command are only sent once
+// dataDone is closed so that they are received after all of the command's
+// output. mu is held while each request is forwarded and replied to.
+func forwardChannelRequests(dst ssh.Channel, reqs <-chan *ssh.Request, dataDone <-chan struct{}, mu *sync.Mutex) {
+	for req := range reqs {
+		switch req.Type {
+		case "exit-status", "exit-signal":
+			<-dataDone
+		}
+		mu.Lock()
+		ok, err := dst.SendRequest(req.Type, req.WantReply, req.Payload)
+		if err != nil {
+			ok = false
+		}
+		if req.WantReply {
+			_ = req.Reply(ok, nil)
+		}
+		mu.Unlock()
+	}
+}
+
+// forwardGlobalRequests sends each global request in reqs to dst and replies
+// with dst's response.
+func forwardGlobalRequests(dst ssh.Conn, reqs <-chan *ssh.Request) {
+	for req := range reqs {
+		ok, payload, err := dst.SendRequest(req.Type, req.WantReply, req.Payload)
+		if err != nil {
+			ok, payload = false, nil
+		}
+		if req.WantReply {
+			_ = req.Reply(ok, payload)
+		}
+	}
+}
@@ -0,0 +1,362 @@
+package ssh
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/servers/worker/proxy"
+	"github.com/hashicorp/boundary/internal/servers/worker/session"
+	"github.com/hashicorp/boundary/internal/session/recording"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"nhooyr.io/websocket"
+)
+
+const (
+	testUsername = "boundary"
+	testPassword = "egress-password"
+)
+
+// testEndpoint starts an SSH server which accepts the testUsername with
+// either the testPassword or the public key of authorizedKey. The server
+// replies to each exec request on a session channel by writing the command
+// to stdout and "stderr" to stderr and exiting with status 0. It returns the
+// address of the server and its host key in the authorized_keys format.
+func testEndpoint(t *testing.T, ctx context.Context, authorizedKey ssh.PublicKey) (string, string) {
+	t.Helper()
+	require := require.New(t)
+
+	_, hostPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(err)
+	hostKey, err := ssh.NewSignerFromKey(hostPriv)
+	require.NoError(err)
+
+	conf := &ssh.ServerConfig{
+		PasswordCallback: func(c ssh.ConnMetadata, pass []byte) (*ssh.Permissions, error) {
+			if c.User() == testUsername && string(pass) == testPassword {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("password rejected for %q", c.User())
+		},
+		PublicKeyCallback: func(c ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if c.User() == testUsername && authorizedKey != nil && bytes.Equal(key.Marshal(), authorizedKey.Marshal()) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("public key rejected for %q", c.User())
+		},
+	}
+	conf.AddHostKey(hostKey)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	go func() {
+		<-ctx.Done()
+		_ = l.Close()
+	}()
+	go func() {
+		for {
+			nc, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				_, chans, reqs, err := ssh.NewServerConn(nc, conf)
+				if err != nil {
+					return
+				}
+				go ssh.DiscardRequests(reqs)
+				for newCh := range chans {
+					if newCh.ChannelType() != "session" {
+						_ = newCh.Reject(ssh.UnknownChannelType, "unsupported channel type")
+						continue
+					}
+					ch, chReqs, err := newCh.Accept()
+					if err != nil {
+						continue
+					}
+					go func() {
+						defer ch.Close()
+						for req := range chReqs {
+							if req.Type != "exec" {
+								_ = req.Reply(false, nil)
+								continue
+							}
+							var exec struct{ Command string }
+							if err := ssh.Unmarshal(req.Payload, &exec); err != nil {
+								_ = req.Reply(false, nil)
+								continue
+							}
+							_ = req.Reply(true, nil)
+							_, _ = ch.Write([]byte(exec.Command))
+							_, _ = ch.Stderr().Write([]byte("stderr"))
+							_ = ch.CloseWrite()
+							_, _ = ch.SendRequest("exit-status", false, ssh.Marshal(struct{ Status uint32 }{0}))
+							return
+						}
+					}()
+				}
+			}()
+		}
+	}()
+	return l.Addr().String(), string(ssh.MarshalAuthorizedKey(hostKey.PublicKey()))
+}
+
+// testProxy runs handleProxy for an ssh endpoint at addr with the provided
+// options and returns an ssh client connected through the proxy. The client
+// verifies that the host key presented by the proxy is the session's key.
+func testProxy(t *testing.T, ctx context.Context, addr string, opt ...proxy.Option) (*ssh.Client, <-chan error) {
+	t.Helper()
+	require := require.New(t)
+
+	clientConn, proxyConn := proxy.TestWsConn(t, ctx)
+	require.NotNil(clientConn)
+	require.NotNil(proxyConn)
+
+	sessPub, sessPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(err)
+
+	si := &session.Info{
+		Id: "one",
+		LookupSessionResponse: &pbs.LookupSessionResponse{
+			Authorization: &targets.SessionAuthorizationData{
+				SessionId:  "mock-session",
+				PrivateKey: sessPriv,
+			},
+		},
+		ConnInfoMap: map[string]*session.ConnInfo{
+			"mock-connection": {},
+		},
+	}
+	conf := proxy.Config{
+		ClientAddress: &net.TCPAddr{
+			IP:   net.ParseIP("127.0.0.1"),
+			Port: 50000,
+		},
+		ClientConn:     proxyConn,
+		RemoteEndpoint: fmt.Sprintf("ssh://%s", addr),
+		SessionClient:  pbs.NewMockSessionServiceClient(),
+		SessionInfo:    si,
+		ConnectionId:   "mock-connection",
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- handleProxy(ctx, conf, opt...)
+	}()
+
+	hostKey, err := ssh.NewPublicKey(sessPub)
+	require.NoError(err)
+	netConn := websocket.NetConn(ctx, clientConn, websocket.MessageBinary)
+	c, chans, reqs, err := ssh.NewClientConn(netConn, "localhost", &ssh.ClientConfig{
+		User:            "user-without-credentials",
+		HostKeyCallback: ssh.FixedHostKey(hostKey),
+	})
+	require.NoError(err)
+	return ssh.NewClient(c, chans, reqs), errCh
+}
+
+func TestHandleProxy(t *testing.T) {
+	t.Parallel()
+
+	_, userPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	userSigner, err := ssh.NewSignerFromKey(userPriv)
+	require.NoError(t, err)
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(userPriv)
+	require.NoError(t, err)
+	userKeyPem := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8})
+
+	tests := []struct {
+		name  string
+		creds []credential.Credential
+	}{
+		{
+			name:  "username-password",
+			creds: []credential.Credential{credential.NewUserPassword("", testUsername, testPassword)},
+		},
+		{
+			name:  "key-pair",
+			creds: []credential.Credential{credential.NewKeyPair("", testUsername, userKeyPem)},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			addr, hostKey := testEndpoint(t, ctx, userSigner.PublicKey())
+			client, errCh := testProxy(t, ctx, addr, proxy.WithEgressCredentials(tt.creds), proxy.WithHostKeys([]string{hostKey}))
+
+			sess, err := client.NewSession()
+			require.NoError(err)
+			var stdout, stderr bytes.Buffer
+			sess.Stdout, sess.Stderr = &stdout, &stderr
+			require.NoError(sess.Run("echo hello"))
+			assert.Equal("echo hello", stdout.String())
+			assert.Equal("stderr", stderr.String())
+
+			require.NoError(client.Close())
+			assert.NoError(<-errCh)
+		})
+	}
+}
+
+func TestHandleProxy_Recording(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	storage, err := recording.NewLocalStorage(ctx, t.TempDir())
+	require.NoError(err)
+	id, err := recording.NewId(ctx)
+	require.NoError(err)
+	rec, err := recording.NewRecorder(ctx, storage, id)
+	require.NoError(err)
+
+	addr, hostKey := testEndpoint(t, ctx, nil)
+	creds := []credential.Credential{credential.NewUserPassword("", testUsername, testPassword)}
+	client, errCh := testProxy(t, ctx, addr, proxy.WithEgressCredentials(creds), proxy.WithHostKeys([]string{hostKey}), proxy.WithRecorder(rec))
+
+	sess, err := client.NewSession()
+	require.NoError(err)
+	out, err := sess.Output("whoami")
+	require.NoError(err)
+	assert.Equal("whoami", string(out))
+	require.NoError(client.Close())
+	require.NoError(<-errCh)
+	require.NoError(rec.Close())
+
+	chunks, err := recording.ReadAll(ctx, storage, id)
+	require.NoError(err)
+	var down bytes.Buffer
+	for _, c := range chunks {
+		if c.Direction == recording.Down {
+			down.Write(c.Data)
+		}
+	}
+	assert.Contains(down.String(), "whoami")
+	assert.Contains(down.String(), "stderr")
+}
+
+func TestHandleProxy_Errors(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	addr, hostKey := testEndpoint(t, ctx, nil)
+	_, otherHostKey := testEndpoint(t, ctx, nil)
+
+	si := &session.Info{
+		LookupSessionResponse: &pbs.LookupSessionResponse{
+			Authorization: &targets.SessionAuthorizationData{},
+		},
+	}
+	_, sessPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	siWithKey := &session.Info{
+		LookupSessionResponse: &pbs.LookupSessionResponse{
+			Authorization: &targets.SessionAuthorizationData{PrivateKey: sessPriv},
+		},
+	}
+	validCreds := []credential.Credential{credential.NewUserPassword("", testUsername, testPassword)}
+
+	tests := []struct {
+		name     string
+		endpoint string
+		info     *session.Info
+		creds    []credential.Credential
+		hostKeys []string
+		wantErr  string
+	}{
+		{
+			name:     "wrong-scheme",
+			endpoint: fmt.Sprintf("tcp://%s", addr),
+			info:     siWithKey,
+			creds:    validCreds,
+			wantErr:  "invalid scheme",
+		},
+		{
+			name:     "no-credentials",
+			endpoint: fmt.Sprintf("ssh://%s", addr),
+			info:     siWithKey,
+			wantErr:  "no username/password or key pair egress credential",
+		},
+		{
+			name:     "invalid-private-key",
+			endpoint: fmt.Sprintf("ssh://%s", addr),
+			info:     siWithKey,
+			creds:    []credential.Credential{credential.NewKeyPair("", testUsername, credential.PrivateKey("not a key"))},
+			wantErr:  "error parsing private key",
+		},
+		{
+			name:     "no-session-key",
+			endpoint: fmt.Sprintf("ssh://%s", addr),
+			info:     si,
+			creds:    validCreds,
+			wantErr:  "missing session private key",
+		},
+		{
+			name:     "wrong-password",
+			endpoint: fmt.Sprintf("ssh://%s", addr),
+			info:     siWithKey,
+			creds:    []credential.Credential{credential.NewUserPassword("", testUsername, "wrong")},
+			wantErr:  "error establishing ssh connection to endpoint",
+		},
+		{
+			name:     "no-host-keys",
+			endpoint: fmt.Sprintf("ssh://%s", addr),
+			info:     siWithKey,
+			creds:    validCreds,
+			hostKeys: []string{},
+			wantErr:  "no host keys configured",
+		},
+		{
+			name:     "invalid-host-key",
+			endpoint: fmt.Sprintf("ssh://%s", addr),
+			info:     siWithKey,
+			creds:    validCreds,
+			hostKeys: []string{"ssh-ed25519 not-a-key"},
+			wantErr:  "error parsing host key",
+		},
+		{
+			name:     "wrong-host-key",
+			endpoint: fmt.Sprintf("ssh://%s", addr),
+			info:     siWithKey,
+			creds:    validCreds,
+			hostKeys: []string{otherHostKey},
+			wantErr:  "does not match any host key of the target",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			hostKeys := tt.hostKeys
+			if hostKeys == nil {
+				hostKeys = []string{hostKey}
+			}
+			conf := proxy.Config{
+				ClientAddress:  &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 50000},
+				RemoteEndpoint: tt.endpoint,
+				SessionClient:  pbs.NewMockSessionServiceClient(),
+				SessionInfo:    tt.info,
+				ConnectionId:   "mock-connection",
+			}
+			err := handleProxy(ctx, conf, proxy.WithEgressCredentials(tt.creds), proxy.WithHostKeys(hostKeys))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
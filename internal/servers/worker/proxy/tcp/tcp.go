@@ -9,9 +9,10 @@ import (
 	"sync"
 
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/observability/metric"
 	"github.com/hashicorp/boundary/internal/servers/worker/proxy"
 	"github.com/hashicorp/boundary/internal/servers/worker/session"
-	"nhooyr.io/websocket"
+	"github.com/hashicorp/boundary/internal/session/recording"
 )
 
 func init() {
@@ -28,9 +29,15 @@ func init() {
 // handleProxy blocks until an error (EOF on happy path) is received on either
 // connection.
 //
-// All options are ignored.
-func handleProxy(ctx context.Context, conf proxy.Config, _ ...proxy.Option) error {
-	conn := conf.ClientConn
+// If a recorder is provided using proxy.WithRecorder, all bytes proxied in
+// either direction are written to it and the recording's id is reported when
+// the connection is marked as connected. A failure to record terminates the
+// connection. All other options are ignored.
+//
+// The bytes proxied in each direction are added to the worker's proxy metrics
+// when the connection closes.
+func handleProxy(ctx context.Context, conf proxy.Config, opt ...proxy.Option) error {
+	opts := proxy.GetOpts(opt...)
 	sessionUrl, err := url.Parse(conf.RemoteEndpoint)
 	if err != nil {
 		return fmt.Errorf("error parsing endpoint information: %w", err)
@@ -54,6 +61,9 @@ func handleProxy(ctx context.Context, conf proxy.Config, _ ...proxy.Option) erro
 		EndpointTcpPort:    uint32(endpointAddr.Port),
 		Type:               "tcp",
 	}
+	if opts.WithRecorder != nil {
+		connectionInfo.RecordingId = opts.WithRecorder.Id()
+	}
 
 	connStatus, err := session.ConnectConnection(ctx, conf.SessionClient, connectionInfo)
 	if err != nil {
@@ -65,20 +75,33 @@ func handleProxy(ctx context.Context, conf proxy.Config, _ ...proxy.Option) erro
 	conf.SessionInfo.ConnInfoMap[conf.ConnectionId].Status = connStatus
 	conf.SessionInfo.Unlock()
 
-	// Get a wrapped net.Conn so we can use io.Copy
-	netConn := websocket.NetConn(ctx, conn, websocket.MessageBinary)
+	// Get a wrapped net.Conn so we can use io.Copy. It counts the bytes
+	// proxied so the worker can report them while the connection is open.
+	netConn := conf.ClientNetConn(ctx)
+
+	// Only wrap the sources when recording, so connections which aren't
+	// recorded are copied as before. Neither direction can use splice as the
+	// client side is a websocket; recording adds a write to the recorder for
+	// every read.
+	var upSrc, downSrc io.Reader = netConn, tcpRemoteConn
+	if rec := opts.WithRecorder; rec != nil {
+		upSrc = io.TeeReader(netConn, rec.Writer(recording.Up))
+		downSrc = io.TeeReader(tcpRemoteConn, rec.Writer(recording.Down))
+	}
 
 	connWg := new(sync.WaitGroup)
 	connWg.Add(2)
 	go func() {
 		defer connWg.Done()
-		_, _ = io.Copy(netConn, tcpRemoteConn)
+		n, _ := io.Copy(netConn, downSrc)
+		metric.AddProxyBytes("tcp", metric.DirectionDown, n)
 		_ = netConn.Close()
 		_ = tcpRemoteConn.Close()
 	}()
 	go func() {
 		defer connWg.Done()
-		_, _ = io.Copy(tcpRemoteConn, netConn)
+		n, _ := io.Copy(tcpRemoteConn, upSrc)
+		metric.AddProxyBytes("tcp", metric.DirectionUp, n)
 		_ = tcpRemoteConn.Close()
 		_ = netConn.Close()
 	}()
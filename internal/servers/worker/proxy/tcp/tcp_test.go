@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"testing"
+	"time"
 
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/servers/worker/proxy"
@@ -99,5 +100,12 @@ func TestHandleTcpProxyV1(t *testing.T) {
 	assert.Equal(writeLen, readLen)
 	assert.Equal("client write to endpoint via proxy", string(b1))
 
+	// The bytes proxied are counted on the connection
+	ci := si.ConnInfoMap["mock-connection"]
+	assert.Eventually(func() bool {
+		return ci.BytesUp() == uint64(len("client write to endpoint via proxy")) &&
+			ci.BytesDown() == uint64(len("endpoint write to client via proxy"))
+	}, time.Second, 10*time.Millisecond)
+
 	cancelCtx()
 }
@@ -0,0 +1,134 @@
+package worker
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/yamux"
+)
+
+// reverseTunnelRetryInterval is how long a reverse worker waits before
+// reopening a tunnel to a controller after it closes or fails to open.
+const reverseTunnelRetryInterval = 5 * time.Second
+
+// startReverseTunnels opens a tunnel to each configured controller. Client
+// proxy connections are relayed to this worker over the tunnels by ingress
+// workers, so the worker doesn't need to be reachable at a public address.
+func (w *Worker) startReverseTunnels() error {
+	addrs, err := w.controllerAddrs()
+	if err != nil {
+		return err
+	}
+	for _, addr := range addrs {
+		addr := addr
+		w.tickerWg.Add(1)
+		go func() {
+			defer w.tickerWg.Done()
+			w.runReverseTunnel(w.baseContext, addr)
+		}()
+	}
+	return nil
+}
+
+// runReverseTunnel keeps a tunnel open to the controller at addr until ctx is
+// done.
+func (w *Worker) runReverseTunnel(ctx context.Context, addr string) {
+	const op = "worker.(Worker).runReverseTunnel"
+	for {
+		if err := w.serveReverseTunnel(ctx, addr); err != nil && ctx.Err() == nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("reverse tunnel to controller closed", "address", addr))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reverseTunnelRetryInterval):
+		}
+	}
+}
+
+// serveReverseTunnel opens a tunnel to the controller at addr and serves
+// proxy connections over it until it closes. The worker is the yamux server
+// side of the tunnel; each stream the controller opens carries one client
+// connection, secured with the session certificate exactly as on a proxy
+// listener.
+func (w *Worker) serveReverseTunnel(ctx context.Context, addr string) error {
+	const op = "worker.(Worker).serveReverseTunnel"
+	conn, err := w.dialController(ctx, addr, globals.ReverseTunnelV1)
+	if err != nil {
+		return err
+	}
+	cfg := yamux.DefaultConfig()
+	// Errors are surfaced to us through the session, don't log them twice
+	cfg.LogOutput = io.Discard
+	sess, err := yamux.Server(conn, cfg)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("error creating reverse tunnel: %w", err)
+	}
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-sess.CloseChan():
+		}
+		_ = sess.Close()
+	}()
+	event.WriteSysEvent(ctx, op, "reverse tunnel to controller opened", "address", addr)
+
+	server := &http.Server{
+		Handler:           w.handler(HandlerProperties{}),
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       30 * time.Second,
+		BaseContext: func(net.Listener) context.Context {
+			return ctx
+		},
+	}
+	return server.Serve(tls.NewListener(sess, &tls.Config{
+		GetConfigForClient: w.getSessionTls,
+	}))
+}
+
+// dialReverseRelay asks a controller to relay a connection to the reverse
+// worker with the given id over its tunnel. Controllers are tried in order
+// until one accepts the connection. The returned connection is opaque to the
+// controller, so it must still be secured with the session certificate.
+func (w *Worker) dialReverseRelay(ctx context.Context, workerId string) (net.Conn, error) {
+	if len(workerId) == 0 || len(workerId) > math.MaxUint16 {
+		return nil, fmt.Errorf("invalid reverse worker id %q", workerId)
+	}
+	addrs, err := w.controllerAddrs()
+	if err != nil {
+		return nil, err
+	}
+	header := make([]byte, 2+len(workerId))
+	binary.BigEndian.PutUint16(header, uint16(len(workerId)))
+	copy(header[2:], workerId)
+
+	errs := make([]error, 0, len(addrs))
+	for _, addr := range addrs {
+		conn, err := w.dialController(ctx, addr, globals.ReverseRelayV1)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if _, err := conn.Write(header); err != nil {
+			_ = conn.Close()
+			errs = append(errs, fmt.Errorf("unable to write relay header: %w", err))
+			continue
+		}
+		return conn, nil
+	}
+	if len(errs) == 0 {
+		return nil, errors.New("no controllers to relay through")
+	}
+	return nil, fmt.Errorf("unable to relay to reverse worker: %v", errs)
+}
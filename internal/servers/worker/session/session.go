@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
@@ -22,6 +23,11 @@ var errMakeSessionCloseInfoNilCloseInfo = errors.New("nil closeInfo supplied to
 
 // ConnInfo defines the information about a connection attached to a session
 type ConnInfo struct {
+	// bytesUp and bytesDown are accessed atomically, so they are kept first
+	// to ensure their 64-bit alignment.
+	bytesUp   uint64
+	bytesDown uint64
+
 	Id         string
 	ConnCtx    context.Context
 	ConnCancel context.CancelFunc
@@ -29,6 +35,30 @@ type ConnInfo struct {
 	CloseTime  time.Time
 }
 
+// AddBytesUp adds n to the number of bytes proxied from the client to the
+// endpoint on the connection. It is safe to call concurrently.
+func (c *ConnInfo) AddBytesUp(n int) {
+	atomic.AddUint64(&c.bytesUp, uint64(n))
+}
+
+// AddBytesDown adds n to the number of bytes proxied from the endpoint to the
+// client on the connection. It is safe to call concurrently.
+func (c *ConnInfo) AddBytesDown(n int) {
+	atomic.AddUint64(&c.bytesDown, uint64(n))
+}
+
+// BytesUp returns the number of bytes proxied from the client to the
+// endpoint on the connection so far.
+func (c *ConnInfo) BytesUp() uint64 {
+	return atomic.LoadUint64(&c.bytesUp)
+}
+
+// BytesDown returns the number of bytes proxied from the endpoint to the
+// client on the connection so far.
+func (c *ConnInfo) BytesDown() uint64 {
+	return atomic.LoadUint64(&c.bytesDown)
+}
+
 // Info defines the information about a session
 type Info struct {
 	sync.RWMutex
@@ -69,10 +99,13 @@ func Cancel(ctx context.Context, sessClient pbs.SessionServiceClient, sessionId
 // AuthorizeConnection is a helper worker function that sends connection
 // authorization request to the controller. It is called by the worker handler after a
 // connection has been received by the worker, and the session has been validated.
-func AuthorizeConnection(ctx context.Context, sessClient pbs.SessionServiceClient, workerId, sessionId string) (*ConnInfo, int32, error) {
+// workerChain holds the ids of the workers which forwarded the connection to
+// this worker, if any.
+func AuthorizeConnection(ctx context.Context, sessClient pbs.SessionServiceClient, workerId, sessionId string, workerChain []string) (*ConnInfo, int32, error) {
 	resp, err := sessClient.AuthorizeConnection(ctx, &pbs.AuthorizeConnectionRequest{
-		SessionId: sessionId,
-		WorkerId:  workerId,
+		SessionId:   sessionId,
+		WorkerId:    workerId,
+		WorkerChain: workerChain,
 	})
 	if err != nil {
 		return nil, 0, fmt.Errorf("error authorizing connection: %w", err)
@@ -139,7 +172,7 @@ func CloseConnections(ctx context.Context, sessClient pbs.SessionServiceClient,
 	// within an adequate period of time.
 	closeConnCtx, closeConnCancel := context.WithTimeout(ctx, common.StatusTimeout)
 	defer closeConnCancel()
-	response, err := closeConnection(closeConnCtx, sessClient, makeCloseConnectionRequest(closeInfo))
+	response, err := closeConnection(closeConnCtx, sessClient, makeCloseConnectionRequest(sessionInfo, closeInfo))
 	if err != nil {
 		event.WriteError(ctx, op, err, event.WithInfoMsg("error marking connections closed",
 			"warning", "error contacting controller, connections will be closed only on worker",
@@ -172,16 +205,27 @@ func CloseConnections(ctx context.Context, sessClient pbs.SessionServiceClient,
 // use with closing connections.
 //
 // closeInfo is a map, indexed by connection ID, to the individual
-// sessions IDs that those connections belong to. The values are
-// ignored; the parameter is expected as such just for convenience of
-// its caller.
-func makeCloseConnectionRequest(closeInfo map[string]string) *pbs.CloseConnectionRequest {
+// sessions IDs that those connections belong to. The session IDs are
+// used to look up the number of bytes proxied on each connection in
+// sessionInfo; connections which can't be found are closed without
+// them.
+func makeCloseConnectionRequest(sessionInfo *sync.Map, closeInfo map[string]string) *pbs.CloseConnectionRequest {
 	closeData := make([]*pbs.CloseConnectionRequestData, 0, len(closeInfo))
-	for connId := range closeInfo {
-		closeData = append(closeData, &pbs.CloseConnectionRequestData{
+	for connId, sessionId := range closeInfo {
+		data := &pbs.CloseConnectionRequestData{
 			ConnectionId: connId,
 			Reason:       session.UnknownReason.String(),
-		})
+		}
+		if siRaw, ok := sessionInfo.Load(sessionId); ok {
+			si := siRaw.(*Info)
+			si.RLock()
+			if ci, ok := si.ConnInfoMap[connId]; ok {
+				data.BytesUp = ci.BytesUp()
+				data.BytesDown = ci.BytesDown()
+			}
+			si.RUnlock()
+		}
+		closeData = append(closeData, data)
 	}
 
 	return &pbs.CloseConnectionRequest{
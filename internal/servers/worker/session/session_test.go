@@ -14,13 +14,21 @@ import (
 func TestWorkerMakeCloseConnectionRequest(t *testing.T) {
 	require := require.New(t)
 	in := map[string]string{"foo": "one", "bar": "two"}
+	foo := &ConnInfo{Id: "foo"}
+	foo.AddBytesUp(10)
+	foo.AddBytesDown(20)
+	sessionInfo := new(sync.Map)
+	sessionInfo.Store("one", &Info{
+		Id:          "one",
+		ConnInfoMap: map[string]*ConnInfo{"foo": foo},
+	})
 	expected := &pbs.CloseConnectionRequest{
 		CloseRequestData: []*pbs.CloseConnectionRequestData{
-			{ConnectionId: "foo", Reason: session.UnknownReason.String()},
+			{ConnectionId: "foo", Reason: session.UnknownReason.String(), BytesUp: 10, BytesDown: 20},
 			{ConnectionId: "bar", Reason: session.UnknownReason.String()},
 		},
 	}
-	actual := makeCloseConnectionRequest(in)
+	actual := makeCloseConnectionRequest(sessionInfo, in)
 	require.ElementsMatch(expected.GetCloseRequestData(), actual.GetCloseRequestData())
 }
 
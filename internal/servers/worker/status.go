@@ -8,6 +8,7 @@ import (
 
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/observability/metric"
 	"github.com/hashicorp/boundary/internal/servers"
 	"github.com/hashicorp/boundary/internal/servers/worker/common"
 	"github.com/hashicorp/boundary/internal/servers/worker/session"
@@ -93,6 +94,7 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context) {
 	// First send info as-is. We'll perform cleanup duties after we
 	// get cancel/job change info back.
 	var activeJobs []*pbs.JobStatus
+	var connectedCount int
 
 	// Range over known sessions and collect info
 	w.sessionInfoMap.Range(func(key, value interface{}) bool {
@@ -106,7 +108,12 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context) {
 			connections = append(connections, &pbs.Connection{
 				ConnectionId: k,
 				Status:       v.Status,
+				BytesUp:      v.BytesUp(),
+				BytesDown:    v.BytesDown(),
 			})
+			if v.Status == pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CONNECTED {
+				connectedCount++
+			}
 		}
 		si.RUnlock()
 		jobInfo.SessionId = sessionId
@@ -125,6 +132,8 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context) {
 		return true
 	})
 
+	metric.SetWorkerSessions(len(activeJobs), connectedCount)
+
 	// Send status information
 	client := w.controllerStatusConn.Load().(pbs.ServerCoordinationServiceClient)
 	var tags map[string]*servers.TagValues
@@ -143,6 +152,7 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context) {
 			Description: w.conf.RawConfig.Worker.Description,
 			Address:     w.conf.RawConfig.Worker.PublicAddr,
 			Tags:        tags,
+			Reverse:     w.conf.RawConfig.Worker.Reverse,
 		},
 		UpdateTags: w.updateTags.Load(),
 	})
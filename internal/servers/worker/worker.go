@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/boundary/internal/observability/event"
 	"github.com/hashicorp/boundary/internal/servers"
 	"github.com/hashicorp/boundary/internal/servers/worker/session"
+	"github.com/hashicorp/boundary/internal/session/recording"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-secure-stdlib/mlock"
 	ua "go.uber.org/atomic"
@@ -45,6 +46,10 @@ type Worker struct {
 	controllerSessionConn *atomic.Value
 	sessionInfoMap        *sync.Map
 
+	// recordingStorage is set when session recording is enabled and is where
+	// proxied connections are recorded to
+	recordingStorage recording.Storage
+
 	// We store the current set in an atomic value so that we can add
 	// reload-on-sighup behavior later
 	tags *atomic.Value
@@ -89,6 +94,12 @@ func New(conf *Config) (*Worker, error) {
 		return nil, fmt.Errorf("error auto-generating worker name: %w", err)
 	}
 
+	if rec := conf.RawConfig.Worker.SessionRecording; rec != nil {
+		if w.recordingStorage, err = recording.NewStorage(context.Background(), recording.StorageType(rec.StorageType), rec.StoragePath); err != nil {
+			return nil, fmt.Errorf("error creating session recording storage: %w", err)
+		}
+	}
+
 	if !conf.RawConfig.DisableMlock {
 		// Ensure our memory usage is locked into physical RAM
 		if err := mlock.LockMemory(); err != nil {
@@ -127,6 +138,11 @@ func (w *Worker) Start() error {
 	if err := w.startControllerConnections(); err != nil {
 		return fmt.Errorf("error making controller connections: %w", err)
 	}
+	if w.conf.RawConfig.Worker.Reverse {
+		if err := w.startReverseTunnels(); err != nil {
+			return fmt.Errorf("error starting reverse tunnels: %w", err)
+		}
+	}
 
 	w.tickerWg.Add(1)
 	go func() {
@@ -313,6 +329,10 @@ func (w *Worker) getSessionTls(hello *tls.ClientHelloInfo) (*tls.Config, error)
 		actualSi := actualSiRaw.(*session.Info)
 		actualSi.Lock()
 		actualSi.LookupSessionResponse = resp
+		if actualSi.Status == pbs.SESSIONSTATUS_SESSIONSTATUS_PENDING_APPROVAL {
+			// The session may have been approved since it was last looked up.
+			actualSi.Status = resp.GetStatus()
+		}
 		actualSi.Unlock()
 	}
 
@@ -33,12 +33,22 @@ type Connection struct {
 	BytesDown uint64 `json:"bytes_down,omitempty" gorm:"default:null"`
 	// ClosedReason of the conneciont
 	ClosedReason string `json:"closed_reason,omitempty" gorm:"default:null"`
+	// RecordingId of the connection, if the connection was recorded
+	RecordingId string `json:"recording_id,omitempty" gorm:"default:null"`
 	// CreateTime from the RDBMS
 	CreateTime *timestamp.Timestamp `json:"create_time,omitempty" gorm:"default:current_timestamp"`
 	// UpdateTime from the RDBMS
 	UpdateTime *timestamp.Timestamp `json:"update_time,omitempty" gorm:"default:current_timestamp"`
 	// Version of the connection
 	Version uint32 `json:"version,omitempty" gorm:"default:null"`
+	// ClosedTime of the connection, if it has been closed. It's read from the
+	// connection's closed state and is ignored during write operations.
+	ClosedTime *timestamp.Timestamp `json:"closed_time,omitempty" gorm:"-"`
+	// WorkerChain of the connection holds the ids of the workers the
+	// connection was proxied through, starting with the worker the client
+	// connected to. It is empty when a single worker handled the connection
+	// and is ignored during write operations.
+	WorkerChain []string `json:"worker_chain,omitempty" gorm:"-"`
 
 	tableName string `gorm:"-"`
 }
@@ -86,8 +96,13 @@ func (c *Connection) Clone() interface{} {
 		BytesUp:            c.BytesUp,
 		BytesDown:          c.BytesDown,
 		ClosedReason:       c.ClosedReason,
+		RecordingId:        c.RecordingId,
 		Version:            c.Version,
 	}
+	if c.WorkerChain != nil {
+		clone.WorkerChain = make([]string, len(c.WorkerChain))
+		copy(clone.WorkerChain, c.WorkerChain)
+	}
 	if c.CreateTime != nil {
 		clone.CreateTime = &timestamp.Timestamp{
 			Timestamp: &timestamppb.Timestamp{
@@ -104,6 +119,14 @@ func (c *Connection) Clone() interface{} {
 			},
 		}
 	}
+	if c.ClosedTime != nil {
+		clone.ClosedTime = &timestamp.Timestamp{
+			Timestamp: &timestamppb.Timestamp{
+				Seconds: c.ClosedTime.Timestamp.Seconds,
+				Nanos:   c.ClosedTime.Timestamp.Nanos,
+			},
+		}
+	}
 	return clone
 }
 
@@ -0,0 +1,64 @@
+package session
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// connectionWorker is the database representation of one hop in the chain of
+// workers a connection was proxied through. Hop 0 is the worker the client
+// connected to and the last hop is the worker which connected to the endpoint.
+type connectionWorker struct {
+	ConnectionId string               `json:"connection_id,omitempty" gorm:"primary_key"`
+	Hop          int32                `json:"hop,omitempty" gorm:"primary_key"`
+	WorkerId     string               `json:"worker_id,omitempty" gorm:"default:null"`
+	CreateTime   *timestamp.Timestamp `json:"create_time,omitempty" gorm:"default:current_timestamp"`
+
+	tableName string `gorm:"-"`
+}
+
+// TableName returns the table name.
+func (c *connectionWorker) TableName() string {
+	if c.tableName != "" {
+		return c.tableName
+	}
+	return "session_connection_worker"
+}
+
+// SetTableName sets the table name.
+func (c *connectionWorker) SetTableName(n string) {
+	c.tableName = n
+}
+
+// fetchWorkerChains returns the worker chains of the given connections keyed
+// by connection id. Connections which were not forwarded between workers have
+// no chain.
+func fetchWorkerChains(ctx context.Context, r db.Reader, connectionIds []string) (map[string][]string, error) {
+	const op = "session.fetchWorkerChains"
+	if len(connectionIds) == 0 {
+		return nil, nil
+	}
+	var hops []*connectionWorker
+	if err := r.SearchWhere(ctx, &hops, "connection_id in (?)", []interface{}{connectionIds}, db.WithOrder("connection_id, hop")); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	chains := make(map[string][]string)
+	for _, h := range hops {
+		chains[h.ConnectionId] = append(chains[h.ConnectionId], h.WorkerId)
+	}
+	return chains, nil
+}
+
+// fetchWorkerChain returns the worker chain of the connection with the given
+// id, or nil if the connection was not forwarded between workers.
+func fetchWorkerChain(ctx context.Context, r db.Reader, connectionId string) ([]string, error) {
+	const op = "session.fetchWorkerChain"
+	chains, err := fetchWorkerChains(ctx, r, []string{connectionId})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return chains[connectionId], nil
+}
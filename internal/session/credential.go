@@ -0,0 +1,61 @@
+package session
+
+import (
+	"context"
+	"crypto/sha256"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-kms-wrapping/structwrapping"
+)
+
+// Credential represents the secret data of a credential issued for a session
+// which is given to the worker handling the session but never returned to the
+// user, such as an egress credential.
+type Credential []byte
+
+// sessionCredential is the database representation of a Credential.
+type sessionCredential struct {
+	SessionId        string `json:"session_id,omitempty" gorm:"default:null"`
+	Credential       []byte `json:"credential,omitempty" gorm:"-" wrapping:"pt,credential"`
+	CtCredential     []byte `json:"ct_credential,omitempty" gorm:"column:credential;not_null" wrapping:"ct,credential"`
+	KeyId            string `json:"key_id,omitempty" gorm:"not_null"`
+	CredentialSha256 []byte `json:"credential_sha256,omitempty" gorm:"not_null"`
+
+	tableName string `gorm:"-"`
+}
+
+// TableName returns the table name.
+func (c *sessionCredential) TableName() string {
+	if c.tableName != "" {
+		return c.tableName
+	}
+	return "session_credential"
+}
+
+// SetTableName sets the table name.
+func (c *sessionCredential) SetTableName(n string) {
+	c.tableName = n
+}
+
+func (c *sessionCredential) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "session.(sessionCredential).encrypt"
+	if len(c.Credential) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "no credential")
+	}
+	if err := structwrapping.WrapStruct(ctx, cipher, c, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	c.KeyId = cipher.KeyID()
+	sum := sha256.Sum256(c.Credential)
+	c.CredentialSha256 = sum[:]
+	return nil
+}
+
+func (c *sessionCredential) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "session.(sessionCredential).decrypt"
+	if err := structwrapping.UnwrapStruct(ctx, cipher, c, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
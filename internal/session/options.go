@@ -29,6 +29,9 @@ type options struct {
 	withSessionIds        []string
 	withServerId          string
 	withDbOpts            []db.Option
+	withStartPageAfterId  *string
+	withWorkerChain       []string
+	withSessionLimits     []SessionLimit
 }
 
 func getDefaultOptions() options {
@@ -108,3 +111,29 @@ func WithDbOpts(opts ...db.Option) Option {
 		o.withDbOpts = opts
 	}
 }
+
+// WithStartPageAfterId provides an option to list sessions ordered by public id,
+// starting after the session with the provided id. An empty id starts with the
+// first session. Combined with WithLimit it returns a page of a listing.
+func WithStartPageAfterId(id string) Option {
+	return func(o *options) {
+		o.withStartPageAfterId = &id
+	}
+}
+
+// WithWorkerChain provides an option with the ids of the workers which
+// forwarded a connection to the worker authorizing it, starting with the
+// worker the client connected to.
+func WithWorkerChain(ids []string) Option {
+	return func(o *options) {
+		o.withWorkerChain = ids
+	}
+}
+
+// WithSessionLimits provides an option with the limits of concurrent sessions
+// a new session must not exceed.
+func WithSessionLimits(limits ...SessionLimit) Option {
+	return func(o *options) {
+		o.withSessionLimits = limits
+	}
+}
@@ -82,4 +82,30 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withServerId = "worker1"
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithStartPageAfterId", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithStartPageAfterId("s_1234567890"))
+		testOpts := getDefaultOptions()
+		id := "s_1234567890"
+		testOpts.withStartPageAfterId = &id
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithWorkerChain", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithWorkerChain([]string{"worker1", "worker2"}))
+		testOpts := getDefaultOptions()
+		testOpts.withWorkerChain = []string{"worker1", "worker2"}
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithSessionLimits", func(t *testing.T) {
+		assert := assert.New(t)
+		limits := []SessionLimit{
+			{Kind: TargetSessionLimit, ResourceId: "ttcp_1234567890", Limit: 1},
+			{Kind: UserSessionLimit, ResourceId: "p_1234567890", Limit: 2},
+		}
+		opts := getOpts(WithSessionLimits(limits...))
+		testOpts := getDefaultOptions()
+		testOpts.withSessionLimits = limits
+		assert.Equal(opts, testOpts)
+	})
 }
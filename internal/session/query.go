@@ -23,6 +23,67 @@ with not_active as (
 select * from not_active;
 `
 
+	// approveSessionCte inserts the pending state for a session which is
+	// pending approval, recording the approver.
+	approveSessionCte = `
+insert into session_state (session_id, state, approver_id)
+with approved as (
+	select session_id, 'pending' as state, @approver_id as approver_id
+	from
+		session s,
+		session_state ss
+	where
+		s.public_id = ss.session_id and
+		ss.state = 'pending_approval' and
+		ss.end_time is null and
+		ss.session_id = @session_id and
+		s.version = @version
+)
+select * from approved;
+`
+
+	// sessionApproval returns the user and current state of a session and
+	// whether the approver may approve it. The approver must be one of the
+	// target's approvers or a member of one of its approver groups, so
+	// sessions for a target without approvers cannot be approved.
+	sessionApproval = `
+select
+	s.user_id,
+	ss.state,
+	(
+		exists (
+			select 1 
+			from target_approver_user tau 
+			where tau.target_id = s.target_id and tau.principal_id = @approver_id
+		) or
+		exists (
+			select 1
+			from 
+				target_approver_group tag,
+				iam_group_member_user gm
+			where
+				tag.principal_id = gm.group_id and
+				tag.target_id = s.target_id and
+				gm.member_id = @approver_id
+		)
+	) as is_approver
+from
+	session s,
+	session_state ss
+where
+	s.public_id = ss.session_id and
+	ss.end_time is null and
+	s.public_id = @session_id;
+`
+
+	// denySessionState inserts the terminated state for a session which is
+	// pending approval, recording the approver.
+	denySessionState = `
+insert into session_state (session_id, state, approver_id)
+values
+	(@session_id, 'terminated', @approver_id);
+`
+
 	// updateSessionState checks that we don't already have a row for the new
 	// state or it's not already terminated (final state) before inserting a new
 	// state.
@@ -162,6 +223,89 @@ where
 %s
 `
 
+	// sessionListPage returns a page of sessions ordered by public id. Unlike
+	// sessionList, the conditions are applied before the limit so a page is
+	// never short of sessions that match them.
+	sessionListPage = `
+select *
+from
+	(select public_id from session s %s order by public_id asc %s) s,
+	session_list ss
+where
+	s.public_id = ss.public_id
+order by s.public_id asc
+`
+
+	// sessionHolders returns the sessions which count against session limits:
+	// sessions which are pending approval, pending or active and have not
+	// expired. The caller adds the conditions selecting the sessions.
+	sessionHolders = `
+select
+	s.public_id as session_id,
+	s.user_id,
+	s.target_id,
+	ss.state as status
+from
+	session s,
+	session_state ss
+where
+	s.public_id = ss.session_id and
+	ss.end_time is null and
+	ss.state in ('pending_approval', 'pending', 'active') and
+	s.expiration_time > now() and
+	%s
+order by s.create_time asc;
+`
+
+	// lockTargetForSessionLimit and lockUserForSessionLimit serialize the
+	// creation of sessions counting against the limits of a target or user,
+	// so concurrent transactions can't all see the limit as not reached.
+	lockTargetForSessionLimit = `
+select public_id from target where public_id = @target_id for update;
+`
+	lockUserForSessionLimit = `
+select public_id from iam_user where public_id = @user_id for update;
+`
+
+	// sessionUsage returns the number of connections made in each session and
+	// the bytes proxied on them. The caller adds the conditions selecting the
+	// sessions.
+	sessionUsage = `
+select
+	s.public_id as session_id,
+	s.user_id,
+	s.target_id,
+	s.scope_id,
+	count(sc.public_id) as connection_count,
+	coalesce(sum(sc.bytes_up), 0) as bytes_up,
+	coalesce(sum(sc.bytes_down), 0) as bytes_down
+from
+	session s
+left join
+	session_connection sc
+on
+	s.public_id = sc.session_id
+where
+	%s
+group by s.public_id
+order by s.public_id;
+`
+
+	// updateConnectionBytes sets the bytes proxied on a connection handled by
+	// the worker which has not been closed yet. Connections whose bytes have
+	// not changed are not updated.
+	updateConnectionBytes = `
+update session_connection
+	set
+		bytes_up = @bytes_up,
+		bytes_down = @bytes_down
+where
+	public_id = @public_id and
+	server_id = @worker_id and
+	closed_reason is null and
+	(bytes_up is distinct from @bytes_up or bytes_down is distinct from @bytes_down);
+`
+
 	// termSessionUpdate is one stmt that terminates sessions for the following
 	// reasons:
 	//	* sessions that are expired and all their connections are closed.
@@ -382,3 +526,15 @@ func batchInsertsessionCredentialDynamic(creds []*DynamicCredential) (string, []
 
 	return q, batchInsertArgs, nil
 }
+
+const (
+	// rewrapTofuTokensWhere selects the sessions with a tofu token which is
+	// not encrypted with the latest version of the database key of their
+	// scope.
+	rewrapTofuTokensWhere = `
+tofu_token is not null
+and (
+  tofu_token_key_id is null
+  or tofu_token_key_id not in (select private_id from kms_latest_database_key_version)
+)`
+)
@@ -0,0 +1,40 @@
+package recording
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+const (
+	// RecordingPrefix for recording ids
+	RecordingPrefix = "sr"
+)
+
+// validId restricts recording ids to the characters produced by
+// db.NewPublicId so that an id can never be used to escape the storage
+// location of a backend.
+var validId = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+
+// NewId creates a new recording id.
+func NewId(ctx context.Context) (string, error) {
+	const op = "recording.NewId"
+	id, err := db.NewPublicId(RecordingPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
+
+func validateId(ctx context.Context, id string) error {
+	const op = "recording.validateId"
+	if id == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing recording id")
+	}
+	if !validId.MatchString(id) {
+		return errors.New(ctx, errors.InvalidParameter, op, "invalid recording id")
+	}
+	return nil
+}
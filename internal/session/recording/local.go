@@ -0,0 +1,69 @@
+package recording
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+const localFileExtension = ".rec"
+
+// LocalStorage stores recordings as files in a directory on the local
+// filesystem. When the controller and workers are not running on the same
+// host the directory must be shared between them for recordings to be read
+// back through the API.
+type LocalStorage struct {
+	path string
+}
+
+var _ Storage = (*LocalStorage)(nil)
+
+// NewLocalStorage creates a LocalStorage rooted at path. The directory is
+// created if it does not exist.
+func NewLocalStorage(ctx context.Context, path string) (*LocalStorage, error) {
+	const op = "recording.NewLocalStorage"
+	if path == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing path")
+	}
+	if err := os.MkdirAll(path, 0o700); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Io), errors.WithMsg("unable to create storage directory"))
+	}
+	return &LocalStorage{path: path}, nil
+}
+
+// Create implements Storage.
+func (s *LocalStorage) Create(ctx context.Context, id string) (io.WriteCloser, error) {
+	const op = "recording.(LocalStorage).Create"
+	if err := validateId(ctx, id); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	f, err := os.OpenFile(s.filename(id), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Io), errors.WithMsg(fmt.Sprintf("unable to create recording %s", id)))
+	}
+	return f, nil
+}
+
+// Open implements Storage.
+func (s *LocalStorage) Open(ctx context.Context, id string) (io.ReadCloser, error) {
+	const op = "recording.(LocalStorage).Open"
+	if err := validateId(ctx, id); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	f, err := os.Open(s.filename(id))
+	switch {
+	case os.IsNotExist(err):
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("recording %s not found", id))
+	case err != nil:
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Io), errors.WithMsg(fmt.Sprintf("unable to open recording %s", id)))
+	}
+	return f, nil
+}
+
+func (s *LocalStorage) filename(id string) string {
+	return filepath.Join(s.path, id+localFileExtension)
+}
@@ -0,0 +1,62 @@
+package recording
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStorage(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	_, err := NewLocalStorage(ctx, "")
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+
+	dir := filepath.Join(t.TempDir(), "nested", "recordings")
+	s, err := NewLocalStorage(ctx, dir)
+	require.NoError(t, err)
+
+	_, err = s.Open(ctx, "sr_1234567890")
+	assert.True(t, errors.IsNotFoundError(err))
+
+	w, err := s.Create(ctx, "sr_1234567890")
+	require.NoError(t, err)
+	_, err = w.Write([]byte("recording"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	_, err = s.Create(ctx, "sr_1234567890")
+	assert.True(t, errors.Match(errors.T(errors.Io), err))
+
+	r, err := s.Open(ctx, "sr_1234567890")
+	require.NoError(t, err)
+	got, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	assert.Equal(t, "recording", string(got))
+
+	_, err = s.Open(ctx, "../../etc/passwd")
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
+
+func TestNewStorage(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	s, err := NewStorage(ctx, "", t.TempDir())
+	require.NoError(t, err)
+	assert.IsType(t, &LocalStorage{}, s)
+
+	s, err = NewStorage(ctx, LocalStorageType, t.TempDir())
+	require.NoError(t, err)
+	assert.IsType(t, &LocalStorage{}, s)
+
+	_, err = NewStorage(ctx, "s3", t.TempDir())
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
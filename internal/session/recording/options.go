@@ -0,0 +1,36 @@
+package recording
+
+import "time"
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withNow func() time.Time
+}
+
+func getDefaultOptions() options {
+	return options{
+		withNow: time.Now,
+	}
+}
+
+// WithNow provides an optional function used to timestamp chunks. It is
+// primarily useful in tests.
+func WithNow(fn func() time.Time) Option {
+	return func(o *options) {
+		if fn != nil {
+			o.withNow = fn
+		}
+	}
+}
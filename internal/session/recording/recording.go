@@ -0,0 +1,318 @@
+// Package recording provides the recording of the bytes proxied for a session
+// connection, and the storage backends those recordings are written to.
+//
+// A recording is a header followed by a sequence of chunks. Each chunk holds
+// the time it was captured, the direction the bytes were travelling in and
+// the bytes themselves:
+//
+//	header: magic "BREC" | version (1 byte)
+//	chunk:  unix nanos (8 bytes) | direction (1 byte) | length (4 bytes) | data
+//
+// All integers are big endian.
+package recording
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+const (
+	magic         = "BREC"
+	formatVersion = 1
+
+	// MaxChunkSize is the largest number of bytes stored in a single chunk.
+	// Larger writes are split across multiple chunks.
+	MaxChunkSize = 32 * 1024
+
+	chunkHeaderSize = 8 + 1 + 4
+)
+
+// Direction is the direction in which the bytes of a chunk were travelling.
+type Direction uint8
+
+const (
+	UnknownDirection Direction = 0
+	// Up is data sent from the client to the endpoint.
+	Up Direction = 1
+	// Down is data sent from the endpoint to the client.
+	Down Direction = 2
+)
+
+// String returns the string representation of the direction.
+func (d Direction) String() string {
+	switch d {
+	case Up:
+		return "up"
+	case Down:
+		return "down"
+	default:
+		return "unknown"
+	}
+}
+
+// Chunk is a single timestamped piece of a recording.
+type Chunk struct {
+	Time      time.Time
+	Direction Direction
+	Data      []byte
+}
+
+// Recorder writes a recording for a single connection. It is safe for
+// concurrent use, which allows both directions of a proxied connection to be
+// recorded at the same time.
+type Recorder struct {
+	id string
+
+	l      sync.Mutex
+	w      io.WriteCloser
+	closed bool
+	now    func() time.Time
+}
+
+// NewRecorder creates a new recording with the given id in storage. Close
+// must be called once the connection has finished.
+func NewRecorder(ctx context.Context, storage Storage, id string, opt ...Option) (*Recorder, error) {
+	const op = "recording.NewRecorder"
+	if storage == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing storage")
+	}
+	if err := validateId(ctx, id); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	opts := getOpts(opt...)
+
+	w, err := storage.Create(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	hdr := append([]byte(magic), formatVersion)
+	if _, err := w.Write(hdr); err != nil {
+		_ = w.Close()
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Io), errors.WithMsg("unable to write recording header"))
+	}
+	return &Recorder{
+		id:  id,
+		w:   w,
+		now: opts.withNow,
+	}, nil
+}
+
+// Id returns the id of the recording.
+func (r *Recorder) Id() string {
+	return r.id
+}
+
+// Record writes data as one or more chunks travelling in direction d.
+func (r *Recorder) Record(d Direction, data []byte) error {
+	r.l.Lock()
+	defer r.l.Unlock()
+	if r.closed {
+		return fmt.Errorf("recording %s is closed", r.id)
+	}
+	ts := r.now()
+	var hdr [chunkHeaderSize]byte
+	for len(data) > 0 {
+		n := len(data)
+		if n > MaxChunkSize {
+			n = MaxChunkSize
+		}
+		binary.BigEndian.PutUint64(hdr[0:8], uint64(ts.UnixNano()))
+		hdr[8] = byte(d)
+		binary.BigEndian.PutUint32(hdr[9:13], uint32(n))
+		if _, err := r.w.Write(hdr[:]); err != nil {
+			return fmt.Errorf("error writing chunk header for recording %s: %w", r.id, err)
+		}
+		if _, err := r.w.Write(data[:n]); err != nil {
+			return fmt.Errorf("error writing chunk data for recording %s: %w", r.id, err)
+		}
+		data = data[n:]
+	}
+	return nil
+}
+
+// Writer returns an io.Writer that records everything written to it as
+// travelling in direction d. It is intended to be used with io.TeeReader or
+// io.MultiWriter.
+func (r *Recorder) Writer(d Direction) io.Writer {
+	return directionWriter{r: r, d: d}
+}
+
+// Close closes the recording. Calling Close more than once has no effect.
+func (r *Recorder) Close() error {
+	r.l.Lock()
+	defer r.l.Unlock()
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+	return r.w.Close()
+}
+
+type directionWriter struct {
+	r *Recorder
+	d Direction
+}
+
+func (w directionWriter) Write(p []byte) (int, error) {
+	if err := w.r.Record(w.d, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Reader reads the chunks of a recording.
+type Reader struct {
+	r io.Reader
+}
+
+// NewReader creates a Reader for the recording in r, validating its header.
+func NewReader(ctx context.Context, r io.Reader) (*Reader, error) {
+	const op = "recording.NewReader"
+	if r == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing reader")
+	}
+	hdr := make([]byte, len(magic)+1)
+	if _, err := io.ReadFull(r, hdr); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Decode), errors.WithMsg("unable to read recording header"))
+	}
+	if string(hdr[:len(magic)]) != magic {
+		return nil, errors.New(ctx, errors.Decode, op, "not a recording")
+	}
+	if v := hdr[len(magic)]; v != formatVersion {
+		return nil, errors.New(ctx, errors.Decode, op, fmt.Sprintf("unsupported recording version %d", v))
+	}
+	return &Reader{r: r}, nil
+}
+
+// Next returns the next chunk in the recording. io.EOF is returned once all
+// chunks have been read.
+func (r *Reader) Next(ctx context.Context) (*Chunk, error) {
+	const op = "recording.(Reader).Next"
+	var hdr [chunkHeaderSize]byte
+	switch _, err := io.ReadFull(r.r, hdr[:]); {
+	case err == io.EOF:
+		return nil, io.EOF
+	case err != nil:
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Decode), errors.WithMsg("unable to read chunk header"))
+	}
+	n := binary.BigEndian.Uint32(hdr[9:13])
+	if n > MaxChunkSize {
+		return nil, errors.New(ctx, errors.Decode, op, fmt.Sprintf("chunk size %d exceeds maximum", n))
+	}
+	c := &Chunk{
+		Time:      time.Unix(0, int64(binary.BigEndian.Uint64(hdr[0:8]))),
+		Direction: Direction(hdr[8]),
+		Data:      make([]byte, n),
+	}
+	if _, err := io.ReadFull(r.r, c.Data); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Decode), errors.WithMsg("unable to read chunk data"))
+	}
+	return c, nil
+}
+
+// skip discards the next chunk in the recording without reading its data
+// into memory. io.EOF is returned once all chunks have been read.
+func (r *Reader) skip(ctx context.Context) error {
+	const op = "recording.(Reader).skip"
+	var hdr [chunkHeaderSize]byte
+	switch _, err := io.ReadFull(r.r, hdr[:]); {
+	case err == io.EOF:
+		return io.EOF
+	case err != nil:
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decode), errors.WithMsg("unable to read chunk header"))
+	}
+	n := binary.BigEndian.Uint32(hdr[9:13])
+	if n > MaxChunkSize {
+		return errors.New(ctx, errors.Decode, op, fmt.Sprintf("chunk size %d exceeds maximum", n))
+	}
+	if _, err := io.CopyN(io.Discard, r.r, int64(n)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decode), errors.WithMsg("unable to read chunk data"))
+	}
+	return nil
+}
+
+// ReadPage reads the chunks of the recording with the given id from storage,
+// starting with the chunk at index offset. Chunks are read until their data
+// exceeds maxBytes, so at least one chunk is returned unless the end of the
+// recording has been reached. The index of the first chunk which was not read
+// is returned as next, which is 0 once every chunk has been read.
+func ReadPage(ctx context.Context, storage Storage, id string, offset uint32, maxBytes int) (chunks []*Chunk, next uint32, err error) {
+	const op = "recording.ReadPage"
+	if storage == nil {
+		return nil, 0, errors.New(ctx, errors.InvalidParameter, op, "missing storage")
+	}
+	if maxBytes <= 0 {
+		return nil, 0, errors.New(ctx, errors.InvalidParameter, op, "max bytes must be greater than 0")
+	}
+	rc, err := storage.Open(ctx, id)
+	if err != nil {
+		return nil, 0, errors.Wrap(ctx, err, op)
+	}
+	defer rc.Close()
+	r, err := NewReader(ctx, rc)
+	if err != nil {
+		return nil, 0, errors.Wrap(ctx, err, op)
+	}
+	for i := uint32(0); i < offset; i++ {
+		switch err := r.skip(ctx); {
+		case err == io.EOF:
+			return nil, 0, nil
+		case err != nil:
+			return nil, 0, errors.Wrap(ctx, err, op)
+		}
+	}
+	var size int
+	for next = offset; size < maxBytes; next++ {
+		c, err := r.Next(ctx)
+		if err == io.EOF {
+			return chunks, 0, nil
+		}
+		if err != nil {
+			return nil, 0, errors.Wrap(ctx, err, op)
+		}
+		chunks = append(chunks, c)
+		size += len(c.Data)
+	}
+	// Only report a next page if there is at least one more chunk.
+	switch err := r.skip(ctx); {
+	case err == io.EOF:
+		return chunks, 0, nil
+	case err != nil:
+		return nil, 0, errors.Wrap(ctx, err, op)
+	}
+	return chunks, next, nil
+}
+
+// ReadAll reads every chunk of the recording with the given id from storage.
+func ReadAll(ctx context.Context, storage Storage, id string) ([]*Chunk, error) {
+	const op = "recording.ReadAll"
+	if storage == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing storage")
+	}
+	rc, err := storage.Open(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rc.Close()
+	r, err := NewReader(ctx, rc)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	var chunks []*Chunk
+	for {
+		c, err := r.Next(ctx)
+		if err == io.EOF {
+			return chunks, nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		chunks = append(chunks, c)
+	}
+}
@@ -0,0 +1,221 @@
+package recording
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	storage, err := NewLocalStorage(ctx, t.TempDir())
+	require.NoError(t, err)
+
+	ts := time.Date(2021, 11, 1, 12, 0, 0, 0, time.UTC)
+	now := func() time.Time { return ts }
+
+	id, err := NewId(ctx)
+	require.NoError(t, err)
+	rec, err := NewRecorder(ctx, storage, id, WithNow(now))
+	require.NoError(t, err)
+	assert.Equal(t, id, rec.Id())
+
+	_, err = rec.Writer(Up).Write([]byte("ping"))
+	require.NoError(t, err)
+	ts = ts.Add(time.Second)
+	big := bytes.Repeat([]byte("a"), MaxChunkSize+10)
+	_, err = rec.Writer(Down).Write(big)
+	require.NoError(t, err)
+	require.NoError(t, rec.Close())
+	require.NoError(t, rec.Close())
+
+	_, err = rec.Writer(Up).Write([]byte("closed"))
+	assert.Error(t, err)
+
+	chunks, err := ReadAll(ctx, storage, id)
+	require.NoError(t, err)
+	require.Len(t, chunks, 3)
+
+	assert.Equal(t, Up, chunks[0].Direction)
+	assert.Equal(t, []byte("ping"), chunks[0].Data)
+	assert.True(t, chunks[0].Time.Equal(ts.Add(-time.Second)))
+
+	assert.Equal(t, Down, chunks[1].Direction)
+	assert.Len(t, chunks[1].Data, MaxChunkSize)
+	assert.True(t, chunks[1].Time.Equal(ts))
+	assert.Equal(t, Down, chunks[2].Direction)
+	assert.Len(t, chunks[2].Data, 10)
+}
+
+func TestNewRecorder(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	storage, err := NewLocalStorage(ctx, t.TempDir())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		storage Storage
+		id      string
+		wantErr errors.Code
+	}{
+		{
+			name:    "missing-storage",
+			id:      "sr_1234567890",
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "missing-id",
+			storage: storage,
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "path-in-id",
+			storage: storage,
+			id:      "../sr_1234567890",
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "valid",
+			storage: storage,
+			id:      "sr_1234567890",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			rec, err := NewRecorder(ctx, tt.storage, tt.id)
+			if tt.wantErr != 0 {
+				assert.Truef(t, errors.Match(errors.T(tt.wantErr), err), "want err code: %q got: %q", tt.wantErr, err)
+				assert.Nil(t, rec)
+				return
+			}
+			require.NoError(t, err)
+			assert.NoError(t, rec.Close())
+		})
+	}
+}
+
+func TestNewReader(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	_, err := NewReader(ctx, nil)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+
+	_, err = NewReader(ctx, strings.NewReader("BR"))
+	assert.True(t, errors.Match(errors.T(errors.Decode), err))
+
+	_, err = NewReader(ctx, strings.NewReader("NOPE\x01"))
+	assert.True(t, errors.Match(errors.T(errors.Decode), err))
+
+	_, err = NewReader(ctx, strings.NewReader("BREC\x02"))
+	assert.True(t, errors.Match(errors.T(errors.Decode), err))
+
+	r, err := NewReader(ctx, strings.NewReader("BREC\x01"))
+	require.NoError(t, err)
+	_, err = r.Next(ctx)
+	assert.Error(t, err)
+}
+
+func TestReadPage(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	storage, err := NewLocalStorage(ctx, t.TempDir())
+	require.NoError(t, err)
+
+	id, err := NewId(ctx)
+	require.NoError(t, err)
+	rec, err := NewRecorder(ctx, storage, id)
+	require.NoError(t, err)
+	for _, d := range []string{"one", "two", "three", "four"} {
+		require.NoError(t, rec.Record(Up, []byte(d)))
+	}
+	require.NoError(t, rec.Close())
+
+	tests := []struct {
+		name     string
+		storage  Storage
+		offset   uint32
+		maxBytes int
+		want     []string
+		wantNext uint32
+		wantErr  errors.Code
+	}{
+		{
+			name:     "missing-storage",
+			maxBytes: 10,
+			wantErr:  errors.InvalidParameter,
+		},
+		{
+			name:    "missing-max-bytes",
+			storage: storage,
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:     "everything",
+			storage:  storage,
+			maxBytes: 100,
+			want:     []string{"one", "two", "three", "four"},
+		},
+		{
+			name:     "first-page",
+			storage:  storage,
+			maxBytes: 5,
+			want:     []string{"one", "two"},
+			wantNext: 2,
+		},
+		{
+			name:     "chunk-larger-than-max",
+			storage:  storage,
+			offset:   2,
+			maxBytes: 1,
+			want:     []string{"three"},
+			wantNext: 3,
+		},
+		{
+			name:     "last-page",
+			storage:  storage,
+			offset:   2,
+			maxBytes: 9,
+			want:     []string{"three", "four"},
+		},
+		{
+			name:     "last-page-exactly-full",
+			storage:  storage,
+			offset:   3,
+			maxBytes: 4,
+			want:     []string{"four"},
+		},
+		{
+			name:     "past-the-end",
+			storage:  storage,
+			offset:   10,
+			maxBytes: 10,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			chunks, next, err := ReadPage(ctx, tt.storage, id, tt.offset, tt.maxBytes)
+			if tt.wantErr != 0 {
+				assert.Truef(t, errors.Match(errors.T(tt.wantErr), err), "want err code: %q got: %q", tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			var got []string
+			for _, c := range chunks {
+				got = append(got, string(c.Data))
+			}
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantNext, next)
+		})
+	}
+}
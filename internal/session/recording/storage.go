@@ -0,0 +1,47 @@
+package recording
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// StorageType defines the type of backend a recording is stored in.
+type StorageType string
+
+const (
+	// LocalStorageType stores recordings as files on the local filesystem.
+	LocalStorageType StorageType = "local"
+)
+
+// Storage is implemented by recording storage backends. A recording is
+// written once, by the worker proxying the connection, and may then be read
+// any number of times.
+type Storage interface {
+	// Create returns a writer for a new recording with the given id. It is an
+	// error to create a recording for an id which already exists.
+	Create(ctx context.Context, id string) (io.WriteCloser, error)
+
+	// Open returns a reader for the recording with the given id. If the
+	// recording does not exist an errors.RecordNotFound error is returned.
+	Open(ctx context.Context, id string) (io.ReadCloser, error)
+}
+
+// NewStorage creates a Storage of the given type. Path is interpreted by the
+// backend; for LocalStorageType it is the directory recordings are written
+// to. An empty storage type defaults to LocalStorageType.
+func NewStorage(ctx context.Context, storageType StorageType, path string) (Storage, error) {
+	const op = "recording.NewStorage"
+	switch storageType {
+	case "", LocalStorageType:
+		s, err := NewLocalStorage(ctx, path)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		return s, nil
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown storage type %q", storageType))
+	}
+}
@@ -126,6 +126,7 @@ func (r *Repository) convertToSessions(ctx context.Context, sessionList []*sessi
 				Version:           sv.Version,
 				Endpoint:          sv.Endpoint,
 				ConnectionLimit:   sv.ConnectionLimit,
+				ApprovalRequired:  sv.ApprovalRequired,
 				KeyId:             sv.KeyId,
 			}
 			if opts.withListingConvert {
@@ -154,6 +155,7 @@ func (r *Repository) convertToSessions(ctx context.Context, sessionList []*sessi
 				PreviousEndTime: sv.PreviousEndTime,
 				StartTime:       sv.StartTime,
 				EndTime:         sv.EndTime,
+				ApproverId:      sv.ApproverId,
 			}
 		}
 
@@ -169,6 +171,9 @@ func (r *Repository) convertToSessions(ctx context.Context, sessionList []*sessi
 					BytesUp:            sv.BytesUp,
 					BytesDown:          sv.BytesDown,
 					ClosedReason:       sv.ClosedReason,
+					RecordingId:        sv.RecordingId,
+					CreateTime:         sv.ConnectionCreateTime,
+					ClosedTime:         sv.ConnectionClosedTime,
 				}
 			}
 		}
@@ -37,6 +37,9 @@ func (r *Repository) LookupConnection(ctx context.Context, connectionId string,
 				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", connectionId)))
 			}
 			var err error
+			if connection.WorkerChain, err = fetchWorkerChain(ctx, read, connectionId); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
 			if states, err = fetchConnectionStates(ctx, read, connectionId, db.WithOrder("start_time desc")); err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
@@ -267,6 +270,62 @@ func (r *Repository) ShouldCloseConnectionsOnWorker(ctx context.Context, foundCo
 	return result, nil
 }
 
+// ConnectionBytes is the number of bytes proxied on a connection, as reported
+// by the worker handling it.
+type ConnectionBytes struct {
+	ConnectionId string
+	BytesUp      uint64
+	BytesDown    uint64
+}
+
+// UpdateConnectionBytes sets the bytes proxied on connections which are still
+// open. It's called with the bytes reported by a worker in its status while
+// the connections are open; the final bytes are set when the connections are
+// closed by CloseConnections. Connections which have been closed, whose bytes
+// have not changed, or which are not handled by the worker are skipped, so a
+// worker can't set the bytes of another worker's connections. The number of
+// connections updated is returned.
+func (r *Repository) UpdateConnectionBytes(ctx context.Context, workerId string, connBytes []ConnectionBytes, _ ...Option) (int, error) {
+	const op = "session.(Repository).UpdateConnectionBytes"
+	if workerId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing worker id")
+	}
+	if len(connBytes) == 0 {
+		return db.NoRowsAffected, nil // nothing to do
+	}
+	for _, cb := range connBytes {
+		if cb.ConnectionId == "" {
+			return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing connection id")
+		}
+	}
+	var rowsUpdated int
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			rowsUpdated = 0
+			for _, cb := range connBytes {
+				n, err := w.Exec(ctx, updateConnectionBytes, []interface{}{
+					sql.Named("public_id", cb.ConnectionId),
+					sql.Named("worker_id", workerId),
+					sql.Named("bytes_up", cb.BytesUp),
+					sql.Named("bytes_down", cb.BytesDown),
+				})
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to update connection %s", cb.ConnectionId)))
+				}
+				rowsUpdated += n
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	return rowsUpdated, nil
+}
+
 func fetchConnectionStates(ctx context.Context, r db.Reader, connectionId string, opt ...db.Option) ([]*ConnectionState, error) {
 	const op = "session.fetchConnectionStates"
 	var states []*ConnectionState
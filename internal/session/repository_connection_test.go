@@ -769,3 +769,92 @@ func testIsConnectionClosed(ctx context.Context, t *testing.T, repo *Repository,
 	// start time, descending.
 	return states[0].Status == StatusClosed
 }
+
+func TestRepository_UpdateConnectionBytes(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	rw := db.New(conn)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+	ctx := context.Background()
+	worker := TestWorker(t, conn, wrapper)
+	otherWorker := TestWorker(t, conn, wrapper)
+
+	t.Run("missing-worker-id", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		n, err := repo.UpdateConnectionBytes(ctx, "", []ConnectionBytes{{ConnectionId: "sc_1234567890", BytesUp: 1}})
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+		assert.Equal(0, n)
+	})
+	t.Run("missing-connection-id", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		n, err := repo.UpdateConnectionBytes(ctx, worker.PrivateId, []ConnectionBytes{{BytesUp: 1}})
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+		assert.Equal(0, n)
+	})
+	t.Run("no-connections", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		n, err := repo.UpdateConnectionBytes(ctx, worker.PrivateId, nil)
+		require.NoError(err)
+		assert.Equal(0, n)
+	})
+	t.Run("other-worker", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		session := TestDefaultSession(t, conn, wrapper, iamRepo)
+		c := TestConnection(t, conn, session.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222, WithServerId(worker.PrivateId))
+
+		// A worker can't set the bytes of another worker's connection.
+		n, err := repo.UpdateConnectionBytes(ctx, otherWorker.PrivateId, []ConnectionBytes{
+			{ConnectionId: c.PublicId, BytesUp: 10, BytesDown: 100},
+		})
+		require.NoError(err)
+		assert.Equal(0, n)
+
+		got, _, err := repo.LookupConnection(ctx, c.PublicId)
+		require.NoError(err)
+		assert.Zero(got.BytesUp)
+		assert.Zero(got.BytesDown)
+	})
+	t.Run("open-and-closed", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		session := TestDefaultSession(t, conn, wrapper, iamRepo)
+		open := TestConnection(t, conn, session.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222, WithServerId(worker.PrivateId))
+		closed := TestConnection(t, conn, session.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222, WithServerId(worker.PrivateId))
+		_, err := repo.CloseConnections(ctx, []CloseWith{{
+			ConnectionId: closed.PublicId,
+			BytesUp:      5,
+			BytesDown:    50,
+			ClosedReason: ConnectionClosedByUser,
+		}})
+		require.NoError(err)
+
+		n, err := repo.UpdateConnectionBytes(ctx, worker.PrivateId, []ConnectionBytes{
+			{ConnectionId: open.PublicId, BytesUp: 10, BytesDown: 100},
+			{ConnectionId: closed.PublicId, BytesUp: 20, BytesDown: 200},
+		})
+		require.NoError(err)
+		assert.Equal(1, n)
+
+		// Reporting the same bytes again doesn't update the connection.
+		n, err = repo.UpdateConnectionBytes(ctx, worker.PrivateId, []ConnectionBytes{
+			{ConnectionId: open.PublicId, BytesUp: 10, BytesDown: 100},
+		})
+		require.NoError(err)
+		assert.Equal(0, n)
+
+		got, _, err := repo.LookupConnection(ctx, open.PublicId)
+		require.NoError(err)
+		assert.Equal(uint64(10), got.BytesUp)
+		assert.Equal(uint64(100), got.BytesDown)
+
+		got, _, err = repo.LookupConnection(ctx, closed.PublicId)
+		require.NoError(err)
+		assert.Equal(uint64(5), got.BytesUp)
+		assert.Equal(uint64(50), got.BytesDown)
+	})
+}
@@ -0,0 +1,89 @@
+package session
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_SessionCredentials(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	rw := db.New(conn)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	t.Run("invalid", func(t *testing.T) {
+		assert := assert.New(t)
+		s := TestDefaultSession(t, conn, wrapper, iamRepo)
+		creds := []Credential{[]byte("secret")}
+
+		err := repo.AddSessionCredentials(ctx, s.ScopeId, "", creds)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+		err = repo.AddSessionCredentials(ctx, "", s.PublicId, creds)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+		err = repo.AddSessionCredentials(ctx, s.ScopeId, s.PublicId, nil)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+		err = repo.AddSessionCredentials(ctx, s.ScopeId, s.PublicId, []Credential{nil})
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+
+		_, err = repo.ListSessionCredentials(ctx, s.ScopeId, "")
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+		_, err = repo.ListSessionCredentials(ctx, "", s.PublicId)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+	t.Run("add-and-list", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s := TestDefaultSession(t, conn, wrapper, iamRepo)
+
+		got, err := repo.ListSessionCredentials(ctx, s.ScopeId, s.PublicId)
+		require.NoError(err)
+		assert.Empty(got)
+
+		creds := []Credential{[]byte(`{"username":"user","password":"pass"}`), []byte(`{"username":"user","private_key":"key"}`)}
+		require.NoError(repo.AddSessionCredentials(ctx, s.ScopeId, s.PublicId, creds))
+
+		got, err = repo.ListSessionCredentials(ctx, s.ScopeId, s.PublicId)
+		require.NoError(err)
+		assert.ElementsMatch(creds, got)
+
+		// The same credential cannot be added to a session twice.
+		err = repo.AddSessionCredentials(ctx, s.ScopeId, s.PublicId, creds[:1])
+		assert.True(errors.Match(errors.T(errors.NotUnique), err))
+	})
+	t.Run("add-duplicates", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s := TestDefaultSession(t, conn, wrapper, iamRepo)
+
+		// The same secret returned by two credential libraries is only
+		// added once.
+		creds := []Credential{[]byte(`{"username":"user","password":"pass"}`), []byte(`{"username":"user","password":"pass"}`)}
+		require.NoError(repo.AddSessionCredentials(ctx, s.ScopeId, s.PublicId, creds))
+
+		got, err := repo.ListSessionCredentials(ctx, s.ScopeId, s.PublicId)
+		require.NoError(err)
+		assert.ElementsMatch(creds[:1], got)
+	})
+	t.Run("deleted-on-terminate", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s := TestDefaultSession(t, conn, wrapper, iamRepo)
+		require.NoError(repo.AddSessionCredentials(ctx, s.ScopeId, s.PublicId, []Credential{[]byte("secret")}))
+
+		_, err := repo.CancelSession(ctx, s.PublicId, s.Version)
+		require.NoError(err)
+
+		got, err := repo.ListSessionCredentials(ctx, s.ScopeId, s.PublicId)
+		require.NoError(err)
+		assert.Empty(got)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
@@ -18,10 +19,12 @@ import (
 )
 
 // CreateSession inserts into the repository and returns the new Session with
-// its State of "Pending".  The following fields must be empty when creating a
-// session: ServerId, ServerType, and PublicId.  No options are
-// currently supported.
-func (r *Repository) CreateSession(ctx context.Context, sessionWrapper wrapping.Wrapper, newSession *Session, _ ...Option) (*Session, ed25519.PrivateKey, error) {
+// its State of "Pending", or "Pending Approval" if the session requires
+// approval.  The following fields must be empty when creating a
+// session: ServerId, ServerType, and PublicId.  WithSessionLimits is
+// supported; if the new session would exceed one of the limits, a
+// *SessionLimitError is returned.
+func (r *Repository) CreateSession(ctx context.Context, sessionWrapper wrapping.Wrapper, newSession *Session, opt ...Option) (*Session, ed25519.PrivateKey, error) {
 	const op = "session.(Repository).CreateSession"
 	if newSession == nil {
 		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing session")
@@ -65,6 +68,7 @@ func (r *Repository) CreateSession(ctx context.Context, sessionWrapper wrapping.
 	if newSession.ExpirationTime == nil || newSession.ExpirationTime.Timestamp.AsTime().IsZero() {
 		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing expiration time")
 	}
+	opts := getOpts(opt...)
 
 	id, err := newId()
 	if err != nil {
@@ -85,6 +89,9 @@ func (r *Repository) CreateSession(ctx context.Context, sessionWrapper wrapping.
 		db.StdRetryCnt,
 		db.ExpBackoff{},
 		func(read db.Reader, w db.Writer) error {
+			if err := checkSessionLimits(ctx, read, w, newSession, opts.withSessionLimits); err != nil {
+				return err
+			}
 			returnedSession = newSession.Clone().(*Session)
 			returnedSession.DynamicCredentials = nil
 			if err = w.Create(ctx, returnedSession); err != nil {
@@ -122,13 +129,21 @@ func (r *Repository) CreateSession(ctx context.Context, sessionWrapper wrapping.
 				return errors.New(ctx, errors.SessionNotFound, op, fmt.Sprintf("no states found for new session %s", returnedSession.PublicId))
 			}
 			returnedSession.States = foundStates
-			if returnedSession.States[0].Status != StatusPending {
+			wantStatus := StatusPending
+			if returnedSession.ApprovalRequired {
+				wantStatus = StatusPendingApproval
+			}
+			if returnedSession.States[0].Status != wantStatus {
 				return errors.New(ctx, errors.InvalidSessionState, op, fmt.Sprintf("new session %s state is not valid: %s", returnedSession.PublicId, returnedSession.States[0].Status))
 			}
 			return nil
 		},
 	)
 	if err != nil {
+		var limitErr *SessionLimitError
+		if errors.As(err, &limitErr) {
+			return nil, nil, limitErr
+		}
 		return nil, nil, errors.Wrap(ctx, err, op)
 	}
 	return returnedSession, privKey, nil
@@ -182,7 +197,7 @@ func (r *Repository) LookupSession(ctx context.Context, sessionId string, _ ...O
 		return nil, nil, errors.Wrap(ctx, err, op)
 	}
 	if len(session.CtTofuToken) > 0 {
-		databaseWrapper, err := r.kms.GetWrapper(ctx, session.ScopeId, kms.KeyPurposeDatabase)
+		databaseWrapper, err := r.kms.GetWrapper(ctx, session.ScopeId, kms.KeyPurposeDatabase, kms.WithKeyId(session.TofuTokenKeyId))
 		if err != nil {
 			return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
 		}
@@ -201,7 +216,8 @@ func (r *Repository) LookupSession(ctx context.Context, sessionId string, _ ...O
 	return &session, authzSummary, nil
 }
 
-// ListSessions will sessions.  Supports the WithLimit, WithScopeId, WithSessionIds, and WithServerId options.
+// ListSessions will sessions.  Supports the WithLimit, WithScopeId, WithSessionIds, WithServerId
+// and WithStartPageAfterId options.
 func (r *Repository) ListSessions(ctx context.Context, opt ...Option) ([]*Session, error) {
 	const op = "session.(Repository).ListSessions"
 	opts := getOpts(opt...)
@@ -250,6 +266,18 @@ func (r *Repository) ListSessions(ctx context.Context, opt ...Option) ([]*Sessio
 		limit = fmt.Sprintf("limit %d", opts.withLimit)
 	}
 
+	if opts.withStartPageAfterId != nil {
+		if *opts.withStartPageAfterId != "" {
+			inClauseCnt += 1
+			where, args = append(where, fmt.Sprintf("s.public_id > @%d", inClauseCnt)), append(args, sql.Named(fmt.Sprintf("%d", inClauseCnt), *opts.withStartPageAfterId))
+		}
+		var whereClause string
+		if len(where) > 0 {
+			whereClause = "where " + strings.Join(where, " and ")
+		}
+		return r.listSessions(ctx, fmt.Sprintf(sessionListPage, whereClause, limit), args)
+	}
+
 	var withOrder string
 	switch opts.withOrderByCreateTime {
 	case db.AscendingOrderBy:
@@ -266,7 +294,13 @@ func (r *Repository) ListSessions(ctx context.Context, opt ...Option) ([]*Sessio
 	}
 	q := sessionList
 	query := fmt.Sprintf(q, limit, whereClause, withOrder)
+	return r.listSessions(ctx, query, args)
+}
 
+// listSessions runs a query returning rows of the session_list view and
+// converts them into sessions.
+func (r *Repository) listSessions(ctx context.Context, query string, args []interface{}) ([]*Session, error) {
+	const op = "session.(Repository).listSessions"
 	rows, err := r.reader.Query(ctx, query, args)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
@@ -288,6 +322,213 @@ func (r *Repository) ListSessions(ctx context.Context, opt ...Option) ([]*Sessio
 	return sessions, nil
 }
 
+// SessionHolder is a session which counts against a session limit.
+type SessionHolder struct {
+	SessionId string
+	UserId    string
+	TargetId  string
+	Status    string
+}
+
+const (
+	// TargetSessionLimit is the kind of the limit of concurrent sessions of
+	// a target.
+	TargetSessionLimit = "target"
+	// UserSessionLimit is the kind of the limit of concurrent sessions of a
+	// user for the targets of a project or org.
+	UserSessionLimit = "user"
+)
+
+// SessionLimit is a limit of concurrent sessions enforced when creating a
+// session.
+type SessionLimit struct {
+	// Kind is TargetSessionLimit or UserSessionLimit.
+	Kind string
+	// ResourceId is the id of the target, or of the scope for user limits.
+	ResourceId string
+	Limit      int32
+}
+
+// SessionLimitError is returned by CreateSession when the new session would
+// exceed a limit of concurrent sessions.
+type SessionLimitError struct {
+	SessionLimit
+	// Holders are the sessions counting against the limit, oldest first.
+	Holders []*SessionHolder
+}
+
+func (e *SessionLimitError) Error() string {
+	return fmt.Sprintf("%s limit of %d concurrent sessions reached for %s", e.Kind, e.Limit, e.ResourceId)
+}
+
+// checkSessionLimits returns a *SessionLimitError if the new session would
+// exceed one of the limits. The target and user rows are locked for the rest
+// of the transaction before counting, so concurrent transactions creating
+// sessions for them count the sessions one after the other.
+func checkSessionLimits(ctx context.Context, read db.Reader, w db.Writer, newSession *Session, limits []SessionLimit) error {
+	const op = "session.checkSessionLimits"
+	var lockTarget, lockUser bool
+	for _, l := range limits {
+		switch l.Kind {
+		case TargetSessionLimit:
+			lockTarget = true
+		case UserSessionLimit:
+			lockUser = true
+		default:
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown session limit kind %q", l.Kind))
+		}
+	}
+	if lockTarget {
+		if _, err := w.Exec(ctx, lockTargetForSessionLimit, []interface{}{sql.Named("target_id", newSession.TargetId)}); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to lock target"))
+		}
+	}
+	if lockUser {
+		if _, err := w.Exec(ctx, lockUserForSessionLimit, []interface{}{sql.Named("user_id", newSession.UserId)}); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to lock user"))
+		}
+	}
+
+	for _, l := range limits {
+		if l.Limit <= 0 {
+			continue
+		}
+		var holders []*SessionHolder
+		var err error
+		switch l.Kind {
+		case TargetSessionLimit:
+			holders, err = listTargetSessionHolders(ctx, read, l.ResourceId)
+		case UserSessionLimit:
+			holders, err = listUserSessionHolders(ctx, read, newSession.UserId, l.ResourceId)
+		}
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if len(holders) >= int(l.Limit) {
+			return &SessionLimitError{SessionLimit: l, Holders: holders}
+		}
+	}
+	return nil
+}
+
+// ListTargetSessionHolders returns the sessions of the target which count
+// against its limit of concurrent sessions, oldest first. Sessions count
+// against limits until they are canceling, terminated or expired.
+func (r *Repository) ListTargetSessionHolders(ctx context.Context, targetId string, _ ...Option) ([]*SessionHolder, error) {
+	const op = "session.(Repository).ListTargetSessionHolders"
+	if targetId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	}
+	holders, err := listTargetSessionHolders(ctx, r.reader, targetId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return holders, nil
+}
+
+// ListUserSessionHolders returns the sessions of the user for the targets of
+// the scope which count against the scope's limit of concurrent sessions per
+// user, oldest first. The scope may be a project or an org, in which case the
+// sessions for the targets of all of its projects are returned.
+func (r *Repository) ListUserSessionHolders(ctx context.Context, userId, scopeId string, _ ...Option) ([]*SessionHolder, error) {
+	const op = "session.(Repository).ListUserSessionHolders"
+	if userId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	}
+	if scopeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	holders, err := listUserSessionHolders(ctx, r.reader, userId, scopeId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return holders, nil
+}
+
+func listTargetSessionHolders(ctx context.Context, reader db.Reader, targetId string) ([]*SessionHolder, error) {
+	return listSessionHolders(ctx, reader, "s.target_id = @target_id", []interface{}{sql.Named("target_id", targetId)})
+}
+
+func listUserSessionHolders(ctx context.Context, reader db.Reader, userId, scopeId string) ([]*SessionHolder, error) {
+	where := "s.user_id = @user_id and (s.scope_id = @scope_id or s.scope_id in (select public_id from iam_scope where parent_id = @scope_id))"
+	return listSessionHolders(ctx, reader, where, []interface{}{
+		sql.Named("user_id", userId),
+		sql.Named("scope_id", scopeId),
+	})
+}
+
+func listSessionHolders(ctx context.Context, reader db.Reader, where string, args []interface{}) ([]*SessionHolder, error) {
+	const op = "session.listSessionHolders"
+	rows, err := reader.Query(ctx, fmt.Sprintf(sessionHolders, where), args)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	var holders []*SessionHolder
+	for rows.Next() {
+		var h SessionHolder
+		if err := reader.ScanRows(rows, &h); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+		holders = append(holders, &h)
+	}
+	return holders, nil
+}
+
+// SessionUsage is the usage of a session: the number of connections made in
+// it and the bytes proxied on them.
+type SessionUsage struct {
+	SessionId       string
+	UserId          string
+	TargetId        string
+	ScopeId         string
+	ConnectionCount uint32
+	BytesUp         uint64
+	BytesDown       uint64
+}
+
+// ListSessionUsage returns the usage of the sessions in the scopes which were
+// created at or after startTime and before endTime, ordered by session id. A
+// zero startTime or endTime leaves that end of the range open.
+func (r *Repository) ListSessionUsage(ctx context.Context, scopeIds []string, startTime, endTime time.Time, _ ...Option) ([]*SessionUsage, error) {
+	const op = "session.(Repository).ListSessionUsage"
+	if len(scopeIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope ids")
+	}
+	if !startTime.IsZero() && !endTime.IsZero() && !endTime.After(startTime) {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "end time must be after start time")
+	}
+	args := make([]interface{}, 0, len(scopeIds)+2)
+	idsInClause := make([]string, 0, len(scopeIds))
+	for i, id := range scopeIds {
+		idsInClause, args = append(idsInClause, fmt.Sprintf("@%d", i+1)), append(args, sql.Named(fmt.Sprintf("%d", i+1), id))
+	}
+	where := []string{fmt.Sprintf("s.scope_id in (%s)", strings.Join(idsInClause, ","))}
+	if !startTime.IsZero() {
+		where, args = append(where, "s.create_time >= @start_time"), append(args, sql.Named("start_time", startTime))
+	}
+	if !endTime.IsZero() {
+		where, args = append(where, "s.create_time < @end_time"), append(args, sql.Named("end_time", endTime))
+	}
+
+	rows, err := r.reader.Query(ctx, fmt.Sprintf(sessionUsage, strings.Join(where, " and ")), args)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	var usage []*SessionUsage
+	for rows.Next() {
+		var u SessionUsage
+		if err := r.reader.ScanRows(rows, &u); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+		usage = append(usage, &u)
+	}
+	return usage, nil
+}
+
 // DeleteSession will delete a session from the repository.
 func (r *Repository) DeleteSession(ctx context.Context, publicId string, _ ...Option) (int, error) {
 	const op = "session.(Repository).DeleteSession"
@@ -349,6 +590,157 @@ func (r *Repository) CancelSession(ctx context.Context, sessionId string, sessio
 	return s, nil
 }
 
+// ApproveSession approves a session which is pending approval by updating its
+// state to "pending", which allows the session to be activated by a worker.
+// The approver is recorded in the new state. The approver cannot be the user
+// of the session and must be one of the approvers of the session's target or
+// a member of one of its approver groups.
+func (r *Repository) ApproveSession(ctx context.Context, sessionId string, sessionVersion uint32, approverId string) (*Session, error) {
+	const op = "session.(Repository).ApproveSession"
+	if sessionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	}
+	if sessionVersion == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session version")
+	}
+	if approverId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing approver id")
+	}
+
+	updatedSession := AllocSession()
+	updatedSession.PublicId = sessionId
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			if err := checkApproval(ctx, reader, sessionId, approverId); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			rowsAffected, err := w.Exec(ctx, approveSessionCte, []interface{}{
+				sql.Named("session_id", sessionId),
+				sql.Named("version", sessionVersion),
+				sql.Named("approver_id", approverId),
+			})
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to approve session %s", sessionId)))
+			}
+			if rowsAffected == 0 {
+				return errors.New(ctx, errors.InvalidSessionState, op, fmt.Sprintf("unable to approve session %s", sessionId))
+			}
+			// We need to update the session version as that's the aggregate
+			updatedSession.Version = sessionVersion + 1
+			rowsUpdated, err := w.Update(ctx, &updatedSession, []string{"Version"}, nil, db.WithVersion(&sessionVersion))
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", sessionId)))
+			}
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("update to session %s would have updated %d session", sessionId, rowsUpdated))
+			}
+			states, err := fetchStates(ctx, reader, sessionId, db.WithOrder("start_time desc"))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			updatedSession.States = states
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &updatedSession, nil
+}
+
+// DenySession denies a session which is pending approval by setting its
+// termination reason to "denied" and its state to "terminated". The
+// approver is recorded in the new state and must satisfy the same
+// requirements as for ApproveSession.
+func (r *Repository) DenySession(ctx context.Context, sessionId string, sessionVersion uint32, approverId string) (*Session, error) {
+	const op = "session.(Repository).DenySession"
+	if sessionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	}
+	if sessionVersion == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session version")
+	}
+	if approverId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing approver id")
+	}
+
+	updatedSession := AllocSession()
+	updatedSession.PublicId = sessionId
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			if err := checkApproval(ctx, reader, sessionId, approverId); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			// The terminated state is inserted before the termination
+			// reason is set so that it records the approver; the
+			// update_session_state_on_termination_reason trigger won't
+			// insert another.
+			if _, err := w.Exec(ctx, denySessionState, []interface{}{
+				sql.Named("session_id", sessionId),
+				sql.Named("approver_id", approverId),
+			}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to deny session %s", sessionId)))
+			}
+			updatedSession.TerminationReason = Denied.String()
+			updatedSession.Version = sessionVersion + 1
+			rowsUpdated, err := w.Update(ctx, &updatedSession, []string{"TerminationReason", "Version"}, nil, db.WithVersion(&sessionVersion))
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", sessionId)))
+			}
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("update to session %s would have updated %d session", sessionId, rowsUpdated))
+			}
+			states, err := fetchStates(ctx, reader, sessionId, db.WithOrder("start_time desc"))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			updatedSession.States = states
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &updatedSession, nil
+}
+
+// checkApproval returns an error if the session is not pending approval or
+// if the approver may not approve or deny it.
+func checkApproval(ctx context.Context, r db.Reader, sessionId, approverId string) error {
+	const op = "session.checkApproval"
+	rows, err := r.Query(ctx, sessionApproval, []interface{}{
+		sql.Named("session_id", sessionId),
+		sql.Named("approver_id", approverId),
+	})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		return errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("session %s not found", sessionId))
+	}
+	var userId, state string
+	var isApprover bool
+	if err := rows.Scan(&userId, &state, &isApprover); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	switch {
+	case state != StatusPendingApproval.String():
+		return errors.New(ctx, errors.InvalidSessionState, op, fmt.Sprintf("session %s is not pending approval", sessionId))
+	case userId == approverId:
+		return errors.New(ctx, errors.Forbidden, op, "users cannot approve or deny their own sessions")
+	case !isApprover:
+		return errors.New(ctx, errors.Forbidden, op, fmt.Sprintf("%s is not an approver for session %s", approverId, sessionId))
+	}
+	return nil
+}
+
 // TerminateSession sets a session's termination reason and it's state to
 // "terminated" Sessions cannot be terminated which still have connections that
 // are not closed.
@@ -428,6 +820,44 @@ func (r *Repository) TerminateCompletedSessions(ctx context.Context) (int, error
 	return rowsAffected, nil
 }
 
+// RewrapTofuTokens re-encrypts the tofu tokens of the sessions which are not
+// encrypted with the latest version of the database key of their scope, so
+// older versions of the key can be destroyed. It returns the number of
+// sessions updated. This function should be called on a periodic basis by
+// Controllers.
+func (r *Repository) RewrapTofuTokens(ctx context.Context, _ ...Option) (int, error) {
+	const op = "session.(Repository).RewrapTofuTokens"
+	var sessions []*Session
+	if err := r.reader.SearchWhere(ctx, &sessions, rewrapTofuTokensWhere, nil, db.WithLimit(-1)); err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	var rowsUpdated int
+	for _, s := range sessions {
+		oldWrapper, err := r.kms.GetWrapper(ctx, s.ScopeId, kms.KeyPurposeDatabase, kms.WithKeyId(s.TofuTokenKeyId))
+		if err != nil {
+			return rowsUpdated, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+		}
+		if err := s.decrypt(ctx, oldWrapper); err != nil {
+			return rowsUpdated, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("cannot decrypt tofu token of session %s", s.PublicId)))
+		}
+		databaseWrapper, err := r.kms.GetWrapper(ctx, s.ScopeId, kms.KeyPurposeDatabase)
+		if err != nil {
+			return rowsUpdated, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+		}
+		if err := s.encrypt(ctx, databaseWrapper); err != nil {
+			return rowsUpdated, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("cannot encrypt tofu token of session %s", s.PublicId)))
+		}
+		// The version guards against a concurrent update of the session; the
+		// session is picked up again on the next run if it changed.
+		n, err := r.writer.Update(ctx, s, []string{"CtTofuToken", "TofuTokenKeyId"}, nil, db.WithVersion(&s.Version))
+		if err != nil {
+			return rowsUpdated, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", s.PublicId)))
+		}
+		rowsUpdated += n
+	}
+	return rowsUpdated, nil
+}
+
 // AuthorizeConnection will check to see if a connection is allowed.  Currently,
 // that authorization checks:
 // * the hasn't expired based on the session.Expiration
@@ -435,8 +865,9 @@ func (r *Repository) TerminateCompletedSessions(ctx context.Context) (int, error
 // If authorization is success, it creates/stores a new connection in the repo
 // and returns it, along with its states.  If the authorization fails, it
 // an error with Code InvalidSessionState.
-func (r *Repository) AuthorizeConnection(ctx context.Context, sessionId, workerId string) (*Connection, []*ConnectionState, *ConnectionAuthzSummary, error) {
+func (r *Repository) AuthorizeConnection(ctx context.Context, sessionId, workerId string, opt ...Option) (*Connection, []*ConnectionState, *ConnectionAuthzSummary, error) {
 	const op = "session.(Repository).AuthorizeConnection"
+	opts := getOpts(opt...)
 	if sessionId == "" {
 		return nil, nil, nil, errors.Wrap(ctx, status.Error(codes.FailedPrecondition, "missing session id"), op, errors.WithCode(errors.InvalidParameter))
 	}
@@ -464,9 +895,25 @@ func (r *Repository) AuthorizeConnection(ctx context.Context, sessionId, workerI
 			if rowsAffected == 0 {
 				return errors.Wrap(ctx, status.Errorf(codes.PermissionDenied, "session %s is not authorized (not active, expired or connection limit reached)", sessionId), op, errors.WithCode(errors.InvalidSessionState))
 			}
+			if len(opts.withWorkerChain) > 0 {
+				chain := append(append([]string{}, opts.withWorkerChain...), workerId)
+				for i, id := range chain {
+					hop := &connectionWorker{
+						ConnectionId: connectionId,
+						Hop:          int32(i),
+						WorkerId:     id,
+					}
+					if err := w.Create(ctx, hop); err != nil {
+						return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to record worker chain for connection %s", connectionId)))
+					}
+				}
+			}
 			if err := reader.LookupById(ctx, &connection); err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for session %s", sessionId)))
 			}
+			if connection.WorkerChain, err = fetchWorkerChain(ctx, reader, connectionId); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
 			connectionStates, err = fetchConnectionStates(ctx, reader, connectionId, db.WithOrder("start_time desc"))
 			if err != nil {
 				return errors.Wrap(ctx, err, op)
@@ -531,12 +978,16 @@ func (r *Repository) ConnectConnection(ctx context.Context, c ConnectWith) (*Con
 			connection.ClientTcpPort = c.ClientTcpPort
 			connection.EndpointTcpAddress = c.EndpointTcpAddress
 			connection.EndpointTcpPort = c.EndpointTcpPort
+			connection.RecordingId = c.RecordingId
 			fieldMask := []string{
 				"ClientTcpAddress",
 				"ClientTcpPort",
 				"EndpointTcpAddress",
 				"EndpointTcpPort",
 			}
+			if c.RecordingId != "" {
+				fieldMask = append(fieldMask, "RecordingId")
+			}
 			rowsUpdated, err := w.Update(ctx, &connection, fieldMask, nil)
 			if err != nil {
 				return errors.Wrap(ctx, err, op)
@@ -690,7 +1141,7 @@ func (r *Repository) ActivateSession(ctx context.Context, sessionId string, sess
 			if err := updatedSession.encrypt(ctx, databaseWrapper); err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
-			rowsUpdated, err := w.Update(ctx, &updatedSession, []string{"CtTofuToken"}, nil)
+			rowsUpdated, err := w.Update(ctx, &updatedSession, []string{"CtTofuToken", "TofuTokenKeyId"}, nil)
 			if err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
@@ -750,7 +1201,7 @@ func (r *Repository) updateState(ctx context.Context, sessionId string, sessionV
 				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated session and %d rows updated", rowsUpdated))
 			}
 			if len(updatedSession.CtTofuToken) > 0 {
-				databaseWrapper, err := r.kms.GetWrapper(ctx, updatedSession.ScopeId, kms.KeyPurposeDatabase)
+				databaseWrapper, err := r.kms.GetWrapper(ctx, updatedSession.ScopeId, kms.KeyPurposeDatabase, kms.WithKeyId(updatedSession.TofuTokenKeyId))
 				if err != nil {
 					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
 				}
@@ -808,5 +1259,25 @@ func fetchConnections(ctx context.Context, r db.Reader, sessionId string, opt ..
 	if len(connections) == 0 {
 		return nil, nil
 	}
+	var closedStates []*ConnectionState
+	if err := r.SearchWhere(ctx, &closedStates, "state = ? and connection_id in (select public_id from session_connection where session_id = ?)", []interface{}{StatusClosed.String(), sessionId}); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	closedTimes := make(map[string]*timestamp.Timestamp, len(closedStates))
+	for _, s := range closedStates {
+		closedTimes[s.ConnectionId] = s.StartTime
+	}
+	connectionIds := make([]string, 0, len(connections))
+	for _, c := range connections {
+		connectionIds = append(connectionIds, c.PublicId)
+	}
+	chains, err := fetchWorkerChains(ctx, r, connectionIds)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	for _, c := range connections {
+		c.ClosedTime = closedTimes[c.PublicId]
+		c.WorkerChain = chains[c.PublicId]
+	}
 	return connections, nil
 }
@@ -2,7 +2,10 @@ package session
 
 import (
 	"context"
+	"crypto/rand"
 	"fmt"
+	"sort"
+	"sync"
 	"testing"
 	"time"
 
@@ -213,6 +216,41 @@ func TestRepository_ListSession(t *testing.T) {
 		assert.Equal(StatusActive, got[0].States[0].Status)
 		assert.Equal(StatusPending, got[0].States[1].Status)
 	})
+	t.Run("withStartPageAfterId", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		require.NoError(conn.Where("1=1").Delete(AllocSession()).Error)
+		var want []string
+		for i := 0; i < 7; i++ {
+			s := TestSession(t, conn, wrapper, composedOf)
+			_ = TestState(t, conn, s.PublicId, StatusActive)
+			_ = TestConnection(t, conn, s.PublicId, "127.0.0.1", 22, "127.0.0.2", 23)
+			want = append(want, s.PublicId)
+		}
+		// A session in another scope must not take up room in a page.
+		_ = TestDefaultSession(t, conn, wrapper, iamRepo)
+		sort.Strings(want)
+
+		const pageSize = 3
+		var got []string
+		var lastId string
+		for {
+			page, err := repo.ListSessions(context.Background(),
+				WithScopeIds([]string{composedOf.ScopeId}),
+				WithLimit(pageSize),
+				WithStartPageAfterId(lastId))
+			require.NoError(err)
+			for _, s := range page {
+				assert.Equal(2, len(s.States))
+				assert.Equal(1, len(s.Connections))
+				got = append(got, s.PublicId)
+			}
+			if len(page) < pageSize {
+				break
+			}
+			lastId = page[len(page)-1].PublicId
+		}
+		assert.Equal(want, got)
+	})
 	t.Run("withServerId", func(t *testing.T) {
 		assert, require := assert.New(t), require.New(t)
 		require.NoError(conn.Where("1=1").Delete(AllocSession()).Error)
@@ -267,6 +305,89 @@ func TestRepository_ListSessions_Multiple_Scopes(t *testing.T) {
 	assert.Equal(t, len(projs), len(got))
 }
 
+func TestRepository_ListSessionUsage(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	rw := db.New(conn)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	composedOf := TestSessionParams(t, conn, wrapper, iamRepo)
+	withConns := TestSession(t, conn, wrapper, composedOf)
+	withoutConns := TestSession(t, conn, wrapper, composedOf)
+	worker := TestWorker(t, conn, wrapper)
+	c1 := TestConnection(t, conn, withConns.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222, WithServerId(worker.PrivateId))
+	c2 := TestConnection(t, conn, withConns.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222, WithServerId(worker.PrivateId))
+	_, err = repo.UpdateConnectionBytes(ctx, worker.PrivateId, []ConnectionBytes{
+		{ConnectionId: c1.PublicId, BytesUp: 1, BytesDown: 10},
+		{ConnectionId: c2.PublicId, BytesUp: 2, BytesDown: 20},
+	})
+	require.NoError(t, err)
+
+	other := TestDefaultSession(t, conn, wrapper, iamRepo)
+
+	t.Run("missing-scope-ids", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.ListSessionUsage(ctx, nil, time.Time{}, time.Time{})
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+		assert.Nil(got)
+	})
+	t.Run("end-before-start", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		now := time.Now()
+		got, err := repo.ListSessionUsage(ctx, []string{composedOf.ScopeId}, now, now.Add(-time.Hour))
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+		assert.Nil(got)
+	})
+	t.Run("scope", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.ListSessionUsage(ctx, []string{composedOf.ScopeId}, time.Time{}, time.Time{})
+		require.NoError(err)
+		want := []*SessionUsage{
+			{
+				SessionId:       withConns.PublicId,
+				UserId:          composedOf.UserId,
+				TargetId:        composedOf.TargetId,
+				ScopeId:         composedOf.ScopeId,
+				ConnectionCount: 2,
+				BytesUp:         3,
+				BytesDown:       30,
+			},
+			{
+				SessionId: withoutConns.PublicId,
+				UserId:    composedOf.UserId,
+				TargetId:  composedOf.TargetId,
+				ScopeId:   composedOf.ScopeId,
+			},
+		}
+		sort.Slice(want, func(i, j int) bool { return want[i].SessionId < want[j].SessionId })
+		assert.Equal(want, got)
+	})
+	t.Run("multiple-scopes", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.ListSessionUsage(ctx, []string{composedOf.ScopeId, other.ScopeId}, time.Time{}, time.Time{})
+		require.NoError(err)
+		assert.Len(got, 3)
+	})
+	t.Run("time-range", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		start := withConns.CreateTime.AsTime()
+		got, err := repo.ListSessionUsage(ctx, []string{composedOf.ScopeId}, start.Add(-time.Minute), start.Add(time.Minute))
+		require.NoError(err)
+		assert.Len(got, 2)
+
+		got, err = repo.ListSessionUsage(ctx, []string{composedOf.ScopeId}, start.Add(time.Hour), time.Time{})
+		require.NoError(err)
+		assert.Empty(got)
+	})
+}
+
 func TestRepository_CreateSession(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -638,6 +759,58 @@ func TestRepository_AuthorizeConnect(t *testing.T) {
 	}
 }
 
+func TestRepository_AuthorizeConnectWorkerChain(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	setupFn := func() (*Session, string) {
+		composedOf := TestSessionParams(t, conn, wrapper, iamRepo)
+		composedOf.ConnectionLimit = -1
+		s := TestSession(t, conn, wrapper, composedOf)
+		srv := TestWorker(t, conn, wrapper)
+		_, _, err := repo.ActivateSession(ctx, s.PublicId, s.Version, srv.PrivateId, srv.Type, TestTofu(t))
+		require.NoError(t, err)
+		return s, srv.PrivateId
+	}
+
+	t.Run("single-worker", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s, workerId := setupFn()
+		c, _, _, err := repo.AuthorizeConnection(ctx, s.PublicId, workerId)
+		require.NoError(err)
+		assert.Empty(c.WorkerChain)
+
+		found, _, err := repo.LookupConnection(ctx, c.PublicId)
+		require.NoError(err)
+		assert.Empty(found.WorkerChain)
+	})
+	t.Run("forwarded", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s, workerId := setupFn()
+		ingress := TestWorker(t, conn, wrapper)
+		c, _, _, err := repo.AuthorizeConnection(ctx, s.PublicId, workerId, WithWorkerChain([]string{ingress.PrivateId}))
+		require.NoError(err)
+		want := []string{ingress.PrivateId, workerId}
+		assert.Equal(want, c.WorkerChain)
+
+		found, _, err := repo.LookupConnection(ctx, c.PublicId)
+		require.NoError(err)
+		assert.Equal(want, found.WorkerChain)
+
+		foundSession, _, err := repo.LookupSession(ctx, s.PublicId)
+		require.NoError(err)
+		require.Len(foundSession.Connections, 1)
+		assert.Equal(want, foundSession.Connections[0].WorkerChain)
+	})
+}
+
 func TestRepository_ConnectConnection(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -826,6 +999,379 @@ func TestRepository_TerminateSession(t *testing.T) {
 	}
 }
 
+func TestRepository_ApproveSession(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	approver := iam.TestUser(t, iamRepo, "global")
+	setupFn := func(approverIds ...string) *Session {
+		c := TestSessionParams(t, conn, wrapper, iamRepo)
+		c.ApprovalRequired = true
+		s := TestSession(t, conn, wrapper, c)
+		require.Equal(t, StatusPendingApproval, s.States[0].Status)
+		for _, id := range approverIds {
+			a, err := target.NewTargetApprover(s.TargetId, id)
+			require.NoError(t, err)
+			require.NoError(t, rw.Create(context.Background(), a))
+		}
+		return s
+	}
+	group := iam.TestGroup(t, conn, "global")
+	iam.TestGroupMember(t, conn, group.PublicId, approver.PublicId)
+
+	tests := []struct {
+		name        string
+		session     *Session
+		approverId  string
+		ownSession  bool
+		wantErr     bool
+		wantIsError errors.Code
+	}{
+		{
+			name:        "no-approvers",
+			session:     setupFn(),
+			approverId:  approver.PublicId,
+			wantErr:     true,
+			wantIsError: errors.Forbidden,
+		},
+		{
+			name:       "valid-user-approver",
+			session:    setupFn(approver.PublicId),
+			approverId: approver.PublicId,
+		},
+		{
+			name:       "valid-group-approver",
+			session:    setupFn(group.PublicId),
+			approverId: approver.PublicId,
+		},
+		{
+			name:        "not-an-approver",
+			session:     setupFn(iam.TestUser(t, iamRepo, "global").PublicId),
+			approverId:  approver.PublicId,
+			wantErr:     true,
+			wantIsError: errors.Forbidden,
+		},
+		{
+			name:        "own-session",
+			session:     setupFn(),
+			ownSession:  true,
+			wantErr:     true,
+			wantIsError: errors.Forbidden,
+		},
+		{
+			name:        "not-pending-approval",
+			session:     TestDefaultSession(t, conn, wrapper, iamRepo),
+			approverId:  approver.PublicId,
+			wantErr:     true,
+			wantIsError: errors.InvalidSessionState,
+		},
+		{
+			name: "empty-session-id",
+			session: func() *Session {
+				s := setupFn()
+				s.PublicId = ""
+				return s
+			}(),
+			approverId:  approver.PublicId,
+			wantErr:     true,
+			wantIsError: errors.InvalidParameter,
+		},
+		{
+			name: "empty-session-version",
+			session: func() *Session {
+				s := setupFn()
+				s.Version = 0
+				return s
+			}(),
+			approverId:  approver.PublicId,
+			wantErr:     true,
+			wantIsError: errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			approverId := tt.approverId
+			if tt.ownSession {
+				approverId = tt.session.UserId
+			}
+			s, err := repo.ApproveSession(context.Background(), tt.session.PublicId, tt.session.Version, approverId)
+			if tt.wantErr {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tt.wantIsError), err), "unexpected error %s", err.Error())
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.session.Version+1, s.Version)
+			require.Len(s.States, 2)
+			assert.Equal(StatusPending, s.States[0].Status)
+			assert.Equal(approverId, s.States[0].ApproverId)
+			assert.Equal(StatusPendingApproval, s.States[1].Status)
+			assert.Empty(s.States[1].ApproverId)
+
+			_, err = repo.ApproveSession(context.Background(), s.PublicId, s.Version, approverId)
+			require.Error(err)
+			assert.Truef(errors.Match(errors.T(errors.InvalidSessionState), err), "unexpected error %s", err.Error())
+		})
+	}
+}
+
+func TestRepository_SessionHolders(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	c := TestSessionParams(t, conn, wrapper, iamRepo)
+	first := TestSession(t, conn, wrapper, c)
+	second := TestSession(t, conn, wrapper, c)
+	// Sessions of other users and targets aren't holders.
+	_ = TestDefaultSession(t, conn, wrapper, iamRepo)
+
+	proj, err := iamRepo.LookupScope(ctx, c.ScopeId)
+	require.NoError(t, err)
+
+	t.Run("missing-ids", func(t *testing.T) {
+		assert := assert.New(t)
+		_, err := repo.ListTargetSessionHolders(ctx, "")
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %s", err)
+		_, err = repo.ListUserSessionHolders(ctx, "", proj.PublicId)
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %s", err)
+		_, err = repo.ListUserSessionHolders(ctx, c.UserId, "")
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %s", err)
+	})
+	t.Run("holders", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		want := []*SessionHolder{
+			{SessionId: first.PublicId, UserId: c.UserId, TargetId: c.TargetId, Status: StatusPending.String()},
+			{SessionId: second.PublicId, UserId: c.UserId, TargetId: c.TargetId, Status: StatusPending.String()},
+		}
+		got, err := repo.ListTargetSessionHolders(ctx, c.TargetId)
+		require.NoError(err)
+		assert.Equal(want, got)
+		for _, scopeId := range []string{proj.PublicId, proj.ParentId} {
+			got, err = repo.ListUserSessionHolders(ctx, c.UserId, scopeId)
+			require.NoError(err)
+			assert.Equal(want, got)
+		}
+
+		_, err = repo.CancelSession(ctx, first.PublicId, first.Version)
+		require.NoError(err)
+		got, err = repo.ListTargetSessionHolders(ctx, c.TargetId)
+		require.NoError(err)
+		assert.Equal(want[1:], got)
+		got, err = repo.ListUserSessionHolders(ctx, c.UserId, proj.PublicId)
+		require.NoError(err)
+		assert.Equal(want[1:], got)
+	})
+}
+
+func TestRepository_CreateSession_SessionLimits(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	c := TestSessionParams(t, conn, wrapper, iamRepo)
+	newSession := func() *Session {
+		return &Session{
+			UserId:          c.UserId,
+			HostId:          c.HostId,
+			TargetId:        c.TargetId,
+			HostSetId:       c.HostSetId,
+			AuthTokenId:     c.AuthTokenId,
+			ScopeId:         c.ScopeId,
+			Endpoint:        "tcp://127.0.0.1:22",
+			ExpirationTime:  c.ExpirationTime,
+			ConnectionLimit: c.ConnectionLimit,
+		}
+	}
+	proj, err := iamRepo.LookupScope(ctx, c.ScopeId)
+	require.NoError(t, err)
+
+	first, _, err := repo.CreateSession(ctx, wrapper, newSession())
+	require.NoError(t, err)
+	wantHolders := []*SessionHolder{
+		{SessionId: first.PublicId, UserId: c.UserId, TargetId: c.TargetId, Status: StatusPending.String()},
+	}
+
+	t.Run("unknown-kind", func(t *testing.T) {
+		_, _, err := repo.CreateSession(ctx, wrapper, newSession(), WithSessionLimits(SessionLimit{Kind: "bad", ResourceId: c.TargetId, Limit: 1}))
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %s", err)
+	})
+
+	tests := []struct {
+		name  string
+		limit SessionLimit
+	}{
+		{
+			name:  "target",
+			limit: SessionLimit{Kind: TargetSessionLimit, ResourceId: c.TargetId, Limit: 1},
+		},
+		{
+			name:  "user-project",
+			limit: SessionLimit{Kind: UserSessionLimit, ResourceId: proj.PublicId, Limit: 1},
+		},
+		{
+			name:  "user-org",
+			limit: SessionLimit{Kind: UserSessionLimit, ResourceId: proj.ParentId, Limit: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, _, err := repo.CreateSession(ctx, wrapper, newSession(), WithSessionLimits(tt.limit))
+			assert.Nil(got)
+			var limitErr *SessionLimitError
+			require.True(errors.As(err, &limitErr), "unexpected error %v", err)
+			assert.Equal(tt.limit, limitErr.SessionLimit)
+			assert.Equal(wantHolders, limitErr.Holders)
+		})
+	}
+
+	t.Run("below-limit", func(t *testing.T) {
+		got, _, err := repo.CreateSession(ctx, wrapper, newSession(), WithSessionLimits(
+			SessionLimit{Kind: TargetSessionLimit, ResourceId: c.TargetId, Limit: 2},
+			SessionLimit{Kind: UserSessionLimit, ResourceId: proj.PublicId, Limit: 2},
+		))
+		require.NoError(t, err)
+		_, err = repo.CancelSession(ctx, got.PublicId, got.Version)
+		require.NoError(t, err)
+	})
+
+	t.Run("concurrent", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		_, err := repo.CancelSession(ctx, first.PublicId, first.Version)
+		require.NoError(err)
+
+		// Only one of the sessions created at the same time fits in the
+		// limit.
+		const attempts = 5
+		var wg sync.WaitGroup
+		errs := make(chan error, attempts)
+		for i := 0; i < attempts; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, _, err := repo.CreateSession(ctx, wrapper, newSession(), WithSessionLimits(
+					SessionLimit{Kind: TargetSessionLimit, ResourceId: c.TargetId, Limit: 1},
+				))
+				errs <- err
+			}()
+		}
+		wg.Wait()
+		close(errs)
+		var created, limited int
+		for err := range errs {
+			var limitErr *SessionLimitError
+			switch {
+			case err == nil:
+				created++
+			case errors.As(err, &limitErr):
+				limited++
+			default:
+				require.NoError(err)
+			}
+		}
+		assert.Equal(1, created)
+		assert.Equal(attempts-1, limited)
+
+		holders, err := repo.ListTargetSessionHolders(ctx, c.TargetId)
+		require.NoError(err)
+		assert.Len(holders, 1)
+	})
+}
+
+func TestRepository_DenySession(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	approver := iam.TestUser(t, iamRepo, "global")
+	setupFn := func(approverIds ...string) *Session {
+		c := TestSessionParams(t, conn, wrapper, iamRepo)
+		c.ApprovalRequired = true
+		s := TestSession(t, conn, wrapper, c)
+		for _, id := range approverIds {
+			a, err := target.NewTargetApprover(s.TargetId, id)
+			require.NoError(t, err)
+			require.NoError(t, rw.Create(context.Background(), a))
+		}
+		return s
+	}
+
+	tests := []struct {
+		name        string
+		session     *Session
+		approverId  string
+		wantErr     bool
+		wantIsError errors.Code
+	}{
+		{
+			name:       "valid",
+			session:    setupFn(approver.PublicId),
+			approverId: approver.PublicId,
+		},
+		{
+			name:        "no-approvers",
+			session:     setupFn(),
+			approverId:  approver.PublicId,
+			wantErr:     true,
+			wantIsError: errors.Forbidden,
+		},
+		{
+			name:        "not-pending-approval",
+			session:     TestDefaultSession(t, conn, wrapper, iamRepo),
+			approverId:  approver.PublicId,
+			wantErr:     true,
+			wantIsError: errors.InvalidSessionState,
+		},
+		{
+			name:        "empty-approver-id",
+			session:     setupFn(approver.PublicId),
+			wantErr:     true,
+			wantIsError: errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			s, err := repo.DenySession(context.Background(), tt.session.PublicId, tt.session.Version, tt.approverId)
+			if tt.wantErr {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tt.wantIsError), err), "unexpected error %s", err.Error())
+				return
+			}
+			require.NoError(err)
+			assert.Equal(Denied.String(), s.TerminationReason)
+			require.Len(s.States, 2)
+			assert.Equal(StatusTerminated, s.States[0].Status)
+			assert.Equal(tt.approverId, s.States[0].ApproverId)
+		})
+	}
+}
+
 func TestRepository_TerminateCompletedSessions(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -1694,3 +2240,52 @@ func testSessionCredentialParams(t *testing.T, conn *db.DB, wrapper wrapping.Wra
 	params.DynamicCredentials = creds
 	return params
 }
+
+func TestRepository_RewrapTofuTokens(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kmsCache)
+	require.NoError(err)
+	ctx := context.Background()
+	worker := TestWorker(t, conn, wrapper)
+
+	tofu := TestTofu(t)
+	s := TestDefaultSession(t, conn, wrapper, iamRepo)
+	active, _, err := repo.ActivateSession(ctx, s.PublicId, s.Version, worker.PrivateId, worker.Type, tofu)
+	require.NoError(err)
+	oldKeyId := active.TofuTokenKeyId
+	require.NotEmpty(oldKeyId)
+
+	// Pending sessions have no tofu token to rewrap.
+	TestDefaultSession(t, conn, wrapper, iamRepo)
+
+	n, err := repo.RewrapTofuTokens(ctx)
+	require.NoError(err)
+	assert.Equal(0, n)
+
+	require.NoError(kmsCache.RotateKeys(ctx, s.ScopeId, rand.Reader))
+
+	n, err = repo.RewrapTofuTokens(ctx)
+	require.NoError(err)
+	assert.Equal(1, n)
+
+	found, _, err := repo.LookupSession(ctx, s.PublicId)
+	require.NoError(err)
+	assert.Equal(tofu, found.TofuToken)
+	assert.NotEqual(oldKeyId, found.TofuTokenKeyId)
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, s.ScopeId, kms.KeyPurposeDatabase)
+	require.NoError(err)
+	assert.Equal(databaseWrapper.KeyID(), found.TofuTokenKeyId)
+
+	n, err = repo.RewrapTofuTokens(ctx)
+	require.NoError(err)
+	assert.Equal(0, n)
+
+	// The old key version is no longer referenced and can be destroyed.
+	require.NoError(kmsCache.DestroyKeyVersion(ctx, s.ScopeId, oldKeyId))
+}
@@ -49,9 +49,16 @@ type ComposedOf struct {
 	// existed at creation time. Round tripping it through here saves a lookup
 	// in the DB. It is not stored in the warehouse.
 	WorkerFilter string
+	// IngressWorkerFilter is the filter of the workers allowed to accept
+	// connections for the session and forward them to a worker matching the
+	// WorkerFilter, when the session was created.
+	IngressWorkerFilter string
 	// DynamicCredentials are dynamic credentials that will be retrieved
 	// for the session. DynamicCredentials optional.
 	DynamicCredentials []*DynamicCredential
+	// ApprovalRequired indicates the session must be approved before it can
+	// be activated.
+	ApprovalRequired bool
 }
 
 // Session contains information about a user's session with a target
@@ -98,6 +105,11 @@ type Session struct {
 	ConnectionLimit int32 `json:"connection_limit,omitempty" gorm:"default:null"`
 	// Worker filter
 	WorkerFilter string `json:"-" gorm:"default:null"`
+	// Ingress worker filter
+	IngressWorkerFilter string `json:"-" gorm:"default:null"`
+	// ApprovalRequired indicates the session must be approved before it can
+	// be activated.
+	ApprovalRequired bool `json:"approval_required,omitempty" gorm:"default:null"`
 
 	// key_id is the key ID that was used for the encryption operation. It can be
 	// used to identify a specific version of the key needed to decrypt the value,
@@ -105,6 +117,10 @@ type Session struct {
 	// @inject_tag: `gorm:"not_null"`
 	KeyId string `json:"key_id,omitempty" gorm:"not_null"`
 
+	// TofuTokenKeyId is the ID of the database key version used to encrypt
+	// the tofu token.
+	TofuTokenKeyId string `json:"tofu_token_key_id,omitempty" gorm:"default:null"`
+
 	// States for the session which are for read only and are ignored during
 	// write operations
 	States []*State `gorm:"-"`
@@ -131,17 +147,19 @@ var (
 func New(c ComposedOf, _ ...Option) (*Session, error) {
 	const op = "session.New"
 	s := Session{
-		UserId:             c.UserId,
-		HostId:             c.HostId,
-		TargetId:           c.TargetId,
-		HostSetId:          c.HostSetId,
-		AuthTokenId:        c.AuthTokenId,
-		ScopeId:            c.ScopeId,
-		Endpoint:           c.Endpoint,
-		ExpirationTime:     c.ExpirationTime,
-		ConnectionLimit:    c.ConnectionLimit,
-		WorkerFilter:       c.WorkerFilter,
-		DynamicCredentials: c.DynamicCredentials,
+		UserId:              c.UserId,
+		HostId:              c.HostId,
+		TargetId:            c.TargetId,
+		HostSetId:           c.HostSetId,
+		AuthTokenId:         c.AuthTokenId,
+		ScopeId:             c.ScopeId,
+		Endpoint:            c.Endpoint,
+		ExpirationTime:      c.ExpirationTime,
+		ConnectionLimit:     c.ConnectionLimit,
+		WorkerFilter:        c.WorkerFilter,
+		IngressWorkerFilter: c.IngressWorkerFilter,
+		DynamicCredentials:  c.DynamicCredentials,
+		ApprovalRequired:    c.ApprovalRequired,
 	}
 	if err := s.validateNewSession(); err != nil {
 		return nil, errors.WrapDeprecated(err, op)
@@ -157,21 +175,24 @@ func AllocSession() Session {
 // Clone creates a clone of the Session
 func (s *Session) Clone() interface{} {
 	clone := &Session{
-		PublicId:          s.PublicId,
-		UserId:            s.UserId,
-		HostId:            s.HostId,
-		ServerId:          s.ServerId,
-		ServerType:        s.ServerType,
-		TargetId:          s.TargetId,
-		HostSetId:         s.HostSetId,
-		AuthTokenId:       s.AuthTokenId,
-		ScopeId:           s.ScopeId,
-		TerminationReason: s.TerminationReason,
-		Version:           s.Version,
-		Endpoint:          s.Endpoint,
-		ConnectionLimit:   s.ConnectionLimit,
-		WorkerFilter:      s.WorkerFilter,
-		KeyId:             s.KeyId,
+		PublicId:            s.PublicId,
+		UserId:              s.UserId,
+		HostId:              s.HostId,
+		ServerId:            s.ServerId,
+		ServerType:          s.ServerType,
+		TargetId:            s.TargetId,
+		HostSetId:           s.HostSetId,
+		AuthTokenId:         s.AuthTokenId,
+		ScopeId:             s.ScopeId,
+		TerminationReason:   s.TerminationReason,
+		Version:             s.Version,
+		Endpoint:            s.Endpoint,
+		ConnectionLimit:     s.ConnectionLimit,
+		WorkerFilter:        s.WorkerFilter,
+		IngressWorkerFilter: s.IngressWorkerFilter,
+		ApprovalRequired:    s.ApprovalRequired,
+		KeyId:               s.KeyId,
+		TofuTokenKeyId:      s.TofuTokenKeyId,
 	}
 	if len(s.States) > 0 {
 		clone.States = make([]*State, 0, len(s.States))
@@ -270,6 +291,8 @@ func (s *Session) VetForWrite(ctx context.Context, _ db.Reader, opType db.OpType
 			return errors.New(ctx, errors.InvalidParameter, op, "connection limit is immutable")
 		case contains(opts.WithFieldMaskPaths, "WorkerFilter"):
 			return errors.New(ctx, errors.InvalidParameter, op, "worker filter is immutable")
+		case contains(opts.WithFieldMaskPaths, "IngressWorkerFilter"):
+			return errors.New(ctx, errors.InvalidParameter, op, "ingress worker filter is immutable")
 		case contains(opts.WithFieldMaskPaths, "DynamicCredentials"):
 			return errors.New(ctx, errors.InvalidParameter, op, "dynamic credentials are immutable")
 		case contains(opts.WithFieldMaskPaths, "TerminationReason"):
@@ -392,7 +415,7 @@ func (s *Session) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
 	if err := structwrapping.WrapStruct(ctx, cipher, s, nil); err != nil {
 		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
 	}
-	s.KeyId = cipher.KeyID()
+	s.TofuTokenKeyId = cipher.KeyID()
 	return nil
 }
 
@@ -425,6 +448,7 @@ type sessionListView struct {
 	Version           uint32               `json:"version,omitempty" gorm:"default:null"`
 	Endpoint          string               `json:"-" gorm:"default:null"`
 	ConnectionLimit   int32                `json:"connection_limit,omitempty" gorm:"default:null"`
+	ApprovalRequired  bool                 `json:"approval_required,omitempty" gorm:"default:null"`
 	KeyId             string               `json:"key_id,omitempty" gorm:"not_null"`
 
 	// State fields
@@ -432,6 +456,7 @@ type sessionListView struct {
 	PreviousEndTime *timestamp.Timestamp `json:"previous_end_time,omitempty" gorm:"default:current_timestamp"`
 	StartTime       *timestamp.Timestamp `json:"start_time,omitempty" gorm:"default:current_timestamp;primary_key"`
 	EndTime         *timestamp.Timestamp `json:"end_time,omitempty" gorm:"default:current_timestamp"`
+	ApproverId      string               `json:"approver_id,omitempty" gorm:"default:null"`
 
 	// Connection fields
 	ConnectionId       string `json:"connection_id,omitempty" gorm:"default:null"`
@@ -442,6 +467,10 @@ type sessionListView struct {
 	BytesUp            uint64 `json:"bytes_up,omitempty" gorm:"default:null"`
 	BytesDown          uint64 `json:"bytes_down,omitempty" gorm:"default:null"`
 	ClosedReason       string `json:"closed_reason,omitempty" gorm:"default:null"`
+	RecordingId        string `json:"recording_id,omitempty" gorm:"default:null"`
+
+	ConnectionCreateTime *timestamp.Timestamp `json:"connection_create_time,omitempty" gorm:"default:null"`
+	ConnectionClosedTime *timestamp.Timestamp `json:"connection_closed_time,omitempty" gorm:"default:null"`
 }
 
 // TableName returns the tablename to override the default gorm table name
@@ -12,6 +12,7 @@ type ConnectWith struct {
 	ClientTcpPort      uint32
 	EndpointTcpAddress string
 	EndpointTcpPort    uint32
+	RecordingId        string
 }
 
 func (c ConnectWith) validate() error {
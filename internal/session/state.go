@@ -19,10 +19,11 @@ const (
 type Status string
 
 const (
-	StatusPending    Status = "pending"
-	StatusActive     Status = "active"
-	StatusCanceling  Status = "canceling"
-	StatusTerminated Status = "terminated"
+	StatusPendingApproval Status = "pending_approval"
+	StatusPending         Status = "pending"
+	StatusActive          Status = "active"
+	StatusCanceling       Status = "canceling"
+	StatusTerminated      Status = "terminated"
 )
 
 // String representation of the state's status
@@ -33,6 +34,8 @@ func (s Status) String() string {
 // ProtoVal returns the enum value corresponding to the state
 func (s Status) ProtoVal() workerpbs.SESSIONSTATUS {
 	switch s {
+	case StatusPendingApproval:
+		return workerpbs.SESSIONSTATUS_SESSIONSTATUS_PENDING_APPROVAL
 	case StatusPending:
 		return workerpbs.SESSIONSTATUS_SESSIONSTATUS_PENDING
 	case StatusActive:
@@ -57,6 +60,9 @@ type State struct {
 	StartTime *timestamp.Timestamp `json:"start_time,omitempty" gorm:"default:current_timestamp;primary_key"`
 	// EndTime from the RDBMS
 	EndTime *timestamp.Timestamp `json:"end_time,omitempty" gorm:"default:current_timestamp"`
+	// ApproverId is the user which approved or denied the session, for the
+	// states entered by approving or denying it
+	ApproverId string `json:"approver_id,omitempty" gorm:"default:null"`
 
 	tableName string `gorm:"-"`
 }
@@ -89,8 +95,9 @@ func allocState() State {
 // Clone creates a clone of the State
 func (s *State) Clone() interface{} {
 	clone := &State{
-		SessionId: s.SessionId,
-		Status:    s.Status,
+		SessionId:  s.SessionId,
+		Status:     s.Status,
+		ApproverId: s.ApproverId,
 	}
 	if s.PreviousEndTime != nil {
 		clone.PreviousEndTime = &timestamp.Timestamp{
@@ -24,7 +24,9 @@ import (
 )
 
 // TestConnection creates a test connection for the sessionId in the repository.
-func TestConnection(t *testing.T, conn *db.DB, sessionId, clientTcpAddr string, clientTcpPort uint32, endpointTcpAddr string, endpointTcpPort uint32) *Connection {
+// Supports the WithServerId option to set the worker handling the connection,
+// which must exist.
+func TestConnection(t *testing.T, conn *db.DB, sessionId, clientTcpAddr string, clientTcpPort uint32, endpointTcpAddr string, endpointTcpPort uint32, opt ...Option) *Connection {
 	t.Helper()
 	require := require.New(t)
 	rw := db.New(conn)
@@ -35,6 +37,11 @@ func TestConnection(t *testing.T, conn *db.DB, sessionId, clientTcpAddr string,
 	c.PublicId = id
 	err = rw.Create(context.Background(), c)
 	require.NoError(err)
+	if opts := getOpts(opt...); opts.withServerId != "" {
+		_, err = rw.Exec(context.Background(), "update session_connection set server_id = ? where public_id = ?",
+			[]interface{}{opts.withServerId, c.PublicId})
+		require.NoError(err)
+	}
 
 	connectedState, err := NewConnectionState(c.PublicId, StatusConnected)
 	require.NoError(err)
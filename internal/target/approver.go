@@ -0,0 +1,146 @@
+package target
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/target/store"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	approverViewDefaultTable  = "target_approver"
+	approverUserDefaultTable  = "target_approver_user"
+	approverGroupDefaultTable = "target_approver_group"
+)
+
+// A TargetApprover represents a user or group which can approve sessions for
+// a target which requires approval.
+type TargetApprover struct {
+	*store.TargetApprover
+	tableName string `gorm:"-"`
+}
+
+var _ db.VetForWriter = (*TargetApprover)(nil)
+
+// NewTargetApprover creates a new in memory TargetApprover. The principalId
+// must be the id of a user or a group. No options are currently supported.
+func NewTargetApprover(targetId, principalId string, _ ...Option) (*TargetApprover, error) {
+	const op = "target.NewTargetApprover"
+	if targetId == "" {
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing target id")
+	}
+	var tableName string
+	switch {
+	case strings.HasPrefix(principalId, iam.UserPrefix+"_"):
+		tableName = approverUserDefaultTable
+	case strings.HasPrefix(principalId, iam.GroupPrefix+"_"):
+		tableName = approverGroupDefaultTable
+	default:
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("invalid approver id %q: must be a user or group id", principalId))
+	}
+	return &TargetApprover{
+		TargetApprover: &store.TargetApprover{
+			TargetId:    targetId,
+			PrincipalId: principalId,
+		},
+		tableName: tableName,
+	}, nil
+}
+
+// Clone creates a clone of the TargetApprover
+func (t *TargetApprover) Clone() interface{} {
+	cp := proto.Clone(t.TargetApprover)
+	return &TargetApprover{
+		TargetApprover: cp.(*store.TargetApprover),
+		tableName:      t.tableName,
+	}
+}
+
+// VetForWrite implements db.VetForWrite() interface and validates the target
+// approver before it's written.
+func (t *TargetApprover) VetForWrite(ctx context.Context, _ db.Reader, opType db.OpType, _ ...db.Option) error {
+	const op = "target.(TargetApprover).VetForWrite"
+	if opType == db.CreateOp {
+		if t.TargetId == "" {
+			return errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+		}
+		if t.PrincipalId == "" {
+			return errors.New(ctx, errors.InvalidParameter, op, "missing principal id")
+		}
+	}
+	return nil
+}
+
+// TableName returns the tablename to override the default gorm table name.
+// The default is the view of the approvers of all principal types.
+func (t *TargetApprover) TableName() string {
+	if t.tableName != "" {
+		return t.tableName
+	}
+	return approverViewDefaultTable
+}
+
+// SetTableName sets the tablename and satisfies the ReplayableMessage
+// interface. If the caller attempts to set the name to "" the name will be
+// reset to the default name.
+func (t *TargetApprover) SetTableName(n string) {
+	t.tableName = n
+}
+
+// fetchApproverIds returns the ids of the approvers of the target.
+func fetchApproverIds(ctx context.Context, r db.Reader, targetId string) ([]string, error) {
+	const op = "target.fetchApproverIds"
+	var approvers []*TargetApprover
+	if err := r.SearchWhere(ctx, &approvers, "target_id = ?", []interface{}{targetId}, db.WithOrder("principal_id")); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(approvers) == 0 {
+		return nil, nil
+	}
+	ids := make([]string, 0, len(approvers))
+	for _, a := range approvers {
+		ids = append(ids, a.PrincipalId)
+	}
+	return ids, nil
+}
+
+// approverChanges returns the approvers which must be added and deleted to
+// change the approvers of the target from current to ids.
+func approverChanges(targetId string, current, ids []string) (add []interface{}, del []interface{}, err error) {
+	const op = "target.approverChanges"
+	remove := make(map[string]struct{}, len(current))
+	for _, id := range current {
+		remove[id] = struct{}{}
+	}
+	seen := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		if _, ok := remove[id]; ok {
+			// found a match, so do nothing (we want to keep it), but remove
+			// it from the ones to delete
+			delete(remove, id)
+			continue
+		}
+		a, err := NewTargetApprover(targetId, id)
+		if err != nil {
+			return nil, nil, errors.WrapDeprecated(err, op)
+		}
+		add = append(add, a)
+	}
+	for id := range remove {
+		a, err := NewTargetApprover(targetId, id)
+		if err != nil {
+			return nil, nil, errors.WrapDeprecated(err, op)
+		}
+		del = append(del, a)
+	}
+	return add, del, nil
+}
@@ -0,0 +1,138 @@
+package target
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTargetApprover(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		targetId      string
+		principalId   string
+		wantTableName string
+		wantErr       bool
+	}{
+		{
+			name:          "user",
+			targetId:      "ttcp_1234567890",
+			principalId:   "u_1234567890",
+			wantTableName: approverUserDefaultTable,
+		},
+		{
+			name:          "group",
+			targetId:      "ttcp_1234567890",
+			principalId:   "g_1234567890",
+			wantTableName: approverGroupDefaultTable,
+		},
+		{
+			name:        "missing-target-id",
+			principalId: "u_1234567890",
+			wantErr:     true,
+		},
+		{
+			name:        "invalid-principal-id",
+			targetId:    "ttcp_1234567890",
+			principalId: "r_1234567890",
+			wantErr:     true,
+		},
+		{
+			name:     "missing-principal-id",
+			targetId: "ttcp_1234567890",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewTargetApprover(tt.targetId, tt.principalId)
+			if tt.wantErr {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.targetId, got.TargetId)
+			assert.Equal(tt.principalId, got.PrincipalId)
+			assert.Equal(tt.wantTableName, got.TableName())
+
+			cp := got.Clone().(*TargetApprover)
+			assert.Equal(got.TableName(), cp.TableName())
+			cp.SetTableName("")
+			assert.Equal(approverViewDefaultTable, cp.TableName())
+		})
+	}
+}
+
+func Test_approverChanges(t *testing.T) {
+	t.Parallel()
+	ids := func(items []interface{}) []string {
+		var ret []string
+		for _, i := range items {
+			ret = append(ret, i.(*TargetApprover).PrincipalId)
+		}
+		sort.Strings(ret)
+		return ret
+	}
+	tests := []struct {
+		name    string
+		current []string
+		ids     []string
+		wantAdd []string
+		wantDel []string
+		wantErr bool
+	}{
+		{
+			name:    "add",
+			ids:     []string{"u_1", "g_1"},
+			wantAdd: []string{"g_1", "u_1"},
+		},
+		{
+			name:    "delete",
+			current: []string{"u_1", "g_1"},
+			wantDel: []string{"g_1", "u_1"},
+		},
+		{
+			name:    "add-and-delete",
+			current: []string{"u_1", "g_1"},
+			ids:     []string{"u_1", "u_2"},
+			wantAdd: []string{"u_2"},
+			wantDel: []string{"g_1"},
+		},
+		{
+			name:    "duplicates",
+			ids:     []string{"u_1", "u_1"},
+			wantAdd: []string{"u_1"},
+		},
+		{
+			name:    "no-change",
+			current: []string{"u_1"},
+			ids:     []string{"u_1"},
+		},
+		{
+			name:    "invalid-id",
+			ids:     []string{"hst_1"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			add, del, err := approverChanges("ttcp_1234567890", tt.current, tt.ids)
+			if tt.wantErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantAdd, ids(add))
+			assert.Equal(tt.wantDel, ids(del))
+		})
+	}
+}
@@ -35,6 +35,12 @@ type options struct {
 	WithSessionConnectionLimit int32
 	WithPublicId               string
 	WithWorkerFilter           string
+	WithIngressWorkerFilter    string
+	WithApprovalRequired       bool
+	WithApproverIds            []string
+	WithMaxConcurrentSessions  int32
+	WithHostKeys               string
+	WithStartPageAfterId       string
 }
 
 func getDefaultOptions() options {
@@ -54,6 +60,12 @@ func getDefaultOptions() options {
 		WithSessionConnectionLimit: 1,
 		WithPublicId:               "",
 		WithWorkerFilter:           "",
+		WithIngressWorkerFilter:    "",
+		WithApprovalRequired:       false,
+		WithApproverIds:            nil,
+		WithMaxConcurrentSessions:  -1,
+		WithHostKeys:               "",
+		WithStartPageAfterId:       "",
 	}
 }
 
@@ -161,3 +173,52 @@ func WithWorkerFilter(filter string) Option {
 		o.WithWorkerFilter = filter
 	}
 }
+
+// WithIngressWorkerFilter provides an optional filter for the workers clients
+// connect to
+func WithIngressWorkerFilter(filter string) Option {
+	return func(o *options) {
+		o.WithIngressWorkerFilter = filter
+	}
+}
+
+// WithApprovalRequired provides an option to require sessions to be approved
+// before they can be activated
+func WithApprovalRequired(required bool) Option {
+	return func(o *options) {
+		o.WithApprovalRequired = required
+	}
+}
+
+// WithApproverIds provides an option for providing a list of the ids of the
+// users and groups which can approve sessions
+func WithApproverIds(ids []string) Option {
+	return func(o *options) {
+		o.WithApproverIds = ids
+	}
+}
+
+// WithMaxConcurrentSessions provides an option for the maximum number of
+// sessions which can be open for a target at the same time
+func WithMaxConcurrentSessions(limit int32) Option {
+	return func(o *options) {
+		o.WithMaxConcurrentSessions = limit
+	}
+}
+
+// WithHostKeys provides an option for providing the public keys of the hosts
+// of a target in the format of an OpenSSH authorized_keys file
+func WithHostKeys(keys string) Option {
+	return func(o *options) {
+		o.WithHostKeys = keys
+	}
+}
+
+// WithStartPageAfterId provides an option to only list the targets whose
+// public id sorts after the provided id. Combined with WithLimit it returns a
+// page of a listing.
+func WithStartPageAfterId(id string) Option {
+	return func(o *options) {
+		o.WithStartPageAfterId = id
+	}
+}
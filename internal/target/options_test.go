@@ -108,6 +108,41 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.WithWorkerFilter = `"/foo" == "bar"`
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithIngressWorkerFilter", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithIngressWorkerFilter(`"/tags/type" contains "edge"`))
+		testOpts := getDefaultOptions()
+		testOpts.WithIngressWorkerFilter = `"/tags/type" contains "edge"`
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithApprovalRequired", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithApprovalRequired(true))
+		testOpts := getDefaultOptions()
+		testOpts.WithApprovalRequired = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithApproverIds", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithApproverIds([]string{"u_1234567890", "g_1234567890"}))
+		testOpts := getDefaultOptions()
+		testOpts.WithApproverIds = []string{"u_1234567890", "g_1234567890"}
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithMaxConcurrentSessions", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithMaxConcurrentSessions(5))
+		testOpts := getDefaultOptions()
+		testOpts.WithMaxConcurrentSessions = 5
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithStartPageAfterId", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithStartPageAfterId("ttcp_1234567890"))
+		testOpts := getDefaultOptions()
+		testOpts.WithStartPageAfterId = "ttcp_1234567890"
+		assert.Equal(opts, testOpts)
+	})
 	t.Run("WithCredentialLibraries", func(t *testing.T) {
 		assert := assert.New(t)
 		opts := GetOpts(WithCredentialLibraries([]*CredentialLibrary{
@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/target"
+)
+
+func init() {
+	target.Register(Subtype, newTarget, allocTarget, vet, target.VetEgressCredentialLibraries, TargetPrefix)
+}
+
+const (
+	// TargetPrefix is the prefix for public ids of a postgres.Target.
+	TargetPrefix = "tpg"
+)
+
+// vet validates that the given target.Target is a postgres.Target and that it
+// has a Target store.
+func vet(ctx context.Context, t target.Target) error {
+	const op = "postgres.vet"
+
+	tt, ok := t.(*Target)
+	if !ok {
+		return errors.New(ctx, errors.InvalidParameter, op, "target is not a postgres.Target")
+	}
+
+	if tt == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target")
+	}
+
+	if tt.Target == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target store")
+	}
+	return nil
+}
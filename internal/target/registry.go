@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/hashicorp/boundary/internal/credential"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/types/subtypes"
 )
@@ -141,6 +142,36 @@ func New(ctx context.Context, subtype subtypes.Subtype, scopeId string, opt ...O
 	return nf(scopeId, opt...)
 }
 
+// VetCredentialLibraries checks that the credential libraries can be used by
+// a target of the given subtype. Not every subtype can use credentials of
+// every purpose; for example, only subtypes whose workers inject credentials
+// into the connection to the endpoint support egress credentials.
+func VetCredentialLibraries(ctx context.Context, subtype subtypes.Subtype, cls []*CredentialLibrary) error {
+	const op = "target.VetCredentialLibraries"
+	vet, ok := subtypeRegistry.vetCredentialLibrariesFunc(subtype)
+	if !ok {
+		return errors.New(ctx, errors.InvalidParameter, op, "unsupported subtype")
+	}
+	return vet(ctx, cls)
+}
+
+// VetEgressCredentialLibraries is a VetCredentialLibrariesFunc for subtypes
+// whose workers authenticate to the endpoint on behalf of the user. It checks
+// that all of the provided credential libraries have a CredentialPurpose of
+// ApplicationPurpose or EgressPurpose. Any other CredentialPurpose will result
+// in an error.
+func VetEgressCredentialLibraries(ctx context.Context, cls []*CredentialLibrary) error {
+	const op = "target.VetEgressCredentialLibraries"
+	for _, cl := range cls {
+		switch credential.Purpose(cl.CredentialPurpose) {
+		case credential.ApplicationPurpose, credential.EgressPurpose:
+		default:
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("only credential purposes %q and %q are supported", credential.ApplicationPurpose, credential.EgressPurpose))
+		}
+	}
+	return nil
+}
+
 // Register registers repository hooks and the prefixes for a provided Subtype. Register
 // panics if the subtype has already been registered or if any of the
 // prefixes are associated with another subtype.
@@ -0,0 +1,65 @@
+package target_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVetEgressCredentialLibraries(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		purposes []credential.Purpose
+		wantErr  bool
+	}{
+		{
+			name: "none",
+		},
+		{
+			name:     "application",
+			purposes: []credential.Purpose{credential.ApplicationPurpose},
+		},
+		{
+			name:     "egress",
+			purposes: []credential.Purpose{credential.EgressPurpose},
+		},
+		{
+			name:     "application-and-egress",
+			purposes: []credential.Purpose{credential.ApplicationPurpose, credential.EgressPurpose},
+		},
+		{
+			name:     "ingress",
+			purposes: []credential.Purpose{credential.EgressPurpose, credential.IngressPurpose},
+			wantErr:  true,
+		},
+		{
+			name:     "unknown",
+			purposes: []credential.Purpose{"unknown"},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var cls []*target.CredentialLibrary
+			for _, p := range tt.purposes {
+				cl, err := target.NewCredentialLibrary("ttcp_1234567890", "clvlt_1234567890", p)
+				assert.NoError(t, err)
+				cls = append(cls, cl)
+			}
+			err := target.VetEgressCredentialLibraries(ctx, cls)
+			if tt.wantErr {
+				assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
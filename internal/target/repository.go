@@ -54,7 +54,7 @@ func NewRepository(r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repo
 }
 
 // LookupTarget will look up a target in the repository and return the target
-// with its host source ids and credential source ids.  If the target is not
+// with its host source ids, credential source ids and approver ids.  If the target is not
 // found, it will return nil, nil, nil, nil. No options are currently supported.
 func (r *Repository) LookupTarget(ctx context.Context, publicIdOrName string, opt ...Option) (Target, []HostSource, []CredentialSource, error) {
 	const op = "target.(Repository).LookupTarget"
@@ -99,6 +99,7 @@ func (r *Repository) LookupTarget(ctx context.Context, publicIdOrName string, op
 	target.PublicId = publicIdOrName
 	var hostSources []HostSource
 	var credSources []CredentialSource
+	var approverIds []string
 	_, err := r.writer.DoTx(
 		ctx,
 		db.StdRetryCnt,
@@ -122,6 +123,9 @@ func (r *Repository) LookupTarget(ctx context.Context, publicIdOrName string, op
 			if credSources, err = fetchCredentialSources(ctx, read, target.PublicId); err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
+			if approverIds, err = fetchApproverIds(ctx, read, target.PublicId); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
 			return nil
 		},
 	)
@@ -135,10 +139,12 @@ func (r *Repository) LookupTarget(ctx context.Context, publicIdOrName string, op
 	if err != nil {
 		return nil, nil, nil, errors.Wrap(ctx, err, op)
 	}
+	subtype.SetApproverIds(approverIds)
 	return subtype, hostSources, credSources, nil
 }
 
-// ListTargets in targets in a scope.  Supports the WithScopeId, WithLimit, WithType options.
+// ListTargets in targets in a scope.  Supports the WithScopeId, WithLimit, WithType
+// and WithStartPageAfterId options. Targets are ordered by public id.
 func (r *Repository) ListTargets(ctx context.Context, opt ...Option) ([]Target, error) {
 	const op = "target.(Repository).ListTargets"
 	opts := GetOpts(opt...)
@@ -154,6 +160,9 @@ func (r *Repository) ListTargets(ctx context.Context, opt ...Option) ([]Target,
 	if opts.WithType != "" {
 		where, args = append(where, "type = ?"), append(args, opts.WithType.String())
 	}
+	if opts.WithStartPageAfterId != "" {
+		where, args = append(where, "public_id > ?"), append(args, opts.WithStartPageAfterId)
+	}
 
 	var foundTargets []*targetView
 	err := r.list(ctx, &foundTargets, strings.Join(where, " and "), args, opt...)
@@ -173,8 +182,8 @@ func (r *Repository) ListTargets(ctx context.Context, opt ...Option) ([]Target,
 	return targets, nil
 }
 
-// list will return a listing of resources and honor the WithLimit option or the
-// repo defaultLimit
+// list will return a listing of resources ordered by public id and honor the
+// WithLimit option or the repo defaultLimit
 func (r *Repository) list(ctx context.Context, resources interface{}, where string, args []interface{}, opt ...Option) error {
 	const op = "target.(Repository).list"
 	opts := GetOpts(opt...)
@@ -184,7 +193,7 @@ func (r *Repository) list(ctx context.Context, resources interface{}, where stri
 		// non-zero signals an override of the default limit for the repo.
 		limit = opts.WithLimit
 	}
-	dbOpts = append(dbOpts, db.WithLimit(limit))
+	dbOpts = append(dbOpts, db.WithLimit(limit), db.WithOrder("public_id asc"))
 	if err := r.reader.SearchWhere(ctx, resources, where, args, dbOpts...); err != nil {
 		return errors.Wrap(ctx, err, op)
 	}
@@ -247,9 +256,10 @@ func (r *Repository) DeleteTarget(ctx context.Context, publicId string, _ ...Opt
 	return rowsDeleted, nil
 }
 
-// update a target in the db repository with an oplog entry.
-// It currently supports no options.
-func (r *Repository) update(ctx context.Context, target Target, version uint32, fieldMaskPaths []string, setToNullPaths []string, _ ...Option) (Target, []HostSource, []CredentialSource, int, error) {
+// update a target in the db repository with an oplog entry. If
+// updateApprovers is true, the approvers of the target are also set to the
+// target's approver ids. It currently supports no options.
+func (r *Repository) update(ctx context.Context, target Target, version uint32, fieldMaskPaths []string, setToNullPaths []string, updateApprovers bool, _ ...Option) (Target, []HostSource, []CredentialSource, int, error) {
 	const op = "target.(Repository).update"
 	if version == 0 {
 		return nil, nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
@@ -261,9 +271,6 @@ func (r *Repository) update(ctx context.Context, target Target, version uint32,
 	if !ok {
 		return nil, nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "target is not cloneable")
 	}
-	dbOpts := []db.Option{
-		db.WithVersion(&version),
-	}
 	scopeId := target.GetScopeId()
 	if scopeId == "" {
 		t := allocTargetView()
@@ -278,10 +285,9 @@ func (r *Repository) update(ctx context.Context, target Target, version uint32,
 		return nil, nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
 	}
 	metadata := target.Oplog(oplog.OpType_OP_TYPE_UPDATE)
-	dbOpts = append(dbOpts, db.WithOplog(oplogWrapper, metadata))
 
 	var rowsUpdated int
-	var returnedTarget interface{}
+	var returnedTarget Target
 	var hostSources []HostSource
 	var credSources []CredentialSource
 	_, err = r.writer.DoTx(
@@ -289,13 +295,20 @@ func (r *Repository) update(ctx context.Context, target Target, version uint32,
 		db.StdRetryCnt,
 		db.ExpBackoff{},
 		func(reader db.Reader, w db.Writer) error {
+			targetTicket, err := w.GetTicket(target)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+			}
+			msgs := make([]*oplog.Message, 0, 3)
+			var targetOplogMsg oplog.Message
 			returnedTarget = cloner.Clone()
 			rowsUpdated, err = w.Update(
 				ctx,
 				returnedTarget,
 				fieldMaskPaths,
 				setToNullPaths,
-				dbOpts...,
+				db.NewOplogMsg(&targetOplogMsg),
+				db.WithVersion(&version),
 			)
 			if err != nil {
 				return errors.Wrap(ctx, err, op)
@@ -304,6 +317,44 @@ func (r *Repository) update(ctx context.Context, target Target, version uint32,
 				// return err, which will result in a rollback of the update
 				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
 			}
+			if rowsUpdated == 0 {
+				return nil
+			}
+			msgs = append(msgs, &targetOplogMsg)
+
+			if updateApprovers {
+				currentApproverIds, err := fetchApproverIds(ctx, reader, target.GetPublicId())
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				addApprovers, deleteApprovers, err := approverChanges(target.GetPublicId(), currentApproverIds, target.GetApproverIds())
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if len(addApprovers) > 0 {
+					approverOplogMsgs := make([]*oplog.Message, 0, len(addApprovers))
+					if err := w.CreateItems(ctx, addApprovers, db.NewOplogMsgs(&approverOplogMsgs)); err != nil {
+						return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add target approvers"))
+					}
+					msgs = append(msgs, approverOplogMsgs...)
+					metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_CREATE.String())
+				}
+				if len(deleteApprovers) > 0 {
+					approverOplogMsgs := make([]*oplog.Message, 0, len(deleteApprovers))
+					rowsDeleted, err := w.DeleteItems(ctx, deleteApprovers, db.NewOplogMsgs(&approverOplogMsgs))
+					if err != nil {
+						return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete target approvers"))
+					}
+					if rowsDeleted != len(deleteApprovers) {
+						return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("target approvers deleted %d did not match request for %d", rowsDeleted, len(deleteApprovers)))
+					}
+					msgs = append(msgs, approverOplogMsgs...)
+					metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_DELETE.String())
+				}
+			}
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, targetTicket, metadata, msgs); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+			}
 
 			if hostSources, err = fetchHostSources(ctx, reader, target.GetPublicId()); err != nil {
 				return errors.Wrap(ctx, err, op)
@@ -312,13 +363,31 @@ func (r *Repository) update(ctx context.Context, target Target, version uint32,
 			if credSources, err = fetchCredentialSources(ctx, reader, target.GetPublicId()); err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
+
+			approverIds, err := fetchApproverIds(ctx, reader, target.GetPublicId())
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if len(approverIds) == 0 {
+				// Sessions for a target without approvers could never be
+				// approved.
+				tv := allocTargetView()
+				tv.PublicId = target.GetPublicId()
+				if err := reader.LookupByPublicId(ctx, &tv); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if tv.GetApprovalRequired() {
+					return errors.New(ctx, errors.InvalidParameter, op, "approval required but no approvers")
+				}
+			}
+			returnedTarget.SetApproverIds(approverIds)
 			return nil
 		},
 	)
 	if err != nil {
 		return nil, nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
 	}
-	return returnedTarget.(Target), hostSources, credSources, rowsUpdated, nil
+	return returnedTarget, hostSources, credSources, rowsUpdated, nil
 }
 
 // CreateTarget inserts into the repository and returns the new Target with
@@ -344,6 +413,9 @@ func (r *Repository) CreateTarget(ctx context.Context, target Target, opt ...Opt
 	if target.GetName() == "" {
 		return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing name")
 	}
+	if target.GetApprovalRequired() && len(target.GetApproverIds()) == 0 {
+		return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, "approval required but no approvers")
+	}
 	if target.GetPublicId() != "" {
 		return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
 	}
@@ -391,6 +463,17 @@ func (r *Repository) CreateTarget(ctx context.Context, target Target, opt ...Opt
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create target"))
 			}
 			msgs = append(msgs, &targetOplogMsg)
+			addApprovers, _, err := approverChanges(t.GetPublicId(), nil, t.GetApproverIds())
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if len(addApprovers) > 0 {
+				approverOplogMsgs := make([]*oplog.Message, 0, len(addApprovers))
+				if err := w.CreateItems(ctx, addApprovers, db.NewOplogMsgs(&approverOplogMsgs)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add target approvers"))
+				}
+				msgs = append(msgs, approverOplogMsgs...)
+			}
 			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, targetTicket, metadata, msgs); err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
 			}
@@ -427,6 +510,7 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 		return nil, nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing target public id")
 	}
 
+	var updateApprovers bool
 	for _, f := range fieldMaskPaths {
 		switch {
 		case strings.EqualFold("name", f):
@@ -435,26 +519,46 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 		case strings.EqualFold("sessionmaxseconds", f):
 		case strings.EqualFold("sessionconnectionlimit", f):
 		case strings.EqualFold("workerfilter", f):
+		case strings.EqualFold("approvalrequired", f):
+		case strings.EqualFold("approverids", f):
+			updateApprovers = true
+		case strings.EqualFold("maxconcurrentsessions", f):
+		case strings.EqualFold("ingressworkerfilter", f):
+		case strings.EqualFold("hostkeys", f):
 		default:
 			return nil, nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
 		}
 	}
+	fieldValues := map[string]interface{}{
+		"Name":                   target.GetName(),
+		"Description":            target.GetDescription(),
+		"DefaultPort":            target.GetDefaultPort(),
+		"SessionMaxSeconds":      target.GetSessionMaxSeconds(),
+		"SessionConnectionLimit": target.GetSessionConnectionLimit(),
+		"WorkerFilter":           target.GetWorkerFilter(),
+		"ApprovalRequired":       target.GetApprovalRequired(),
+		"MaxConcurrentSessions":  target.GetMaxConcurrentSessions(),
+		"IngressWorkerFilter":    target.GetIngressWorkerFilter(),
+	}
+	if hk, ok := target.(HostKeyTarget); ok {
+		fieldValues["HostKeys"] = hk.GetHostKeys()
+	}
 	var dbMask, nullFields []string
 	dbMask, nullFields = dbcommon.BuildUpdatePaths(
-		map[string]interface{}{
-			"Name":                   target.GetName(),
-			"Description":            target.GetDescription(),
-			"DefaultPort":            target.GetDefaultPort(),
-			"SessionMaxSeconds":      target.GetSessionMaxSeconds(),
-			"SessionConnectionLimit": target.GetSessionConnectionLimit(),
-			"WorkerFilter":           target.GetWorkerFilter(),
-		},
+		fieldValues,
 		fieldMaskPaths,
-		[]string{"SessionMaxSeconds", "SessionConnectionLimit"},
+		[]string{"SessionMaxSeconds", "SessionConnectionLimit", "ApprovalRequired", "MaxConcurrentSessions"},
 	)
-	if len(dbMask) == 0 && len(nullFields) == 0 {
+	if len(dbMask) == 0 && len(nullFields) == 0 && !updateApprovers {
 		return nil, nil, nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
 	}
+	t := target.Clone()
+	if len(dbMask) == 0 && len(nullFields) == 0 {
+		// Only the approvers are being changed, so update the version of the
+		// target to record the change.
+		t.SetVersion(version + 1)
+		dbMask = append(dbMask, "Version")
+	}
 	var returnedTarget Target
 	var rowsUpdated int
 	var hostSources []HostSource
@@ -465,8 +569,7 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 		db.ExpBackoff{},
 		func(read db.Reader, w db.Writer) error {
 			var err error
-			t := target.Clone()
-			returnedTarget, hostSources, credSources, rowsUpdated, err = r.update(ctx, t, version, dbMask, nullFields)
+			returnedTarget, hostSources, credSources, rowsUpdated, err = r.update(ctx, t, version, dbMask, nullFields, updateApprovers)
 			if err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
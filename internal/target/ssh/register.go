@@ -0,0 +1,42 @@
+package ssh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/target"
+)
+
+func init() {
+	target.Register(Subtype, newTarget, allocTarget, vet, target.VetEgressCredentialLibraries, TargetPrefix)
+}
+
+const (
+	// TargetPrefix is the prefix for public ids of an ssh.Target.
+	TargetPrefix = "tssh"
+)
+
+// vet validates that the given target.Target is an ssh.Target and that it
+// has a Target store.
+func vet(ctx context.Context, t target.Target) error {
+	const op = "ssh.vet"
+
+	tt, ok := t.(*Target)
+	if !ok {
+		return errors.New(ctx, errors.InvalidParameter, op, "target is not an ssh.Target")
+	}
+
+	if tt == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target")
+	}
+
+	if tt.Target == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target store")
+	}
+
+	if _, err := ParseHostKeys(tt.HostKeys); err != nil {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid host keys: %v", err))
+	}
+	return nil
+}
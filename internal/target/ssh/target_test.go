@@ -0,0 +1,70 @@
+package ssh_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/target/ssh"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gossh "golang.org/x/crypto/ssh"
+)
+
+func TestParseHostKeys(t *testing.T) {
+	t.Parallel()
+	newKey := func(t *testing.T) gossh.PublicKey {
+		t.Helper()
+		pub, _, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		pk, err := gossh.NewPublicKey(pub)
+		require.NoError(t, err)
+		return pk
+	}
+	key1, key2 := newKey(t), newKey(t)
+	line1, line2 := string(gossh.MarshalAuthorizedKey(key1)), string(gossh.MarshalAuthorizedKey(key2))
+
+	tests := []struct {
+		name    string
+		keys    string
+		want    []gossh.PublicKey
+		wantErr bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "one-key",
+			keys: line1,
+			want: []gossh.PublicKey{key1},
+		},
+		{
+			name: "multiple-keys-with-comments",
+			keys: "# web servers\n" + line1 + "\n\n" + strings.TrimSpace(line2) + " host2.example.com\n# end",
+			want: []gossh.PublicKey{key1, key2},
+		},
+		{
+			name:    "invalid-key",
+			keys:    line1 + "ssh-ed25519 not-a-key\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := ssh.ParseHostKeys(tt.keys)
+			if tt.wantErr {
+				require.Error(err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			require.Len(got, len(tt.want))
+			for i := range tt.want {
+				assert.Equal(tt.want[i].Marshal(), got[i].Marshal())
+			}
+		})
+	}
+}
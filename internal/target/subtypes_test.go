@@ -0,0 +1,488 @@
+package target_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/vault"
+	"github.com/hashicorp/boundary/internal/db"
+	dbassert "github.com/hashicorp/boundary/internal/db/assert"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/postgres"
+	"github.com/hashicorp/boundary/internal/target/ssh"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
+	"github.com/hashicorp/go-uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// testSubtypes are the target subtypes which only differ from each other by
+// their table, public id prefix and subtype specific attributes.  The tests
+// in this file are run against each of them.
+var testSubtypes = []struct {
+	subtype      subtypes.Subtype
+	prefix       string
+	tableName    string
+	resourceType string
+}{
+	{
+		subtype:      ssh.Subtype,
+		prefix:       ssh.TargetPrefix,
+		tableName:    "target_ssh",
+		resourceType: "ssh target",
+	},
+	{
+		subtype:      postgres.Subtype,
+		prefix:       postgres.TargetPrefix,
+		tableName:    "target_postgres",
+		resourceType: "postgres target",
+	},
+}
+
+func testSubtypeId(t *testing.T, prefix string) string {
+	t.Helper()
+	id, err := uuid.GenerateUUID()
+	require.NoError(t, err)
+	return fmt.Sprintf("%s_%s", prefix, id)
+}
+
+func testSubtypeTargetName(t *testing.T, prefix, scopeId string) string {
+	t.Helper()
+	return fmt.Sprintf("%s-%s", scopeId, testSubtypeId(t, prefix))
+}
+
+// newTestSubtypeTarget creates an in memory Target of the subtype with
+// scopeId, bypassing the scope id check performed by target.New.
+func newTestSubtypeTarget(t *testing.T, subtype subtypes.Subtype, scopeId string) target.Target {
+	t.Helper()
+	tar, err := target.New(context.Background(), subtype, "testScope")
+	require.NoError(t, err)
+	tar.SetScopeId(scopeId)
+	return tar
+}
+
+func TestSubtype_Create(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	ctx := context.Background()
+	for _, st := range testSubtypes {
+		st := st
+		t.Run(st.subtype.String(), func(t *testing.T) {
+			type args struct {
+				scopeId string
+				opt     []target.Option
+			}
+			tests := []struct {
+				name      string
+				args      args
+				want      target.Target
+				wantErr   bool
+				wantIsErr errors.Code
+				create    bool
+			}{
+				{
+					name:      "empty-scopeId",
+					args:      args{},
+					wantErr:   true,
+					wantIsErr: errors.InvalidParameter,
+				},
+				{
+					name: "valid-proj-scope",
+					args: args{
+						scopeId: prj.PublicId,
+						opt:     []target.Option{target.WithName("valid-proj-scope")},
+					},
+					want: func() target.Target {
+						t, _ := target.New(
+							ctx,
+							st.subtype,
+							prj.PublicId,
+							target.WithName("valid-proj-scope"),
+							target.WithSessionMaxSeconds(uint32((8 * time.Hour).Seconds())),
+							target.WithSessionConnectionLimit(1),
+						)
+						return t
+					}(),
+					create: true,
+				},
+			}
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					assert, require := assert.New(t), require.New(t)
+					got, err := target.New(ctx, st.subtype, tt.args.scopeId, tt.args.opt...)
+					if tt.wantErr {
+						require.Error(err)
+						assert.True(errors.Match(errors.T(tt.wantIsErr), err))
+						return
+					}
+					require.NoError(err)
+					assert.Equal(tt.want, got)
+					if tt.create {
+						id, err := db.NewPublicId(st.prefix)
+						require.NoError(err)
+						require.NoError(got.SetPublicId(ctx, id))
+						assert.NoError(db.New(conn).Create(ctx, got))
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestSubtype_Delete(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	ctx := context.Background()
+	for _, st := range testSubtypes {
+		st := st
+		t.Run(st.subtype.String(), func(t *testing.T) {
+			tests := []struct {
+				name            string
+				target          target.Target
+				wantRowsDeleted int
+			}{
+				{
+					name:            "valid",
+					target:          target.TestTarget(ctx, t, conn, st.subtype, proj.PublicId, testSubtypeTargetName(t, st.prefix, proj.PublicId)),
+					wantRowsDeleted: 1,
+				},
+				{
+					name: "bad-id",
+					target: func() target.Target {
+						tar, _ := target.New(ctx, st.subtype, proj.PublicId)
+						id, err := db.NewPublicId(st.prefix)
+						require.NoError(t, err)
+						tar.SetPublicId(ctx, id)
+						tar.SetName(testSubtypeTargetName(t, st.prefix, proj.PublicId))
+						return tar
+					}(),
+					wantRowsDeleted: 0,
+				},
+			}
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					assert, require := assert.New(t), require.New(t)
+					deleteTarget := newTestSubtypeTarget(t, st.subtype, "")
+					deleteTarget.SetPublicId(ctx, tt.target.GetPublicId())
+					deletedRows, err := rw.Delete(ctx, deleteTarget)
+					require.NoError(err)
+					assert.Equal(tt.wantRowsDeleted, deletedRows)
+					if tt.wantRowsDeleted == 0 {
+						return
+					}
+					foundTarget := newTestSubtypeTarget(t, st.subtype, "")
+					foundTarget.SetPublicId(ctx, tt.target.GetPublicId())
+					err = rw.LookupById(ctx, foundTarget)
+					require.Error(err)
+					assert.True(errors.IsNotFoundError(err))
+				})
+			}
+		})
+	}
+}
+
+func TestSubtype_Update(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	for _, st := range testSubtypes {
+		st := st
+		t.Run(st.subtype.String(), func(t *testing.T) {
+			id := testSubtypeId(t, st.prefix)
+			type args struct {
+				name           string
+				description    string
+				fieldMaskPaths []string
+				nullPaths      []string
+				ScopeId        string
+			}
+			tests := []struct {
+				name           string
+				args           args
+				wantRowsUpdate int
+				wantErr        bool
+				wantErrMsg     string
+				wantDup        bool
+			}{
+				{
+					name: "valid",
+					args: args{
+						name:           "valid" + id,
+						fieldMaskPaths: []string{"Name"},
+						ScopeId:        proj.PublicId,
+					},
+					wantRowsUpdate: 1,
+				},
+				{
+					name: "empty-scope-id",
+					args: args{
+						name:           "empty-scope-id" + id,
+						fieldMaskPaths: []string{"Name"},
+						ScopeId:        "",
+					},
+					wantRowsUpdate: 1,
+				},
+				{
+					name: "dup-name",
+					args: args{
+						name:           "dup-name" + id,
+						fieldMaskPaths: []string{"Name"},
+						ScopeId:        proj.PublicId,
+					},
+					wantErr:    true,
+					wantDup:    true,
+					wantErrMsg: fmt.Sprintf(`db.Update: duplicate key value violates unique constraint "%s_scope_id_name_key": unique constraint violation: integrity violation: error #1002`, st.tableName),
+				},
+				{
+					name: "set description null",
+					args: args{
+						name:           "set description null" + id,
+						fieldMaskPaths: []string{"Name"},
+						nullPaths:      []string{"Description"},
+						ScopeId:        proj.PublicId,
+					},
+					wantRowsUpdate: 1,
+				},
+				{
+					name: "set name null",
+					args: args{
+						description:    "set name null" + id,
+						fieldMaskPaths: []string{"Description"},
+						nullPaths:      []string{"Name"},
+						ScopeId:        proj.PublicId,
+					},
+					wantErr:    true,
+					wantErrMsg: `db.Update: name must not be empty: not null constraint violated: integrity violation: error #1001`,
+				},
+			}
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					assert, require := assert.New(t), require.New(t)
+					ctx := context.Background()
+					if tt.wantDup {
+						dup := target.TestTarget(ctx, t, conn, st.subtype, proj.PublicId, testSubtypeTargetName(t, st.prefix, proj.PublicId))
+						dup.SetName(tt.args.name)
+						_, err := rw.Update(ctx, dup, tt.args.fieldMaskPaths, tt.args.nullPaths)
+						require.NoError(err)
+					}
+
+					id := testSubtypeId(t, st.prefix)
+					tar := target.TestTarget(ctx, t, conn, st.subtype, proj.PublicId, id, target.WithDescription(id))
+
+					updateTarget := newTestSubtypeTarget(t, st.subtype, tt.args.ScopeId)
+					updateTarget.SetPublicId(ctx, tar.GetPublicId())
+					updateTarget.SetName(tt.args.name)
+					updateTarget.SetDescription(tt.args.description)
+
+					updatedRows, err := rw.Update(ctx, updateTarget, tt.args.fieldMaskPaths, tt.args.nullPaths)
+					if tt.wantErr {
+						require.Error(err)
+						assert.Equal(0, updatedRows)
+						assert.Equal(tt.wantErrMsg, err.Error())
+						err = db.TestVerifyOplog(t, rw, tar.GetPublicId(), db.WithOperation(oplog.OpType_OP_TYPE_UPDATE), db.WithCreateNotBefore(10*time.Second))
+						require.Error(err)
+						assert.Contains(err.Error(), "record not found")
+						return
+					}
+					require.NoError(err)
+					assert.Equal(tt.wantRowsUpdate, updatedRows)
+					assert.NotEqual(tar.GetUpdateTime(), updateTarget.GetUpdateTime())
+					foundTarget := newTestSubtypeTarget(t, st.subtype, tt.args.ScopeId)
+					foundTarget.SetPublicId(ctx, tar.GetPublicId())
+					err = rw.LookupByPublicId(ctx, foundTarget)
+					require.NoError(err)
+					assert.True(proto.Equal(updateTarget.(proto.Message), foundTarget.(proto.Message)))
+					if len(tt.args.nullPaths) != 0 {
+						underlyingDB, err := conn.SqlDB(ctx)
+						require.NoError(err)
+						dbassert := dbassert.New(t, underlyingDB)
+						for _, f := range tt.args.nullPaths {
+							dbassert.IsNull(foundTarget, f)
+						}
+					}
+				})
+			}
+			t.Run("update dup names in diff scopes", func(t *testing.T) {
+				ctx := context.Background()
+				assert, require := assert.New(t), require.New(t)
+				id := testSubtypeId(t, st.prefix)
+				_, proj2 := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+				_ = target.TestTarget(ctx, t, conn, st.subtype, proj2.PublicId, id, target.WithDescription(id))
+				projTarget := target.TestTarget(ctx, t, conn, st.subtype, proj.PublicId, testSubtypeTargetName(t, st.prefix, proj.PublicId))
+				projTarget.SetName(id)
+				updatedRows, err := rw.Update(ctx, projTarget, []string{"Name"}, nil)
+				require.NoError(err)
+				assert.Equal(1, updatedRows)
+
+				foundTarget := newTestSubtypeTarget(t, st.subtype, proj.PublicId)
+				foundTarget.SetPublicId(ctx, projTarget.GetPublicId())
+				err = rw.LookupByPublicId(ctx, foundTarget)
+				require.NoError(err)
+				assert.Equal(id, foundTarget.GetName())
+			})
+		})
+	}
+}
+
+func TestSubtype_Clone(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	for _, st := range testSubtypes {
+		st := st
+		t.Run(st.subtype.String(), func(t *testing.T) {
+			t.Run("valid", func(t *testing.T) {
+				assert := assert.New(t)
+				_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+				tar := target.TestTarget(ctx, t, conn, st.subtype, proj.PublicId, testSubtypeTargetName(t, st.prefix, proj.PublicId))
+				cp := tar.Clone()
+				assert.True(proto.Equal(cp.(proto.Message), tar.(proto.Message)))
+			})
+			t.Run("not-equal", func(t *testing.T) {
+				assert := assert.New(t)
+				_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+				_, proj2 := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+				target1 := target.TestTarget(ctx, t, conn, st.subtype, proj.PublicId, testSubtypeTargetName(t, st.prefix, proj.PublicId))
+				target2 := target.TestTarget(ctx, t, conn, st.subtype, proj2.PublicId, testSubtypeTargetName(t, st.prefix, proj2.PublicId))
+				cp := target1.Clone()
+				assert.False(proto.Equal(cp.(proto.Message), target2.(proto.Message)))
+			})
+		})
+	}
+}
+
+func TestSubtype_SetTableName(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	type tableNamer interface {
+		TableName() string
+		SetTableName(string)
+	}
+	for _, st := range testSubtypes {
+		st := st
+		t.Run(st.subtype.String(), func(t *testing.T) {
+			tests := []struct {
+				name      string
+				setNameTo string
+				want      string
+			}{
+				{
+					name:      "new-name",
+					setNameTo: "new-name",
+					want:      "new-name",
+				},
+				{
+					name:      "reset to default",
+					setNameTo: "",
+					want:      st.tableName,
+				},
+			}
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					assert, require := assert.New(t), require.New(t)
+					def, err := target.New(ctx, st.subtype, "testScope")
+					require.NoError(err)
+					require.Equal(st.tableName, def.(tableNamer).TableName())
+					s, err := target.New(ctx, st.subtype, "testScope")
+					require.NoError(err)
+					s.(tableNamer).SetTableName(tt.setNameTo)
+					assert.Equal(tt.want, s.(tableNamer).TableName())
+				})
+			}
+		})
+	}
+}
+
+func TestSubtype_oplog(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	for _, st := range testSubtypes {
+		st := st
+		t.Run(st.subtype.String(), func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			id := testSubtypeId(t, st.prefix)
+			tar, err := target.New(ctx, st.subtype, id)
+			require.NoError(err)
+			require.NoError(tar.SetPublicId(ctx, id))
+			want := oplog.Metadata{
+				"resource-public-id": []string{id},
+				"resource-type":      []string{st.resourceType},
+				"op-type":            []string{oplog.OpType_OP_TYPE_CREATE.String()},
+				"scope-id":           []string{id},
+			}
+			assert.Equal(want, tar.Oplog(oplog.OpType_OP_TYPE_CREATE))
+		})
+	}
+}
+
+func TestSubtype_TestTarget(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, proj := iam.TestScopes(t, iamRepo)
+	repo, err := target.NewRepository(rw, rw, testKms)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	cats := static.TestCatalogs(t, conn, proj.PublicId, 1)
+	hsets := static.TestSets(t, conn, cats[0].GetPublicId(), 2)
+	var sets []string
+	for _, s := range hsets {
+		sets = append(sets, s.PublicId)
+	}
+	credStore := vault.TestCredentialStores(t, conn, wrapper, proj.GetPublicId(), 1)[0]
+	vlibs := vault.TestCredentialLibraries(t, conn, wrapper, credStore.GetPublicId(), 2)
+
+	for _, st := range testSubtypes {
+		st := st
+		t.Run(st.subtype.String(), func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			var libIds []string
+			var libs []*target.CredentialLibrary
+			for _, v := range vlibs {
+				libIds = append(libIds, v.GetPublicId())
+				libs = append(libs, target.TestNewCredentialLibrary("", v.GetPublicId(), credential.ApplicationPurpose))
+			}
+
+			name := testSubtypeTargetName(t, st.prefix, proj.PublicId)
+			tar := target.TestTarget(ctx, t, conn, st.subtype, proj.PublicId, name, target.WithHostSources(sets), target.WithCredentialLibraries(libs))
+			require.NotNil(tar)
+			assert.Equal(st.subtype, tar.GetType())
+			assert.Equal(st.subtype, target.SubtypeFromId(tar.GetPublicId()))
+			assert.Equal(name, tar.GetName())
+
+			_, foundHostSources, foundCredSources, err := repo.LookupTarget(ctx, tar.GetPublicId())
+			require.NoError(err)
+			foundIds := make([]string, 0, len(foundHostSources))
+			for _, s := range foundHostSources {
+				foundIds = append(foundIds, s.Id())
+			}
+			assert.ElementsMatch(sets, foundIds)
+			foundIds = make([]string, 0, len(foundCredSources))
+			for _, s := range foundCredSources {
+				foundIds = append(foundIds, s.Id())
+			}
+			assert.ElementsMatch(libIds, foundIds)
+		})
+	}
+}
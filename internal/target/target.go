@@ -25,6 +25,10 @@ type Target interface {
 	GetSessionMaxSeconds() uint32
 	GetSessionConnectionLimit() int32
 	GetWorkerFilter() string
+	GetApprovalRequired() bool
+	GetApproverIds() []string
+	GetMaxConcurrentSessions() int32
+	GetIngressWorkerFilter() string
 	Clone() Target
 	SetPublicId(context.Context, string) error
 	SetScopeId(string)
@@ -37,9 +41,22 @@ type Target interface {
 	SetSessionMaxSeconds(uint32)
 	SetSessionConnectionLimit(int32)
 	SetWorkerFilter(string)
+	SetApprovalRequired(bool)
+	SetApproverIds([]string)
+	SetMaxConcurrentSessions(int32)
+	SetIngressWorkerFilter(string)
 	Oplog(op oplog.OpType) oplog.Metadata
 }
 
+// HostKeyTarget is implemented by the target subtypes which verify the public
+// keys presented by their hosts, such as ssh targets. The host keys are in the
+// format of an OpenSSH authorized_keys file.
+type HostKeyTarget interface {
+	Target
+	GetHostKeys() string
+	SetHostKeys(string)
+}
+
 const (
 	targetsViewDefaultTable = "target_all_subtypes"
 )
@@ -104,5 +121,11 @@ func (t *targetView) targetSubtype(ctx context.Context) (Target, error) {
 	tt.SetSessionMaxSeconds(t.SessionMaxSeconds)
 	tt.SetSessionConnectionLimit(t.SessionConnectionLimit)
 	tt.SetWorkerFilter(t.WorkerFilter)
+	tt.SetApprovalRequired(t.ApprovalRequired)
+	tt.SetMaxConcurrentSessions(t.MaxConcurrentSessions)
+	tt.SetIngressWorkerFilter(t.IngressWorkerFilter)
+	if hk, ok := tt.(HostKeyTarget); ok {
+		hk.SetHostKeys(t.HostKeys)
+	}
 	return tt, nil
 }
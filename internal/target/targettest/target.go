@@ -116,6 +116,22 @@ func (t *Target) GetWorkerFilter() string {
 	return t.WorkerFilter
 }
 
+func (t *Target) GetApprovalRequired() bool {
+	return t.ApprovalRequired
+}
+
+func (t *Target) GetApproverIds() []string {
+	return t.ApproverIds
+}
+
+func (t *Target) GetMaxConcurrentSessions() int32 {
+	return t.MaxConcurrentSessions
+}
+
+func (t *Target) GetIngressWorkerFilter() string {
+	return t.IngressWorkerFilter
+}
+
 func (t *Target) Clone() target.Target {
 	cp := proto.Clone(t.Target)
 	return &Target{
@@ -168,6 +184,22 @@ func (t *Target) SetWorkerFilter(f string) {
 	t.WorkerFilter = f
 }
 
+func (t *Target) SetApprovalRequired(r bool) {
+	t.ApprovalRequired = r
+}
+
+func (t *Target) SetApproverIds(ids []string) {
+	t.ApproverIds = ids
+}
+
+func (t *Target) SetMaxConcurrentSessions(limit int32) {
+	t.MaxConcurrentSessions = limit
+}
+
+func (t *Target) SetIngressWorkerFilter(filter string) {
+	t.IngressWorkerFilter = filter
+}
+
 func (t *Target) Oplog(op oplog.OpType) oplog.Metadata {
 	return oplog.Metadata{
 		"resource-public-id": []string{t.PublicId},
@@ -224,6 +256,10 @@ func New(scopeId string, opt ...target.Option) (target.Target, error) {
 			SessionConnectionLimit: opts.WithSessionConnectionLimit,
 			SessionMaxSeconds:      opts.WithSessionMaxSeconds,
 			WorkerFilter:           opts.WithWorkerFilter,
+			ApprovalRequired:       opts.WithApprovalRequired,
+			ApproverIds:            opts.WithApproverIds,
+			MaxConcurrentSessions:  opts.WithMaxConcurrentSessions,
+			IngressWorkerFilter:    opts.WithIngressWorkerFilter,
 		},
 	}
 	return t, nil
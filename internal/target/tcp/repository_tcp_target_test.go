@@ -488,3 +488,137 @@ func TestRepository_UpdateTcpTarget(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_TargetApprovers(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	repo, err := target.NewRepository(rw, rw, testKms)
+	require.NoError(t, err)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, proj := iam.TestScopes(t, iamRepo)
+	approver := iam.TestUser(t, iamRepo, org.PublicId)
+	ctx := context.Background()
+
+	t.Run("create-no-approvers", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tar, err := target.New(ctx, tcp.Subtype, proj.PublicId, target.WithName("create-no-approvers"), target.WithApprovalRequired(true))
+		require.NoError(err)
+		got, _, _, err := repo.CreateTarget(ctx, tar)
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %s", err)
+		assert.Nil(got)
+	})
+	t.Run("update-remove-approvers", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tar, err := target.New(ctx, tcp.Subtype, proj.PublicId,
+			target.WithName("update-remove-approvers"),
+			target.WithApprovalRequired(true),
+			target.WithApproverIds([]string{approver.PublicId}))
+		require.NoError(err)
+		created, _, _, err := repo.CreateTarget(ctx, tar)
+		require.NoError(err)
+		assert.Equal([]string{approver.PublicId}, created.GetApproverIds())
+
+		upd := created.Clone()
+		upd.SetApproverIds(nil)
+		_, _, _, _, err = repo.UpdateTarget(ctx, upd, created.GetVersion(), []string{"ApproverIds"})
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %s", err)
+
+		found, _, _, err := repo.LookupTarget(ctx, created.GetPublicId())
+		require.NoError(err)
+		assert.Equal([]string{approver.PublicId}, found.GetApproverIds())
+
+		upd.SetApprovalRequired(false)
+		got, _, _, _, err := repo.UpdateTarget(ctx, upd, created.GetVersion(), []string{"ApprovalRequired", "ApproverIds"})
+		require.NoError(err)
+		assert.False(got.GetApprovalRequired())
+		assert.Empty(got.GetApproverIds())
+	})
+}
+
+func TestRepository_TargetMaxConcurrentSessions(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	repo, err := target.NewRepository(rw, rw, testKms)
+	require.NoError(t, err)
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	ctx := context.Background()
+
+	t.Run("default-unlimited", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tar, err := target.New(ctx, tcp.Subtype, proj.PublicId, target.WithName("default-unlimited"))
+		require.NoError(err)
+		got, _, _, err := repo.CreateTarget(ctx, tar)
+		require.NoError(err)
+		assert.Equal(int32(-1), got.GetMaxConcurrentSessions())
+	})
+	t.Run("invalid-limit", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tar, err := target.New(ctx, tcp.Subtype, proj.PublicId, target.WithName("invalid-limit"), target.WithMaxConcurrentSessions(-2))
+		require.NoError(err)
+		got, _, _, err := repo.CreateTarget(ctx, tar)
+		assert.Error(err)
+		assert.Nil(got)
+	})
+	t.Run("update", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tar, err := target.New(ctx, tcp.Subtype, proj.PublicId, target.WithName("update"), target.WithMaxConcurrentSessions(2))
+		require.NoError(err)
+		created, _, _, err := repo.CreateTarget(ctx, tar)
+		require.NoError(err)
+		assert.Equal(int32(2), created.GetMaxConcurrentSessions())
+
+		upd := created.Clone()
+		upd.SetMaxConcurrentSessions(-1)
+		got, _, _, _, err := repo.UpdateTarget(ctx, upd, created.GetVersion(), []string{"MaxConcurrentSessions"})
+		require.NoError(err)
+		assert.Equal(int32(-1), got.GetMaxConcurrentSessions())
+	})
+}
+
+func TestRepository_TargetIngressWorkerFilter(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	repo, err := target.NewRepository(rw, rw, testKms)
+	require.NoError(t, err)
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	ctx := context.Background()
+
+	t.Run("invalid-filter", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tar, err := target.New(ctx, tcp.Subtype, proj.PublicId, target.WithName("invalid-filter"), target.WithIngressWorkerFilter(" "))
+		require.NoError(err)
+		got, _, _, err := repo.CreateTarget(ctx, tar)
+		assert.Error(err)
+		assert.Nil(got)
+	})
+	t.Run("update", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tar, err := target.New(ctx, tcp.Subtype, proj.PublicId, target.WithName("update"),
+			target.WithWorkerFilter(`"inner" in "/tags/type"`),
+			target.WithIngressWorkerFilter(`"edge" in "/tags/type"`))
+		require.NoError(err)
+		created, _, _, err := repo.CreateTarget(ctx, tar)
+		require.NoError(err)
+		assert.Equal(`"edge" in "/tags/type"`, created.GetIngressWorkerFilter())
+
+		got, _, _, err := repo.LookupTarget(ctx, created.GetPublicId())
+		require.NoError(err)
+		assert.Equal(`"edge" in "/tags/type"`, got.GetIngressWorkerFilter())
+
+		upd := created.Clone()
+		upd.SetIngressWorkerFilter("")
+		updated, _, _, _, err := repo.UpdateTarget(ctx, upd, created.GetVersion(), []string{"IngressWorkerFilter"})
+		require.NoError(err)
+		assert.Empty(updated.GetIngressWorkerFilter())
+		assert.Equal(`"inner" in "/tags/type"`, updated.GetWorkerFilter())
+	})
+}
@@ -3,6 +3,7 @@ package tcp_test
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
 	"testing"
 	"time"
@@ -266,6 +267,44 @@ func TestRepository_ListRoles_Multiple_Scopes(t *testing.T) {
 	assert.Equal(t, total, len(got))
 }
 
+func TestRepository_ListTargets_Pages(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, proj := iam.TestScopes(t, iamRepo)
+	rw := db.New(conn)
+	repo, err := target.NewRepository(rw, rw, testKms)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	const total, pageSize = 7, 3
+	var want []string
+	for i := 0; i < total; i++ {
+		want = append(want, tcp.TestTarget(ctx, t, conn, proj.GetPublicId(), strconv.Itoa(i)).GetPublicId())
+	}
+	sort.Strings(want)
+
+	var got []string
+	var lastId string
+	for {
+		page, err := repo.ListTargets(ctx,
+			target.WithScopeIds([]string{proj.GetPublicId()}),
+			target.WithLimit(pageSize),
+			target.WithStartPageAfterId(lastId))
+		require.NoError(t, err)
+		for _, tar := range page {
+			got = append(got, tar.GetPublicId())
+		}
+		if len(page) < pageSize {
+			break
+		}
+		lastId = page[len(page)-1].GetPublicId()
+	}
+	assert.Equal(t, want, got)
+}
+
 func TestRepository_DeleteTarget(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
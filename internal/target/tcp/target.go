@@ -54,6 +54,10 @@ func newTarget(scopeId string, opt ...target.Option) (target.Target, error) {
 			SessionConnectionLimit: opts.WithSessionConnectionLimit,
 			SessionMaxSeconds:      opts.WithSessionMaxSeconds,
 			WorkerFilter:           opts.WithWorkerFilter,
+			ApprovalRequired:       opts.WithApprovalRequired,
+			ApproverIds:            opts.WithApproverIds,
+			MaxConcurrentSessions:  opts.WithMaxConcurrentSessions,
+			IngressWorkerFilter:    opts.WithIngressWorkerFilter,
 		},
 	}
 	return t, nil
@@ -171,3 +175,19 @@ func (t *Target) SetSessionConnectionLimit(limit int32) {
 func (t *Target) SetWorkerFilter(filter string) {
 	t.WorkerFilter = filter
 }
+
+func (t *Target) SetApprovalRequired(required bool) {
+	t.ApprovalRequired = required
+}
+
+func (t *Target) SetApproverIds(ids []string) {
+	t.ApproverIds = ids
+}
+
+func (t *Target) SetMaxConcurrentSessions(limit int32) {
+	t.MaxConcurrentSessions = limit
+}
+
+func (t *Target) SetIngressWorkerFilter(filter string) {
+	t.IngressWorkerFilter = filter
+}
@@ -7,9 +7,52 @@ import (
 	"github.com/hashicorp/boundary/internal/credential"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/target/store"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
 	"github.com/stretchr/testify/require"
 )
 
+// TestTarget creates a Target of the given subtype named name in scopeId.
+// Host sources and credential libraries provided with WithHostSources and
+// WithCredentialLibraries are added to the Target. The subtype must be
+// registered.
+func TestTarget(ctx context.Context, t *testing.T, conn *db.DB, subtype subtypes.Subtype, scopeId, name string, opt ...Option) Target {
+	t.Helper()
+	opt = append(opt, WithName(name))
+	opts := GetOpts(opt...)
+	require := require.New(t)
+	rw := db.New(conn)
+	prefix, ok := subtypeRegistry.idPrefix(subtype)
+	require.True(ok, "unregistered target subtype %s", subtype)
+	tar, err := New(ctx, subtype, scopeId, opt...)
+	require.NoError(err)
+	id, err := db.NewPublicId(prefix)
+	require.NoError(err)
+	require.NoError(tar.SetPublicId(ctx, id))
+	err = rw.Create(ctx, tar)
+	require.NoError(err)
+
+	if len(opts.WithHostSources) > 0 {
+		newHostSets := make([]interface{}, 0, len(opts.WithHostSources))
+		for _, s := range opts.WithHostSources {
+			hostSet, err := NewTargetHostSet(tar.GetPublicId(), s)
+			require.NoError(err)
+			newHostSets = append(newHostSets, hostSet)
+		}
+		err := rw.CreateItems(ctx, newHostSets)
+		require.NoError(err)
+	}
+	if len(opts.WithCredentialLibraries) > 0 {
+		newCredLibs := make([]interface{}, 0, len(opts.WithCredentialLibraries))
+		for _, cl := range opts.WithCredentialLibraries {
+			cl.TargetId = tar.GetPublicId()
+			newCredLibs = append(newCredLibs, cl)
+		}
+		err := rw.CreateItems(ctx, newCredLibs)
+		require.NoError(err)
+	}
+	return tar
+}
+
 // TestNewCredentialLibrary creates a new in memory CredentialLibrary
 // representing the relationship between targetId and credentialLibraryId with
 // the given purpose.
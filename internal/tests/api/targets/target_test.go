@@ -1,6 +1,7 @@
 package targets_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -11,10 +12,12 @@ import (
 	"github.com/hashicorp/boundary/api/hostcatalogs"
 	"github.com/hashicorp/boundary/api/hostsets"
 	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/servers/controller"
 	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/mitchellh/cli"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -165,6 +168,36 @@ func TestList(t *testing.T) {
 	assert.Equal(filterItem.Id, ul.Items[0].Id)
 }
 
+func TestList_JsonFormatPages(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	tc := controller.NewTestController(t, nil)
+	defer tc.Shutdown()
+
+	client := tc.Client()
+	token := tc.Token()
+	client.SetToken(token.Token)
+	_, proj := iam.TestScopes(t, tc.IamRepo(), iam.WithUserId(token.UserId))
+
+	tarClient := targets.NewClient(client)
+	for i := 0; i < 3; i++ {
+		_, err := tarClient.Create(tc.Context(), "tcp", proj.GetPublicId(), targets.WithName(fmt.Sprint(i)))
+		require.NoError(err)
+	}
+	ul, err := tarClient.List(tc.Context(), proj.GetPublicId(), targets.WithPageSize(1))
+	require.NoError(err)
+	require.Len(ul.Items, 3)
+
+	ui := cli.NewMockUi()
+	require.True(base.NewCommand(ui).PrintJsonItems(ul))
+	var out struct {
+		StatusCode int               `json:"status_code"`
+		Items      []*targets.Target `json:"items"`
+	}
+	require.NoError(json.Unmarshal(ui.OutputWriter.Bytes(), &out))
+	assert.Equal(http.StatusOK, out.StatusCode)
+	assert.ElementsMatch(comparableSlice(ul.Items), comparableSlice(out.Items))
+}
+
 func comparableSlice(in []*targets.Target) []targets.Target {
 	var filtered []targets.Target
 	for _, i := range in {
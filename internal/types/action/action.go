@@ -53,6 +53,18 @@ const (
 	AddHostSources            Type = 42
 	SetHostSources            Type = 43
 	RemoveHostSources         Type = 44
+	ReadRecording             Type = 45
+	Approve                   Type = 46
+	Deny                      Type = 47
+	RotateKeys                Type = 48
+	ListKeys                  Type = 49
+	DestroyKeyVersion         Type = 50
+	Unlock                    Type = 51
+	EnrollTotp                Type = 52
+	ConfirmTotp               Type = 53
+	RemoveTotp                Type = 54
+	RevokeAllForUser          Type = 55
+	Introspect                Type = 56
 )
 
 var Map = map[string]Type{
@@ -100,6 +112,18 @@ var Map = map[string]Type{
 	AddHostSources.String():            AddHostSources,
 	SetHostSources.String():            SetHostSources,
 	RemoveHostSources.String():         RemoveHostSources,
+	ReadRecording.String():             ReadRecording,
+	Approve.String():                   Approve,
+	Deny.String():                      Deny,
+	RotateKeys.String():                RotateKeys,
+	ListKeys.String():                  ListKeys,
+	DestroyKeyVersion.String():         DestroyKeyVersion,
+	Unlock.String():                    Unlock,
+	EnrollTotp.String():                EnrollTotp,
+	ConfirmTotp.String():               ConfirmTotp,
+	RemoveTotp.String():                RemoveTotp,
+	RevokeAllForUser.String():          RevokeAllForUser,
+	Introspect.String():                Introspect,
 }
 
 func (a Type) String() string {
@@ -149,6 +173,18 @@ func (a Type) String() string {
 		"add-host-sources",
 		"set-host-sources",
 		"remove-host-sources",
+		"read-recording",
+		"approve",
+		"deny",
+		"rotate-keys",
+		"list-keys",
+		"destroy-key-version",
+		"unlock",
+		"enroll-totp",
+		"confirm-totp",
+		"remove-totp",
+		"revoke-all-for-user",
+		"introspect",
 	}[a]
 }
 
@@ -95,6 +95,54 @@ func TestAction(t *testing.T) {
 			action: NoOp,
 			want:   "no-op",
 		},
+		{
+			action: ReadRecording,
+			want:   "read-recording",
+		},
+		{
+			action: Approve,
+			want:   "approve",
+		},
+		{
+			action: Deny,
+			want:   "deny",
+		},
+		{
+			action: RotateKeys,
+			want:   "rotate-keys",
+		},
+		{
+			action: ListKeys,
+			want:   "list-keys",
+		},
+		{
+			action: DestroyKeyVersion,
+			want:   "destroy-key-version",
+		},
+		{
+			action: Unlock,
+			want:   "unlock",
+		},
+		{
+			action: EnrollTotp,
+			want:   "enroll-totp",
+		},
+		{
+			action: ConfirmTotp,
+			want:   "confirm-totp",
+		},
+		{
+			action: RemoveTotp,
+			want:   "remove-totp",
+		},
+		{
+			action: RevokeAllForUser,
+			want:   "revoke-all-for-user",
+		},
+		{
+			action: Introspect,
+			want:   "introspect",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.want, func(t *testing.T) {
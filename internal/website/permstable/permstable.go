@@ -71,6 +71,7 @@ func main() {
 		hostCatalog,
 		hostSet,
 		managedGroup,
+		plugin,
 		role,
 		scope,
 		session,
@@ -342,6 +343,38 @@ var account = &Resource{
 						"id=<pin>;type=<type>;actions=change-password",
 					},
 				},
+				&Action{
+					Name:        "unlock",
+					Description: "Unlock an account locked out after too many failed authentication attempts",
+					Examples: []string{
+						"id=<id>;actions=unlock",
+						"id=<pin>;type=<type>;actions=unlock",
+					},
+				},
+				&Action{
+					Name:        "enroll-totp",
+					Description: "Start enrolling an account in TOTP multi-factor authentication",
+					Examples: []string{
+						"id=<id>;actions=enroll-totp",
+						"id=<pin>;type=<type>;actions=enroll-totp",
+					},
+				},
+				&Action{
+					Name:        "confirm-totp",
+					Description: "Confirm the TOTP enrollment of an account given a TOTP code",
+					Examples: []string{
+						"id=<id>;actions=confirm-totp",
+						"id=<pin>;type=<type>;actions=confirm-totp",
+					},
+				},
+				&Action{
+					Name:        "remove-totp",
+					Description: "Remove the TOTP enrollment and recovery codes of an account",
+					Examples: []string{
+						"id=<id>;actions=remove-totp",
+						"id=<pin>;type=<type>;actions=remove-totp",
+					},
+				},
 			),
 		},
 	},
@@ -395,6 +428,20 @@ var authToken = &Resource{
 						"type=<type>;actions=list",
 					},
 				},
+				{
+					Name:        "revoke-all-for-user",
+					Description: "Revoke all auth tokens of a user",
+					Examples: []string{
+						"id=*;type=<type>;actions=revoke-all-for-user",
+					},
+				},
+				{
+					Name:        "introspect",
+					Description: "Check the validity of an auth token and return its expiration and principals",
+					Examples: []string{
+						"id=*;type=<type>;actions=introspect",
+					},
+				},
 			},
 		},
 		{
@@ -585,6 +632,44 @@ var managedGroup = &Resource{
 	},
 }
 
+var plugin = &Resource{
+	Type:   "Plugin",
+	Scopes: []string{"Global"},
+	Endpoints: []*Endpoint{
+		{
+			Path: "/plugins",
+			Params: map[string]string{
+				"Type": "plugin",
+			},
+			Actions: []*Action{
+				{
+					Name:        "list",
+					Description: "List plugins",
+					Examples: []string{
+						"type=<type>;actions=list",
+					},
+				},
+			},
+		},
+		{
+			Path: "/plugins/<id>",
+			Params: map[string]string{
+				"ID":   "<id>",
+				"Type": "plugin",
+			},
+			Actions: []*Action{
+				{
+					Name:        "read",
+					Description: "Read a plugin",
+					Examples: []string{
+						"id=<id>;actions=read",
+					},
+				},
+			},
+		},
+	},
+}
+
 var role = &Resource{
 	Type:   "Role",
 	Scopes: append(iamScopes, infraScope...),
@@ -668,7 +753,30 @@ var scope = &Resource{
 				"ID":   "<id>",
 				"Type": "scope",
 			},
-			Actions: rudActions("a scope", false),
+			Actions: append(
+				rudActions("a scope", false),
+				&Action{
+					Name:        "list-keys",
+					Description: "List the keys of a scope and their versions",
+					Examples: []string{
+						"id=<id>;actions=list-keys",
+					},
+				},
+				&Action{
+					Name:        "rotate-keys",
+					Description: "Create new versions of the keys of a scope",
+					Examples: []string{
+						"id=<id>;actions=rotate-keys",
+					},
+				},
+				&Action{
+					Name:        "destroy-key-version",
+					Description: "Destroy a version of a key of a scope",
+					Examples: []string{
+						"id=<id>;actions=destroy-key-version",
+					},
+				},
+			),
 		},
 	},
 }
@@ -727,6 +835,27 @@ var session = &Resource{
 						"id=*;type=session;actions=cancel:self",
 					},
 				},
+				{
+					Name:        "read-recording",
+					Description: "Read the recording of a connection of a session",
+					Examples: []string{
+						"id=<id>;actions=read-recording",
+					},
+				},
+				{
+					Name:        "approve",
+					Description: "Approve a session which is pending approval",
+					Examples: []string{
+						"id=<id>;actions=approve",
+					},
+				},
+				{
+					Name:        "deny",
+					Description: "Deny a session which is pending approval",
+					Examples: []string{
+						"id=<id>;actions=deny",
+					},
+				},
 			},
 		},
 	},
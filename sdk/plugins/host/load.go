@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"io/fs"
 	"io/ioutil"
@@ -162,6 +163,14 @@ func executeHostPlugin(plugin pluginInfo, opt ...Option) (pb.HostPluginServiceCl
 		buf = uncompBuf.Bytes()
 	}
 
+	// If a checksum was given, verify the plugin before writing it out
+	if opts.withHostPluginChecksum != nil {
+		sum := sha256.Sum256(buf)
+		if !bytes.Equal(sum[:], opts.withHostPluginChecksum) {
+			return nil, nil, fmt.Errorf("host plugin checksum mismatch: expected %x, got %x", opts.withHostPluginChecksum, sum)
+		}
+	}
+
 	cleanup := func() error {
 		return nil
 	}
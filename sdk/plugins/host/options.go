@@ -1,6 +1,7 @@
 package external_host_plugins
 
 import (
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"io/fs"
@@ -32,6 +33,7 @@ type Option func(*options) error
 type options struct {
 	withHostPluginsSources     []pluginSourceInfo
 	withHostPluginExecutionDir string
+	withHostPluginChecksum     []byte
 	withLogger                 hclog.Logger
 }
 
@@ -117,3 +119,17 @@ func WithHostPluginExecutionDir(dir string) Option {
 		return nil
 	}
 }
+
+// WithHostPluginChecksum provides the SHA-256 checksum a plugin binary must
+// match before it is executed. For compressed plugins the checksum is that of
+// the uncompressed binary. Plugins provided through WithHostPluginsMap are not
+// checked.
+func WithHostPluginChecksum(sum []byte) Option {
+	return func(o *options) error {
+		if len(sum) != sha256.Size {
+			return fmt.Errorf("host plugin checksum must be %d bytes, got %d", sha256.Size, len(sum))
+		}
+		o.withHostPluginChecksum = sum
+		return nil
+	}
+}